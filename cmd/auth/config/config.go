@@ -4,32 +4,51 @@ import (
 	"errors"
 	"os"
 
+	sessionRepository "kudago/internal/auth/repository/session"
+	"kudago/internal/mail"
 	"kudago/internal/repository/postgres"
-
-	sessionRepository "kudago/internal/repository/redis"
+	redisDB "kudago/internal/repository/redis"
 )
 
 type Config struct {
 	PostgresConfig postgres.PostgresConfig
-	RedisConfig    sessionRepository.RedisConfig
+	RedisConfig    redisDB.RedisConfig
+	SessionConfig  sessionRepository.SessionConfig
+	MailConfig     mail.Config
+	AppURL         string
 	ServiceAddr    string
 }
 
+const defaultAppURL = "https://vyhodnoy.online"
+
 func LoadConfig() (Config, error) {
 	var conf Config
 
-	redisConfig, err := sessionRepository.GetRedisConfig()
+	redisConfig, err := redisDB.GetRedisConfig()
 	if err != nil {
 		return Config{}, errors.New("Failed to connect to the redis database")
 	}
 	conf.RedisConfig = *redisConfig
 
+	sessionConfig, err := sessionRepository.GetSessionConfig()
+	if err != nil {
+		return Config{}, err
+	}
+	conf.SessionConfig = sessionConfig
+
 	postgresConfig, err := postgres.GetPostgresConfig()
 	if err != nil {
 		return Config{}, errors.New("Failed to connect to the postgres database")
 	}
 	conf.PostgresConfig = postgresConfig
 
+	conf.MailConfig = mail.GetConfig()
+
+	conf.AppURL = os.Getenv("APP_URL")
+	if conf.AppURL == "" {
+		conf.AppURL = defaultAppURL
+	}
+
 	conf.ServiceAddr = os.Getenv("AUTH_SERVICE_ADDR")
 	if conf.ServiceAddr == "" {
 		return Config{}, errors.New("Failed to get service address")
@@ -8,11 +8,14 @@ import (
 	"kudago/cmd/auth/config"
 	proto "kudago/internal/auth/api"
 	grpcAuth "kudago/internal/auth/grpc"
+	attemptsRepository "kudago/internal/auth/repository/attempts"
 	authRepository "kudago/internal/auth/repository/auth"
 	sessionRepository "kudago/internal/auth/repository/session"
+	tokensRepository "kudago/internal/auth/repository/tokens"
 	authService "kudago/internal/auth/service"
 	"kudago/internal/interceptors"
 	"kudago/internal/logger"
+	"kudago/internal/mail"
 	"kudago/internal/metrics"
 	"kudago/internal/repository/postgres"
 
@@ -46,9 +49,12 @@ func main() {
 	}
 
 	userDB := authRepository.NewDB(pool)
-	sessionDB := sessionRepository.NewDB(&conf.RedisConfig)
+	sessionDB := sessionRepository.NewDB(&conf.RedisConfig, conf.SessionConfig)
+	attemptsDB := attemptsRepository.NewDB(&conf.RedisConfig)
+	tokensDB := tokensRepository.NewDB(&conf.RedisConfig)
+	mailer := mail.NewFileSender(conf.MailConfig)
 
-	authService := authService.NewService(userDB)
+	authService := authService.NewService(userDB, attemptsDB, tokensDB, mailer, conf.AppURL)
 	authServer := grpcAuth.NewServerAPI(authService, sessionDB, appLogger)
 	metrics.InitMetrics()
 
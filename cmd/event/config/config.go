@@ -2,16 +2,33 @@ package config
 
 import (
 	"errors"
+	"fmt"
 	"os"
+	"time"
 
 	"kudago/internal/repository/postgres"
 
 	"github.com/joho/godotenv"
 )
 
+const (
+	defaultTrendingRefreshInterval = 10 * time.Minute
+	defaultPublishInterval         = time.Minute
+	defaultWaitlistInterval        = 5 * time.Minute
+)
+
 type Config struct {
-	PostgresConfig postgres.PostgresConfig
-	ServiceAddr    string
+	PostgresConfig          postgres.PostgresConfig
+	ServiceAddr             string
+	NotificationServiceAddr string
+	// TrendingRefreshInterval is how often trending scores are recomputed.
+	TrendingRefreshInterval time.Duration
+	// PublishInterval is how often scheduled drafts are checked, so it bounds
+	// how late a draft can be published.
+	PublishInterval time.Duration
+	// WaitlistInterval is how often expired waitlist claims are passed on to
+	// the next users in the queue.
+	WaitlistInterval time.Duration
 }
 
 func LoadConfig() (Config, error) {
@@ -32,5 +49,39 @@ func LoadConfig() (Config, error) {
 		return Config{}, errors.New("Failed to get service address")
 	}
 
+	conf.NotificationServiceAddr = os.Getenv("NOTIFICATION_SERVICE_ADDR")
+	if conf.NotificationServiceAddr == "" {
+		return Config{}, errors.New("Failed to get notification service address")
+	}
+
+	conf.TrendingRefreshInterval, err = getInterval("TRENDING_REFRESH_INTERVAL", defaultTrendingRefreshInterval)
+	if err != nil {
+		return Config{}, err
+	}
+
+	conf.PublishInterval, err = getInterval("PUBLISH_INTERVAL", defaultPublishInterval)
+	if err != nil {
+		return Config{}, err
+	}
+
+	conf.WaitlistInterval, err = getInterval("WAITLIST_INTERVAL", defaultWaitlistInterval)
+	if err != nil {
+		return Config{}, err
+	}
+
 	return conf, nil
 }
+
+func getInterval(name string, defaultValue time.Duration) (time.Duration, error) {
+	value := os.Getenv(name)
+	if value == "" {
+		return defaultValue, nil
+	}
+
+	interval, err := time.ParseDuration(value)
+	if err != nil || interval <= 0 {
+		return 0, fmt.Errorf("invalid %s: %q", name, value)
+	}
+
+	return interval, nil
+}
@@ -1,13 +1,21 @@
 package main
 
 import (
+	"context"
 	"log"
 	"net"
 	"net/http"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
 
 	"kudago/cmd/event/config"
 	proto "kudago/internal/event/api"
 	grpcEvent "kudago/internal/event/grpc"
+	"kudago/internal/event/jobs"
+	"kudago/internal/event/notifier"
 	eventRepository "kudago/internal/event/repository"
 	eventService "kudago/internal/event/service"
 	"kudago/internal/interceptors"
@@ -45,9 +53,31 @@ func main() {
 	}
 
 	eventDB := eventRepository.NewDB(pool)
-	eventService := eventService.NewService(eventDB)
+	notifier, err := notifier.NewNotifier(conf.NotificationServiceAddr)
+	if err != nil {
+		log.Fatalf("Failed to connect to the notification service: %v", err)
+	}
+
+	eventService := eventService.NewService(eventDB, notifier)
 	metrics.InitMetrics()
 
+	// Background jobs stop on SIGINT or SIGTERM before the server is drained,
+	// so none of them is left running against a closing pool.
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	var jobsDone sync.WaitGroup
+	runJob := func(name string, interval time.Duration, job func(context.Context) error) {
+		jobsDone.Add(1)
+		go func() {
+			defer jobsDone.Done()
+			jobs.Run(ctx, name, interval, job, appLogger)
+		}()
+	}
+	runJob("refresh trending scores", conf.TrendingRefreshInterval, eventService.RefreshTrendingScores)
+	runJob("publish scheduled events", conf.PublishInterval, eventService.PublishScheduledEvents)
+	runJob("promote waitlists", conf.WaitlistInterval, eventService.PromoteWaitlists)
+
 	grpc_prometheus.EnableHandlingTimeHistogram()
 	grpcServer := grpc.NewServer(
 		grpc.ChainUnaryInterceptor(
@@ -70,6 +100,13 @@ func main() {
 		}
 	}()
 
+	go func() {
+		<-ctx.Done()
+		log.Printf("Остановка gRPC-сервера")
+		jobsDone.Wait()
+		grpcServer.GracefulStop()
+	}()
+
 	log.Printf("gRPC сервер запущен на %s", conf.ServiceAddr)
 	if err := grpcServer.Serve(listener); err != nil {
 		log.Fatalf("Ошибка запуска gRPC-сервера: %v", err)
@@ -2,7 +2,9 @@ package config
 
 import (
 	"errors"
+	"fmt"
 	"os"
+	"strconv"
 
 	imageRepository "kudago/internal/image/repository"
 
@@ -22,7 +24,15 @@ func LoadConfig() (Config, error) {
 	}
 
 	conf.ImageConfig = imageRepository.ImageConfig{
-		Path: "./static/images",
+		Storage: os.Getenv("IMAGE_STORAGE"),
+		Path:    "./static/images",
+	}
+
+	if conf.ImageConfig.Storage == imageRepository.StorageS3 {
+		conf.ImageConfig.S3, err = getS3Config()
+		if err != nil {
+			return Config{}, err
+		}
 	}
 
 	conf.ServiceAddr = os.Getenv("IMAGE_SERVICE_ADDR")
@@ -32,3 +42,28 @@ func LoadConfig() (Config, error) {
 
 	return conf, nil
 }
+
+func getS3Config() (imageRepository.S3Config, error) {
+	s3Config := imageRepository.S3Config{
+		Endpoint:  os.Getenv("S3_ENDPOINT"),
+		Region:    os.Getenv("S3_REGION"),
+		Bucket:    os.Getenv("S3_BUCKET"),
+		AccessKey: os.Getenv("S3_ACCESS_KEY"),
+		SecretKey: os.Getenv("S3_SECRET_KEY"),
+		PublicURL: os.Getenv("S3_PUBLIC_URL"),
+	}
+
+	if s3Config.Endpoint == "" || s3Config.Bucket == "" || s3Config.PublicURL == "" {
+		return imageRepository.S3Config{}, errors.New("Failed to get S3 endpoint, bucket or public URL")
+	}
+
+	if value := os.Getenv("S3_USE_SSL"); value != "" {
+		useSSL, err := strconv.ParseBool(value)
+		if err != nil {
+			return imageRepository.S3Config{}, fmt.Errorf("invalid S3_USE_SSL: %q", value)
+		}
+		s3Config.UseSSL = useSSL
+	}
+
+	return s3Config, nil
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"google.golang.org/grpc"
 	imageRepository "kudago/internal/image/repository"
+	imageService "kudago/internal/image/service"
 )
 
 func main() {
@@ -35,9 +36,13 @@ func main() {
 		log.Fatalf("Не удалось запустить gRPC-сервер image: %v", err)
 	}
 
-	imageDB := imageRepository.NewDB(conf.ImageConfig)
+	storage, err := imageRepository.NewStorage(conf.ImageConfig)
+	if err != nil {
+		log.Fatalf("Failed to set up image storage: %v", err)
+	}
+	imageService := imageService.NewService(storage)
 
-	imageServer := grpcImage.NewServerAPI(imageDB, appLogger)
+	imageServer := grpcImage.NewServerAPI(&imageService, appLogger)
 	metrics.InitMetrics()
 
 	grpc_prometheus.EnableHandlingTimeHistogram()
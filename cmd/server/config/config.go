@@ -2,7 +2,10 @@ package config
 
 import (
 	"errors"
+	"fmt"
+	"net"
 	"os"
+	"strings"
 
 	"github.com/joho/godotenv"
 )
@@ -19,6 +22,9 @@ type Config struct {
 	ImageServiceAddr        string
 	CSATServiceAddr         string
 	NotificationServiceAddr string
+	// TrustedProxies are the networks of the proxies in front of the gateway,
+	// only they may set the client address in forwarding headers.
+	TrustedProxies []*net.IPNet
 }
 
 func LoadConfig() (Config, error) {
@@ -64,5 +70,38 @@ func LoadConfig() (Config, error) {
 		return Config{}, errors.New("Failed to get notification service address")
 	}
 
+	conf.TrustedProxies, err = parseTrustedProxies(os.Getenv("TRUSTED_PROXIES"))
+	if err != nil {
+		return Config{}, err
+	}
+
 	return conf, nil
 }
+
+// parseTrustedProxies reads a comma separated list of addresses and networks
+// in CIDR notation.
+func parseTrustedProxies(value string) ([]*net.IPNet, error) {
+	var networks []*net.IPNet
+	for _, entry := range strings.Split(value, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		if !strings.Contains(entry, "/") {
+			ip := net.ParseIP(entry)
+			if ip == nil {
+				return nil, fmt.Errorf("invalid TRUSTED_PROXIES entry: %q", entry)
+			}
+			networks = append(networks, &net.IPNet{IP: ip, Mask: net.CIDRMask(len(ip)*8, len(ip)*8)})
+			continue
+		}
+
+		_, network, err := net.ParseCIDR(entry)
+		if err != nil {
+			return nil, fmt.Errorf("invalid TRUSTED_PROXIES entry: %q", entry)
+		}
+		networks = append(networks, network)
+	}
+	return networks, nil
+}
@@ -7,6 +7,7 @@ import (
 	"kudago/cmd/server/config"
 	_ "kudago/docs"
 	authHandlers "kudago/internal/gateway/auth"
+	csatHandlers "kudago/internal/gateway/csat"
 	eventHandlers "kudago/internal/gateway/event"
 	userHandlers "kudago/internal/gateway/user"
 
@@ -43,7 +44,7 @@ func main() {
 		log.Fatalf("Failed to connect to auth service: %v", err)
 	}
 
-	userHandler, err := userHandlers.NewHandlers(conf.UserServiceAddr, appLogger)
+	userHandler, err := userHandlers.NewHandlers(conf.UserServiceAddr, conf.AuthServiceAddr, appLogger)
 	if err != nil {
 		log.Fatalf("Failed to connect to user service: %v", err)
 	}
@@ -53,6 +54,11 @@ func main() {
 		log.Fatalf("Failed to connect to event service: %v", err)
 	}
 
+	csatHandler, err := csatHandlers.NewHandlers(conf.CSATServiceAddr, appLogger)
+	if err != nil {
+		log.Fatalf("Failed to connect to csat service: %v", err)
+	}
+
 	r := mux.NewRouter()
 
 	fs := http.FileServer(http.Dir("./static/"))
@@ -63,9 +69,17 @@ func main() {
 	r.HandleFunc("/login", authHandler.Login).Methods(http.MethodPost)
 	r.HandleFunc("/logout", authHandler.Logout).Methods(http.MethodPost)
 	r.HandleFunc("/session", authHandler.CheckSession).Methods(http.MethodGet)
+	r.HandleFunc("/sessions", authHandler.ListSessions).Methods(http.MethodGet)
+	r.HandleFunc("/sessions", authHandler.RevokeAllSessions).Methods(http.MethodDelete)
+	r.HandleFunc("/sessions/{id:[0-9a-f]+}", authHandler.RevokeSession).Methods(http.MethodDelete)
+	r.HandleFunc("/password/reset", authHandler.RequestPasswordReset).Methods(http.MethodPost)
+	r.HandleFunc("/password/reset/confirm", authHandler.ConfirmPasswordReset).Methods(http.MethodPost)
+	r.HandleFunc("/verify-email", authHandler.VerifyEmail).Methods(http.MethodPost)
+	r.HandleFunc("/verify-email/resend", authHandler.ResendVerificationEmail).Methods(http.MethodPost)
 
 	r.HandleFunc("/profile/{id:[0-9]+}", userHandler.Profile).Methods(http.MethodGet)
 	r.HandleFunc("/profile", userHandler.UpdateUser).Methods(http.MethodPut)
+	r.HandleFunc("/profile/password", authHandler.ChangePassword).Methods(http.MethodPut)
 
 	r.HandleFunc("/profile/subscribe/{id:[0-9]+}", userHandler.Subscribe).Methods(http.MethodPost)
 	r.HandleFunc("/profile/subscribe", userHandler.GetSubscribers).Methods(http.MethodGet)
@@ -73,6 +87,7 @@ func main() {
 	r.HandleFunc("/profile/subscribe/{id:[0-9]+}", userHandler.Unsubscribe).Methods(http.MethodDelete)
 
 	r.HandleFunc("/events/{id:[0-9]+}", eventHandler.GetEventByID).Methods(http.MethodGet)
+	r.HandleFunc("/events/{id:[0-9]+}.ics", eventHandler.ExportEventICS).Methods(http.MethodGet)
 	r.HandleFunc("/events/categories/{category:[0-9]+}", eventHandler.GetEventsByCategory).Methods(http.MethodGet)
 	r.HandleFunc("/events", eventHandler.GetUpcomingEvents).Methods(http.MethodGet)
 	r.HandleFunc("/events/past", eventHandler.GetPastEvents).Methods(http.MethodGet)
@@ -82,18 +97,47 @@ func main() {
 	r.HandleFunc("/events/user/{id:[0-9]+}", eventHandler.GetEventsByUser).Methods(http.MethodGet)
 	r.HandleFunc("/events/{id:[0-9]+}", eventHandler.UpdateEvent).Methods(http.MethodPut)
 	r.HandleFunc("/events/{id:[0-9]+}", eventHandler.DeleteEvent).Methods(http.MethodDelete)
+	r.HandleFunc("/events/{id:[0-9]+}/publish", eventHandler.PublishEvent).Methods(http.MethodPost)
+	r.HandleFunc("/events/drafts", eventHandler.GetDrafts).Methods(http.MethodGet)
+	r.HandleFunc("/events/{id:[0-9]+}/cancel", eventHandler.CancelEvent).Methods(http.MethodPost)
+	r.HandleFunc("/events/{id:[0-9]+}/reschedule", eventHandler.RescheduleEvent).Methods(http.MethodPost)
+	r.HandleFunc("/events/{id:[0-9]+}/images", eventHandler.AddEventImage).Methods(http.MethodPost)
+	r.HandleFunc("/events/{id:[0-9]+}/images", eventHandler.UpdateEventImages).Methods(http.MethodPut)
+	r.HandleFunc("/events/{id:[0-9]+}/images/{imageID:[0-9]+}", eventHandler.DeleteEventImage).Methods(http.MethodDelete)
 	r.HandleFunc("/events", eventHandler.AddEvent).Methods(http.MethodPost)
 	r.HandleFunc("/events/search", eventHandler.SearchEvents).Methods(http.MethodGet)
+	r.HandleFunc("/events/suggest", eventHandler.SuggestEvents).Methods(http.MethodGet)
+	r.HandleFunc("/events/recommended", eventHandler.GetRecommendedEvents).Methods(http.MethodGet)
+	r.HandleFunc("/events/trending", eventHandler.GetTrendingEvents).Methods(http.MethodGet)
 	r.HandleFunc("/events/favorites", eventHandler.GetFavorites).Methods(http.MethodGet)
 	r.HandleFunc("/events/favorites/{id:[0-9]+}", eventHandler.AddEventToFavorites).Methods(http.MethodPost)
 	r.HandleFunc("/events/favorites/{id:[0-9]+}", eventHandler.DeleteEventFromFavorites).Methods(http.MethodDelete)
 
+	r.HandleFunc("/events/{id:[0-9]+}/ticket-types", eventHandler.AddTicketType).Methods(http.MethodPost)
+	r.HandleFunc("/events/{id:[0-9]+}/tickets", eventHandler.GetTicketTypes).Methods(http.MethodGet)
+	r.HandleFunc("/events/{id:[0-9]+}/tickets", eventHandler.ReserveTickets).Methods(http.MethodPost)
+	r.HandleFunc("/tickets/{id:[0-9]+}/buy", eventHandler.BuyTicket).Methods(http.MethodPost)
+	r.HandleFunc("/profile/tickets", eventHandler.GetUserTickets).Methods(http.MethodGet)
+
+	r.HandleFunc("/events/{id:[0-9]+}/attendees", eventHandler.GetAttendees).Methods(http.MethodGet)
+	r.HandleFunc("/events/{id:[0-9]+}/attendees", eventHandler.AttendEvent).Methods(http.MethodPost)
+	r.HandleFunc("/events/{id:[0-9]+}/attendees", eventHandler.CancelAttendance).Methods(http.MethodDelete)
+	r.HandleFunc("/events/{id:[0-9]+}/attendees/{user_id:[0-9]+}/check-in", eventHandler.CheckInAttendee).Methods(http.MethodPost)
+
+	r.HandleFunc("/calendar/token", eventHandler.CreateCalendarToken).Methods(http.MethodPost)
+	r.HandleFunc("/calendar/{token:[0-9a-f]+}.ics", eventHandler.GetCalendarFeed).Methods(http.MethodGet)
+
 	r.HandleFunc("/notification", eventHandler.GetNotifications).Methods(http.MethodGet)
 	r.HandleFunc("/notification", eventHandler.CreateInvitationNotification).Methods(http.MethodPost)
 
+	r.HandleFunc("/csat/tests", csatHandler.GetTests).Methods(http.MethodGet)
+	r.HandleFunc("/csat/tests/{id:[0-9]+}/answers", csatHandler.AddAnswers).Methods(http.MethodPost)
+	r.HandleFunc("/csat/tests/{id:[0-9]+}/statistics", csatHandler.GetStatistics).Methods(http.MethodGet)
+
 	handlerWithAuth := middleware.AuthMiddleware(authHandler.AuthService, r)
 	handlerWithCORS := middleware.CORSMiddleware(handlerWithAuth)
-	handlerWithLogging := middleware.LoggingMiddleware(handlerWithCORS, appLogger.Logger)
+	handlerWithClientIP := middleware.ClientIPMiddleware(handlerWithCORS, conf.TrustedProxies)
+	handlerWithLogging := middleware.LoggingMiddleware(handlerWithClientIP, appLogger.Logger)
 	handlerWithMetrics := middleware.MetricsMiddleware(handlerWithLogging, "server")
 	handler := middleware.PanicMiddleware(handlerWithMetrics)
 
@@ -4,6 +4,7 @@ package auth
 
 import (
 	"context"
+	"time"
 
 	pb "kudago/internal/auth/api"
 	"kudago/internal/logger"
@@ -21,12 +22,21 @@ type AuthService interface {
 	CheckCredentials(ctx context.Context, creds models.Credentials) (models.User, error)
 	Register(ctx context.Context, user models.User) (models.User, error)
 	GetUserByID(ctx context.Context, ID int) (models.User, error)
+	ChangePassword(ctx context.Context, userID int, oldPassword, newPassword string) error
+	RequestPasswordReset(ctx context.Context, email string) error
+	ConfirmPasswordReset(ctx context.Context, token, newPassword string) (int, error)
+	SendVerificationEmail(ctx context.Context, userID int) error
+	VerifyEmail(ctx context.Context, token string) (int, error)
 }
 
 type SessionManager interface {
 	DeleteSession(ctx context.Context, token string) error
 	CheckSession(ctx context.Context, cookie string) (models.Session, error)
-	CreateSession(ctx context.Context, ID int) (models.Session, error)
+	CreateSession(ctx context.Context, ID int, verified bool, userAgent, ip string) (models.Session, error)
+	ListSessions(ctx context.Context, userID int, currentToken string) ([]models.SessionInfo, error)
+	RevokeSession(ctx context.Context, userID int, sessionID string) error
+	RevokeAllSessions(ctx context.Context, userID int, exceptToken string) error
+	SetVerified(ctx context.Context, userID int, verified bool) error
 }
 
 func NewServerAPI(service AuthService, sessionManager SessionManager, logger *logger.Logger) *ServerAPI {
@@ -43,5 +53,17 @@ func userToUserPb(userData models.User) *pb.User {
 		Username:  userData.Username,
 		Email:     userData.Email,
 		AvatarUrl: userData.ImageURL,
+		Verified:  userData.Verified,
+	}
+}
+
+func sessionInfoToPb(session models.SessionInfo) *pb.SessionInfo {
+	return &pb.SessionInfo{
+		ID:         session.ID,
+		UserAgent:  session.UserAgent,
+		Ip:         session.IP,
+		CreatedAt:  session.CreatedAt.Format(time.RFC3339),
+		LastUsedAt: session.LastUsedAt.Format(time.RFC3339),
+		Current:    session.Current,
 	}
 }
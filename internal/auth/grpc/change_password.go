@@ -0,0 +1,35 @@
+package auth
+
+import (
+	"context"
+	"errors"
+
+	pb "kudago/internal/auth/api"
+	"kudago/internal/models"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *ServerAPI) ChangePassword(ctx context.Context, in *pb.ChangePasswordRequest) (*pb.Empty, error) {
+	err := s.service.ChangePassword(ctx, int(in.UserID), in.OldPassword, in.NewPassword)
+	if err != nil {
+		if errors.Is(err, models.ErrWrongPassword) {
+			return nil, status.Error(codes.PermissionDenied, ErrWrongPassword)
+		}
+		if errors.Is(err, models.ErrUserNotFound) {
+			return nil, status.Error(codes.NotFound, ErrUserNotFound)
+		}
+		s.logger.Error(ctx, "change password", err)
+		return nil, status.Error(codes.Internal, ErrInternal)
+	}
+
+	// the device the password was changed from stays logged in
+	err = s.sessionManager.RevokeAllSessions(ctx, int(in.UserID), in.Token)
+	if err != nil {
+		s.logger.Error(ctx, "change password", err)
+		return nil, status.Error(codes.Internal, ErrInternal)
+	}
+
+	return &pb.Empty{}, nil
+}
@@ -23,9 +23,11 @@ func (s *ServerAPI) CheckSession(ctx context.Context, in *pb.CheckSessionRequest
 	}
 
 	user := &pb.Session{
-		UserID:  int32(session.UserID),
-		Token:   session.Token,
-		Expires: session.Expires.Format(time.RFC3339),
+		UserID:   int32(session.UserID),
+		Token:    session.Token,
+		Expires:  session.Expires.Format(time.RFC3339),
+		Renewed:  session.Renewed,
+		Verified: session.Verified,
 	}
 
 	return user, nil
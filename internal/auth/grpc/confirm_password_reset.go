@@ -0,0 +1,34 @@
+package auth
+
+import (
+	"context"
+	"errors"
+
+	pb "kudago/internal/auth/api"
+	"kudago/internal/models"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *ServerAPI) ConfirmPasswordReset(ctx context.Context, in *pb.ConfirmPasswordResetRequest) (*pb.Empty, error) {
+	userID, err := s.service.ConfirmPasswordReset(ctx, in.Token, in.NewPassword)
+	if err != nil {
+		if errors.Is(err, models.ErrInvalidToken) {
+			return nil, status.Error(codes.InvalidArgument, ErrInvalidToken)
+		}
+		if errors.Is(err, models.ErrUserNotFound) {
+			return nil, status.Error(codes.NotFound, ErrUserNotFound)
+		}
+		s.logger.Error(ctx, "confirm password reset", err)
+		return nil, status.Error(codes.Internal, ErrInternal)
+	}
+
+	err = s.sessionManager.RevokeAllSessions(ctx, userID, "")
+	if err != nil {
+		s.logger.Error(ctx, "confirm password reset", err)
+		return nil, status.Error(codes.Internal, ErrInternal)
+	}
+
+	return &pb.Empty{}, nil
+}
@@ -5,4 +5,8 @@ const (
 	ErrUserNotFound           = "user not found"
 	ErrInvalidCredentials     = "invalid credentials"
 	ErrUsernameOrEmailIsTaken = "username or email is taken"
+	ErrTooManyAttempts        = "too many login attempts"
+	ErrSessionNotFound        = "session not found"
+	ErrWrongPassword          = "wrong password"
+	ErrInvalidToken           = "invalid or expired token"
 )
@@ -2,25 +2,37 @@ package auth
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	pb "kudago/internal/auth/api"
+	"kudago/internal/models"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
 func (s *ServerAPI) CreateSession(ctx context.Context, in *pb.CreateSessionRequest) (*pb.Session, error) {
-	session, err := s.sessionManager.CreateSession(ctx, int(in.ID))
+	userData, err := s.service.GetUserByID(ctx, int(in.ID))
+	if err != nil {
+		if errors.Is(err, models.ErrUserNotFound) {
+			return nil, status.Error(codes.NotFound, ErrUserNotFound)
+		}
+		s.logger.Error(ctx, "create session", err)
+		return nil, status.Error(codes.Internal, ErrInternal)
+	}
+
+	session, err := s.sessionManager.CreateSession(ctx, userData.ID, userData.Verified, in.UserAgent, in.Ip)
 	if err != nil {
 		s.logger.Error(ctx, "create session", err)
 		return nil, status.Error(codes.Internal, ErrInternal)
 	}
 
 	user := &pb.Session{
-		UserID:  int32(session.UserID),
-		Token:   session.Token,
-		Expires: session.Expires.Format(time.RFC3339),
+		UserID:   int32(session.UserID),
+		Token:    session.Token,
+		Expires:  session.Expires.Format(time.RFC3339),
+		Verified: session.Verified,
 	}
 
 	return user, nil
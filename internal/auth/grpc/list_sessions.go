@@ -0,0 +1,27 @@
+package auth
+
+import (
+	"context"
+
+	pb "kudago/internal/auth/api"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *ServerAPI) ListSessions(ctx context.Context, in *pb.ListSessionsRequest) (*pb.Sessions, error) {
+	sessions, err := s.sessionManager.ListSessions(ctx, int(in.UserID), in.Token)
+	if err != nil {
+		s.logger.Error(ctx, "list sessions", err)
+		return nil, status.Error(codes.Internal, ErrInternal)
+	}
+
+	resp := &pb.Sessions{
+		Sessions: make([]*pb.SessionInfo, 0, len(sessions)),
+	}
+	for _, session := range sessions {
+		resp.Sessions = append(resp.Sessions, sessionInfoToPb(session))
+	}
+
+	return resp, nil
+}
@@ -7,18 +7,25 @@ import (
 	pb "kudago/internal/auth/api"
 	"kudago/internal/models"
 
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
 )
 
 func (s *ServerAPI) Login(ctx context.Context, in *pb.LoginRequest) (*pb.User, error) {
 	creds := models.Credentials{
-		Username: in.Username,
+		Login:    in.Login,
 		Password: in.Password,
+		IP:       in.Ip,
 	}
 
 	userData, err := s.service.CheckCredentials(ctx, creds)
 	if err != nil {
+		var lockoutErr models.LockoutError
+		if errors.As(err, &lockoutErr) {
+			return nil, lockoutStatus(lockoutErr)
+		}
 		if errors.Is(err, models.ErrUserNotFound) {
 			return nil, status.Error(codes.NotFound, ErrInvalidCredentials)
 		}
@@ -30,3 +37,14 @@ func (s *ServerAPI) Login(ctx context.Context, in *pb.LoginRequest) (*pb.User, e
 
 	return user, nil
 }
+
+func lockoutStatus(lockoutErr models.LockoutError) error {
+	st := status.New(codes.ResourceExhausted, ErrTooManyAttempts)
+	detailed, err := st.WithDetails(&errdetails.RetryInfo{
+		RetryDelay: durationpb.New(lockoutErr.RetryAfter),
+	})
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
@@ -28,6 +28,12 @@ func (s *ServerAPI) Register(ctx context.Context, in *pb.RegisterRequest) (*pb.U
 		return nil, status.Error(codes.Internal, ErrInternal)
 	}
 
+	// the account is created anyway, the user can ask for another email later
+	err = s.service.SendVerificationEmail(ctx, userData.ID)
+	if err != nil {
+		s.logger.Error(ctx, "send verification email", err)
+	}
+
 	resp := userToUserPb(userData)
 
 	return resp, nil
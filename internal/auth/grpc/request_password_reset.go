@@ -0,0 +1,20 @@
+package auth
+
+import (
+	"context"
+
+	pb "kudago/internal/auth/api"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *ServerAPI) RequestPasswordReset(ctx context.Context, in *pb.RequestPasswordResetRequest) (*pb.Empty, error) {
+	err := s.service.RequestPasswordReset(ctx, in.Email)
+	if err != nil {
+		s.logger.Error(ctx, "request password reset", err)
+		return nil, status.Error(codes.Internal, ErrInternal)
+	}
+
+	return &pb.Empty{}, nil
+}
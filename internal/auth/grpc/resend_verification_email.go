@@ -0,0 +1,25 @@
+package auth
+
+import (
+	"context"
+	"errors"
+
+	pb "kudago/internal/auth/api"
+	"kudago/internal/models"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *ServerAPI) ResendVerificationEmail(ctx context.Context, in *pb.ResendVerificationEmailRequest) (*pb.Empty, error) {
+	err := s.service.SendVerificationEmail(ctx, int(in.UserID))
+	if err != nil {
+		if errors.Is(err, models.ErrUserNotFound) {
+			return nil, status.Error(codes.NotFound, ErrUserNotFound)
+		}
+		s.logger.Error(ctx, "resend verification email", err)
+		return nil, status.Error(codes.Internal, ErrInternal)
+	}
+
+	return &pb.Empty{}, nil
+}
@@ -0,0 +1,33 @@
+package auth
+
+import (
+	"context"
+	"errors"
+
+	pb "kudago/internal/auth/api"
+	"kudago/internal/models"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ResetEmailVerification is called after the user changes their email: the
+// sessions lose the verified flag and a link is sent to the new address.
+func (s *ServerAPI) ResetEmailVerification(ctx context.Context, in *pb.ResetEmailVerificationRequest) (*pb.Empty, error) {
+	err := s.sessionManager.SetVerified(ctx, int(in.UserID), false)
+	if err != nil {
+		s.logger.Error(ctx, "reset email verification", err)
+		return nil, status.Error(codes.Internal, ErrInternal)
+	}
+
+	err = s.service.SendVerificationEmail(ctx, int(in.UserID))
+	if err != nil {
+		if errors.Is(err, models.ErrUserNotFound) {
+			return nil, status.Error(codes.NotFound, ErrUserNotFound)
+		}
+		s.logger.Error(ctx, "reset email verification", err)
+		return nil, status.Error(codes.Internal, ErrInternal)
+	}
+
+	return &pb.Empty{}, nil
+}
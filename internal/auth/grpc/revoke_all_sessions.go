@@ -0,0 +1,20 @@
+package auth
+
+import (
+	"context"
+
+	pb "kudago/internal/auth/api"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *ServerAPI) RevokeAllSessions(ctx context.Context, in *pb.RevokeAllSessionsRequest) (*pb.Empty, error) {
+	err := s.sessionManager.RevokeAllSessions(ctx, int(in.UserID), in.ExceptToken)
+	if err != nil {
+		s.logger.Error(ctx, "revoke all sessions", err)
+		return nil, status.Error(codes.Internal, ErrInternal)
+	}
+
+	return &pb.Empty{}, nil
+}
@@ -0,0 +1,25 @@
+package auth
+
+import (
+	"context"
+	"errors"
+
+	pb "kudago/internal/auth/api"
+	"kudago/internal/models"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *ServerAPI) RevokeSession(ctx context.Context, in *pb.RevokeSessionRequest) (*pb.Empty, error) {
+	err := s.sessionManager.RevokeSession(ctx, int(in.UserID), in.SessionID)
+	if err != nil {
+		if errors.Is(err, models.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, ErrSessionNotFound)
+		}
+		s.logger.Error(ctx, "revoke session", err)
+		return nil, status.Error(codes.Internal, ErrInternal)
+	}
+
+	return &pb.Empty{}, nil
+}
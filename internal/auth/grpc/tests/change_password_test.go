@@ -0,0 +1,94 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	pb "kudago/internal/auth/api"
+	"kudago/internal/auth/grpc/tests/mocks"
+	"kudago/internal/logger"
+	"kudago/internal/models"
+
+	auth "kudago/internal/auth/grpc"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestAuthGRPC_ChangePassword(t *testing.T) {
+	t.Parallel()
+
+	req := &pb.ChangePasswordRequest{
+		UserID:      1,
+		OldPassword: "old_password",
+		NewPassword: "new_password",
+		Token:       "current_token",
+	}
+
+	tests := []struct {
+		name        string
+		setupFunc   func(ctrl *gomock.Controller) *auth.ServerAPI
+		expectedErr error
+	}{
+		{
+			name: "success change password, other sessions revoked",
+			setupFunc: func(ctrl *gomock.Controller) *auth.ServerAPI {
+				mockSessionManager := mocks.NewMockSessionManager(ctrl)
+				mockAuthService := mocks.NewMockAuthService(ctrl)
+				logger, _ := logger.NewLogger()
+
+				mockAuthService.EXPECT().
+					ChangePassword(context.Background(), 1, "old_password", "new_password").
+					Return(nil)
+				mockSessionManager.EXPECT().
+					RevokeAllSessions(context.Background(), 1, "current_token").
+					Return(nil)
+				return auth.NewServerAPI(mockAuthService, mockSessionManager, logger)
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "wrong password",
+			setupFunc: func(ctrl *gomock.Controller) *auth.ServerAPI {
+				mockSessionManager := mocks.NewMockSessionManager(ctrl)
+				mockAuthService := mocks.NewMockAuthService(ctrl)
+				logger, _ := logger.NewLogger()
+
+				mockAuthService.EXPECT().
+					ChangePassword(context.Background(), 1, "old_password", "new_password").
+					Return(models.ErrWrongPassword)
+				return auth.NewServerAPI(mockAuthService, mockSessionManager, logger)
+			},
+			expectedErr: status.Error(codes.PermissionDenied, auth.ErrWrongPassword),
+		},
+		{
+			name: "internal error",
+			setupFunc: func(ctrl *gomock.Controller) *auth.ServerAPI {
+				mockSessionManager := mocks.NewMockSessionManager(ctrl)
+				mockAuthService := mocks.NewMockAuthService(ctrl)
+				logger, _ := logger.NewLogger()
+
+				mockAuthService.EXPECT().
+					ChangePassword(context.Background(), 1, "old_password", "new_password").
+					Return(models.ErrInternal)
+				return auth.NewServerAPI(mockAuthService, mockSessionManager, logger)
+			},
+			expectedErr: status.Error(codes.Internal, auth.ErrInternal),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			_, err := tt.setupFunc(ctrl).ChangePassword(context.Background(), req)
+
+			assert.Equal(t, tt.expectedErr, err)
+		})
+	}
+}
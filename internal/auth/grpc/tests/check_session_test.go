@@ -21,8 +21,9 @@ func TestAuthGRPC_CheckSession(t *testing.T) {
 	t.Parallel()
 
 	session := models.Session{
-		UserID: 1,
-		Token:  "test",
+		UserID:   1,
+		Token:    "test",
+		Verified: true,
 	}
 
 	type expected struct {
@@ -53,9 +54,38 @@ func TestAuthGRPC_CheckSession(t *testing.T) {
 			},
 			expected: expected{
 				session: &pb.Session{
-					UserID:  int32(session.UserID),
-					Token:   session.Token,
-					Expires: "0001-01-01T00:00:00Z",
+					UserID:   int32(session.UserID),
+					Token:    session.Token,
+					Expires:  "0001-01-01T00:00:00Z",
+					Verified: true,
+				},
+				err: nil,
+			},
+		},
+		{
+			name: "renewed session",
+			req: &pb.CheckSessionRequest{
+				Cookie: "cookie",
+			},
+			setupFunc: func(ctrl *gomock.Controller) *auth.ServerAPI {
+				mockSessionManager := mocks.NewMockSessionManager(ctrl)
+				mockAuthService := mocks.NewMockAuthService(ctrl)
+				logger, _ := logger.NewLogger()
+
+				renewed := session
+				renewed.Renewed = true
+				mockSessionManager.EXPECT().
+					CheckSession(context.Background(), "cookie").
+					Return(renewed, nil)
+				return auth.NewServerAPI(mockAuthService, mockSessionManager, logger)
+			},
+			expected: expected{
+				session: &pb.Session{
+					UserID:   int32(session.UserID),
+					Token:    session.Token,
+					Expires:  "0001-01-01T00:00:00Z",
+					Renewed:  true,
+					Verified: true,
 				},
 				err: nil,
 			},
@@ -0,0 +1,95 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	pb "kudago/internal/auth/api"
+	"kudago/internal/auth/grpc/tests/mocks"
+	"kudago/internal/logger"
+	"kudago/internal/models"
+
+	auth "kudago/internal/auth/grpc"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestAuthGRPC_ConfirmPasswordReset(t *testing.T) {
+	t.Parallel()
+
+	req := &pb.ConfirmPasswordResetRequest{
+		Token:       "reset_token",
+		NewPassword: "new_password",
+	}
+
+	tests := []struct {
+		name        string
+		setupFunc   func(ctrl *gomock.Controller) *auth.ServerAPI
+		expectedErr error
+	}{
+		{
+			name: "success reset, all sessions revoked",
+			setupFunc: func(ctrl *gomock.Controller) *auth.ServerAPI {
+				mockSessionManager := mocks.NewMockSessionManager(ctrl)
+				mockAuthService := mocks.NewMockAuthService(ctrl)
+				logger, _ := logger.NewLogger()
+
+				mockAuthService.EXPECT().
+					ConfirmPasswordReset(context.Background(), "reset_token", "new_password").
+					Return(1, nil)
+				mockSessionManager.EXPECT().
+					RevokeAllSessions(context.Background(), 1, "").
+					Return(nil)
+				return auth.NewServerAPI(mockAuthService, mockSessionManager, logger)
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "invalid token",
+			setupFunc: func(ctrl *gomock.Controller) *auth.ServerAPI {
+				mockSessionManager := mocks.NewMockSessionManager(ctrl)
+				mockAuthService := mocks.NewMockAuthService(ctrl)
+				logger, _ := logger.NewLogger()
+
+				mockAuthService.EXPECT().
+					ConfirmPasswordReset(context.Background(), "reset_token", "new_password").
+					Return(0, models.ErrInvalidToken)
+				return auth.NewServerAPI(mockAuthService, mockSessionManager, logger)
+			},
+			expectedErr: status.Error(codes.InvalidArgument, auth.ErrInvalidToken),
+		},
+		{
+			name: "internal error",
+			setupFunc: func(ctrl *gomock.Controller) *auth.ServerAPI {
+				mockSessionManager := mocks.NewMockSessionManager(ctrl)
+				mockAuthService := mocks.NewMockAuthService(ctrl)
+				logger, _ := logger.NewLogger()
+
+				mockAuthService.EXPECT().
+					ConfirmPasswordReset(context.Background(), "reset_token", "new_password").
+					Return(1, nil)
+				mockSessionManager.EXPECT().
+					RevokeAllSessions(context.Background(), 1, "").
+					Return(models.ErrInternal)
+				return auth.NewServerAPI(mockAuthService, mockSessionManager, logger)
+			},
+			expectedErr: status.Error(codes.Internal, auth.ErrInternal),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			_, err := tt.setupFunc(ctrl).ConfirmPasswordReset(context.Background(), req)
+
+			assert.Equal(t, tt.expectedErr, err)
+		})
+	}
+}
@@ -24,8 +24,9 @@ func TestAuthGRPC_CreateSession(t *testing.T) {
 	defer ctrl.Finish()
 
 	session := models.Session{
-		UserID: 1,
-		Token:  "test",
+		UserID:   1,
+		Token:    "test",
+		Verified: true,
 	}
 
 	tests := []struct {
@@ -37,15 +38,20 @@ func TestAuthGRPC_CreateSession(t *testing.T) {
 		{
 			name: "success create session",
 			req: &pb.CreateSessionRequest{
-				ID: 1,
+				ID:        1,
+				UserAgent: "Mozilla/5.0",
+				Ip:        "192.0.2.1",
 			},
 			setupFunc: func(ctrl *gomock.Controller) *auth.ServerAPI {
 				mockSessionManager := mocks.NewMockSessionManager(ctrl)
 				mockAuthService := mocks.NewMockAuthService(ctrl)
 				logger, _ := logger.NewLogger()
 
+				mockAuthService.EXPECT().
+					GetUserByID(context.Background(), 1).
+					Return(models.User{ID: 1, Verified: true}, nil)
 				mockSessionManager.EXPECT().
-					CreateSession(context.Background(), 1).
+					CreateSession(context.Background(), 1, true, "Mozilla/5.0", "192.0.2.1").
 					Return(session, nil)
 				return auth.NewServerAPI(mockAuthService, mockSessionManager, logger)
 			},
@@ -54,20 +60,44 @@ func TestAuthGRPC_CreateSession(t *testing.T) {
 		{
 			name: "internal error",
 			req: &pb.CreateSessionRequest{
-				ID: 1,
+				ID:        1,
+				UserAgent: "Mozilla/5.0",
+				Ip:        "192.0.2.1",
 			},
 			setupFunc: func(ctrl *gomock.Controller) *auth.ServerAPI {
 				mockSessionManager := mocks.NewMockSessionManager(ctrl)
 				mockAuthService := mocks.NewMockAuthService(ctrl)
 				logger, _ := logger.NewLogger()
 
+				mockAuthService.EXPECT().
+					GetUserByID(context.Background(), 1).
+					Return(models.User{ID: 1, Verified: true}, nil)
 				mockSessionManager.EXPECT().
-					CreateSession(context.Background(), 1).
+					CreateSession(context.Background(), 1, true, "Mozilla/5.0", "192.0.2.1").
 					Return(models.Session{}, models.ErrInternal)
 				return auth.NewServerAPI(mockAuthService, mockSessionManager, logger)
 			},
 			expectedErr: status.Error(codes.Internal, auth.ErrInternal),
 		},
+		{
+			name: "user not found",
+			req: &pb.CreateSessionRequest{
+				ID:        1,
+				UserAgent: "Mozilla/5.0",
+				Ip:        "192.0.2.1",
+			},
+			setupFunc: func(ctrl *gomock.Controller) *auth.ServerAPI {
+				mockSessionManager := mocks.NewMockSessionManager(ctrl)
+				mockAuthService := mocks.NewMockAuthService(ctrl)
+				logger, _ := logger.NewLogger()
+
+				mockAuthService.EXPECT().
+					GetUserByID(context.Background(), 1).
+					Return(models.User{}, models.ErrUserNotFound)
+				return auth.NewServerAPI(mockAuthService, mockSessionManager, logger)
+			},
+			expectedErr: status.Error(codes.NotFound, auth.ErrUserNotFound),
+		},
 	}
 
 	for _, tt := range tests {
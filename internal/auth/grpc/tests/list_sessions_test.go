@@ -0,0 +1,109 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pb "kudago/internal/auth/api"
+	"kudago/internal/auth/grpc/tests/mocks"
+	"kudago/internal/logger"
+	"kudago/internal/models"
+
+	auth "kudago/internal/auth/grpc"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestAuthGRPC_ListSessions(t *testing.T) {
+	t.Parallel()
+
+	createdAt := time.Date(2024, 12, 1, 10, 0, 0, 0, time.UTC)
+	lastUsedAt := time.Date(2024, 12, 2, 10, 0, 0, 0, time.UTC)
+
+	sessions := []models.SessionInfo{
+		{
+			ID:         "a1b2c3d4e5f60718",
+			UserAgent:  "Mozilla/5.0",
+			IP:         "192.0.2.1",
+			CreatedAt:  createdAt,
+			LastUsedAt: lastUsedAt,
+			Current:    true,
+		},
+	}
+
+	tests := []struct {
+		name         string
+		req          *pb.ListSessionsRequest
+		setupFunc    func(ctrl *gomock.Controller) *auth.ServerAPI
+		expectedResp *pb.Sessions
+		expectedErr  error
+	}{
+		{
+			name: "success list sessions",
+			req: &pb.ListSessionsRequest{
+				UserID: 1,
+				Token:  "current_token",
+			},
+			setupFunc: func(ctrl *gomock.Controller) *auth.ServerAPI {
+				mockSessionManager := mocks.NewMockSessionManager(ctrl)
+				mockAuthService := mocks.NewMockAuthService(ctrl)
+				logger, _ := logger.NewLogger()
+
+				mockSessionManager.EXPECT().
+					ListSessions(context.Background(), 1, "current_token").
+					Return(sessions, nil)
+				return auth.NewServerAPI(mockAuthService, mockSessionManager, logger)
+			},
+			expectedResp: &pb.Sessions{
+				Sessions: []*pb.SessionInfo{
+					{
+						ID:         "a1b2c3d4e5f60718",
+						UserAgent:  "Mozilla/5.0",
+						Ip:         "192.0.2.1",
+						CreatedAt:  createdAt.Format(time.RFC3339),
+						LastUsedAt: lastUsedAt.Format(time.RFC3339),
+						Current:    true,
+					},
+				},
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "internal error",
+			req: &pb.ListSessionsRequest{
+				UserID: 1,
+				Token:  "current_token",
+			},
+			setupFunc: func(ctrl *gomock.Controller) *auth.ServerAPI {
+				mockSessionManager := mocks.NewMockSessionManager(ctrl)
+				mockAuthService := mocks.NewMockAuthService(ctrl)
+				logger, _ := logger.NewLogger()
+
+				mockSessionManager.EXPECT().
+					ListSessions(context.Background(), 1, "current_token").
+					Return(nil, models.ErrInternal)
+				return auth.NewServerAPI(mockAuthService, mockSessionManager, logger)
+			},
+			expectedResp: nil,
+			expectedErr:  status.Error(codes.Internal, auth.ErrInternal),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			resp, err := tt.setupFunc(ctrl).ListSessions(context.Background(), tt.req)
+
+			assert.Equal(t, tt.expectedErr, err)
+			assert.Equal(t, tt.expectedResp, resp)
+		})
+	}
+}
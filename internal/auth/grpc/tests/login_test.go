@@ -3,6 +3,7 @@ package grpc
 import (
 	"context"
 	"testing"
+	"time"
 
 	pb "kudago/internal/auth/api"
 	"kudago/internal/auth/grpc/tests/mocks"
@@ -13,6 +14,7 @@ import (
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -41,7 +43,7 @@ func TestAuthGRPC_Login(t *testing.T) {
 		{
 			name: "success login",
 			req: &pb.LoginRequest{
-				Username: user.Username,
+				Login:    user.Username,
 				Password: user.Password,
 			},
 			setupFunc: func(ctrl *gomock.Controller) *auth.ServerAPI {
@@ -50,7 +52,7 @@ func TestAuthGRPC_Login(t *testing.T) {
 				logger, _ := logger.NewLogger()
 
 				mockAuthService.EXPECT().
-					CheckCredentials(context.Background(), models.Credentials{Username: user.Username, Password: user.Password}).
+					CheckCredentials(context.Background(), models.Credentials{Login: user.Username, Password: user.Password}).
 					Return(user, nil)
 				return auth.NewServerAPI(mockAuthService, mockSessionManager, logger)
 			},
@@ -66,7 +68,7 @@ func TestAuthGRPC_Login(t *testing.T) {
 		{
 			name: "not found",
 			req: &pb.LoginRequest{
-				Username: user.Username,
+				Login:    user.Username,
 				Password: user.Password,
 			},
 			setupFunc: func(ctrl *gomock.Controller) *auth.ServerAPI {
@@ -75,7 +77,7 @@ func TestAuthGRPC_Login(t *testing.T) {
 				logger, _ := logger.NewLogger()
 
 				mockAuthService.EXPECT().
-					CheckCredentials(context.Background(), models.Credentials{Username: user.Username, Password: user.Password}).
+					CheckCredentials(context.Background(), models.Credentials{Login: user.Username, Password: user.Password}).
 					Return(models.User{}, models.ErrUserNotFound)
 
 				return auth.NewServerAPI(mockAuthService, mockSessionManager, logger)
@@ -88,7 +90,7 @@ func TestAuthGRPC_Login(t *testing.T) {
 		{
 			name: "internal error",
 			req: &pb.LoginRequest{
-				Username: user.Username,
+				Login:    user.Username,
 				Password: user.Password,
 			},
 			setupFunc: func(ctrl *gomock.Controller) *auth.ServerAPI {
@@ -97,7 +99,7 @@ func TestAuthGRPC_Login(t *testing.T) {
 				logger, _ := logger.NewLogger()
 
 				mockAuthService.EXPECT().
-					CheckCredentials(context.Background(), models.Credentials{Username: user.Username, Password: user.Password}).
+					CheckCredentials(context.Background(), models.Credentials{Login: user.Username, Password: user.Password}).
 					Return(models.User{}, models.ErrInternal)
 
 				return auth.NewServerAPI(mockAuthService, mockSessionManager, logger)
@@ -109,6 +111,37 @@ func TestAuthGRPC_Login(t *testing.T) {
 		},
 	}
 
+	t.Run("too many attempts", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockSessionManager := mocks.NewMockSessionManager(ctrl)
+		mockAuthService := mocks.NewMockAuthService(ctrl)
+		logger, _ := logger.NewLogger()
+
+		mockAuthService.EXPECT().
+			CheckCredentials(context.Background(), models.Credentials{Login: user.Email, Password: user.Password, IP: "127.0.0.1"}).
+			Return(models.User{}, models.LockoutError{RetryAfter: time.Minute})
+
+		req := &pb.LoginRequest{
+			Login:    user.Email,
+			Password: user.Password,
+			Ip:       "127.0.0.1",
+		}
+		actual, err := auth.NewServerAPI(mockAuthService, mockSessionManager, logger).Login(context.Background(), req)
+
+		assert.Nil(t, actual)
+		st, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.ResourceExhausted, st.Code())
+		assert.Len(t, st.Details(), 1)
+		retryInfo, ok := st.Details()[0].(*errdetails.RetryInfo)
+		assert.True(t, ok)
+		assert.Equal(t, time.Minute, retryInfo.RetryDelay.AsDuration())
+	})
+
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
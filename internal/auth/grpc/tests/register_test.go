@@ -53,6 +53,38 @@ func TestAuthGRPC_Register(t *testing.T) {
 				mockAuthService.EXPECT().
 					Register(context.Background(), user).
 					Return(user, nil)
+				mockAuthService.EXPECT().
+					SendVerificationEmail(context.Background(), user.ID).
+					Return(nil)
+				return auth.NewServerAPI(mockAuthService, mockSessionManager, logger)
+			},
+			expected: expected{
+				user: &pb.User{
+					ID:       int32(user.ID),
+					Username: user.Username,
+					Email:    user.Email,
+				},
+				err: nil,
+			},
+		},
+		{
+			name: "verification email failed, user is registered",
+			req: &pb.RegisterRequest{
+				Username: user.Username,
+				Email:    user.Email,
+				Password: user.Password,
+			},
+			setupFunc: func(ctrl *gomock.Controller) *auth.ServerAPI {
+				mockSessionManager := mocks.NewMockSessionManager(ctrl)
+				mockAuthService := mocks.NewMockAuthService(ctrl)
+				logger, _ := logger.NewLogger()
+
+				mockAuthService.EXPECT().
+					Register(context.Background(), user).
+					Return(user, nil)
+				mockAuthService.EXPECT().
+					SendVerificationEmail(context.Background(), user.ID).
+					Return(models.ErrInternal)
 				return auth.NewServerAPI(mockAuthService, mockSessionManager, logger)
 			},
 			expected: expected{
@@ -0,0 +1,72 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	pb "kudago/internal/auth/api"
+	"kudago/internal/auth/grpc/tests/mocks"
+	"kudago/internal/logger"
+	"kudago/internal/models"
+
+	auth "kudago/internal/auth/grpc"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestAuthGRPC_RequestPasswordReset(t *testing.T) {
+	t.Parallel()
+
+	req := &pb.RequestPasswordResetRequest{Email: "user1@mail.ru"}
+
+	tests := []struct {
+		name        string
+		setupFunc   func(ctrl *gomock.Controller) *auth.ServerAPI
+		expectedErr error
+	}{
+		{
+			name: "success request password reset",
+			setupFunc: func(ctrl *gomock.Controller) *auth.ServerAPI {
+				mockSessionManager := mocks.NewMockSessionManager(ctrl)
+				mockAuthService := mocks.NewMockAuthService(ctrl)
+				logger, _ := logger.NewLogger()
+
+				mockAuthService.EXPECT().
+					RequestPasswordReset(context.Background(), "user1@mail.ru").
+					Return(nil)
+				return auth.NewServerAPI(mockAuthService, mockSessionManager, logger)
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "internal error",
+			setupFunc: func(ctrl *gomock.Controller) *auth.ServerAPI {
+				mockSessionManager := mocks.NewMockSessionManager(ctrl)
+				mockAuthService := mocks.NewMockAuthService(ctrl)
+				logger, _ := logger.NewLogger()
+
+				mockAuthService.EXPECT().
+					RequestPasswordReset(context.Background(), "user1@mail.ru").
+					Return(models.ErrInternal)
+				return auth.NewServerAPI(mockAuthService, mockSessionManager, logger)
+			},
+			expectedErr: status.Error(codes.Internal, auth.ErrInternal),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			_, err := tt.setupFunc(ctrl).RequestPasswordReset(context.Background(), req)
+
+			assert.Equal(t, tt.expectedErr, err)
+		})
+	}
+}
@@ -0,0 +1,112 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	pb "kudago/internal/auth/api"
+	"kudago/internal/auth/grpc/tests/mocks"
+	"kudago/internal/logger"
+	"kudago/internal/models"
+
+	auth "kudago/internal/auth/grpc"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestAuthGRPC_ResetEmailVerification(t *testing.T) {
+	t.Parallel()
+
+	req := &pb.ResetEmailVerificationRequest{UserID: 1}
+
+	tests := []struct {
+		name        string
+		setupFunc   func(ctrl *gomock.Controller) *auth.ServerAPI
+		expectedErr error
+	}{
+		{
+			name: "success reset",
+			setupFunc: func(ctrl *gomock.Controller) *auth.ServerAPI {
+				mockSessionManager := mocks.NewMockSessionManager(ctrl)
+				mockAuthService := mocks.NewMockAuthService(ctrl)
+				logger, _ := logger.NewLogger()
+
+				gomock.InOrder(
+					mockSessionManager.EXPECT().
+						SetVerified(context.Background(), 1, false).
+						Return(nil),
+					mockAuthService.EXPECT().
+						SendVerificationEmail(context.Background(), 1).
+						Return(nil),
+				)
+				return auth.NewServerAPI(mockAuthService, mockSessionManager, logger)
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "session error",
+			setupFunc: func(ctrl *gomock.Controller) *auth.ServerAPI {
+				mockSessionManager := mocks.NewMockSessionManager(ctrl)
+				mockAuthService := mocks.NewMockAuthService(ctrl)
+				logger, _ := logger.NewLogger()
+
+				mockSessionManager.EXPECT().
+					SetVerified(context.Background(), 1, false).
+					Return(errors.New("redis error"))
+				return auth.NewServerAPI(mockAuthService, mockSessionManager, logger)
+			},
+			expectedErr: status.Error(codes.Internal, auth.ErrInternal),
+		},
+		{
+			name: "user not found",
+			setupFunc: func(ctrl *gomock.Controller) *auth.ServerAPI {
+				mockSessionManager := mocks.NewMockSessionManager(ctrl)
+				mockAuthService := mocks.NewMockAuthService(ctrl)
+				logger, _ := logger.NewLogger()
+
+				mockSessionManager.EXPECT().
+					SetVerified(context.Background(), 1, false).
+					Return(nil)
+				mockAuthService.EXPECT().
+					SendVerificationEmail(context.Background(), 1).
+					Return(models.ErrUserNotFound)
+				return auth.NewServerAPI(mockAuthService, mockSessionManager, logger)
+			},
+			expectedErr: status.Error(codes.NotFound, auth.ErrUserNotFound),
+		},
+		{
+			name: "mail error",
+			setupFunc: func(ctrl *gomock.Controller) *auth.ServerAPI {
+				mockSessionManager := mocks.NewMockSessionManager(ctrl)
+				mockAuthService := mocks.NewMockAuthService(ctrl)
+				logger, _ := logger.NewLogger()
+
+				mockSessionManager.EXPECT().
+					SetVerified(context.Background(), 1, false).
+					Return(nil)
+				mockAuthService.EXPECT().
+					SendVerificationEmail(context.Background(), 1).
+					Return(models.ErrInternal)
+				return auth.NewServerAPI(mockAuthService, mockSessionManager, logger)
+			},
+			expectedErr: status.Error(codes.Internal, auth.ErrInternal),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			_, err := tt.setupFunc(ctrl).ResetEmailVerification(context.Background(), req)
+
+			assert.Equal(t, tt.expectedErr, err)
+		})
+	}
+}
@@ -0,0 +1,98 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	pb "kudago/internal/auth/api"
+	"kudago/internal/auth/grpc/tests/mocks"
+	"kudago/internal/logger"
+	"kudago/internal/models"
+
+	auth "kudago/internal/auth/grpc"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestAuthGRPC_RevokeSession(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		req         *pb.RevokeSessionRequest
+		setupFunc   func(ctrl *gomock.Controller) *auth.ServerAPI
+		expectedErr error
+	}{
+		{
+			name: "success revoke session",
+			req: &pb.RevokeSessionRequest{
+				UserID:    1,
+				SessionID: "a1b2c3d4e5f60718",
+			},
+			setupFunc: func(ctrl *gomock.Controller) *auth.ServerAPI {
+				mockSessionManager := mocks.NewMockSessionManager(ctrl)
+				mockAuthService := mocks.NewMockAuthService(ctrl)
+				logger, _ := logger.NewLogger()
+
+				mockSessionManager.EXPECT().
+					RevokeSession(context.Background(), 1, "a1b2c3d4e5f60718").
+					Return(nil)
+				return auth.NewServerAPI(mockAuthService, mockSessionManager, logger)
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "session not found",
+			req: &pb.RevokeSessionRequest{
+				UserID:    1,
+				SessionID: "unknown",
+			},
+			setupFunc: func(ctrl *gomock.Controller) *auth.ServerAPI {
+				mockSessionManager := mocks.NewMockSessionManager(ctrl)
+				mockAuthService := mocks.NewMockAuthService(ctrl)
+				logger, _ := logger.NewLogger()
+
+				mockSessionManager.EXPECT().
+					RevokeSession(context.Background(), 1, "unknown").
+					Return(fmt.Errorf("%s: %w", models.LevelDB, models.ErrNotFound))
+				return auth.NewServerAPI(mockAuthService, mockSessionManager, logger)
+			},
+			expectedErr: status.Error(codes.NotFound, auth.ErrSessionNotFound),
+		},
+		{
+			name: "internal error",
+			req: &pb.RevokeSessionRequest{
+				UserID:    1,
+				SessionID: "a1b2c3d4e5f60718",
+			},
+			setupFunc: func(ctrl *gomock.Controller) *auth.ServerAPI {
+				mockSessionManager := mocks.NewMockSessionManager(ctrl)
+				mockAuthService := mocks.NewMockAuthService(ctrl)
+				logger, _ := logger.NewLogger()
+
+				mockSessionManager.EXPECT().
+					RevokeSession(context.Background(), 1, "a1b2c3d4e5f60718").
+					Return(models.ErrInternal)
+				return auth.NewServerAPI(mockAuthService, mockSessionManager, logger)
+			},
+			expectedErr: status.Error(codes.Internal, auth.ErrInternal),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			_, err := tt.setupFunc(ctrl).RevokeSession(context.Background(), tt.req)
+
+			assert.Equal(t, tt.expectedErr, err)
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package auth
+
+import (
+	"context"
+	"errors"
+
+	pb "kudago/internal/auth/api"
+	"kudago/internal/models"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *ServerAPI) VerifyEmail(ctx context.Context, in *pb.VerifyEmailRequest) (*pb.Empty, error) {
+	userID, err := s.service.VerifyEmail(ctx, in.Token)
+	if err != nil {
+		if errors.Is(err, models.ErrInvalidToken) {
+			return nil, status.Error(codes.InvalidArgument, ErrInvalidToken)
+		}
+		s.logger.Error(ctx, "verify email", err)
+		return nil, status.Error(codes.Internal, ErrInternal)
+	}
+
+	err = s.sessionManager.SetVerified(ctx, userID, true)
+	if err != nil {
+		s.logger.Error(ctx, "verify email", err)
+		return nil, status.Error(codes.Internal, ErrInternal)
+	}
+
+	return &pb.Empty{}, nil
+}
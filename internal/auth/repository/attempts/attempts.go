@@ -0,0 +1,94 @@
+package attemptsRepository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"kudago/internal/models"
+	redisDB "kudago/internal/repository/redis"
+)
+
+const keyPrefix = "login_attempts:"
+
+type AttemptsDB struct {
+	client *redis.Client
+}
+
+func NewDB(config *redisDB.RedisConfig) *AttemptsDB {
+	redisClient := redis.NewClient(&redis.Options{
+		Addr:     config.URL,
+		Password: config.Password,
+		DB:       config.DB,
+		PoolSize: config.PoolSize,
+	})
+
+	return &AttemptsDB{
+		client: redisClient,
+	}
+}
+
+// GetAttempts returns the number of failed attempts for the subject
+// and how long the counter stays alive.
+func (db *AttemptsDB) GetAttempts(ctx context.Context, subject string) (int, time.Duration, error) {
+	key := keyPrefix + subject
+
+	count, err := db.client.Get(ctx, key).Int()
+	if err == redis.Nil {
+		return 0, 0, nil
+	}
+	if err != nil {
+		return 0, 0, fmt.Errorf("%s: %w", models.LevelDB, err)
+	}
+
+	ttl, err := db.client.TTL(ctx, key).Result()
+	if err != nil {
+		return 0, 0, fmt.Errorf("%s: %w", models.LevelDB, err)
+	}
+
+	return count, ttl, nil
+}
+
+// AddAttempt increments the counter of failed attempts. The window starts
+// with the first failure and is not extended by the following ones.
+func (db *AttemptsDB) AddAttempt(ctx context.Context, subject string, window time.Duration) (int, time.Duration, error) {
+	key := keyPrefix + subject
+
+	count, err := db.client.Incr(ctx, key).Result()
+	if err != nil {
+		return 0, 0, fmt.Errorf("%s: %w", models.LevelDB, err)
+	}
+
+	if count == 1 {
+		err = db.client.Expire(ctx, key, window).Err()
+		if err != nil {
+			return 0, 0, fmt.Errorf("%s: %w", models.LevelDB, err)
+		}
+		return int(count), window, nil
+	}
+
+	ttl, err := db.client.TTL(ctx, key).Result()
+	if err != nil {
+		return 0, 0, fmt.Errorf("%s: %w", models.LevelDB, err)
+	}
+
+	if ttl < 0 {
+		err = db.client.Expire(ctx, key, window).Err()
+		if err != nil {
+			return 0, 0, fmt.Errorf("%s: %w", models.LevelDB, err)
+		}
+		ttl = window
+	}
+
+	return int(count), ttl, nil
+}
+
+func (db *AttemptsDB) ResetAttempts(ctx context.Context, subject string) error {
+	err := db.client.Del(ctx, keyPrefix+subject).Err()
+	if err != nil {
+		return fmt.Errorf("%s: %w", models.LevelDB, err)
+	}
+	return nil
+}
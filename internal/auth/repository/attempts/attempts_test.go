@@ -0,0 +1,164 @@
+package attemptsRepository
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-redis/redismock/v9"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAttemptsDB_GetAttempts(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	tests := []struct {
+		name          string
+		subject       string
+		mockSetup     func(mock redismock.ClientMock)
+		expectedCount int
+		expectedTTL   time.Duration
+		expectedError error
+	}{
+		{
+			name:    "Попыток не было",
+			subject: "user:1",
+			mockSetup: func(mock redismock.ClientMock) {
+				mock.ExpectGet("login_attempts:user:1").RedisNil()
+			},
+			expectedCount: 0,
+			expectedTTL:   0,
+		},
+		{
+			name:    "Есть неудачные попытки",
+			subject: "user:1",
+			mockSetup: func(mock redismock.ClientMock) {
+				mock.ExpectGet("login_attempts:user:1").SetVal("3")
+				mock.ExpectTTL("login_attempts:user:1").SetVal(time.Minute)
+			},
+			expectedCount: 3,
+			expectedTTL:   time.Minute,
+		},
+		{
+			name:    "Ошибка Redis",
+			subject: "ip:127.0.0.1",
+			mockSetup: func(mock redismock.ClientMock) {
+				mock.ExpectGet("login_attempts:ip:127.0.0.1").SetErr(errors.New("redis error"))
+			},
+			expectedError: errors.New("redis error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockRedis, mock := redismock.NewClientMock()
+			tt.mockSetup(mock)
+
+			db := &AttemptsDB{client: mockRedis}
+			count, ttl, err := db.GetAttempts(ctx, tt.subject)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedCount, count)
+				assert.Equal(t, tt.expectedTTL, ttl)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestAttemptsDB_AddAttempt(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	window := 15 * time.Minute
+
+	tests := []struct {
+		name          string
+		mockSetup     func(mock redismock.ClientMock)
+		expectedCount int
+		expectedTTL   time.Duration
+		expectedError error
+	}{
+		{
+			name: "Первая попытка открывает окно",
+			mockSetup: func(mock redismock.ClientMock) {
+				mock.ExpectIncr("login_attempts:user:1").SetVal(1)
+				mock.ExpectExpire("login_attempts:user:1", window).SetVal(true)
+			},
+			expectedCount: 1,
+			expectedTTL:   window,
+		},
+		{
+			name: "Следующая попытка не продлевает окно",
+			mockSetup: func(mock redismock.ClientMock) {
+				mock.ExpectIncr("login_attempts:user:1").SetVal(4)
+				mock.ExpectTTL("login_attempts:user:1").SetVal(time.Minute)
+			},
+			expectedCount: 4,
+			expectedTTL:   time.Minute,
+		},
+		{
+			name: "Счетчик без времени жизни",
+			mockSetup: func(mock redismock.ClientMock) {
+				mock.ExpectIncr("login_attempts:user:1").SetVal(2)
+				mock.ExpectTTL("login_attempts:user:1").SetVal(-1)
+				mock.ExpectExpire("login_attempts:user:1", window).SetVal(true)
+			},
+			expectedCount: 2,
+			expectedTTL:   window,
+		},
+		{
+			name: "Ошибка Redis",
+			mockSetup: func(mock redismock.ClientMock) {
+				mock.ExpectIncr("login_attempts:user:1").SetErr(errors.New("redis error"))
+			},
+			expectedError: errors.New("redis error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockRedis, mock := redismock.NewClientMock()
+			tt.mockSetup(mock)
+
+			db := &AttemptsDB{client: mockRedis}
+			count, ttl, err := db.AddAttempt(ctx, "user:1", window)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedCount, count)
+				assert.Equal(t, tt.expectedTTL, ttl)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestAttemptsDB_ResetAttempts(t *testing.T) {
+	t.Parallel()
+
+	mockRedis, mock := redismock.NewClientMock()
+	mock.ExpectDel("login_attempts:user:1").SetVal(1)
+
+	db := &AttemptsDB{client: mockRedis}
+	err := db.ResetAttempts(context.Background(), "user:1")
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,38 @@
+package userRepository
+
+import (
+	"context"
+	"fmt"
+
+	"kudago/internal/models"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+)
+
+const getCredentialsQuery = `
+	SELECT id, username, email, created_at, url_to_avatar, verified_at, password_hash
+	FROM "USER"
+	WHERE username = $1 OR email = $1`
+
+func (d UserDB) GetCredentials(ctx context.Context, login string) (models.User, string, error) {
+	var userInfo UserInfo
+	var passwordHash string
+	err := d.Pool.QueryRow(ctx, getCredentialsQuery, login).Scan(
+		&userInfo.ID,
+		&userInfo.Username,
+		&userInfo.Email,
+		&userInfo.CreatedAt,
+		&userInfo.ImageURL,
+		&userInfo.VerifiedAt,
+		&passwordHash,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.User{}, "", fmt.Errorf("%s: %w", models.LevelDB, models.ErrUserNotFound)
+		}
+		return models.User{}, "", fmt.Errorf("%s: %w", models.LevelDB, err)
+	}
+	user := ToDomainUser(userInfo)
+	return user, passwordHash, nil
+}
@@ -0,0 +1,26 @@
+package userRepository
+
+import (
+	"context"
+	"fmt"
+
+	"kudago/internal/models"
+
+	"github.com/jackc/pgx/v5"
+)
+
+const getPasswordHashQuery = `SELECT password_hash FROM "USER" WHERE id = $1`
+
+func (d *UserDB) GetPasswordHash(ctx context.Context, ID int) (string, error) {
+	var passwordHash string
+
+	err := d.Pool.QueryRow(ctx, getPasswordHashQuery, ID).Scan(&passwordHash)
+	if err == pgx.ErrNoRows {
+		return "", fmt.Errorf("%s: %w", models.LevelDB, models.ErrUserNotFound)
+	}
+	if err != nil {
+		return "", fmt.Errorf("%s: %w", models.LevelDB, err)
+	}
+
+	return passwordHash, nil
+}
@@ -9,7 +9,7 @@ import (
 	"github.com/jackc/pgx/v5"
 )
 
-const getUserByIDQuery = `SELECT id, username, email, url_to_avatar FROM "USER" WHERE id=$1`
+const getUserByIDQuery = `SELECT id, username, email, url_to_avatar, verified_at FROM "USER" WHERE id=$1`
 
 func (d UserDB) GetUserByID(ctx context.Context, ID int) (models.User, error) {
 	var userInfo UserInfo
@@ -19,6 +19,7 @@ func (d UserDB) GetUserByID(ctx context.Context, ID int) (models.User, error) {
 		&userInfo.Username,
 		&userInfo.Email,
 		&userInfo.ImageURL,
+		&userInfo.VerifiedAt,
 	)
 
 	if err == pgx.ErrNoRows {
@@ -0,0 +1,26 @@
+package userRepository
+
+import (
+	"context"
+	"fmt"
+
+	"kudago/internal/models"
+)
+
+// the email check makes a token useless once the address has been changed
+const setEmailVerifiedQuery = `
+	UPDATE "USER"
+	SET verified_at = NOW(), modified_at = NOW()
+	WHERE id = $1 AND email = $2 AND verified_at IS NULL`
+
+func (d *UserDB) SetEmailVerified(ctx context.Context, ID int, email string) error {
+	result, err := d.Pool.Exec(ctx, setEmailVerifiedQuery, ID, email)
+	if err != nil {
+		return fmt.Errorf("%s: %w", models.LevelDB, err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("%s: %w", models.LevelDB, models.ErrInvalidToken)
+	}
+	return nil
+}
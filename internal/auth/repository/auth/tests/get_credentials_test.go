@@ -0,0 +1,113 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"kudago/internal/auth/repository/auth"
+
+	"kudago/internal/models"
+)
+
+func TestUserDB_GetCredentials(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	tests := []struct {
+		name         string
+		username     string
+		mockSetup    func(m pgxmock.PgxConnIface)
+		expectedUser models.User
+		expectedHash string
+		expectErr    bool
+	}{
+		{
+			name:     "Пользователь найден",
+			username: "testuser",
+			mockSetup: func(m pgxmock.PgxConnIface) {
+				m.ExpectQuery(`SELECT id, username, email, created_at, url_to_avatar, verified_at, password_hash`).
+					WithArgs("testuser").
+					WillReturnRows(pgxmock.NewRows([]string{"id", "username", "email", "created_at", "url_to_avatar", "verified_at", "password_hash"}).
+						AddRow(1, "testuser", "testuser@example.com", time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC), stringPtr("avatar.jpg"), (*time.Time)(nil), "$2a$10$hash"))
+			},
+			expectedUser: models.User{
+				ID:       1,
+				Username: "testuser",
+				Email:    "testuser@example.com",
+				ImageURL: "avatar.jpg",
+			},
+			expectedHash: "$2a$10$hash",
+			expectErr:    false,
+		},
+		{
+			name:     "Подтвержденный пользователь",
+			username: "testuser",
+			mockSetup: func(m pgxmock.PgxConnIface) {
+				verifiedAt := time.Date(2024, time.January, 2, 0, 0, 0, 0, time.UTC)
+				m.ExpectQuery(`SELECT id, username, email, created_at, url_to_avatar, verified_at, password_hash`).
+					WithArgs("testuser").
+					WillReturnRows(pgxmock.NewRows([]string{"id", "username", "email", "created_at", "url_to_avatar", "verified_at", "password_hash"}).
+						AddRow(1, "testuser", "testuser@example.com", time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC), stringPtr("avatar.jpg"), &verifiedAt, "$2a$10$hash"))
+			},
+			expectedUser: models.User{
+				ID:       1,
+				Username: "testuser",
+				Email:    "testuser@example.com",
+				ImageURL: "avatar.jpg",
+				Verified: true,
+			},
+			expectedHash: "$2a$10$hash",
+			expectErr:    false,
+		},
+		{
+			name:     "Пользователь не найден",
+			username: "testuser",
+			mockSetup: func(m pgxmock.PgxConnIface) {
+				m.ExpectQuery(`SELECT id, username, email, created_at, url_to_avatar, verified_at, password_hash`).
+					WithArgs("testuser").
+					WillReturnError(pgx.ErrNoRows)
+			},
+			expectedUser: models.User{},
+			expectErr:    true,
+		},
+		{
+			name:     "Ошибка при выполнении запроса",
+			username: "testuser",
+			mockSetup: func(m pgxmock.PgxConnIface) {
+				m.ExpectQuery(`SELECT id, username, email, created_at, url_to_avatar, verified_at, password_hash`).
+					WithArgs("testuser").
+					WillReturnError(fmt.Errorf("database error"))
+			},
+			expectedUser: models.User{},
+			expectErr:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockConn, err := pgxmock.NewConn()
+			require.NoError(t, err)
+			defer mockConn.Close(ctx)
+			tt.mockSetup(mockConn)
+			db := userRepository.UserDB{Pool: mockConn}
+			user, hash, err := db.GetCredentials(ctx, tt.username)
+
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedUser, user)
+				assert.Equal(t, tt.expectedHash, hash)
+			}
+		})
+	}
+}
@@ -0,0 +1,86 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"kudago/internal/auth/repository/auth"
+
+	"kudago/internal/models"
+)
+
+func TestUserDB_GetPasswordHash(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	tests := []struct {
+		name         string
+		ID           int
+		mockSetup    func(m pgxmock.PgxConnIface)
+		expectedHash string
+		expectedErr  error
+		expectErr    bool
+	}{
+		{
+			name: "Успешное получение хеша",
+			ID:   1,
+			mockSetup: func(m pgxmock.PgxConnIface) {
+				m.ExpectQuery(`SELECT password_hash FROM "USER"`).
+					WithArgs(1).
+					WillReturnRows(pgxmock.NewRows([]string{"password_hash"}).AddRow("$2a$10$hash"))
+			},
+			expectedHash: "$2a$10$hash",
+			expectErr:    false,
+		},
+		{
+			name: "Пользователь не найден",
+			ID:   2,
+			mockSetup: func(m pgxmock.PgxConnIface) {
+				m.ExpectQuery(`SELECT password_hash FROM "USER"`).
+					WithArgs(2).
+					WillReturnError(pgx.ErrNoRows)
+			},
+			expectedErr: models.ErrUserNotFound,
+			expectErr:   true,
+		},
+		{
+			name: "Ошибка при выполнении запроса",
+			ID:   3,
+			mockSetup: func(m pgxmock.PgxConnIface) {
+				m.ExpectQuery(`SELECT password_hash FROM "USER"`).
+					WithArgs(3).
+					WillReturnError(fmt.Errorf("database error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockConn, err := pgxmock.NewConn()
+			require.NoError(t, err)
+			defer mockConn.Close(ctx)
+			tt.mockSetup(mockConn)
+			db := userRepository.UserDB{Pool: mockConn}
+			hash, err := db.GetPasswordHash(ctx, tt.ID)
+
+			if tt.expectErr {
+				assert.Error(t, err)
+				if tt.expectedErr != nil {
+					assert.ErrorIs(t, err, tt.expectedErr)
+				}
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedHash, hash)
+			}
+		})
+	}
+}
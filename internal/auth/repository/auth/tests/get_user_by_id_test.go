@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/pashagolub/pgxmock/v4"
@@ -31,7 +32,7 @@ func TestUserDB_GetUserByID(t *testing.T) {
 			name: "Пользователь не найден",
 			ID:   2,
 			mockSetup: func(m pgxmock.PgxConnIface) {
-				m.ExpectQuery(`SELECT id, username, email, url_to_avatar FROM "USER" WHERE id=\$1`).
+				m.ExpectQuery(`SELECT id, username, email, url_to_avatar, verified_at FROM "USER" WHERE id=\$1`).
 					WithArgs(2).
 					WillReturnError(pgx.ErrNoRows)
 			},
@@ -42,13 +43,26 @@ func TestUserDB_GetUserByID(t *testing.T) {
 			name: "Ошибка при запросе",
 			ID:   3,
 			mockSetup: func(m pgxmock.PgxConnIface) {
-				m.ExpectQuery(`SELECT id, username, email, url_to_avatar FROM "USER" WHERE id=\$1`).
+				m.ExpectQuery(`SELECT id, username, email, url_to_avatar, verified_at FROM "USER" WHERE id=\$1`).
 					WithArgs(3).
 					WillReturnError(fmt.Errorf("database error"))
 			},
 			expected:  models.User{},
 			expectErr: true,
 		},
+		{
+			name: "Пользователь с подтвержденной почтой",
+			ID:   1,
+			mockSetup: func(m pgxmock.PgxConnIface) {
+				verifiedAt := time.Now()
+				m.ExpectQuery(`SELECT id, username, email, url_to_avatar, verified_at FROM "USER" WHERE id=\$1`).
+					WithArgs(1).
+					WillReturnRows(pgxmock.NewRows([]string{"id", "username", "email", "url_to_avatar", "verified_at"}).
+						AddRow(1, "user1", "user1@mail.ru", nil, &verifiedAt))
+			},
+			expected:  models.User{ID: 1, Username: "user1", Email: "user1@mail.ru", Verified: true},
+			expectErr: false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,78 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"kudago/internal/auth/repository/auth"
+
+	"kudago/internal/models"
+)
+
+func TestUserDB_SetEmailVerified(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	tests := []struct {
+		name        string
+		mockSetup   func(m pgxmock.PgxConnIface)
+		expectedErr error
+		expectErr   bool
+	}{
+		{
+			name: "Почта подтверждена",
+			mockSetup: func(m pgxmock.PgxConnIface) {
+				m.ExpectExec(`UPDATE "USER"`).
+					WithArgs(1, "user1@mail.ru").
+					WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+			},
+			expectErr: false,
+		},
+		{
+			name: "Почта изменилась или уже подтверждена",
+			mockSetup: func(m pgxmock.PgxConnIface) {
+				m.ExpectExec(`UPDATE "USER"`).
+					WithArgs(1, "user1@mail.ru").
+					WillReturnResult(pgxmock.NewResult("UPDATE", 0))
+			},
+			expectedErr: models.ErrInvalidToken,
+			expectErr:   true,
+		},
+		{
+			name: "Ошибка при выполнении запроса",
+			mockSetup: func(m pgxmock.PgxConnIface) {
+				m.ExpectExec(`UPDATE "USER"`).
+					WithArgs(1, "user1@mail.ru").
+					WillReturnError(fmt.Errorf("database error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockConn, err := pgxmock.NewConn()
+			require.NoError(t, err)
+			defer mockConn.Close(ctx)
+			tt.mockSetup(mockConn)
+			db := userRepository.UserDB{Pool: mockConn}
+			err = db.SetEmailVerified(ctx, 1, "user1@mail.ru")
+
+			if tt.expectErr {
+				assert.Error(t, err)
+				if tt.expectedErr != nil {
+					assert.ErrorIs(t, err, tt.expectedErr)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,86 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"kudago/internal/auth/repository/auth"
+
+	"kudago/internal/models"
+)
+
+func TestUserDB_UpdatePasswordHash(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	tests := []struct {
+		name        string
+		ID          int
+		hash        string
+		mockSetup   func(m pgxmock.PgxConnIface)
+		expectedErr error
+		expectErr   bool
+	}{
+		{
+			name: "Успешное обновление",
+			ID:   1,
+			hash: "$2a$10$hash",
+			mockSetup: func(m pgxmock.PgxConnIface) {
+				m.ExpectExec(`UPDATE "USER"`).
+					WithArgs(1, "$2a$10$hash").
+					WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+			},
+			expectErr: false,
+		},
+		{
+			name: "Пользователь не найден",
+			ID:   2,
+			hash: "$2a$10$hash",
+			mockSetup: func(m pgxmock.PgxConnIface) {
+				m.ExpectExec(`UPDATE "USER"`).
+					WithArgs(2, "$2a$10$hash").
+					WillReturnResult(pgxmock.NewResult("UPDATE", 0))
+			},
+			expectedErr: models.ErrUserNotFound,
+			expectErr:   true,
+		},
+		{
+			name: "Ошибка при выполнении запроса",
+			ID:   3,
+			hash: "$2a$10$hash",
+			mockSetup: func(m pgxmock.PgxConnIface) {
+				m.ExpectExec(`UPDATE "USER"`).
+					WithArgs(3, "$2a$10$hash").
+					WillReturnError(fmt.Errorf("database error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockConn, err := pgxmock.NewConn()
+			require.NoError(t, err)
+			defer mockConn.Close(ctx)
+			tt.mockSetup(mockConn)
+			db := userRepository.UserDB{Pool: mockConn}
+			err = db.UpdatePasswordHash(ctx, tt.ID, tt.hash)
+
+			if tt.expectErr {
+				assert.Error(t, err)
+				if tt.expectedErr != nil {
+					assert.ErrorIs(t, err, tt.expectedErr)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,25 @@
+package userRepository
+
+import (
+	"context"
+	"fmt"
+
+	"kudago/internal/models"
+)
+
+const updatePasswordHashQuery = `
+	UPDATE "USER"
+	SET password_hash = $2, modified_at = NOW()
+	WHERE id = $1`
+
+func (d *UserDB) UpdatePasswordHash(ctx context.Context, ID int, passwordHash string) error {
+	result, err := d.Pool.Exec(ctx, updatePasswordHashQuery, ID, passwordHash)
+	if err != nil {
+		return fmt.Errorf("%s: %w", models.LevelDB, err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("%s: %w", models.LevelDB, models.ErrUserNotFound)
+	}
+	return nil
+}
@@ -11,12 +11,13 @@ import (
 )
 
 type UserInfo struct {
-	ID         int       `db:"id"`
-	Username   string    `db:"username"`
-	Email      string    `db:"email"`
-	ImageURL   *string   `db:"url_to_avatar"`
-	CreatedAt  time.Time `db:"created_at"`
-	ModifiedAt time.Time `db:"modified_at"`
+	ID         int        `db:"id"`
+	Username   string     `db:"username"`
+	Email      string     `db:"email"`
+	ImageURL   *string    `db:"url_to_avatar"`
+	CreatedAt  time.Time  `db:"created_at"`
+	ModifiedAt time.Time  `db:"modified_at"`
+	VerifiedAt *time.Time `db:"verified_at"`
 }
 
 type UserDB struct {
@@ -57,5 +58,6 @@ func ToDomainUser(user UserInfo) models.User {
 		Username: user.Username,
 		Email:    user.Email,
 		ImageURL: imageURL,
+		Verified: user.VerifiedAt != nil,
 	}
 }
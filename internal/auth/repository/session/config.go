@@ -0,0 +1,47 @@
+package sessionRepository
+
+import (
+	"fmt"
+	"os"
+	"time"
+)
+
+const (
+	defaultIdleTimeout = 24 * time.Hour
+	defaultMaxLifetime = 30 * 24 * time.Hour
+)
+
+// SessionConfig controls sliding expiration: a session dies after
+// IdleTimeout without requests and never lives longer than MaxLifetime.
+type SessionConfig struct {
+	IdleTimeout time.Duration
+	MaxLifetime time.Duration
+}
+
+func GetSessionConfig() (SessionConfig, error) {
+	config := SessionConfig{
+		IdleTimeout: defaultIdleTimeout,
+		MaxLifetime: defaultMaxLifetime,
+	}
+
+	var err error
+	if value := os.Getenv("SESSION_IDLE_TIMEOUT"); value != "" {
+		config.IdleTimeout, err = time.ParseDuration(value)
+		if err != nil {
+			return SessionConfig{}, fmt.Errorf("invalid SESSION_IDLE_TIMEOUT: %v", err)
+		}
+	}
+
+	if value := os.Getenv("SESSION_MAX_LIFETIME"); value != "" {
+		config.MaxLifetime, err = time.ParseDuration(value)
+		if err != nil {
+			return SessionConfig{}, fmt.Errorf("invalid SESSION_MAX_LIFETIME: %v", err)
+		}
+	}
+
+	if config.IdleTimeout <= 0 || config.MaxLifetime < config.IdleTimeout {
+		return SessionConfig{}, fmt.Errorf("session max lifetime must not be shorter than idle timeout")
+	}
+
+	return config, nil
+}
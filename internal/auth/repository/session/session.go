@@ -3,6 +3,7 @@ package sessionRepository
 import (
 	"context"
 	"crypto/rand"
+	"crypto/sha256"
 	"encoding/hex"
 	"fmt"
 	"strconv"
@@ -15,14 +16,22 @@ import (
 )
 
 const (
-	expirationTime = 24 * time.Hour
+	sessionInfoPrefix  = "session_info:"
+	userSessionsPrefix = "user_sessions:"
+
+	fieldUserAgent  = "user_agent"
+	fieldIP         = "ip"
+	fieldCreatedAt  = "created_at"
+	fieldLastUsedAt = "last_used_at"
+	fieldVerified   = "verified"
 )
 
 type SessionDB struct {
 	client *redis.Client
+	config SessionConfig
 }
 
-func NewDB(config *redisDB.RedisConfig) *SessionDB {
+func NewDB(config *redisDB.RedisConfig, sessionConfig SessionConfig) *SessionDB {
 	redisClient := redis.NewClient(&redis.Options{
 		Addr:     config.URL,
 		Password: config.Password,
@@ -32,25 +41,51 @@ func NewDB(config *redisDB.RedisConfig) *SessionDB {
 
 	return &SessionDB{
 		client: redisClient,
+		config: sessionConfig,
 	}
 }
 
-func (db *SessionDB) CreateSession(ctx context.Context, ID int) (models.Session, error) {
+// CreateSession stores the session together with whether the user has
+// verified their email, so that checking it needs no trip to Postgres.
+func (db *SessionDB) CreateSession(ctx context.Context, ID int, verified bool, userAgent, ip string) (models.Session, error) {
 	sessionToken := generateSessionToken()
-	expiration := time.Now().Add(expirationTime)
+	now := time.Now()
+	ttl := min(db.config.IdleTimeout, db.config.MaxLifetime)
+	expiration := now.Add(ttl)
 
 	session := models.Session{
-		UserID:  ID,
-		Token:   sessionToken,
-		Expires: expiration,
+		UserID:   ID,
+		Token:    sessionToken,
+		Expires:  expiration,
+		Verified: verified,
 	}
-	err := db.client.Set(ctx, sessionToken, session.UserID, expirationTime).Err()
+
+	infoKey := sessionInfoPrefix + sessionToken
+	userKey := userSessionsKey(ID)
+	_, err := db.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Set(ctx, sessionToken, session.UserID, ttl)
+		pipe.HSet(ctx, infoKey,
+			fieldUserAgent, userAgent,
+			fieldIP, ip,
+			fieldCreatedAt, now.Unix(),
+			fieldLastUsedAt, now.Unix(),
+			fieldVerified, verified,
+		)
+		pipe.Expire(ctx, infoKey, ttl)
+		pipe.SAdd(ctx, userKey, sessionToken)
+		// no session outlives MaxLifetime, so neither does the index
+		pipe.Expire(ctx, userKey, db.config.MaxLifetime)
+		return nil
+	})
 	if err != nil {
 		return models.Session{}, fmt.Errorf("%s: %w", models.LevelDB, err)
 	}
 	return session, nil
 }
 
+// CheckSession validates the token and slides its expiration. The TTL is
+// only extended once less than half of the idle timeout is left, so that
+// the cookie has to be reissued at most that often; Renewed reports it.
 func (db *SessionDB) CheckSession(ctx context.Context, cookie string) (models.Session, error) {
 	ID, err := db.client.Get(ctx, cookie).Result()
 	if err == redis.Nil {
@@ -66,23 +101,227 @@ func (db *SessionDB) CheckSession(ctx context.Context, cookie string) (models.Se
 		return models.Session{}, fmt.Errorf("%s: %w", models.LevelDB, err)
 	}
 
+	ttl, err := db.client.TTL(ctx, cookie).Result()
+	if err != nil {
+		return models.Session{}, fmt.Errorf("%s: %w", models.LevelDB, err)
+	}
+
+	infoKey := sessionInfoPrefix + cookie
+	info, err := db.client.HMGet(ctx, infoKey, fieldCreatedAt, fieldVerified).Result()
+	if err != nil {
+		return models.Session{}, fmt.Errorf("%s: %w", models.LevelDB, err)
+	}
+
+	now := time.Now()
 	session := models.Session{
 		UserID:  userID,
 		Token:   cookie,
-		Expires: time.Now().Add(expirationTime),
+		Expires: now.Add(ttl),
+	}
+
+	// sessions created before the index existed have no metadata,
+	// they are left to expire on their own
+	createdAtValue, ok := info[0].(string)
+	if !ok {
+		return session, nil
+	}
+
+	createdAt, err := strconv.ParseInt(createdAtValue, 10, 64)
+	if err != nil {
+		return models.Session{}, fmt.Errorf("%s: %w", models.LevelDB, err)
+	}
+	session.Verified = info[1] == "1"
+
+	deadline := time.Unix(createdAt, 0).Add(db.config.MaxLifetime)
+	if !now.Before(deadline) {
+		err = db.deleteSessions(ctx, userID, cookie)
+		if err != nil {
+			return models.Session{}, err
+		}
+		return models.Session{}, models.ErrUserNotFound
+	}
+
+	newTTL := min(db.config.IdleTimeout, deadline.Sub(now))
+	renew := ttl < db.config.IdleTimeout/2 && newTTL > ttl
+
+	_, err = db.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.HSet(ctx, infoKey, fieldLastUsedAt, now.Unix())
+		if renew {
+			pipe.Expire(ctx, cookie, newTTL)
+			pipe.Expire(ctx, infoKey, newTTL)
+		}
+		return nil
+	})
+	if err != nil {
+		return models.Session{}, fmt.Errorf("%s: %w", models.LevelDB, err)
+	}
+
+	if renew {
+		session.Expires = now.Add(newTTL)
+		session.Renewed = true
 	}
 
 	return session, nil
 }
 
 func (db *SessionDB) DeleteSession(ctx context.Context, token string) error {
-	err := db.client.Del(ctx, token).Err()
+	ID, err := db.client.Get(ctx, token).Result()
+	if err == redis.Nil {
+		return nil
+	}
 	if err != nil {
 		return err
 	}
+
+	userID, err := strconv.Atoi(ID)
+	if err != nil {
+		return fmt.Errorf("%s: %w", models.LevelDB, err)
+	}
+
+	return db.deleteSessions(ctx, userID, token)
+}
+
+// ListSessions returns active sessions of the user. Tokens whose session
+// has already expired are removed from the index on the way.
+func (db *SessionDB) ListSessions(ctx context.Context, userID int, currentToken string) ([]models.SessionInfo, error) {
+	tokens, err := db.client.SMembers(ctx, userSessionsKey(userID)).Result()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", models.LevelDB, err)
+	}
+
+	sessions := make([]models.SessionInfo, 0, len(tokens))
+	var expired []interface{}
+	for _, token := range tokens {
+		info, err := db.client.HGetAll(ctx, sessionInfoPrefix+token).Result()
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", models.LevelDB, err)
+		}
+
+		if len(info) == 0 {
+			expired = append(expired, token)
+			continue
+		}
+
+		session := toSessionInfo(token, info)
+		session.Current = token == currentToken
+		sessions = append(sessions, session)
+	}
+
+	if len(expired) > 0 {
+		err = db.client.SRem(ctx, userSessionsKey(userID), expired...).Err()
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", models.LevelDB, err)
+		}
+	}
+
+	return sessions, nil
+}
+
+// SetVerified sets the verification flag on every live session of the user.
+func (db *SessionDB) SetVerified(ctx context.Context, userID int, verified bool) error {
+	tokens, err := db.client.SMembers(ctx, userSessionsKey(userID)).Result()
+	if err != nil {
+		return fmt.Errorf("%s: %w", models.LevelDB, err)
+	}
+
+	for _, token := range tokens {
+		infoKey := sessionInfoPrefix + token
+		// HSet would recreate the metadata of an expired session without
+		// a TTL, so only sessions that are still alive are updated
+		ttl, err := db.client.TTL(ctx, infoKey).Result()
+		if err != nil {
+			return fmt.Errorf("%s: %w", models.LevelDB, err)
+		}
+		if ttl <= 0 {
+			continue
+		}
+
+		_, err = db.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+			pipe.HSet(ctx, infoKey, fieldVerified, verified)
+			pipe.Expire(ctx, infoKey, ttl)
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("%s: %w", models.LevelDB, err)
+		}
+	}
+
 	return nil
 }
 
+func (db *SessionDB) RevokeSession(ctx context.Context, userID int, sessionID string) error {
+	tokens, err := db.client.SMembers(ctx, userSessionsKey(userID)).Result()
+	if err != nil {
+		return fmt.Errorf("%s: %w", models.LevelDB, err)
+	}
+
+	for _, token := range tokens {
+		if publicSessionID(token) == sessionID {
+			return db.deleteSessions(ctx, userID, token)
+		}
+	}
+
+	return fmt.Errorf("%s: %w", models.LevelDB, models.ErrNotFound)
+}
+
+// RevokeAllSessions logs the user out everywhere except the session
+// with exceptToken. An empty exceptToken revokes every session.
+func (db *SessionDB) RevokeAllSessions(ctx context.Context, userID int, exceptToken string) error {
+	tokens, err := db.client.SMembers(ctx, userSessionsKey(userID)).Result()
+	if err != nil {
+		return fmt.Errorf("%s: %w", models.LevelDB, err)
+	}
+
+	toDelete := make([]string, 0, len(tokens))
+	for _, token := range tokens {
+		if token != exceptToken {
+			toDelete = append(toDelete, token)
+		}
+	}
+
+	if len(toDelete) == 0 {
+		return nil
+	}
+	return db.deleteSessions(ctx, userID, toDelete...)
+}
+
+func (db *SessionDB) deleteSessions(ctx context.Context, userID int, tokens ...string) error {
+	_, err := db.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		for _, token := range tokens {
+			pipe.Del(ctx, token, sessionInfoPrefix+token)
+			pipe.SRem(ctx, userSessionsKey(userID), token)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", models.LevelDB, err)
+	}
+	return nil
+}
+
+func toSessionInfo(token string, info map[string]string) models.SessionInfo {
+	createdAt, _ := strconv.ParseInt(info[fieldCreatedAt], 10, 64)
+	lastUsedAt, _ := strconv.ParseInt(info[fieldLastUsedAt], 10, 64)
+
+	return models.SessionInfo{
+		ID:         publicSessionID(token),
+		UserAgent:  info[fieldUserAgent],
+		IP:         info[fieldIP],
+		CreatedAt:  time.Unix(createdAt, 0),
+		LastUsedAt: time.Unix(lastUsedAt, 0),
+	}
+}
+
+// publicSessionID identifies a session in the API without exposing its token.
+func publicSessionID(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:8])
+}
+
+func userSessionsKey(userID int) string {
+	return userSessionsPrefix + strconv.Itoa(userID)
+}
+
 func generateSessionToken() string {
 	b := make([]byte, 16)
 	rand.Read(b)
@@ -3,7 +3,9 @@ package sessionRepository
 import (
 	"context"
 	"errors"
+	"strconv"
 	"testing"
+	"time"
 
 	"github.com/go-redis/redismock/v9"
 	"github.com/stretchr/testify/assert"
@@ -11,24 +13,162 @@ import (
 	"kudago/internal/models"
 )
 
-func TestSessionDB_CheckSession(t *testing.T) {
+var testConfig = SessionConfig{
+	IdleTimeout: 24 * time.Hour,
+	MaxLifetime: 30 * 24 * time.Hour,
+}
+
+func TestSessionDB_CreateSession(t *testing.T) {
 	t.Parallel()
 
 	ctx := context.Background()
 
 	tests := []struct {
 		name          string
-		token         string
 		mockSetup     func(mock redismock.ClientMock)
 		expectedError error
 	}{
 		{
-			name:  "Сессия найдена",
+			name: "Успешное создание сессии",
+			mockSetup: func(mock redismock.ClientMock) {
+				re := mock.Regexp()
+				re.ExpectTxPipeline()
+				re.ExpectSet(`^[0-9a-f]{32}$`, 1, testConfig.IdleTimeout).SetVal("OK")
+				re.ExpectHSet(`^`+sessionInfoPrefix+`[0-9a-f]{32}$`,
+					fieldUserAgent, "^Mozilla/5.0$",
+					fieldIP, `^192\.0\.2\.1$`,
+					fieldCreatedAt, `^\d+$`,
+					fieldLastUsedAt, `^\d+$`,
+					fieldVerified, "^true$",
+				).SetVal(5)
+				re.ExpectExpire(`^`+sessionInfoPrefix+`[0-9a-f]{32}$`, testConfig.IdleTimeout).SetVal(true)
+				re.ExpectSAdd(userSessionsPrefix+"1", `^[0-9a-f]{32}$`).SetVal(1)
+				re.ExpectExpire(userSessionsPrefix+"1", testConfig.MaxLifetime).SetVal(true)
+				re.ExpectTxPipelineExec()
+			},
+			expectedError: nil,
+		},
+		{
+			name: "Ошибка Redis",
+			mockSetup: func(mock redismock.ClientMock) {
+				re := mock.Regexp()
+				re.ExpectTxPipeline()
+				re.ExpectSet(`^[0-9a-f]{32}$`, 1, testConfig.IdleTimeout).SetErr(errors.New("redis error"))
+			},
+			expectedError: errors.New("redis error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockRedis, mock := redismock.NewClientMock()
+			tt.mockSetup(mock)
+
+			db := &SessionDB{client: mockRedis, config: testConfig}
+			session, err := db.CreateSession(ctx, 1, true, "Mozilla/5.0", "192.0.2.1")
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, 1, session.UserID)
+				assert.True(t, session.Verified)
+				assert.Len(t, session.Token, 32)
+				assert.NoError(t, mock.ExpectationsWereMet())
+			}
+		})
+	}
+}
+
+func TestSessionDB_CheckSession(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	now := time.Now()
+	anyArgs := func(expected, actual []interface{}) error { return nil }
+
+	tests := []struct {
+		name             string
+		token            string
+		mockSetup        func(mock redismock.ClientMock)
+		expectedRenewed  bool
+		expectedVerified bool
+		expectedError    error
+	}{
+		{
+			name:  "Сессия найдена, продление не требуется",
 			token: "valid-token",
 			mockSetup: func(mock redismock.ClientMock) {
 				mock.ExpectGet("valid-token").SetVal("1")
+				mock.ExpectTTL("valid-token").SetVal(20 * time.Hour)
+				mock.ExpectHMGet(sessionInfoPrefix+"valid-token", fieldCreatedAt, fieldVerified).SetVal([]interface{}{strconv.FormatInt(now.Add(-time.Hour).Unix(), 10), "1"})
+				mock.ExpectTxPipeline()
+				mock.Regexp().ExpectHSet(sessionInfoPrefix+"valid-token", fieldLastUsedAt, `^\d+$`).SetVal(0)
+				mock.ExpectTxPipelineExec()
 			},
-			expectedError: nil,
+			expectedRenewed:  false,
+			expectedVerified: true,
+			expectedError:    nil,
+		},
+		{
+			name:  "Сессия продлевается на idle timeout",
+			token: "valid-token",
+			mockSetup: func(mock redismock.ClientMock) {
+				mock.ExpectGet("valid-token").SetVal("1")
+				mock.ExpectTTL("valid-token").SetVal(time.Hour)
+				mock.ExpectHMGet(sessionInfoPrefix+"valid-token", fieldCreatedAt, fieldVerified).SetVal([]interface{}{strconv.FormatInt(now.Add(-23*time.Hour).Unix(), 10), "0"})
+				mock.ExpectTxPipeline()
+				mock.Regexp().ExpectHSet(sessionInfoPrefix+"valid-token", fieldLastUsedAt, `^\d+$`).SetVal(0)
+				mock.ExpectExpire("valid-token", testConfig.IdleTimeout).SetVal(true)
+				mock.ExpectExpire(sessionInfoPrefix+"valid-token", testConfig.IdleTimeout).SetVal(true)
+				mock.ExpectTxPipelineExec()
+			},
+			expectedRenewed: true,
+			expectedError:   nil,
+		},
+		{
+			name:  "Продление ограничено максимальным временем жизни",
+			token: "valid-token",
+			mockSetup: func(mock redismock.ClientMock) {
+				mock.ExpectGet("valid-token").SetVal("1")
+				mock.ExpectTTL("valid-token").SetVal(time.Hour)
+				mock.ExpectHMGet(sessionInfoPrefix+"valid-token", fieldCreatedAt, fieldVerified).SetVal([]interface{}{strconv.FormatInt(now.Add(-testConfig.MaxLifetime+2*time.Hour).Unix(), 10), "0"})
+				mock.ExpectTxPipeline()
+				mock.Regexp().ExpectHSet(sessionInfoPrefix+"valid-token", fieldLastUsedAt, `^\d+$`).SetVal(0)
+				mock.CustomMatch(anyArgs).ExpectExpire("valid-token", 2*time.Hour).SetVal(true)
+				mock.CustomMatch(anyArgs).ExpectExpire(sessionInfoPrefix+"valid-token", 2*time.Hour).SetVal(true)
+				mock.ExpectTxPipelineExec()
+			},
+			expectedRenewed: true,
+			expectedError:   nil,
+		},
+		{
+			name:  "Истекло максимальное время жизни",
+			token: "old-token",
+			mockSetup: func(mock redismock.ClientMock) {
+				mock.ExpectGet("old-token").SetVal("1")
+				mock.ExpectTTL("old-token").SetVal(time.Hour)
+				mock.ExpectHMGet(sessionInfoPrefix+"old-token", fieldCreatedAt, fieldVerified).SetVal([]interface{}{strconv.FormatInt(now.Add(-testConfig.MaxLifetime-time.Hour).Unix(), 10), "0"})
+				mock.ExpectTxPipeline()
+				mock.ExpectDel("old-token", sessionInfoPrefix+"old-token").SetVal(2)
+				mock.ExpectSRem(userSessionsPrefix+"1", "old-token").SetVal(1)
+				mock.ExpectTxPipelineExec()
+			},
+			expectedError: models.ErrUserNotFound,
+		},
+		{
+			name:  "Сессия без метаданных не продлевается",
+			token: "legacy-token",
+			mockSetup: func(mock redismock.ClientMock) {
+				mock.ExpectGet("legacy-token").SetVal("1")
+				mock.ExpectTTL("legacy-token").SetVal(time.Hour)
+				mock.ExpectHMGet(sessionInfoPrefix+"legacy-token", fieldCreatedAt, fieldVerified).SetVal([]interface{}{nil, nil})
+			},
+			expectedRenewed: false,
+			expectedError:   nil,
 		},
 		{
 			name:  "Сессия не найдена",
@@ -55,17 +195,19 @@ func TestSessionDB_CheckSession(t *testing.T) {
 			mockRedis, mock := redismock.NewClientMock()
 			tt.mockSetup(mock)
 
-			db := &SessionDB{client: mockRedis}
-			_, err := db.CheckSession(ctx, tt.token)
+			db := &SessionDB{client: mockRedis, config: testConfig}
+			session, err := db.CheckSession(ctx, tt.token)
 
 			if tt.expectedError != nil {
 				assert.Error(t, err)
 				assert.Contains(t, err.Error(), tt.expectedError.Error())
 			} else {
 				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedRenewed, session.Renewed)
+				assert.Equal(t, tt.expectedVerified, session.Verified)
 			}
 
-			mock.ExpectationsWereMet()
+			assert.NoError(t, mock.ExpectationsWereMet())
 		})
 	}
 }
@@ -85,7 +227,19 @@ func TestSessionDB_DeleteSession(t *testing.T) {
 			name:  "Успешное удаление сессии",
 			token: "valid-token",
 			mockSetup: func(mock redismock.ClientMock) {
-				mock.ExpectDel("valid-token").SetVal(1)
+				mock.ExpectGet("valid-token").SetVal("1")
+				mock.ExpectTxPipeline()
+				mock.ExpectDel("valid-token", sessionInfoPrefix+"valid-token").SetVal(2)
+				mock.ExpectSRem(userSessionsPrefix+"1", "valid-token").SetVal(1)
+				mock.ExpectTxPipelineExec()
+			},
+			expectedError: nil,
+		},
+		{
+			name:  "Сессия уже истекла",
+			token: "expired-token",
+			mockSetup: func(mock redismock.ClientMock) {
+				mock.ExpectGet("expired-token").RedisNil()
 			},
 			expectedError: nil,
 		},
@@ -93,7 +247,9 @@ func TestSessionDB_DeleteSession(t *testing.T) {
 			name:  "Ошибка при удалении сессии",
 			token: "error-token",
 			mockSetup: func(mock redismock.ClientMock) {
-				mock.ExpectDel("error-token").SetErr(errors.New("redis error"))
+				mock.ExpectGet("error-token").SetVal("1")
+				mock.ExpectTxPipeline()
+				mock.ExpectDel("error-token", sessionInfoPrefix+"error-token").SetErr(errors.New("redis error"))
 			},
 			expectedError: errors.New("redis error"),
 		},
@@ -106,7 +262,7 @@ func TestSessionDB_DeleteSession(t *testing.T) {
 			mockRedis, mock := redismock.NewClientMock()
 			tt.mockSetup(mock)
 
-			db := &SessionDB{client: mockRedis}
+			db := &SessionDB{client: mockRedis, config: testConfig}
 			err := db.DeleteSession(ctx, tt.token)
 
 			if tt.expectedError != nil {
@@ -120,3 +276,254 @@ func TestSessionDB_DeleteSession(t *testing.T) {
 		})
 	}
 }
+
+func TestSessionDB_ListSessions(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	tests := []struct {
+		name          string
+		mockSetup     func(mock redismock.ClientMock)
+		expected      []models.SessionInfo
+		expectedError error
+	}{
+		{
+			name: "Активные сессии, истекшая удаляется из индекса",
+			mockSetup: func(mock redismock.ClientMock) {
+				mock.ExpectSMembers(userSessionsPrefix + "1").SetVal([]string{"current-token", "expired-token"})
+				mock.ExpectHGetAll(sessionInfoPrefix + "current-token").SetVal(map[string]string{
+					fieldUserAgent:  "Mozilla/5.0",
+					fieldIP:         "192.0.2.1",
+					fieldCreatedAt:  "1733047200",
+					fieldLastUsedAt: "1733133600",
+				})
+				mock.ExpectHGetAll(sessionInfoPrefix + "expired-token").SetVal(map[string]string{})
+				mock.ExpectSRem(userSessionsPrefix+"1", "expired-token").SetVal(1)
+			},
+			expected: []models.SessionInfo{
+				{
+					ID:         publicSessionID("current-token"),
+					UserAgent:  "Mozilla/5.0",
+					IP:         "192.0.2.1",
+					CreatedAt:  time.Unix(1733047200, 0),
+					LastUsedAt: time.Unix(1733133600, 0),
+					Current:    true,
+				},
+			},
+			expectedError: nil,
+		},
+		{
+			name: "Ошибка Redis",
+			mockSetup: func(mock redismock.ClientMock) {
+				mock.ExpectSMembers(userSessionsPrefix + "1").SetErr(errors.New("redis error"))
+			},
+			expected:      nil,
+			expectedError: errors.New("redis error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockRedis, mock := redismock.NewClientMock()
+			tt.mockSetup(mock)
+
+			db := &SessionDB{client: mockRedis, config: testConfig}
+			sessions, err := db.ListSessions(ctx, 1, "current-token")
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expected, sessions)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestSessionDB_RevokeSession(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	tests := []struct {
+		name          string
+		sessionID     string
+		mockSetup     func(mock redismock.ClientMock)
+		expectedError error
+	}{
+		{
+			name:      "Сессия отозвана",
+			sessionID: publicSessionID("other-token"),
+			mockSetup: func(mock redismock.ClientMock) {
+				mock.ExpectSMembers(userSessionsPrefix + "1").SetVal([]string{"current-token", "other-token"})
+				mock.ExpectTxPipeline()
+				mock.ExpectDel("other-token", sessionInfoPrefix+"other-token").SetVal(2)
+				mock.ExpectSRem(userSessionsPrefix+"1", "other-token").SetVal(1)
+				mock.ExpectTxPipelineExec()
+			},
+			expectedError: nil,
+		},
+		{
+			name:      "Сессия не найдена",
+			sessionID: "unknown",
+			mockSetup: func(mock redismock.ClientMock) {
+				mock.ExpectSMembers(userSessionsPrefix + "1").SetVal([]string{"current-token"})
+			},
+			expectedError: models.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockRedis, mock := redismock.NewClientMock()
+			tt.mockSetup(mock)
+
+			db := &SessionDB{client: mockRedis, config: testConfig}
+			err := db.RevokeSession(ctx, 1, tt.sessionID)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestSessionDB_RevokeAllSessions(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	tests := []struct {
+		name          string
+		exceptToken   string
+		mockSetup     func(mock redismock.ClientMock)
+		expectedError error
+	}{
+		{
+			name:        "Отзыв всех сессий кроме текущей",
+			exceptToken: "current-token",
+			mockSetup: func(mock redismock.ClientMock) {
+				mock.ExpectSMembers(userSessionsPrefix + "1").SetVal([]string{"current-token", "other-token"})
+				mock.ExpectTxPipeline()
+				mock.ExpectDel("other-token", sessionInfoPrefix+"other-token").SetVal(2)
+				mock.ExpectSRem(userSessionsPrefix+"1", "other-token").SetVal(1)
+				mock.ExpectTxPipelineExec()
+			},
+			expectedError: nil,
+		},
+		{
+			name:        "Других сессий нет",
+			exceptToken: "current-token",
+			mockSetup: func(mock redismock.ClientMock) {
+				mock.ExpectSMembers(userSessionsPrefix + "1").SetVal([]string{"current-token"})
+			},
+			expectedError: nil,
+		},
+		{
+			name:        "Ошибка Redis",
+			exceptToken: "",
+			mockSetup: func(mock redismock.ClientMock) {
+				mock.ExpectSMembers(userSessionsPrefix + "1").SetErr(errors.New("redis error"))
+			},
+			expectedError: errors.New("redis error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockRedis, mock := redismock.NewClientMock()
+			tt.mockSetup(mock)
+
+			db := &SessionDB{client: mockRedis, config: testConfig}
+			err := db.RevokeAllSessions(ctx, 1, tt.exceptToken)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestSessionDB_SetVerified(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	tests := []struct {
+		name          string
+		verified      bool
+		mockSetup     func(mock redismock.ClientMock)
+		expectedError error
+	}{
+		{
+			name:     "Обновляются только живые сессии",
+			verified: true,
+			mockSetup: func(mock redismock.ClientMock) {
+				mock.ExpectSMembers(userSessionsPrefix + "1").SetVal([]string{"live-token", "expired-token"})
+				mock.ExpectTTL(sessionInfoPrefix + "live-token").SetVal(time.Hour)
+				mock.ExpectTxPipeline()
+				mock.ExpectHSet(sessionInfoPrefix+"live-token", fieldVerified, true).SetVal(0)
+				mock.ExpectExpire(sessionInfoPrefix+"live-token", time.Hour).SetVal(true)
+				mock.ExpectTxPipelineExec()
+				mock.ExpectTTL(sessionInfoPrefix + "expired-token").SetVal(-2)
+			},
+			expectedError: nil,
+		},
+		{
+			name:     "Сброс подтверждения после смены почты",
+			verified: false,
+			mockSetup: func(mock redismock.ClientMock) {
+				mock.ExpectSMembers(userSessionsPrefix + "1").SetVal([]string{"live-token"})
+				mock.ExpectTTL(sessionInfoPrefix + "live-token").SetVal(time.Hour)
+				mock.ExpectTxPipeline()
+				mock.ExpectHSet(sessionInfoPrefix+"live-token", fieldVerified, false).SetVal(0)
+				mock.ExpectExpire(sessionInfoPrefix+"live-token", time.Hour).SetVal(true)
+				mock.ExpectTxPipelineExec()
+			},
+			expectedError: nil,
+		},
+		{
+			name: "Ошибка Redis",
+			mockSetup: func(mock redismock.ClientMock) {
+				mock.ExpectSMembers(userSessionsPrefix + "1").SetErr(errors.New("redis error"))
+			},
+			expectedError: errors.New("redis error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockRedis, mock := redismock.NewClientMock()
+			tt.mockSetup(mock)
+
+			db := &SessionDB{client: mockRedis, config: testConfig}
+			err := db.SetVerified(ctx, 1, tt.verified)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,97 @@
+package tokensRepository
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"kudago/internal/models"
+	redisDB "kudago/internal/repository/redis"
+)
+
+const (
+	keyPrefix = "token:"
+
+	fieldUserID = "user_id"
+	fieldEmail  = "email"
+)
+
+// TokensDB stores single-use tokens sent to users by email. Only a hash
+// of the token is kept, so a leaked dump can't be used to take over accounts.
+type TokensDB struct {
+	client *redis.Client
+}
+
+func NewDB(config *redisDB.RedisConfig) *TokensDB {
+	redisClient := redis.NewClient(&redis.Options{
+		Addr:     config.URL,
+		Password: config.Password,
+		DB:       config.DB,
+		PoolSize: config.PoolSize,
+	})
+
+	return &TokensDB{
+		client: redisClient,
+	}
+}
+
+// CreateToken issues a token for the user. The email is saved along with
+// the user ID, so a token sent to an address stops working once it changes.
+func (db *TokensDB) CreateToken(ctx context.Context, purpose string, user models.User, ttl time.Duration) (string, error) {
+	b := make([]byte, 32)
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", fmt.Errorf("%s: %w", models.LevelDB, err)
+	}
+	token := hex.EncodeToString(b)
+
+	key := tokenKey(purpose, token)
+	_, err = db.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.HSet(ctx, key, fieldUserID, user.ID, fieldEmail, user.Email)
+		pipe.Expire(ctx, key, ttl)
+		return nil
+	})
+	if err != nil {
+		return "", fmt.Errorf("%s: %w", models.LevelDB, err)
+	}
+	return token, nil
+}
+
+// ConsumeToken returns the user the token was issued for and deletes it,
+// so the same token can't be used twice.
+func (db *TokensDB) ConsumeToken(ctx context.Context, purpose, token string) (models.User, error) {
+	key := tokenKey(purpose, token)
+
+	var data *redis.MapStringStringCmd
+	_, err := db.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		data = pipe.HGetAll(ctx, key)
+		pipe.Del(ctx, key)
+		return nil
+	})
+	if err != nil {
+		return models.User{}, fmt.Errorf("%s: %w", models.LevelDB, err)
+	}
+
+	values := data.Val()
+	if len(values) == 0 {
+		return models.User{}, fmt.Errorf("%s: %w", models.LevelDB, models.ErrInvalidToken)
+	}
+
+	userID, err := strconv.Atoi(values[fieldUserID])
+	if err != nil {
+		return models.User{}, fmt.Errorf("%s: %w", models.LevelDB, err)
+	}
+
+	return models.User{ID: userID, Email: values[fieldEmail]}, nil
+}
+
+func tokenKey(purpose, token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return keyPrefix + purpose + ":" + hex.EncodeToString(sum[:])
+}
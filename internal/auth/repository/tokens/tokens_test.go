@@ -0,0 +1,132 @@
+package tokensRepository
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-redis/redismock/v9"
+	"github.com/stretchr/testify/assert"
+
+	"kudago/internal/models"
+)
+
+func TestTokensDB_CreateToken(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	tests := []struct {
+		name          string
+		mockSetup     func(mock redismock.ClientMock)
+		expectedError error
+	}{
+		{
+			name: "Токен создан",
+			mockSetup: func(mock redismock.ClientMock) {
+				re := mock.Regexp()
+				re.ExpectTxPipeline()
+				re.ExpectHSet(`^token:password_reset:[0-9a-f]{64}$`, fieldUserID, 1, fieldEmail, "^user1@mail\\.ru$").SetVal(2)
+				re.ExpectExpire(`^token:password_reset:[0-9a-f]{64}$`, time.Hour).SetVal(true)
+				re.ExpectTxPipelineExec()
+			},
+		},
+		{
+			name: "Ошибка Redis",
+			mockSetup: func(mock redismock.ClientMock) {
+				re := mock.Regexp()
+				re.ExpectTxPipeline()
+				re.ExpectHSet(`^token:password_reset:[0-9a-f]{64}$`, fieldUserID, 1, fieldEmail, "^user1@mail\\.ru$").SetErr(errors.New("redis error"))
+			},
+			expectedError: errors.New("redis error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockRedis, mock := redismock.NewClientMock()
+			tt.mockSetup(mock)
+
+			db := &TokensDB{client: mockRedis}
+			token, err := db.CreateToken(ctx, "password_reset", models.User{ID: 1, Email: "user1@mail.ru"}, time.Hour)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Len(t, token, 64)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestTokensDB_ConsumeToken(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	key := tokenKey("password_reset", "abc")
+
+	tests := []struct {
+		name          string
+		mockSetup     func(mock redismock.ClientMock)
+		expectedUser  models.User
+		expectedError error
+	}{
+		{
+			name: "Токен использован",
+			mockSetup: func(mock redismock.ClientMock) {
+				mock.ExpectTxPipeline()
+				mock.ExpectHGetAll(key).SetVal(map[string]string{fieldUserID: "1", fieldEmail: "user1@mail.ru"})
+				mock.ExpectDel(key).SetVal(1)
+				mock.ExpectTxPipelineExec()
+			},
+			expectedUser: models.User{ID: 1, Email: "user1@mail.ru"},
+		},
+		{
+			name: "Токен не найден или истек",
+			mockSetup: func(mock redismock.ClientMock) {
+				mock.ExpectTxPipeline()
+				mock.ExpectHGetAll(key).SetVal(map[string]string{})
+				mock.ExpectDel(key).SetVal(0)
+				mock.ExpectTxPipelineExec()
+			},
+			expectedError: models.ErrInvalidToken,
+		},
+		{
+			name: "Ошибка Redis",
+			mockSetup: func(mock redismock.ClientMock) {
+				mock.ExpectTxPipeline()
+				mock.ExpectHGetAll(key).SetErr(errors.New("redis error"))
+			},
+			expectedError: errors.New("redis error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockRedis, mock := redismock.NewClientMock()
+			tt.mockSetup(mock)
+
+			db := &TokensDB{client: mockRedis}
+			user, err := db.ConsumeToken(ctx, "password_reset", "abc")
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedUser, user)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
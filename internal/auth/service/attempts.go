@@ -0,0 +1,67 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"kudago/internal/models"
+)
+
+const (
+	maxAccountAttempts = 5
+	maxIPAttempts      = 20
+	attemptsWindow     = 15 * time.Minute
+)
+
+func accountSubject(ID int) string {
+	return "user:" + strconv.Itoa(ID)
+}
+
+func ipSubject(ip string) string {
+	return "ip:" + ip
+}
+
+func (a *service) checkLockout(ctx context.Context, subject string, limit int) error {
+	count, ttl, err := a.AttemptsDB.GetAttempts(ctx, subject)
+	if err != nil {
+		return err
+	}
+
+	if count >= limit {
+		return fmt.Errorf("%s: %w", models.LevelService, models.LockoutError{RetryAfter: ttl})
+	}
+	return nil
+}
+
+func (a *service) addAttempt(ctx context.Context, subject string, limit int) error {
+	count, ttl, err := a.AttemptsDB.AddAttempt(ctx, subject, attemptsWindow)
+	if err != nil {
+		return err
+	}
+
+	if count >= limit {
+		return fmt.Errorf("%s: %w", models.LevelService, models.LockoutError{RetryAfter: ttl})
+	}
+	return nil
+}
+
+func (a *service) addIPAttempt(ctx context.Context, ip string) error {
+	if ip == "" {
+		return nil
+	}
+	return a.addAttempt(ctx, ipSubject(ip), maxIPAttempts)
+}
+
+// addFailedAttempt counts a failed login both for the IP and for the account.
+// It returns a LockoutError once one of the limits is reached.
+func (a *service) addFailedAttempt(ctx context.Context, ip string, userID int) error {
+	ipErr := a.addIPAttempt(ctx, ip)
+
+	err := a.addAttempt(ctx, accountSubject(userID), maxAccountAttempts)
+	if err != nil {
+		return err
+	}
+	return ipErr
+}
@@ -4,23 +4,59 @@ package service
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"time"
 
+	"kudago/internal/mail"
 	"kudago/internal/models"
+
+	"golang.org/x/crypto/bcrypt"
 )
 
 type service struct {
-	UserDB UserDB
+	UserDB     UserDB
+	AttemptsDB AttemptsDB
+	TokensDB   TokensDB
+	Mailer     Mailer
+	appURL     string
 }
 
 type UserDB interface {
 	CreateUser(ctx context.Context, user models.User) (models.User, error)
 	GetUserByID(ctx context.Context, ID int) (models.User, error)
-	CheckCredentials(ctx context.Context, username string, password string) (models.User, error)
+	GetCredentials(ctx context.Context, login string) (models.User, string, error)
+	GetPasswordHash(ctx context.Context, ID int) (string, error)
+	UpdatePasswordHash(ctx context.Context, ID int, passwordHash string) error
+	SetEmailVerified(ctx context.Context, ID int, email string) error
 	UserExists(ctx context.Context, user models.User) (bool, error)
 }
 
-func NewService(userDB UserDB) *service {
-	return &service{UserDB: userDB}
+type AttemptsDB interface {
+	GetAttempts(ctx context.Context, subject string) (int, time.Duration, error)
+	AddAttempt(ctx context.Context, subject string, window time.Duration) (int, time.Duration, error)
+	ResetAttempts(ctx context.Context, subject string) error
+}
+
+type TokensDB interface {
+	CreateToken(ctx context.Context, purpose string, user models.User, ttl time.Duration) (string, error)
+	ConsumeToken(ctx context.Context, purpose, token string) (models.User, error)
+}
+
+type Mailer interface {
+	Send(ctx context.Context, msg mail.Message) error
+}
+
+// NewService creates the auth service. appURL is the address of the frontend
+// used to build links in emails sent to users.
+func NewService(userDB UserDB, attemptsDB AttemptsDB, tokensDB TokensDB, mailer Mailer, appURL string) *service {
+	return &service{
+		UserDB:     userDB,
+		AttemptsDB: attemptsDB,
+		TokensDB:   tokensDB,
+		Mailer:     mailer,
+		appURL:     appURL,
+	}
 }
 
 func (a *service) GetUserByID(ctx context.Context, ID int) (models.User, error) {
@@ -28,7 +64,55 @@ func (a *service) GetUserByID(ctx context.Context, ID int) (models.User, error)
 }
 
 func (a *service) CheckCredentials(ctx context.Context, creds models.Credentials) (models.User, error) {
-	return a.UserDB.CheckCredentials(ctx, creds.Username, creds.Password)
+	if creds.IP != "" {
+		err := a.checkLockout(ctx, ipSubject(creds.IP), maxIPAttempts)
+		if err != nil {
+			return models.User{}, err
+		}
+	}
+
+	user, passwordHash, err := a.UserDB.GetCredentials(ctx, creds.Login)
+	if err != nil {
+		if errors.Is(err, models.ErrUserNotFound) {
+			bcrypt.CompareHashAndPassword(dummyPasswordHash, []byte(creds.Password))
+			if err := a.addIPAttempt(ctx, creds.IP); err != nil {
+				return models.User{}, err
+			}
+		}
+		return models.User{}, err
+	}
+
+	err = a.checkLockout(ctx, accountSubject(user.ID), maxAccountAttempts)
+	if err != nil {
+		return models.User{}, err
+	}
+
+	ok, needsRehash := checkPassword(passwordHash, creds.Password)
+	if !ok {
+		if err := a.addFailedAttempt(ctx, creds.IP, user.ID); err != nil {
+			return models.User{}, err
+		}
+		return models.User{}, fmt.Errorf("%s: %w", models.LevelService, models.ErrUserNotFound)
+	}
+
+	err = a.AttemptsDB.ResetAttempts(ctx, accountSubject(user.ID))
+	if err != nil {
+		return models.User{}, err
+	}
+
+	if needsRehash {
+		newHash, err := hashPassword(creds.Password)
+		if err != nil {
+			return models.User{}, err
+		}
+
+		err = a.UserDB.UpdatePasswordHash(ctx, user.ID, newHash)
+		if err != nil {
+			return models.User{}, err
+		}
+	}
+
+	return user, nil
 }
 
 func (a *service) Register(ctx context.Context, user models.User) (models.User, error) {
@@ -41,6 +125,11 @@ func (a *service) Register(ctx context.Context, user models.User) (models.User,
 		return models.User{}, models.ErrEmailIsUsed
 	}
 
+	user.Password, err = hashPassword(user.Password)
+	if err != nil {
+		return models.User{}, err
+	}
+
 	user, err = a.UserDB.CreateUser(ctx, user)
 	if err != nil {
 		return models.User{}, err
@@ -3,12 +3,14 @@ package service
 import (
 	"context"
 	"testing"
+	"time"
 
 	"kudago/internal/auth/service/mocks"
 	"kudago/internal/models"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/bcrypt"
 )
 
 func TestAuthService_Register(t *testing.T) {
@@ -43,9 +45,13 @@ func TestAuthService_Register(t *testing.T) {
 					Return(false, nil)
 
 				mockUserDB.EXPECT().
-					CreateUser(context.Background(), user).
-					Return(user, nil)
-				return NewService(mockUserDB)
+					CreateUser(context.Background(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, created models.User) (models.User, error) {
+						assert.True(t, isPasswordHash(created.Password))
+						assert.NoError(t, bcrypt.CompareHashAndPassword([]byte(created.Password), []byte(user.Password)))
+						return user, nil
+					})
+				return NewService(mockUserDB, mocks.NewMockAttemptsDB(ctrl), nil, nil, "")
 			},
 			expected: expected{
 				user: user,
@@ -62,7 +68,7 @@ func TestAuthService_Register(t *testing.T) {
 					UserExists(context.Background(), user).
 					Return(true, nil)
 
-				return NewService(mockUserDB)
+				return NewService(mockUserDB, mocks.NewMockAttemptsDB(ctrl), nil, nil, "")
 			},
 			expected: expected{
 				user: models.User{},
@@ -79,7 +85,7 @@ func TestAuthService_Register(t *testing.T) {
 					UserExists(context.Background(), user).
 					Return(false, models.ErrInternal)
 
-				return NewService(mockUserDB)
+				return NewService(mockUserDB, mocks.NewMockAttemptsDB(ctrl), nil, nil, "")
 			},
 			expected: expected{
 				user: models.User{},
@@ -102,3 +108,210 @@ func TestAuthService_Register(t *testing.T) {
 		})
 	}
 }
+
+func TestAuthService_CheckCredentials(t *testing.T) {
+	t.Parallel()
+
+	user := models.User{
+		ID:       1,
+		Username: "test",
+		Email:    "test@mail.ru",
+	}
+	creds := models.Credentials{
+		Login:    "test",
+		Password: "password",
+		IP:       "127.0.0.1",
+	}
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(creds.Password), passwordHashCost)
+	assert.NoError(t, err)
+
+	type expected struct {
+		user models.User
+		err  error
+	}
+
+	tests := []struct {
+		name      string
+		setupFunc func(ctrl *gomock.Controller) *service
+		expected  expected
+	}{
+		{
+			name: "success with hashed password",
+			setupFunc: func(ctrl *gomock.Controller) *service {
+				mockUserDB := mocks.NewMockUserDB(ctrl)
+				mockAttemptsDB := mocks.NewMockAttemptsDB(ctrl)
+
+				mockAttemptsDB.EXPECT().GetAttempts(context.Background(), "ip:127.0.0.1").Return(0, time.Duration(0), nil)
+				mockUserDB.EXPECT().
+					GetCredentials(context.Background(), creds.Login).
+					Return(user, string(hash), nil)
+				mockAttemptsDB.EXPECT().GetAttempts(context.Background(), "user:1").Return(2, time.Minute, nil)
+				mockAttemptsDB.EXPECT().ResetAttempts(context.Background(), "user:1").Return(nil)
+
+				return NewService(mockUserDB, mockAttemptsDB, nil, nil, "")
+			},
+			expected: expected{
+				user: user,
+				err:  nil,
+			},
+		},
+		{
+			name: "legacy plaintext password is rehashed",
+			setupFunc: func(ctrl *gomock.Controller) *service {
+				mockUserDB := mocks.NewMockUserDB(ctrl)
+				mockAttemptsDB := mocks.NewMockAttemptsDB(ctrl)
+
+				mockAttemptsDB.EXPECT().GetAttempts(context.Background(), "ip:127.0.0.1").Return(0, time.Duration(0), nil)
+				mockUserDB.EXPECT().
+					GetCredentials(context.Background(), creds.Login).
+					Return(user, creds.Password, nil)
+				mockAttemptsDB.EXPECT().GetAttempts(context.Background(), "user:1").Return(0, time.Duration(0), nil)
+				mockAttemptsDB.EXPECT().ResetAttempts(context.Background(), "user:1").Return(nil)
+
+				mockUserDB.EXPECT().
+					UpdatePasswordHash(context.Background(), user.ID, gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ int, newHash string) error {
+						assert.True(t, isPasswordHash(newHash))
+						assert.NoError(t, bcrypt.CompareHashAndPassword([]byte(newHash), []byte(creds.Password)))
+						return nil
+					})
+
+				return NewService(mockUserDB, mockAttemptsDB, nil, nil, "")
+			},
+			expected: expected{
+				user: user,
+				err:  nil,
+			},
+		},
+		{
+			name: "wrong password",
+			setupFunc: func(ctrl *gomock.Controller) *service {
+				mockUserDB := mocks.NewMockUserDB(ctrl)
+				mockAttemptsDB := mocks.NewMockAttemptsDB(ctrl)
+
+				mockAttemptsDB.EXPECT().GetAttempts(context.Background(), "ip:127.0.0.1").Return(0, time.Duration(0), nil)
+				mockUserDB.EXPECT().
+					GetCredentials(context.Background(), creds.Login).
+					Return(user, "$2a$10$invalidinvalidinvalidinvalidinvalidinvalidinvalidinva", nil)
+				mockAttemptsDB.EXPECT().GetAttempts(context.Background(), "user:1").Return(0, time.Duration(0), nil)
+				mockAttemptsDB.EXPECT().AddAttempt(context.Background(), "ip:127.0.0.1", attemptsWindow).Return(1, attemptsWindow, nil)
+				mockAttemptsDB.EXPECT().AddAttempt(context.Background(), "user:1", attemptsWindow).Return(1, attemptsWindow, nil)
+
+				return NewService(mockUserDB, mockAttemptsDB, nil, nil, "")
+			},
+			expected: expected{
+				user: models.User{},
+				err:  models.ErrUserNotFound,
+			},
+		},
+		{
+			name: "wrong legacy password",
+			setupFunc: func(ctrl *gomock.Controller) *service {
+				mockUserDB := mocks.NewMockUserDB(ctrl)
+				mockAttemptsDB := mocks.NewMockAttemptsDB(ctrl)
+
+				mockAttemptsDB.EXPECT().GetAttempts(context.Background(), "ip:127.0.0.1").Return(0, time.Duration(0), nil)
+				mockUserDB.EXPECT().
+					GetCredentials(context.Background(), creds.Login).
+					Return(user, "other", nil)
+				mockAttemptsDB.EXPECT().GetAttempts(context.Background(), "user:1").Return(0, time.Duration(0), nil)
+				mockAttemptsDB.EXPECT().AddAttempt(context.Background(), "ip:127.0.0.1", attemptsWindow).Return(1, attemptsWindow, nil)
+				mockAttemptsDB.EXPECT().AddAttempt(context.Background(), "user:1", attemptsWindow).Return(1, attemptsWindow, nil)
+
+				return NewService(mockUserDB, mockAttemptsDB, nil, nil, "")
+			},
+			expected: expected{
+				user: models.User{},
+				err:  models.ErrUserNotFound,
+			},
+		},
+		{
+			name: "account is locked after too many failures",
+			setupFunc: func(ctrl *gomock.Controller) *service {
+				mockUserDB := mocks.NewMockUserDB(ctrl)
+				mockAttemptsDB := mocks.NewMockAttemptsDB(ctrl)
+
+				mockAttemptsDB.EXPECT().GetAttempts(context.Background(), "ip:127.0.0.1").Return(0, time.Duration(0), nil)
+				mockUserDB.EXPECT().
+					GetCredentials(context.Background(), creds.Login).
+					Return(user, "other", nil)
+				mockAttemptsDB.EXPECT().GetAttempts(context.Background(), "user:1").Return(maxAccountAttempts-1, time.Minute, nil)
+				mockAttemptsDB.EXPECT().AddAttempt(context.Background(), "ip:127.0.0.1", attemptsWindow).Return(1, attemptsWindow, nil)
+				mockAttemptsDB.EXPECT().AddAttempt(context.Background(), "user:1", attemptsWindow).Return(maxAccountAttempts, time.Minute, nil)
+
+				return NewService(mockUserDB, mockAttemptsDB, nil, nil, "")
+			},
+			expected: expected{
+				user: models.User{},
+				err:  models.LockoutError{RetryAfter: time.Minute},
+			},
+		},
+		{
+			name: "locked account is rejected before password check",
+			setupFunc: func(ctrl *gomock.Controller) *service {
+				mockUserDB := mocks.NewMockUserDB(ctrl)
+				mockAttemptsDB := mocks.NewMockAttemptsDB(ctrl)
+
+				mockAttemptsDB.EXPECT().GetAttempts(context.Background(), "ip:127.0.0.1").Return(0, time.Duration(0), nil)
+				mockUserDB.EXPECT().
+					GetCredentials(context.Background(), creds.Login).
+					Return(user, string(hash), nil)
+				mockAttemptsDB.EXPECT().GetAttempts(context.Background(), "user:1").Return(maxAccountAttempts, 10*time.Minute, nil)
+
+				return NewService(mockUserDB, mockAttemptsDB, nil, nil, "")
+			},
+			expected: expected{
+				user: models.User{},
+				err:  models.LockoutError{RetryAfter: 10 * time.Minute},
+			},
+		},
+		{
+			name: "ip is locked",
+			setupFunc: func(ctrl *gomock.Controller) *service {
+				mockAttemptsDB := mocks.NewMockAttemptsDB(ctrl)
+
+				mockAttemptsDB.EXPECT().GetAttempts(context.Background(), "ip:127.0.0.1").Return(maxIPAttempts, 5*time.Minute, nil)
+
+				return NewService(mocks.NewMockUserDB(ctrl), mockAttemptsDB, nil, nil, "")
+			},
+			expected: expected{
+				user: models.User{},
+				err:  models.LockoutError{RetryAfter: 5 * time.Minute},
+			},
+		},
+		{
+			name: "user not found",
+			setupFunc: func(ctrl *gomock.Controller) *service {
+				mockUserDB := mocks.NewMockUserDB(ctrl)
+				mockAttemptsDB := mocks.NewMockAttemptsDB(ctrl)
+
+				mockAttemptsDB.EXPECT().GetAttempts(context.Background(), "ip:127.0.0.1").Return(0, time.Duration(0), nil)
+				mockUserDB.EXPECT().
+					GetCredentials(context.Background(), creds.Login).
+					Return(models.User{}, "", models.ErrUserNotFound)
+				mockAttemptsDB.EXPECT().AddAttempt(context.Background(), "ip:127.0.0.1", attemptsWindow).Return(1, attemptsWindow, nil)
+
+				return NewService(mockUserDB, mockAttemptsDB, nil, nil, "")
+			},
+			expected: expected{
+				user: models.User{},
+				err:  models.ErrUserNotFound,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			actual, err := tt.setupFunc(ctrl).CheckCredentials(context.Background(), creds)
+
+			assert.Equal(t, tt.expected.user, actual)
+			assert.ErrorIs(t, err, tt.expected.err)
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"kudago/internal/mail"
+	"kudago/internal/models"
+)
+
+const (
+	emailVerificationPurpose = "email_verification"
+	emailVerificationTTL     = 24 * time.Hour
+)
+
+// SendVerificationEmail mails a confirmation link to the user's current
+// address. Already verified users get nothing.
+func (a *service) SendVerificationEmail(ctx context.Context, userID int) error {
+	user, err := a.UserDB.GetUserByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if user.Verified {
+		return nil
+	}
+
+	token, err := a.TokensDB.CreateToken(ctx, emailVerificationPurpose, user, emailVerificationTTL)
+	if err != nil {
+		return err
+	}
+
+	msg := mail.Message{
+		To:      user.Email,
+		Subject: "Подтверждение почты",
+		Body: fmt.Sprintf(
+			"Здравствуйте, %s!\n\nЧтобы подтвердить адрес электронной почты, перейдите по ссылке:\n%s\n\nСсылка действительна %d часа.\n",
+			user.Username, a.link("/verify-email", token), int(emailVerificationTTL.Hours()),
+		),
+	}
+
+	err = a.Mailer.Send(ctx, msg)
+	if err != nil {
+		return fmt.Errorf("%s: %w", models.LevelService, err)
+	}
+	return nil
+}
+
+// VerifyEmail confirms the email by a verification token and returns the
+// user the token belonged to.
+func (a *service) VerifyEmail(ctx context.Context, token string) (int, error) {
+	user, err := a.TokensDB.ConsumeToken(ctx, emailVerificationPurpose, token)
+	if err != nil {
+		return 0, err
+	}
+
+	err = a.UserDB.SetEmailVerified(ctx, user.ID, user.Email)
+	if err != nil {
+		return 0, err
+	}
+	return user.ID, nil
+}
@@ -0,0 +1,145 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"kudago/internal/auth/service/mocks"
+	"kudago/internal/mail"
+	"kudago/internal/models"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuthService_SendVerificationEmail(t *testing.T) {
+	t.Parallel()
+
+	user := models.User{
+		ID:       1,
+		Username: "user1",
+		Email:    "user1@mail.ru",
+	}
+
+	tests := []struct {
+		name        string
+		setupFunc   func(ctrl *gomock.Controller) *service
+		expectedErr error
+	}{
+		{
+			name: "verification link is sent",
+			setupFunc: func(ctrl *gomock.Controller) *service {
+				mockUserDB := mocks.NewMockUserDB(ctrl)
+				mockTokensDB := mocks.NewMockTokensDB(ctrl)
+				mockMailer := mocks.NewMockMailer(ctrl)
+
+				mockUserDB.EXPECT().GetUserByID(context.Background(), 1).Return(user, nil)
+				mockTokensDB.EXPECT().
+					CreateToken(context.Background(), emailVerificationPurpose, user, emailVerificationTTL).
+					Return("token", nil)
+				mockMailer.EXPECT().
+					Send(context.Background(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, msg mail.Message) error {
+						assert.Equal(t, user.Email, msg.To)
+						assert.Contains(t, msg.Body, "https://example.com/verify-email?token=token")
+						return nil
+					})
+
+				return NewService(mockUserDB, nil, mockTokensDB, mockMailer, "https://example.com")
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "already verified",
+			setupFunc: func(ctrl *gomock.Controller) *service {
+				mockUserDB := mocks.NewMockUserDB(ctrl)
+
+				verified := user
+				verified.Verified = true
+				mockUserDB.EXPECT().GetUserByID(context.Background(), 1).Return(verified, nil)
+
+				return NewService(mockUserDB, nil, nil, nil, "https://example.com")
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "user not found",
+			setupFunc: func(ctrl *gomock.Controller) *service {
+				mockUserDB := mocks.NewMockUserDB(ctrl)
+
+				mockUserDB.EXPECT().GetUserByID(context.Background(), 1).Return(models.User{}, models.ErrUserNotFound)
+
+				return NewService(mockUserDB, nil, nil, nil, "https://example.com")
+			},
+			expectedErr: models.ErrUserNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			err := tt.setupFunc(ctrl).SendVerificationEmail(context.Background(), 1)
+
+			assert.ErrorIs(t, err, tt.expectedErr)
+		})
+	}
+}
+
+func TestAuthService_VerifyEmail(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		setupFunc   func(ctrl *gomock.Controller) *service
+		expectedID  int
+		expectedErr error
+	}{
+		{
+			name: "email is verified",
+			setupFunc: func(ctrl *gomock.Controller) *service {
+				mockUserDB := mocks.NewMockUserDB(ctrl)
+				mockTokensDB := mocks.NewMockTokensDB(ctrl)
+
+				mockTokensDB.EXPECT().
+					ConsumeToken(context.Background(), emailVerificationPurpose, "token").
+					Return(models.User{ID: 1, Email: "user1@mail.ru"}, nil)
+				mockUserDB.EXPECT().SetEmailVerified(context.Background(), 1, "user1@mail.ru").Return(nil)
+
+				return NewService(mockUserDB, nil, mockTokensDB, nil, "")
+			},
+			expectedID:  1,
+			expectedErr: nil,
+		},
+		{
+			name: "invalid token",
+			setupFunc: func(ctrl *gomock.Controller) *service {
+				mockTokensDB := mocks.NewMockTokensDB(ctrl)
+
+				mockTokensDB.EXPECT().
+					ConsumeToken(context.Background(), emailVerificationPurpose, "token").
+					Return(models.User{}, models.ErrInvalidToken)
+
+				return NewService(nil, nil, mockTokensDB, nil, "")
+			},
+			expectedErr: models.ErrInvalidToken,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			userID, err := tt.setupFunc(ctrl).VerifyEmail(context.Background(), "token")
+
+			assert.ErrorIs(t, err, tt.expectedErr)
+			assert.Equal(t, tt.expectedID, userID)
+		})
+	}
+}
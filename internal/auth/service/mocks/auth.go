@@ -6,8 +6,10 @@ package mocks
 
 import (
 	context "context"
+	mail "kudago/internal/mail"
 	models "kudago/internal/models"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 )
@@ -35,34 +37,50 @@ func (m *MockUserDB) EXPECT() *MockUserDBMockRecorder {
 	return m.recorder
 }
 
-// CheckCredentials mocks base method.
-func (m *MockUserDB) CheckCredentials(ctx context.Context, username, password string) (models.User, error) {
+// CreateUser mocks base method.
+func (m *MockUserDB) CreateUser(ctx context.Context, user models.User) (models.User, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CheckCredentials", ctx, username, password)
+	ret := m.ctrl.Call(m, "CreateUser", ctx, user)
 	ret0, _ := ret[0].(models.User)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CheckCredentials indicates an expected call of CheckCredentials.
-func (mr *MockUserDBMockRecorder) CheckCredentials(ctx, username, password interface{}) *gomock.Call {
+// CreateUser indicates an expected call of CreateUser.
+func (mr *MockUserDBMockRecorder) CreateUser(ctx, user interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckCredentials", reflect.TypeOf((*MockUserDB)(nil).CheckCredentials), ctx, username, password)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockUserDB)(nil).CreateUser), ctx, user)
 }
 
-// CreateUser mocks base method.
-func (m *MockUserDB) CreateUser(ctx context.Context, user models.User) (models.User, error) {
+// GetCredentials mocks base method.
+func (m *MockUserDB) GetCredentials(ctx context.Context, login string) (models.User, string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateUser", ctx, user)
+	ret := m.ctrl.Call(m, "GetCredentials", ctx, login)
 	ret0, _ := ret[0].(models.User)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetCredentials indicates an expected call of GetCredentials.
+func (mr *MockUserDBMockRecorder) GetCredentials(ctx, login interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCredentials", reflect.TypeOf((*MockUserDB)(nil).GetCredentials), ctx, login)
+}
+
+// GetPasswordHash mocks base method.
+func (m *MockUserDB) GetPasswordHash(ctx context.Context, ID int) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPasswordHash", ctx, ID)
+	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateUser indicates an expected call of CreateUser.
-func (mr *MockUserDBMockRecorder) CreateUser(ctx, user interface{}) *gomock.Call {
+// GetPasswordHash indicates an expected call of GetPasswordHash.
+func (mr *MockUserDBMockRecorder) GetPasswordHash(ctx, ID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockUserDB)(nil).CreateUser), ctx, user)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPasswordHash", reflect.TypeOf((*MockUserDB)(nil).GetPasswordHash), ctx, ID)
 }
 
 // GetUserByID mocks base method.
@@ -80,6 +98,34 @@ func (mr *MockUserDBMockRecorder) GetUserByID(ctx, ID interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByID", reflect.TypeOf((*MockUserDB)(nil).GetUserByID), ctx, ID)
 }
 
+// SetEmailVerified mocks base method.
+func (m *MockUserDB) SetEmailVerified(ctx context.Context, ID int, email string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetEmailVerified", ctx, ID, email)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetEmailVerified indicates an expected call of SetEmailVerified.
+func (mr *MockUserDBMockRecorder) SetEmailVerified(ctx, ID, email interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetEmailVerified", reflect.TypeOf((*MockUserDB)(nil).SetEmailVerified), ctx, ID, email)
+}
+
+// UpdatePasswordHash mocks base method.
+func (m *MockUserDB) UpdatePasswordHash(ctx context.Context, ID int, passwordHash string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePasswordHash", ctx, ID, passwordHash)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePasswordHash indicates an expected call of UpdatePasswordHash.
+func (mr *MockUserDBMockRecorder) UpdatePasswordHash(ctx, ID, passwordHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePasswordHash", reflect.TypeOf((*MockUserDB)(nil).UpdatePasswordHash), ctx, ID, passwordHash)
+}
+
 // UserExists mocks base method.
 func (m *MockUserDB) UserExists(ctx context.Context, user models.User) (bool, error) {
 	m.ctrl.T.Helper()
@@ -94,3 +140,162 @@ func (mr *MockUserDBMockRecorder) UserExists(ctx, user interface{}) *gomock.Call
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserExists", reflect.TypeOf((*MockUserDB)(nil).UserExists), ctx, user)
 }
+
+// MockAttemptsDB is a mock of AttemptsDB interface.
+type MockAttemptsDB struct {
+	ctrl     *gomock.Controller
+	recorder *MockAttemptsDBMockRecorder
+}
+
+// MockAttemptsDBMockRecorder is the mock recorder for MockAttemptsDB.
+type MockAttemptsDBMockRecorder struct {
+	mock *MockAttemptsDB
+}
+
+// NewMockAttemptsDB creates a new mock instance.
+func NewMockAttemptsDB(ctrl *gomock.Controller) *MockAttemptsDB {
+	mock := &MockAttemptsDB{ctrl: ctrl}
+	mock.recorder = &MockAttemptsDBMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAttemptsDB) EXPECT() *MockAttemptsDBMockRecorder {
+	return m.recorder
+}
+
+// AddAttempt mocks base method.
+func (m *MockAttemptsDB) AddAttempt(ctx context.Context, subject string, window time.Duration) (int, time.Duration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddAttempt", ctx, subject, window)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(time.Duration)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// AddAttempt indicates an expected call of AddAttempt.
+func (mr *MockAttemptsDBMockRecorder) AddAttempt(ctx, subject, window interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddAttempt", reflect.TypeOf((*MockAttemptsDB)(nil).AddAttempt), ctx, subject, window)
+}
+
+// GetAttempts mocks base method.
+func (m *MockAttemptsDB) GetAttempts(ctx context.Context, subject string) (int, time.Duration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAttempts", ctx, subject)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(time.Duration)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetAttempts indicates an expected call of GetAttempts.
+func (mr *MockAttemptsDBMockRecorder) GetAttempts(ctx, subject interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttempts", reflect.TypeOf((*MockAttemptsDB)(nil).GetAttempts), ctx, subject)
+}
+
+// ResetAttempts mocks base method.
+func (m *MockAttemptsDB) ResetAttempts(ctx context.Context, subject string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetAttempts", ctx, subject)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResetAttempts indicates an expected call of ResetAttempts.
+func (mr *MockAttemptsDBMockRecorder) ResetAttempts(ctx, subject interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetAttempts", reflect.TypeOf((*MockAttemptsDB)(nil).ResetAttempts), ctx, subject)
+}
+
+// MockTokensDB is a mock of TokensDB interface.
+type MockTokensDB struct {
+	ctrl     *gomock.Controller
+	recorder *MockTokensDBMockRecorder
+}
+
+// MockTokensDBMockRecorder is the mock recorder for MockTokensDB.
+type MockTokensDBMockRecorder struct {
+	mock *MockTokensDB
+}
+
+// NewMockTokensDB creates a new mock instance.
+func NewMockTokensDB(ctrl *gomock.Controller) *MockTokensDB {
+	mock := &MockTokensDB{ctrl: ctrl}
+	mock.recorder = &MockTokensDBMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTokensDB) EXPECT() *MockTokensDBMockRecorder {
+	return m.recorder
+}
+
+// ConsumeToken mocks base method.
+func (m *MockTokensDB) ConsumeToken(ctx context.Context, purpose, token string) (models.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConsumeToken", ctx, purpose, token)
+	ret0, _ := ret[0].(models.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConsumeToken indicates an expected call of ConsumeToken.
+func (mr *MockTokensDBMockRecorder) ConsumeToken(ctx, purpose, token interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConsumeToken", reflect.TypeOf((*MockTokensDB)(nil).ConsumeToken), ctx, purpose, token)
+}
+
+// CreateToken mocks base method.
+func (m *MockTokensDB) CreateToken(ctx context.Context, purpose string, user models.User, ttl time.Duration) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateToken", ctx, purpose, user, ttl)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateToken indicates an expected call of CreateToken.
+func (mr *MockTokensDBMockRecorder) CreateToken(ctx, purpose, user, ttl interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateToken", reflect.TypeOf((*MockTokensDB)(nil).CreateToken), ctx, purpose, user, ttl)
+}
+
+// MockMailer is a mock of Mailer interface.
+type MockMailer struct {
+	ctrl     *gomock.Controller
+	recorder *MockMailerMockRecorder
+}
+
+// MockMailerMockRecorder is the mock recorder for MockMailer.
+type MockMailerMockRecorder struct {
+	mock *MockMailer
+}
+
+// NewMockMailer creates a new mock instance.
+func NewMockMailer(ctrl *gomock.Controller) *MockMailer {
+	mock := &MockMailer{ctrl: ctrl}
+	mock.recorder = &MockMailerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMailer) EXPECT() *MockMailerMockRecorder {
+	return m.recorder
+}
+
+// Send mocks base method.
+func (m *MockMailer) Send(ctx context.Context, msg mail.Message) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", ctx, msg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockMailerMockRecorder) Send(ctx, msg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockMailer)(nil).Send), ctx, msg)
+}
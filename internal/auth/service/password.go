@@ -0,0 +1,50 @@
+package service
+
+import (
+	"crypto/subtle"
+	"fmt"
+	"strings"
+
+	"kudago/internal/models"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+const passwordHashCost = bcrypt.DefaultCost
+
+// dummyPasswordHash is compared against when the user does not exist,
+// so a login attempt takes the same time whether the username is known or not.
+var dummyPasswordHash, _ = bcrypt.GenerateFromPassword([]byte("dummy-password"), passwordHashCost)
+
+func hashPassword(password string) (string, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), passwordHashCost)
+	if err != nil {
+		return "", fmt.Errorf("%s: %w", models.LevelService, err)
+	}
+	return string(hash), nil
+}
+
+// isPasswordHash reports whether the stored value is a bcrypt hash.
+// Rows created before hashing was introduced keep the raw password.
+func isPasswordHash(stored string) bool {
+	return strings.HasPrefix(stored, "$2a$") ||
+		strings.HasPrefix(stored, "$2b$") ||
+		strings.HasPrefix(stored, "$2y$")
+}
+
+// checkPassword compares the password with the stored value in constant time.
+// needsRehash is true when the stored value is a legacy plaintext password
+// or a bcrypt hash with an outdated cost.
+func checkPassword(stored, password string) (ok bool, needsRehash bool) {
+	if !isPasswordHash(stored) {
+		ok = subtle.ConstantTimeCompare([]byte(stored), []byte(password)) == 1
+		return ok, ok
+	}
+
+	if bcrypt.CompareHashAndPassword([]byte(stored), []byte(password)) != nil {
+		return false, false
+	}
+
+	cost, err := bcrypt.Cost([]byte(stored))
+	return true, err != nil || cost != passwordHashCost
+}
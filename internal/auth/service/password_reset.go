@@ -0,0 +1,109 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"kudago/internal/mail"
+	"kudago/internal/models"
+)
+
+const (
+	passwordResetPurpose = "password_reset"
+	passwordResetTTL     = time.Hour
+)
+
+func (a *service) ChangePassword(ctx context.Context, userID int, oldPassword, newPassword string) error {
+	passwordHash, err := a.UserDB.GetPasswordHash(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	ok, _ := checkPassword(passwordHash, oldPassword)
+	if !ok {
+		return fmt.Errorf("%s: %w", models.LevelService, models.ErrWrongPassword)
+	}
+
+	return a.setPassword(ctx, userID, newPassword)
+}
+
+// RequestPasswordReset mails a reset link to the owner of the email.
+// Unknown emails are ignored silently, so the endpoint can't be used
+// to find out who is registered.
+func (a *service) RequestPasswordReset(ctx context.Context, email string) error {
+	user, _, err := a.UserDB.GetCredentials(ctx, email)
+	if err != nil {
+		if errors.Is(err, models.ErrUserNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	token, err := a.TokensDB.CreateToken(ctx, passwordResetPurpose, user, passwordResetTTL)
+	if err != nil {
+		return err
+	}
+
+	msg := mail.Message{
+		To:      user.Email,
+		Subject: "Восстановление пароля",
+		Body: fmt.Sprintf(
+			"Здравствуйте, %s!\n\nЧтобы задать новый пароль, перейдите по ссылке:\n%s\n\nСсылка действительна %d минут. Если вы не запрашивали смену пароля, просто проигнорируйте это письмо.\n",
+			user.Username, a.link("/reset-password", token), int(passwordResetTTL.Minutes()),
+		),
+	}
+
+	err = a.Mailer.Send(ctx, msg)
+	if err != nil {
+		return fmt.Errorf("%s: %w", models.LevelService, err)
+	}
+	return nil
+}
+
+// ConfirmPasswordReset sets a new password by a reset token and returns
+// the user the token belonged to. A token mailed to an address the user has
+// changed since is rejected.
+func (a *service) ConfirmPasswordReset(ctx context.Context, token, newPassword string) (int, error) {
+	user, err := a.TokensDB.ConsumeToken(ctx, passwordResetPurpose, token)
+	if err != nil {
+		return 0, err
+	}
+
+	current, err := a.UserDB.GetUserByID(ctx, user.ID)
+	if err != nil {
+		if errors.Is(err, models.ErrUserNotFound) {
+			return 0, fmt.Errorf("%s: %w", models.LevelService, models.ErrInvalidToken)
+		}
+		return 0, err
+	}
+	if current.Email != user.Email {
+		return 0, fmt.Errorf("%s: %w", models.LevelService, models.ErrInvalidToken)
+	}
+
+	err = a.setPassword(ctx, user.ID, newPassword)
+	if err != nil {
+		return 0, err
+	}
+
+	err = a.AttemptsDB.ResetAttempts(ctx, accountSubject(user.ID))
+	if err != nil {
+		return 0, err
+	}
+	return user.ID, nil
+}
+
+func (a *service) setPassword(ctx context.Context, userID int, password string) error {
+	passwordHash, err := hashPassword(password)
+	if err != nil {
+		return err
+	}
+
+	return a.UserDB.UpdatePasswordHash(ctx, userID, passwordHash)
+}
+
+func (a *service) link(path, token string) string {
+	return a.appURL + path + "?token=" + url.QueryEscape(token)
+}
@@ -0,0 +1,276 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"kudago/internal/auth/service/mocks"
+	"kudago/internal/mail"
+	"kudago/internal/models"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestAuthService_ChangePassword(t *testing.T) {
+	t.Parallel()
+
+	hash, err := bcrypt.GenerateFromPassword([]byte("old_password"), passwordHashCost)
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		oldPassword string
+		setupFunc   func(ctrl *gomock.Controller) *service
+		expectedErr error
+	}{
+		{
+			name:        "success change password",
+			oldPassword: "old_password",
+			setupFunc: func(ctrl *gomock.Controller) *service {
+				mockUserDB := mocks.NewMockUserDB(ctrl)
+
+				mockUserDB.EXPECT().GetPasswordHash(context.Background(), 1).Return(string(hash), nil)
+				mockUserDB.EXPECT().
+					UpdatePasswordHash(context.Background(), 1, gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ int, newHash string) error {
+						assert.NoError(t, bcrypt.CompareHashAndPassword([]byte(newHash), []byte("new_password")))
+						return nil
+					})
+
+				return NewService(mockUserDB, nil, nil, nil, "")
+			},
+			expectedErr: nil,
+		},
+		{
+			name:        "wrong old password",
+			oldPassword: "wrong",
+			setupFunc: func(ctrl *gomock.Controller) *service {
+				mockUserDB := mocks.NewMockUserDB(ctrl)
+
+				mockUserDB.EXPECT().GetPasswordHash(context.Background(), 1).Return(string(hash), nil)
+
+				return NewService(mockUserDB, nil, nil, nil, "")
+			},
+			expectedErr: models.ErrWrongPassword,
+		},
+		{
+			name:        "user not found",
+			oldPassword: "old_password",
+			setupFunc: func(ctrl *gomock.Controller) *service {
+				mockUserDB := mocks.NewMockUserDB(ctrl)
+
+				mockUserDB.EXPECT().GetPasswordHash(context.Background(), 1).Return("", models.ErrUserNotFound)
+
+				return NewService(mockUserDB, nil, nil, nil, "")
+			},
+			expectedErr: models.ErrUserNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			err := tt.setupFunc(ctrl).ChangePassword(context.Background(), 1, tt.oldPassword, "new_password")
+
+			assert.ErrorIs(t, err, tt.expectedErr)
+		})
+	}
+}
+
+func TestAuthService_RequestPasswordReset(t *testing.T) {
+	t.Parallel()
+
+	user := models.User{
+		ID:       1,
+		Username: "user1",
+		Email:    "user1@mail.ru",
+	}
+
+	tests := []struct {
+		name        string
+		setupFunc   func(ctrl *gomock.Controller) *service
+		expectedErr error
+	}{
+		{
+			name: "reset link is sent",
+			setupFunc: func(ctrl *gomock.Controller) *service {
+				mockUserDB := mocks.NewMockUserDB(ctrl)
+				mockTokensDB := mocks.NewMockTokensDB(ctrl)
+				mockMailer := mocks.NewMockMailer(ctrl)
+
+				mockUserDB.EXPECT().GetCredentials(context.Background(), user.Email).Return(user, "hash", nil)
+				mockTokensDB.EXPECT().
+					CreateToken(context.Background(), passwordResetPurpose, user, passwordResetTTL).
+					Return("token", nil)
+				mockMailer.EXPECT().
+					Send(context.Background(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, msg mail.Message) error {
+						assert.Equal(t, user.Email, msg.To)
+						assert.Contains(t, msg.Body, "https://example.com/reset-password?token=token")
+						return nil
+					})
+
+				return NewService(mockUserDB, nil, mockTokensDB, mockMailer, "https://example.com")
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "unknown email is ignored",
+			setupFunc: func(ctrl *gomock.Controller) *service {
+				mockUserDB := mocks.NewMockUserDB(ctrl)
+
+				mockUserDB.EXPECT().GetCredentials(context.Background(), user.Email).Return(models.User{}, "", models.ErrUserNotFound)
+
+				return NewService(mockUserDB, nil, nil, nil, "https://example.com")
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "mail error",
+			setupFunc: func(ctrl *gomock.Controller) *service {
+				mockUserDB := mocks.NewMockUserDB(ctrl)
+				mockTokensDB := mocks.NewMockTokensDB(ctrl)
+				mockMailer := mocks.NewMockMailer(ctrl)
+
+				mockUserDB.EXPECT().GetCredentials(context.Background(), user.Email).Return(user, "hash", nil)
+				mockTokensDB.EXPECT().
+					CreateToken(context.Background(), passwordResetPurpose, user, passwordResetTTL).
+					Return("token", nil)
+				mockMailer.EXPECT().Send(context.Background(), gomock.Any()).Return(models.ErrInternal)
+
+				return NewService(mockUserDB, nil, mockTokensDB, mockMailer, "https://example.com")
+			},
+			expectedErr: models.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			err := tt.setupFunc(ctrl).RequestPasswordReset(context.Background(), user.Email)
+
+			assert.ErrorIs(t, err, tt.expectedErr)
+		})
+	}
+}
+
+func TestAuthService_ConfirmPasswordReset(t *testing.T) {
+	t.Parallel()
+
+	resetUser := models.User{ID: 1, Email: "old@mail.ru"}
+
+	tests := []struct {
+		name           string
+		setupFunc      func(ctrl *gomock.Controller) *service
+		expectedUserID int
+		expectedErr    error
+	}{
+		{
+			name: "password is reset",
+			setupFunc: func(ctrl *gomock.Controller) *service {
+				mockUserDB := mocks.NewMockUserDB(ctrl)
+				mockAttemptsDB := mocks.NewMockAttemptsDB(ctrl)
+				mockTokensDB := mocks.NewMockTokensDB(ctrl)
+
+				mockTokensDB.EXPECT().ConsumeToken(context.Background(), passwordResetPurpose, "token").Return(resetUser, nil)
+				mockUserDB.EXPECT().GetUserByID(context.Background(), 1).Return(resetUser, nil)
+				mockUserDB.EXPECT().
+					UpdatePasswordHash(context.Background(), 1, gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ int, newHash string) error {
+						assert.NoError(t, bcrypt.CompareHashAndPassword([]byte(newHash), []byte("new_password")))
+						return nil
+					})
+				mockAttemptsDB.EXPECT().ResetAttempts(context.Background(), "user:1").Return(nil)
+
+				return NewService(mockUserDB, mockAttemptsDB, mockTokensDB, nil, "")
+			},
+			expectedUserID: 1,
+			expectedErr:    nil,
+		},
+		{
+			name: "invalid token",
+			setupFunc: func(ctrl *gomock.Controller) *service {
+				mockTokensDB := mocks.NewMockTokensDB(ctrl)
+
+				mockTokensDB.EXPECT().
+					ConsumeToken(context.Background(), passwordResetPurpose, "token").
+					Return(models.User{}, models.ErrInvalidToken)
+
+				return NewService(nil, nil, mockTokensDB, nil, "")
+			},
+			expectedErr: models.ErrInvalidToken,
+		},
+		{
+			name: "email changed since the token was sent",
+			setupFunc: func(ctrl *gomock.Controller) *service {
+				mockUserDB := mocks.NewMockUserDB(ctrl)
+				mockTokensDB := mocks.NewMockTokensDB(ctrl)
+
+				mockTokensDB.EXPECT().ConsumeToken(context.Background(), passwordResetPurpose, "token").Return(resetUser, nil)
+				mockUserDB.EXPECT().GetUserByID(context.Background(), 1).Return(models.User{ID: 1, Email: "new@mail.ru"}, nil)
+
+				return NewService(mockUserDB, nil, mockTokensDB, nil, "")
+			},
+			expectedErr: models.ErrInvalidToken,
+		},
+		{
+			name: "user deleted since the token was sent",
+			setupFunc: func(ctrl *gomock.Controller) *service {
+				mockUserDB := mocks.NewMockUserDB(ctrl)
+				mockTokensDB := mocks.NewMockTokensDB(ctrl)
+
+				mockTokensDB.EXPECT().ConsumeToken(context.Background(), passwordResetPurpose, "token").Return(resetUser, nil)
+				mockUserDB.EXPECT().GetUserByID(context.Background(), 1).Return(models.User{}, models.ErrUserNotFound)
+
+				return NewService(mockUserDB, nil, mockTokensDB, nil, "")
+			},
+			expectedErr: models.ErrInvalidToken,
+		},
+		{
+			name: "update error",
+			setupFunc: func(ctrl *gomock.Controller) *service {
+				mockUserDB := mocks.NewMockUserDB(ctrl)
+				mockTokensDB := mocks.NewMockTokensDB(ctrl)
+
+				mockTokensDB.EXPECT().ConsumeToken(context.Background(), passwordResetPurpose, "token").Return(resetUser, nil)
+				mockUserDB.EXPECT().GetUserByID(context.Background(), 1).Return(resetUser, nil)
+				mockUserDB.EXPECT().
+					UpdatePasswordHash(context.Background(), 1, gomock.Any()).
+					Return(errors.New("db error"))
+
+				return NewService(mockUserDB, nil, mockTokensDB, nil, "")
+			},
+			expectedErr: errors.New("db error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			userID, err := tt.setupFunc(ctrl).ConfirmPasswordReset(context.Background(), "token", "new_password")
+
+			if tt.expectedErr != nil {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedUserID, userID)
+			}
+		})
+	}
+}
@@ -0,0 +1,9 @@
+package grpc
+
+const (
+	ErrInternal         = "internal error"
+	ErrTestNotFound     = "test not found"
+	ErrQuestionNotFound = "question not found"
+	ErrAlreadyAnswered  = "user already answered the question"
+	ErrBadData          = "bad data request"
+)
@@ -0,0 +1,119 @@
+//go:generate mockgen -source=csat.go -destination=tests/mocks/csat.go -package=mocks
+
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	pb "kudago/internal/csat/api"
+	"kudago/internal/logger"
+	"kudago/internal/models"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type ServerAPI struct {
+	pb.UnimplementedCSATServiceServer
+	service CSATService
+	logger  *logger.Logger
+}
+
+type CSATService interface {
+	GetTests(ctx context.Context) ([]models.Test, error)
+	AddAnswers(ctx context.Context, testID, userID int, answers []models.Answer) error
+	GetStatistics(ctx context.Context, testID int) ([]models.QuestionStatistics, error)
+}
+
+func NewServerAPI(service CSATService, logger *logger.Logger) *ServerAPI {
+	return &ServerAPI{
+		service: service,
+		logger:  logger,
+	}
+}
+
+func (s *ServerAPI) GetTests(ctx context.Context, req *pb.Empty) (*pb.Tests, error) {
+	tests, err := s.service.GetTests(ctx)
+	if err != nil {
+		s.logger.Error(ctx, "get tests", err)
+		return nil, status.Error(codes.Internal, ErrInternal)
+	}
+
+	resp := &pb.Tests{
+		Tests: make([]*pb.Test, 0, len(tests)),
+	}
+	for _, test := range tests {
+		pbTest := &pb.Test{
+			ID:        int32(test.ID),
+			Title:     test.Title,
+			Questions: make([]*pb.Question, 0, len(test.Questions)),
+		}
+		for _, question := range test.Questions {
+			pbTest.Questions = append(pbTest.Questions, &pb.Question{
+				ID:   int32(question.ID),
+				Text: question.Text,
+			})
+		}
+		resp.Tests = append(resp.Tests, pbTest)
+	}
+	return resp, nil
+}
+
+func (s *ServerAPI) AddAnswers(ctx context.Context, req *pb.AddAnswersRequest) (*pb.Empty, error) {
+	answers := make([]models.Answer, 0, len(req.Answers))
+	for _, answer := range req.Answers {
+		answers = append(answers, models.Answer{
+			QuestionID: int(answer.QuestionID),
+			Value:      int(answer.Value),
+		})
+	}
+
+	err := s.service.AddAnswers(ctx, int(req.TestID), int(req.UserID), answers)
+	if err != nil {
+		switch {
+		case errors.Is(err, models.ErrInvalidAnswers):
+			return nil, status.Error(codes.InvalidArgument, ErrBadData)
+		case errors.Is(err, models.ErrTestNotFound):
+			return nil, status.Error(codes.NotFound, ErrTestNotFound)
+		case errors.Is(err, models.ErrQuestionNotFound):
+			return nil, status.Error(codes.InvalidArgument, ErrQuestionNotFound)
+		case errors.Is(err, models.ErrAlreadyAnswered):
+			return nil, status.Error(codes.AlreadyExists, ErrAlreadyAnswered)
+		}
+		s.logger.Error(ctx, "add answers", err)
+		return nil, status.Error(codes.Internal, ErrInternal)
+	}
+
+	return &pb.Empty{}, nil
+}
+
+func (s *ServerAPI) GetStatistics(ctx context.Context, req *pb.GetStatisticsRequest) (*pb.Statistics, error) {
+	stats, err := s.service.GetStatistics(ctx, int(req.TestID))
+	if err != nil {
+		if errors.Is(err, models.ErrTestNotFound) {
+			return nil, status.Error(codes.NotFound, ErrTestNotFound)
+		}
+		s.logger.Error(ctx, "get statistics", err)
+		return nil, status.Error(codes.Internal, ErrInternal)
+	}
+
+	resp := &pb.Statistics{
+		TestID:    req.TestID,
+		Questions: make([]*pb.QuestionStatistics, 0, len(stats)),
+	}
+	for _, question := range stats {
+		distribution := make([]int32, 0, len(question.Distribution))
+		for _, count := range question.Distribution {
+			distribution = append(distribution, int32(count))
+		}
+		resp.Questions = append(resp.Questions, &pb.QuestionStatistics{
+			QuestionID:   int32(question.QuestionID),
+			Question:     question.Question,
+			Average:      question.Average,
+			Total:        int32(question.Total),
+			Distribution: distribution,
+		})
+	}
+	return resp, nil
+}
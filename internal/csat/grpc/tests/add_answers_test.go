@@ -0,0 +1,86 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	pb "kudago/internal/csat/api"
+	csat "kudago/internal/csat/grpc"
+	"kudago/internal/csat/grpc/tests/mocks"
+	"kudago/internal/logger"
+	"kudago/internal/models"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCSATGRPC_AddAnswers(t *testing.T) {
+	t.Parallel()
+
+	validAnswers := []*pb.Answer{{QuestionID: 1, Value: 8}, {QuestionID: 2, Value: 10}}
+
+	tests := []struct {
+		name       string
+		req        *pb.AddAnswersRequest
+		setupMocks func(mockService *mocks.MockCSATService)
+		err        error
+	}{
+		{
+			name: "success add answers",
+			req:  &pb.AddAnswersRequest{TestID: 1, UserID: 3, Answers: validAnswers},
+			setupMocks: func(mockService *mocks.MockCSATService) {
+				mockService.EXPECT().
+					AddAnswers(context.Background(), 1, 3, []models.Answer{{QuestionID: 1, Value: 8}, {QuestionID: 2, Value: 10}}).
+					Return(nil)
+			},
+		},
+		{
+			name: "invalid answers",
+			req:  &pb.AddAnswersRequest{TestID: 1, UserID: 3, Answers: []*pb.Answer{{QuestionID: 1, Value: 11}}},
+			setupMocks: func(mockService *mocks.MockCSATService) {
+				mockService.EXPECT().AddAnswers(context.Background(), 1, 3, []models.Answer{{QuestionID: 1, Value: 11}}).
+					Return(fmt.Errorf("%s: %w", models.LevelService, models.ErrInvalidAnswers))
+			},
+			err: status.Error(codes.InvalidArgument, csat.ErrBadData),
+		},
+		{
+			name: "already answered",
+			req:  &pb.AddAnswersRequest{TestID: 1, UserID: 3, Answers: validAnswers},
+			setupMocks: func(mockService *mocks.MockCSATService) {
+				mockService.EXPECT().AddAnswers(context.Background(), 1, 3, gomock.Any()).
+					Return(fmt.Errorf("%s: %w", models.LevelDB, models.ErrAlreadyAnswered))
+			},
+			err: status.Error(codes.AlreadyExists, csat.ErrAlreadyAnswered),
+		},
+		{
+			name: "test not found",
+			req:  &pb.AddAnswersRequest{TestID: 1, UserID: 3, Answers: validAnswers},
+			setupMocks: func(mockService *mocks.MockCSATService) {
+				mockService.EXPECT().AddAnswers(context.Background(), 1, 3, gomock.Any()).
+					Return(fmt.Errorf("%s: %w", models.LevelDB, models.ErrTestNotFound))
+			},
+			err: status.Error(codes.NotFound, csat.ErrTestNotFound),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockService := mocks.NewMockCSATService(ctrl)
+			logger, _ := logger.NewLogger()
+			tt.setupMocks(mockService)
+
+			server := csat.NewServerAPI(mockService, logger)
+			_, err := server.AddAnswers(context.Background(), tt.req)
+
+			assert.Equal(t, tt.err, err)
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	pb "kudago/internal/csat/api"
+	csat "kudago/internal/csat/grpc"
+	"kudago/internal/csat/grpc/tests/mocks"
+	"kudago/internal/logger"
+	"kudago/internal/models"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCSATGRPC_GetStatistics(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success get statistics", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockService := mocks.NewMockCSATService(ctrl)
+		logger, _ := logger.NewLogger()
+		mockService.EXPECT().GetStatistics(context.Background(), 1).Return([]models.QuestionStatistics{
+			{QuestionID: 1, Question: "Q", Average: 8.5, Total: 2, Distribution: []int{0, 0, 0, 0, 0, 0, 0, 1, 1, 0}},
+		}, nil)
+
+		server := csat.NewServerAPI(mockService, logger)
+		resp, err := server.GetStatistics(context.Background(), &pb.GetStatisticsRequest{TestID: 1})
+
+		assert.NoError(t, err)
+		assert.Equal(t, int32(1), resp.TestID)
+		assert.Len(t, resp.Questions, 1)
+		assert.Equal(t, 8.5, resp.Questions[0].Average)
+		assert.Equal(t, []int32{0, 0, 0, 0, 0, 0, 0, 1, 1, 0}, resp.Questions[0].Distribution)
+	})
+
+	t.Run("test not found", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockService := mocks.NewMockCSATService(ctrl)
+		logger, _ := logger.NewLogger()
+		mockService.EXPECT().GetStatistics(context.Background(), 1).
+			Return(nil, fmt.Errorf("%s: %w", models.LevelDB, models.ErrTestNotFound))
+
+		server := csat.NewServerAPI(mockService, logger)
+		_, err := server.GetStatistics(context.Background(), &pb.GetStatisticsRequest{TestID: 1})
+
+		assert.Equal(t, status.Error(codes.NotFound, csat.ErrTestNotFound), err)
+	})
+}
@@ -0,0 +1,80 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: csat.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	models "kudago/internal/models"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockCSATService is a mock of CSATService interface.
+type MockCSATService struct {
+	ctrl     *gomock.Controller
+	recorder *MockCSATServiceMockRecorder
+}
+
+// MockCSATServiceMockRecorder is the mock recorder for MockCSATService.
+type MockCSATServiceMockRecorder struct {
+	mock *MockCSATService
+}
+
+// NewMockCSATService creates a new mock instance.
+func NewMockCSATService(ctrl *gomock.Controller) *MockCSATService {
+	mock := &MockCSATService{ctrl: ctrl}
+	mock.recorder = &MockCSATServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCSATService) EXPECT() *MockCSATServiceMockRecorder {
+	return m.recorder
+}
+
+// AddAnswers mocks base method.
+func (m *MockCSATService) AddAnswers(ctx context.Context, testID, userID int, answers []models.Answer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddAnswers", ctx, testID, userID, answers)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddAnswers indicates an expected call of AddAnswers.
+func (mr *MockCSATServiceMockRecorder) AddAnswers(ctx, testID, userID, answers interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddAnswers", reflect.TypeOf((*MockCSATService)(nil).AddAnswers), ctx, testID, userID, answers)
+}
+
+// GetStatistics mocks base method.
+func (m *MockCSATService) GetStatistics(ctx context.Context, testID int) ([]models.QuestionStatistics, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStatistics", ctx, testID)
+	ret0, _ := ret[0].([]models.QuestionStatistics)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStatistics indicates an expected call of GetStatistics.
+func (mr *MockCSATServiceMockRecorder) GetStatistics(ctx, testID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStatistics", reflect.TypeOf((*MockCSATService)(nil).GetStatistics), ctx, testID)
+}
+
+// GetTests mocks base method.
+func (m *MockCSATService) GetTests(ctx context.Context) ([]models.Test, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTests", ctx)
+	ret0, _ := ret[0].([]models.Test)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTests indicates an expected call of GetTests.
+func (mr *MockCSATServiceMockRecorder) GetTests(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTests", reflect.TypeOf((*MockCSATService)(nil).GetTests), ctx)
+}
@@ -0,0 +1,69 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"kudago/internal/models"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/pkg/errors"
+)
+
+const testIsActiveQuery = `SELECT EXISTS(SELECT 1 FROM test WHERE id = $1 AND is_active)`
+
+const countTestQuestionsQuery = `SELECT COUNT(*) FROM question WHERE test_id = $1 AND id = ANY($2)`
+
+const insertAnswerQuery = `
+	INSERT INTO answers (question_id, user_id, answer)
+	VALUES ($1, $2, $3)`
+
+// AddAnswers stores all answers of the user to the test at once. The unique
+// (question_id, user_id) constraint rejects repeated answers.
+func (db *CSATDB) AddAnswers(ctx context.Context, testID, userID int, answers []models.Answer) error {
+	tx, err := db.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: %w", models.LevelDB, err)
+	}
+	defer tx.Rollback(ctx)
+
+	var active bool
+	err = tx.QueryRow(ctx, testIsActiveQuery, testID).Scan(&active)
+	if err != nil {
+		return fmt.Errorf("%s: %w", models.LevelDB, err)
+	}
+	if !active {
+		return fmt.Errorf("%s: %w", models.LevelDB, models.ErrTestNotFound)
+	}
+
+	questionIDs := make([]int, 0, len(answers))
+	for _, answer := range answers {
+		questionIDs = append(questionIDs, answer.QuestionID)
+	}
+
+	var found int
+	err = tx.QueryRow(ctx, countTestQuestionsQuery, testID, questionIDs).Scan(&found)
+	if err != nil {
+		return fmt.Errorf("%s: %w", models.LevelDB, err)
+	}
+	if found != len(questionIDs) {
+		return fmt.Errorf("%s: %w", models.LevelDB, models.ErrQuestionNotFound)
+	}
+
+	for _, answer := range answers {
+		_, err = tx.Exec(ctx, insertAnswerQuery, answer.QuestionID, userID, answer.Value)
+		if err != nil {
+			var pgErr *pgconn.PgError
+			if errors.As(err, &pgErr) && pgErr.Code == uniqueViolationCode {
+				return fmt.Errorf("%s: %w", models.LevelDB, models.ErrAlreadyAnswered)
+			}
+			return fmt.Errorf("%s: %w", models.LevelDB, err)
+		}
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: %w", models.LevelDB, err)
+	}
+	return nil
+}
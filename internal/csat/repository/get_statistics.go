@@ -0,0 +1,65 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"kudago/internal/models"
+)
+
+const getAnswerCountsQuery = `
+	SELECT question.id, COALESCE(question.question, ''), COALESCE(answers.answer, 0), COUNT(answers.id)
+	FROM question
+	LEFT JOIN answers ON answers.question_id = question.id
+	WHERE question.test_id = $1
+	GROUP BY question.id, question.question, answers.answer
+	ORDER BY question.id, answers.answer`
+
+func (db *CSATDB) GetStatistics(ctx context.Context, testID int) ([]models.QuestionStatistics, error) {
+	rows, err := db.pool.Query(ctx, getAnswerCountsQuery, testID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", models.LevelDB, err)
+	}
+	defer rows.Close()
+
+	var stats []models.QuestionStatistics
+	for rows.Next() {
+		var (
+			questionID, answer, count int
+			question                  string
+		)
+		if err = rows.Scan(&questionID, &question, &answer, &count); err != nil {
+			return nil, fmt.Errorf("%s: %w", models.LevelDB, err)
+		}
+
+		if len(stats) == 0 || stats[len(stats)-1].QuestionID != questionID {
+			stats = append(stats, models.QuestionStatistics{
+				QuestionID:   questionID,
+				Question:     question,
+				Distribution: make([]int, models.MaxAnswer-models.MinAnswer+1),
+			})
+		}
+
+		if answer < models.MinAnswer || answer > models.MaxAnswer {
+			continue
+		}
+		last := &stats[len(stats)-1]
+		last.Distribution[answer-models.MinAnswer] += count
+		last.Total += count
+		last.Average += float64(answer * count)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", models.LevelDB, err)
+	}
+
+	if len(stats) == 0 {
+		return nil, fmt.Errorf("%s: %w", models.LevelDB, models.ErrTestNotFound)
+	}
+
+	for i := range stats {
+		if stats[i].Total > 0 {
+			stats[i].Average /= float64(stats[i].Total)
+		}
+	}
+	return stats, nil
+}
@@ -0,0 +1,46 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"kudago/internal/models"
+)
+
+const getActiveTestsQuery = `
+	SELECT test.id, COALESCE(test.title, ''), question.id, COALESCE(question.question, '')
+	FROM test
+	JOIN question ON question.test_id = test.id
+	WHERE test.is_active
+	ORDER BY test.id, question.id`
+
+func (db *CSATDB) GetTests(ctx context.Context) ([]models.Test, error) {
+	rows, err := db.pool.Query(ctx, getActiveTestsQuery)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", models.LevelDB, err)
+	}
+	defer rows.Close()
+
+	var tests []models.Test
+	for rows.Next() {
+		var (
+			testID   int
+			title    string
+			question models.Question
+		)
+		if err = rows.Scan(&testID, &title, &question.ID, &question.Text); err != nil {
+			return nil, fmt.Errorf("%s: %w", models.LevelDB, err)
+		}
+
+		if len(tests) == 0 || tests[len(tests)-1].ID != testID {
+			tests = append(tests, models.Test{ID: testID, Title: title})
+		}
+		last := &tests[len(tests)-1]
+		last.Questions = append(last.Questions, question)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", models.LevelDB, err)
+	}
+
+	return tests, nil
+}
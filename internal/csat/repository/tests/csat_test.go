@@ -0,0 +1,247 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"kudago/internal/csat/repository"
+	"kudago/internal/models"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCSATRepository_GetTests(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	tests := []struct {
+		name         string
+		mockSetup    func(m pgxmock.PgxConnIface)
+		expectedData []models.Test
+		expectErr    bool
+	}{
+		{
+			name: "Успешное получение опросов",
+			mockSetup: func(m pgxmock.PgxConnIface) {
+				rows := pgxmock.NewRows([]string{"test_id", "title", "question_id", "question"}).
+					AddRow(1, "Удобство сайта", 1, "Насколько удобен поиск?").
+					AddRow(1, "Удобство сайта", 2, "Насколько удобна регистрация?").
+					AddRow(2, "Мероприятия", 3, "Насколько интересны события?")
+				m.ExpectQuery(`SELECT test.id`).WillReturnRows(rows)
+			},
+			expectedData: []models.Test{
+				{ID: 1, Title: "Удобство сайта", Questions: []models.Question{
+					{ID: 1, Text: "Насколько удобен поиск?"},
+					{ID: 2, Text: "Насколько удобна регистрация?"},
+				}},
+				{ID: 2, Title: "Мероприятия", Questions: []models.Question{
+					{ID: 3, Text: "Насколько интересны события?"},
+				}},
+			},
+		},
+		{
+			name: "Ошибка при выполнении запроса",
+			mockSetup: func(m pgxmock.PgxConnIface) {
+				m.ExpectQuery(`SELECT test.id`).WillReturnError(fmt.Errorf("query error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockConn, err := pgxmock.NewConn()
+			require.NoError(t, err)
+			defer mockConn.Close(ctx)
+
+			tt.mockSetup(mockConn)
+
+			db := repository.NewDB(mockConn)
+			csatTests, err := db.GetTests(ctx)
+
+			if tt.expectErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), models.LevelDB)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedData, csatTests)
+			}
+		})
+	}
+}
+
+func TestCSATRepository_AddAnswers(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	answers := []models.Answer{{QuestionID: 1, Value: 8}, {QuestionID: 2, Value: 10}}
+
+	tests := []struct {
+		name        string
+		mockSetup   func(m pgxmock.PgxConnIface)
+		expectedErr error
+	}{
+		{
+			name: "Успешное сохранение ответов",
+			mockSetup: func(m pgxmock.PgxConnIface) {
+				m.ExpectBegin()
+				m.ExpectQuery(`SELECT EXISTS\(SELECT 1 FROM test`).
+					WithArgs(1).
+					WillReturnRows(pgxmock.NewRows([]string{"exists"}).AddRow(true))
+				m.ExpectQuery(`SELECT COUNT\(\*\) FROM question`).
+					WithArgs(1, []int{1, 2}).
+					WillReturnRows(pgxmock.NewRows([]string{"count"}).AddRow(2))
+				m.ExpectExec(`INSERT INTO answers`).
+					WithArgs(1, 3, 8).
+					WillReturnResult(pgxmock.NewResult("INSERT", 1))
+				m.ExpectExec(`INSERT INTO answers`).
+					WithArgs(2, 3, 10).
+					WillReturnResult(pgxmock.NewResult("INSERT", 1))
+				m.ExpectCommit()
+				m.ExpectRollback()
+			},
+		},
+		{
+			name: "Опрос не найден",
+			mockSetup: func(m pgxmock.PgxConnIface) {
+				m.ExpectBegin()
+				m.ExpectQuery(`SELECT EXISTS\(SELECT 1 FROM test`).
+					WithArgs(1).
+					WillReturnRows(pgxmock.NewRows([]string{"exists"}).AddRow(false))
+				m.ExpectRollback()
+			},
+			expectedErr: models.ErrTestNotFound,
+		},
+		{
+			name: "Вопрос из другого опроса",
+			mockSetup: func(m pgxmock.PgxConnIface) {
+				m.ExpectBegin()
+				m.ExpectQuery(`SELECT EXISTS\(SELECT 1 FROM test`).
+					WithArgs(1).
+					WillReturnRows(pgxmock.NewRows([]string{"exists"}).AddRow(true))
+				m.ExpectQuery(`SELECT COUNT\(\*\) FROM question`).
+					WithArgs(1, []int{1, 2}).
+					WillReturnRows(pgxmock.NewRows([]string{"count"}).AddRow(1))
+				m.ExpectRollback()
+			},
+			expectedErr: models.ErrQuestionNotFound,
+		},
+		{
+			name: "Повторный ответ",
+			mockSetup: func(m pgxmock.PgxConnIface) {
+				m.ExpectBegin()
+				m.ExpectQuery(`SELECT EXISTS\(SELECT 1 FROM test`).
+					WithArgs(1).
+					WillReturnRows(pgxmock.NewRows([]string{"exists"}).AddRow(true))
+				m.ExpectQuery(`SELECT COUNT\(\*\) FROM question`).
+					WithArgs(1, []int{1, 2}).
+					WillReturnRows(pgxmock.NewRows([]string{"count"}).AddRow(2))
+				m.ExpectExec(`INSERT INTO answers`).
+					WithArgs(1, 3, 8).
+					WillReturnError(&pgconn.PgError{Code: "23505"})
+				m.ExpectRollback()
+			},
+			expectedErr: models.ErrAlreadyAnswered,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockConn, err := pgxmock.NewConn()
+			require.NoError(t, err)
+			defer mockConn.Close(ctx)
+
+			tt.mockSetup(mockConn)
+
+			db := repository.NewDB(mockConn)
+			err = db.AddAnswers(ctx, 1, 3, answers)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, mockConn.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestCSATRepository_GetStatistics(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	tests := []struct {
+		name         string
+		mockSetup    func(m pgxmock.PgxConnIface)
+		expectedData []models.QuestionStatistics
+		expectedErr  error
+	}{
+		{
+			name: "Успешное получение статистики",
+			mockSetup: func(m pgxmock.PgxConnIface) {
+				rows := pgxmock.NewRows([]string{"question_id", "question", "answer", "count"}).
+					AddRow(1, "Насколько удобен поиск?", 8, 3).
+					AddRow(1, "Насколько удобен поиск?", 10, 1).
+					AddRow(2, "Насколько удобна регистрация?", 0, 0)
+				m.ExpectQuery(`SELECT question.id`).
+					WithArgs(1).
+					WillReturnRows(rows)
+			},
+			expectedData: []models.QuestionStatistics{
+				{
+					QuestionID:   1,
+					Question:     "Насколько удобен поиск?",
+					Average:      8.5,
+					Total:        4,
+					Distribution: []int{0, 0, 0, 0, 0, 0, 0, 3, 0, 1},
+				},
+				{
+					QuestionID:   2,
+					Question:     "Насколько удобна регистрация?",
+					Distribution: []int{0, 0, 0, 0, 0, 0, 0, 0, 0, 0},
+				},
+			},
+		},
+		{
+			name: "Опрос не найден",
+			mockSetup: func(m pgxmock.PgxConnIface) {
+				m.ExpectQuery(`SELECT question.id`).
+					WithArgs(1).
+					WillReturnRows(pgxmock.NewRows([]string{"question_id", "question", "answer", "count"}))
+			},
+			expectedErr: models.ErrTestNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockConn, err := pgxmock.NewConn()
+			require.NoError(t, err)
+			defer mockConn.Close(ctx)
+
+			tt.mockSetup(mockConn)
+
+			db := repository.NewDB(mockConn)
+			stats, err := db.GetStatistics(ctx, 1)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedData, stats)
+			}
+		})
+	}
+}
@@ -0,0 +1,52 @@
+//go:generate mockgen -source ./csat.go -destination=./mocks/csat.go -package=mocks
+
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"kudago/internal/models"
+)
+
+type service struct {
+	CSATDB CSATDB
+}
+
+type CSATDB interface {
+	GetTests(ctx context.Context) ([]models.Test, error)
+	AddAnswers(ctx context.Context, testID, userID int, answers []models.Answer) error
+	GetStatistics(ctx context.Context, testID int) ([]models.QuestionStatistics, error)
+}
+
+func NewService(csatDB CSATDB) *service {
+	return &service{
+		CSATDB: csatDB,
+	}
+}
+
+func (s *service) GetTests(ctx context.Context) ([]models.Test, error) {
+	return s.CSATDB.GetTests(ctx)
+}
+
+// AddAnswers saves a user's answers to a test. Every question can be answered
+// once, with a value from MinAnswer to MaxAnswer.
+func (s *service) AddAnswers(ctx context.Context, testID, userID int, answers []models.Answer) error {
+	if len(answers) == 0 {
+		return fmt.Errorf("%s: %w", models.LevelService, models.ErrInvalidAnswers)
+	}
+
+	answered := make(map[int]bool, len(answers))
+	for _, answer := range answers {
+		if answer.Value < models.MinAnswer || answer.Value > models.MaxAnswer || answered[answer.QuestionID] {
+			return fmt.Errorf("%s: %w", models.LevelService, models.ErrInvalidAnswers)
+		}
+		answered[answer.QuestionID] = true
+	}
+
+	return s.CSATDB.AddAnswers(ctx, testID, userID, answers)
+}
+
+func (s *service) GetStatistics(ctx context.Context, testID int) ([]models.QuestionStatistics, error) {
+	return s.CSATDB.GetStatistics(ctx, testID)
+}
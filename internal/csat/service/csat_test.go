@@ -0,0 +1,146 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"kudago/internal/csat/service/mocks"
+	"kudago/internal/models"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCSATService_AddAnswers(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		answers   []models.Answer
+		setupFunc func(ctrl *gomock.Controller) *service
+		expected  error
+	}{
+		{
+			name:    "success add answers",
+			answers: []models.Answer{{QuestionID: 1, Value: models.MinAnswer}, {QuestionID: 2, Value: models.MaxAnswer}},
+			setupFunc: func(ctrl *gomock.Controller) *service {
+				mockCSATDB := mocks.NewMockCSATDB(ctrl)
+
+				mockCSATDB.EXPECT().
+					AddAnswers(context.Background(), 1, 3, []models.Answer{{QuestionID: 1, Value: models.MinAnswer}, {QuestionID: 2, Value: models.MaxAnswer}}).
+					Return(nil)
+				return NewService(mockCSATDB)
+			},
+		},
+		{
+			name:    "no answers",
+			answers: nil,
+			setupFunc: func(ctrl *gomock.Controller) *service {
+				return NewService(mocks.NewMockCSATDB(ctrl))
+			},
+			expected: models.ErrInvalidAnswers,
+		},
+		{
+			name:    "answer below the scale",
+			answers: []models.Answer{{QuestionID: 1, Value: models.MinAnswer - 1}},
+			setupFunc: func(ctrl *gomock.Controller) *service {
+				return NewService(mocks.NewMockCSATDB(ctrl))
+			},
+			expected: models.ErrInvalidAnswers,
+		},
+		{
+			name:    "answer above the scale",
+			answers: []models.Answer{{QuestionID: 1, Value: 8}, {QuestionID: 2, Value: models.MaxAnswer + 1}},
+			setupFunc: func(ctrl *gomock.Controller) *service {
+				return NewService(mocks.NewMockCSATDB(ctrl))
+			},
+			expected: models.ErrInvalidAnswers,
+		},
+		{
+			name:    "question answered twice",
+			answers: []models.Answer{{QuestionID: 1, Value: 5}, {QuestionID: 1, Value: 6}},
+			setupFunc: func(ctrl *gomock.Controller) *service {
+				return NewService(mocks.NewMockCSATDB(ctrl))
+			},
+			expected: models.ErrInvalidAnswers,
+		},
+		{
+			name:    "already answered",
+			answers: []models.Answer{{QuestionID: 1, Value: 5}},
+			setupFunc: func(ctrl *gomock.Controller) *service {
+				mockCSATDB := mocks.NewMockCSATDB(ctrl)
+
+				mockCSATDB.EXPECT().
+					AddAnswers(context.Background(), 1, 3, []models.Answer{{QuestionID: 1, Value: 5}}).
+					Return(models.ErrAlreadyAnswered)
+				return NewService(mockCSATDB)
+			},
+			expected: models.ErrAlreadyAnswered,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			service := tt.setupFunc(ctrl)
+			err := service.AddAnswers(context.Background(), 1, 3, tt.answers)
+
+			assert.ErrorIs(t, err, tt.expected)
+		})
+	}
+}
+
+func TestCSATService_GetStatistics(t *testing.T) {
+	t.Parallel()
+
+	stats := []models.QuestionStatistics{
+		{QuestionID: 1, Question: "Насколько удобен поиск?", Average: 7.5, Total: 2, Distribution: []int{0, 0, 0, 0, 0, 0, 1, 1, 0, 0}},
+	}
+
+	tests := []struct {
+		name      string
+		setupFunc func(ctrl *gomock.Controller) *service
+		expected  []models.QuestionStatistics
+		err       error
+	}{
+		{
+			name: "success get statistics",
+			setupFunc: func(ctrl *gomock.Controller) *service {
+				mockCSATDB := mocks.NewMockCSATDB(ctrl)
+
+				mockCSATDB.EXPECT().GetStatistics(context.Background(), 1).Return(stats, nil)
+				return NewService(mockCSATDB)
+			},
+			expected: stats,
+		},
+		{
+			name: "test not found",
+			setupFunc: func(ctrl *gomock.Controller) *service {
+				mockCSATDB := mocks.NewMockCSATDB(ctrl)
+
+				mockCSATDB.EXPECT().GetStatistics(context.Background(), 1).Return(nil, models.ErrTestNotFound)
+				return NewService(mockCSATDB)
+			},
+			err: models.ErrTestNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			service := tt.setupFunc(ctrl)
+			result, err := service.GetStatistics(context.Background(), 1)
+
+			assert.ErrorIs(t, err, tt.err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
@@ -0,0 +1,80 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./csat.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	models "kudago/internal/models"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockCSATDB is a mock of CSATDB interface.
+type MockCSATDB struct {
+	ctrl     *gomock.Controller
+	recorder *MockCSATDBMockRecorder
+}
+
+// MockCSATDBMockRecorder is the mock recorder for MockCSATDB.
+type MockCSATDBMockRecorder struct {
+	mock *MockCSATDB
+}
+
+// NewMockCSATDB creates a new mock instance.
+func NewMockCSATDB(ctrl *gomock.Controller) *MockCSATDB {
+	mock := &MockCSATDB{ctrl: ctrl}
+	mock.recorder = &MockCSATDBMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCSATDB) EXPECT() *MockCSATDBMockRecorder {
+	return m.recorder
+}
+
+// AddAnswers mocks base method.
+func (m *MockCSATDB) AddAnswers(ctx context.Context, testID, userID int, answers []models.Answer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddAnswers", ctx, testID, userID, answers)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddAnswers indicates an expected call of AddAnswers.
+func (mr *MockCSATDBMockRecorder) AddAnswers(ctx, testID, userID, answers interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddAnswers", reflect.TypeOf((*MockCSATDB)(nil).AddAnswers), ctx, testID, userID, answers)
+}
+
+// GetStatistics mocks base method.
+func (m *MockCSATDB) GetStatistics(ctx context.Context, testID int) ([]models.QuestionStatistics, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStatistics", ctx, testID)
+	ret0, _ := ret[0].([]models.QuestionStatistics)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStatistics indicates an expected call of GetStatistics.
+func (mr *MockCSATDBMockRecorder) GetStatistics(ctx, testID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStatistics", reflect.TypeOf((*MockCSATDB)(nil).GetStatistics), ctx, testID)
+}
+
+// GetTests mocks base method.
+func (m *MockCSATDB) GetTests(ctx context.Context) ([]models.Test, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTests", ctx)
+	ret0, _ := ret[0].([]models.Test)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTests indicates an expected call of GetTests.
+func (mr *MockCSATDBMockRecorder) GetTests(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTests", reflect.TypeOf((*MockCSATDB)(nil).GetTests), ctx)
+}
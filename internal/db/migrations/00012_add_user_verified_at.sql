@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE "USER" ADD COLUMN verified_at TIMESTAMP;
+
+-- accounts registered before email verification existed stay fully active
+UPDATE "USER" SET verified_at = created_at;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE "USER" DROP COLUMN IF EXISTS verified_at;
+-- +goose StatementEnd
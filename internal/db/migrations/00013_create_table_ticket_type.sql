@@ -0,0 +1,33 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE TICKET_TYPE (
+                             id SERIAL PRIMARY KEY,
+                             name TEXT NOT NULL,
+                             price DECIMAL(10, 2) NOT NULL CHECK (price >= 0),
+                             event_id INT NOT NULL,
+                             created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                             UNIQUE (event_id, name),
+                             FOREIGN KEY (event_id) REFERENCES EVENT (id) ON DELETE CASCADE
+);
+
+ALTER TABLE TICKET
+    ADD COLUMN ticket_type_id INT REFERENCES TICKET_TYPE (id) ON DELETE CASCADE,
+    ADD COLUMN status TEXT NOT NULL DEFAULT 'paid' CHECK (status IN ('reserved', 'paid')),
+    ADD COLUMN reserved_until TIMESTAMP WITH TIME ZONE;
+
+CREATE INDEX ticket_event_id_idx ON TICKET (event_id);
+CREATE INDEX ticket_user_id_idx ON TICKET (user_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS ticket_user_id_idx;
+DROP INDEX IF EXISTS ticket_event_id_idx;
+
+ALTER TABLE TICKET
+    DROP COLUMN IF EXISTS reserved_until,
+    DROP COLUMN IF EXISTS status,
+    DROP COLUMN IF EXISTS ticket_type_id;
+
+DROP TABLE IF EXISTS TICKET_TYPE;
+-- +goose StatementEnd
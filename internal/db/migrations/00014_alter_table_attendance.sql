@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE ATTENDANCE
+    ALTER COLUMN attended_at DROP DEFAULT,
+    ALTER COLUMN attended_at DROP NOT NULL,
+    ALTER COLUMN attended_at TYPE TIMESTAMP WITH TIME ZONE,
+    ADD COLUMN created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP;
+
+CREATE INDEX attendance_event_id_idx ON ATTENDANCE (event_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS attendance_event_id_idx;
+
+UPDATE ATTENDANCE SET attended_at = created_at WHERE attended_at IS NULL;
+
+ALTER TABLE ATTENDANCE
+    DROP COLUMN IF EXISTS created_at,
+    ALTER COLUMN attended_at TYPE DATE,
+    ALTER COLUMN attended_at SET NOT NULL,
+    ALTER COLUMN attended_at SET DEFAULT CURRENT_DATE;
+-- +goose StatementEnd
@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE WAITLIST (
+                          id SERIAL PRIMARY KEY,
+                          event_id INT NOT NULL,
+                          user_id INT NOT NULL,
+                          claim_until TIMESTAMP WITH TIME ZONE,
+                          created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                          UNIQUE (event_id, user_id),
+                          FOREIGN KEY (event_id) REFERENCES EVENT (id) ON DELETE CASCADE,
+                          FOREIGN KEY (user_id) REFERENCES "USER" (id) ON DELETE CASCADE
+);
+
+CREATE INDEX waitlist_event_id_idx ON WAITLIST (event_id, id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS WAITLIST;
+-- +goose StatementEnd
@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE TEST
+    ADD COLUMN is_active BOOLEAN NOT NULL DEFAULT TRUE;
+
+ALTER TABLE ANSWERS
+    ADD CONSTRAINT answers_answer_range CHECK (answer BETWEEN 1 AND 10);
+
+CREATE INDEX question_test_id_idx ON QUESTION (test_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS question_test_id_idx;
+
+ALTER TABLE ANSWERS
+    DROP CONSTRAINT IF EXISTS answers_answer_range;
+
+ALTER TABLE TEST
+    DROP COLUMN IF EXISTS is_active;
+-- +goose StatementEnd
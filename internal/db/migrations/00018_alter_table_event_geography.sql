@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE EVENT
+    ADD COLUMN geo GEOGRAPHY(POINT, 4326) GENERATED ALWAYS AS (
+        CASE
+            WHEN lat IS NOT NULL AND lon IS NOT NULL
+                THEN ST_SetSRID(ST_MakePoint(lon, lat), 4326)::GEOGRAPHY
+        END
+    ) STORED;
+
+CREATE INDEX event_geo_idx ON EVENT USING GIST (geo);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS event_geo_idx;
+
+ALTER TABLE EVENT
+    DROP COLUMN IF EXISTS geo;
+-- +goose StatementEnd
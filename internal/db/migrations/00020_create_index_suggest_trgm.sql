@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+CREATE INDEX event_title_trgm_idx ON EVENT USING GIN (title gin_trgm_ops);
+CREATE INDEX tag_name_trgm_idx ON TAG USING GIN (name gin_trgm_ops);
+CREATE INDEX favorite_event_event_id_idx ON FAVORITE_EVENT (event_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS favorite_event_event_id_idx;
+DROP INDEX IF EXISTS tag_name_trgm_idx;
+DROP INDEX IF EXISTS event_title_trgm_idx;
+-- +goose StatementEnd
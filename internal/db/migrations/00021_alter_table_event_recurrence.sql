@@ -0,0 +1,31 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE EVENT
+    ADD COLUMN recurrence_rule TEXT NULL,
+    ADD COLUMN recurrence_exdates TIMESTAMPTZ[] NOT NULL DEFAULT '{}',
+    ADD COLUMN recurrence_until TIMESTAMPTZ NULL;
+
+CREATE INDEX event_recurrence_until_idx ON EVENT (recurrence_until) WHERE recurrence_rule IS NOT NULL;
+
+ALTER TABLE FAVORITE_EVENT
+    ADD COLUMN occurrence_start TIMESTAMPTZ NULL,
+    DROP CONSTRAINT unique_favorites,
+    ADD CONSTRAINT unique_favorites UNIQUE NULLS NOT DISTINCT (user_id, event_id, occurrence_start);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DELETE FROM FAVORITE_EVENT WHERE occurrence_start IS NOT NULL;
+
+ALTER TABLE FAVORITE_EVENT
+    DROP CONSTRAINT unique_favorites,
+    DROP COLUMN IF EXISTS occurrence_start,
+    ADD CONSTRAINT unique_favorites UNIQUE (user_id, event_id);
+
+DROP INDEX IF EXISTS event_recurrence_until_idx;
+
+ALTER TABLE EVENT
+    DROP COLUMN IF EXISTS recurrence_until,
+    DROP COLUMN IF EXISTS recurrence_exdates,
+    DROP COLUMN IF EXISTS recurrence_rule;
+-- +goose StatementEnd
@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE CALENDAR_TOKEN (
+                                user_id INT PRIMARY KEY,
+                                token_hash TEXT NOT NULL UNIQUE,
+                                created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                                FOREIGN KEY (user_id) REFERENCES "USER" (id) ON DELETE CASCADE
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS CALENDAR_TOKEN;
+-- +goose StatementEnd
@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE INDEX event_tag_tag_id_idx ON EVENT_TAG (tag_id, event_id);
+CREATE INDEX event_tag_event_id_idx ON EVENT_TAG (event_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS event_tag_event_id_idx;
+DROP INDEX IF EXISTS event_tag_tag_id_idx;
+-- +goose StatementEnd
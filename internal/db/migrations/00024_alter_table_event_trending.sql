@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE EVENT
+    ADD COLUMN trending_score DOUBLE PRECISION NOT NULL DEFAULT 0;
+
+CREATE INDEX event_trending_score_idx ON EVENT (trending_score DESC, id);
+CREATE INDEX favorite_event_created_at_idx ON FAVORITE_EVENT (created_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS favorite_event_created_at_idx;
+DROP INDEX IF EXISTS event_trending_score_idx;
+
+ALTER TABLE EVENT
+    DROP COLUMN IF EXISTS trending_score;
+-- +goose StatementEnd
@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE EVENT
+    ADD COLUMN status TEXT NOT NULL DEFAULT 'published'
+        CONSTRAINT event_status_check CHECK (status IN ('draft', 'published', 'cancelled', 'archived')),
+    ADD COLUMN publish_at TIMESTAMPTZ NULL;
+
+CREATE INDEX event_status_idx ON EVENT (status);
+CREATE INDEX event_publish_at_idx ON EVENT (publish_at) WHERE status = 'draft';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS event_publish_at_idx;
+DROP INDEX IF EXISTS event_status_idx;
+
+ALTER TABLE EVENT
+    DROP COLUMN IF EXISTS publish_at,
+    DROP COLUMN IF EXISTS status;
+-- +goose StatementEnd
@@ -0,0 +1,31 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE MEDIA_URL
+    ADD COLUMN position INT NOT NULL DEFAULT 0,
+    ADD COLUMN is_cover BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- Events without an image used to get a row with an empty URL.
+DELETE FROM MEDIA_URL WHERE url = '';
+
+UPDATE MEDIA_URL
+SET position = ordered.position - 1,
+    is_cover = ordered.position = 1
+FROM (
+    SELECT id, ROW_NUMBER() OVER (PARTITION BY event_id ORDER BY id) AS position
+    FROM MEDIA_URL
+) AS ordered
+WHERE MEDIA_URL.id = ordered.id;
+
+CREATE UNIQUE INDEX media_url_cover_idx ON MEDIA_URL (event_id) WHERE is_cover;
+CREATE INDEX media_url_event_position_idx ON MEDIA_URL (event_id, position);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS media_url_event_position_idx;
+DROP INDEX IF EXISTS media_url_cover_idx;
+
+ALTER TABLE MEDIA_URL
+    DROP COLUMN IF EXISTS is_cover,
+    DROP COLUMN IF EXISTS position;
+-- +goose StatementEnd
@@ -23,6 +23,13 @@ const (
 	EventService_AddEventToFavorites_FullMethodName       = "/event.EventService/AddEventToFavorites"
 	EventService_DeleteEventFromFavorites_FullMethodName  = "/event.EventService/DeleteEventFromFavorites"
 	EventService_DeleteEvent_FullMethodName               = "/event.EventService/DeleteEvent"
+	EventService_PublishEvent_FullMethodName              = "/event.EventService/PublishEvent"
+	EventService_GetDrafts_FullMethodName                 = "/event.EventService/GetDrafts"
+	EventService_CancelEvent_FullMethodName               = "/event.EventService/CancelEvent"
+	EventService_RescheduleEvent_FullMethodName           = "/event.EventService/RescheduleEvent"
+	EventService_AddEventImage_FullMethodName             = "/event.EventService/AddEventImage"
+	EventService_UpdateEventImages_FullMethodName         = "/event.EventService/UpdateEventImages"
+	EventService_DeleteEventImage_FullMethodName          = "/event.EventService/DeleteEventImage"
 	EventService_GetCategories_FullMethodName             = "/event.EventService/GetCategories"
 	EventService_GetEventByID_FullMethodName              = "/event.EventService/GetEventByID"
 	EventService_GetEventsByCategory_FullMethodName       = "/event.EventService/GetEventsByCategory"
@@ -30,12 +37,26 @@ const (
 	EventService_GetFavorites_FullMethodName              = "/event.EventService/GetFavorites"
 	EventService_GetPastEvents_FullMethodName             = "/event.EventService/GetPastEvents"
 	EventService_GetUpcomingEvents_FullMethodName         = "/event.EventService/GetUpcomingEvents"
+	EventService_GetRecommendedEvents_FullMethodName      = "/event.EventService/GetRecommendedEvents"
+	EventService_GetTrendingEvents_FullMethodName         = "/event.EventService/GetTrendingEvents"
 	EventService_GetSubscriptionsEvents_FullMethodName    = "/event.EventService/GetSubscriptionsEvents"
 	EventService_UpdateEvent_FullMethodName               = "/event.EventService/UpdateEvent"
 	EventService_SearchEvents_FullMethodName              = "/event.EventService/SearchEvents"
+	EventService_SuggestEvents_FullMethodName             = "/event.EventService/SuggestEvents"
 	EventService_GetUserIDsByFavoriteEvent_FullMethodName = "/event.EventService/GetUserIDsByFavoriteEvent"
 	EventService_GetEventsByIDs_FullMethodName            = "/event.EventService/GetEventsByIDs"
 	EventService_GetSubscribersIDs_FullMethodName         = "/event.EventService/GetSubscribersIDs"
+	EventService_AddTicketType_FullMethodName             = "/event.EventService/AddTicketType"
+	EventService_GetTicketTypes_FullMethodName            = "/event.EventService/GetTicketTypes"
+	EventService_ReserveTickets_FullMethodName            = "/event.EventService/ReserveTickets"
+	EventService_BuyTicket_FullMethodName                 = "/event.EventService/BuyTicket"
+	EventService_GetUserTickets_FullMethodName            = "/event.EventService/GetUserTickets"
+	EventService_AttendEvent_FullMethodName               = "/event.EventService/AttendEvent"
+	EventService_CancelAttendance_FullMethodName          = "/event.EventService/CancelAttendance"
+	EventService_GetAttendees_FullMethodName              = "/event.EventService/GetAttendees"
+	EventService_CheckInAttendee_FullMethodName           = "/event.EventService/CheckInAttendee"
+	EventService_CreateCalendarToken_FullMethodName       = "/event.EventService/CreateCalendarToken"
+	EventService_GetUserIDByCalendarToken_FullMethodName  = "/event.EventService/GetUserIDByCalendarToken"
 )
 
 // EventServiceClient is the client API for EventService service.
@@ -45,7 +66,14 @@ type EventServiceClient interface {
 	AddEvent(ctx context.Context, in *Event, opts ...grpc.CallOption) (*Event, error)
 	AddEventToFavorites(ctx context.Context, in *FavoriteEvent, opts ...grpc.CallOption) (*Empty, error)
 	DeleteEventFromFavorites(ctx context.Context, in *FavoriteEvent, opts ...grpc.CallOption) (*Empty, error)
-	DeleteEvent(ctx context.Context, in *DeleteEventRequest, opts ...grpc.CallOption) (*Empty, error)
+	DeleteEvent(ctx context.Context, in *DeleteEventRequest, opts ...grpc.CallOption) (*DeleteEventResponse, error)
+	PublishEvent(ctx context.Context, in *PublishEventRequest, opts ...grpc.CallOption) (*Event, error)
+	GetDrafts(ctx context.Context, in *GetEventsByUserRequest, opts ...grpc.CallOption) (*Events, error)
+	CancelEvent(ctx context.Context, in *CancelEventRequest, opts ...grpc.CallOption) (*Event, error)
+	RescheduleEvent(ctx context.Context, in *RescheduleEventRequest, opts ...grpc.CallOption) (*Event, error)
+	AddEventImage(ctx context.Context, in *AddEventImageRequest, opts ...grpc.CallOption) (*EventImage, error)
+	UpdateEventImages(ctx context.Context, in *UpdateEventImagesRequest, opts ...grpc.CallOption) (*EventImages, error)
+	DeleteEventImage(ctx context.Context, in *DeleteEventImageRequest, opts ...grpc.CallOption) (*EventImage, error)
 	GetCategories(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*GetCategoriesResponse, error)
 	GetEventByID(ctx context.Context, in *GetEventByIDRequest, opts ...grpc.CallOption) (*Event, error)
 	GetEventsByCategory(ctx context.Context, in *GetEventsByCategoryRequest, opts ...grpc.CallOption) (*Events, error)
@@ -53,12 +81,26 @@ type EventServiceClient interface {
 	GetFavorites(ctx context.Context, in *GetFavoritesRequest, opts ...grpc.CallOption) (*Events, error)
 	GetPastEvents(ctx context.Context, in *PaginationParams, opts ...grpc.CallOption) (*Events, error)
 	GetUpcomingEvents(ctx context.Context, in *PaginationParams, opts ...grpc.CallOption) (*Events, error)
+	GetRecommendedEvents(ctx context.Context, in *GetRecommendedEventsRequest, opts ...grpc.CallOption) (*Events, error)
+	GetTrendingEvents(ctx context.Context, in *GetTrendingEventsRequest, opts ...grpc.CallOption) (*Events, error)
 	GetSubscriptionsEvents(ctx context.Context, in *GetSubscriptionsRequest, opts ...grpc.CallOption) (*Events, error)
 	UpdateEvent(ctx context.Context, in *Event, opts ...grpc.CallOption) (*Event, error)
 	SearchEvents(ctx context.Context, in *SearchParams, opts ...grpc.CallOption) (*Events, error)
+	SuggestEvents(ctx context.Context, in *SuggestRequest, opts ...grpc.CallOption) (*Suggestions, error)
 	GetUserIDsByFavoriteEvent(ctx context.Context, in *GetUserIDsByFavoriteEventRequest, opts ...grpc.CallOption) (*GetUserIDsResponse, error)
 	GetEventsByIDs(ctx context.Context, in *GetEventsByIDsRequest, opts ...grpc.CallOption) (*Events, error)
 	GetSubscribersIDs(ctx context.Context, in *GetSubscribersIDsRequest, opts ...grpc.CallOption) (*GetUserIDsResponse, error)
+	AddTicketType(ctx context.Context, in *AddTicketTypeRequest, opts ...grpc.CallOption) (*TicketType, error)
+	GetTicketTypes(ctx context.Context, in *GetTicketTypesRequest, opts ...grpc.CallOption) (*TicketTypes, error)
+	ReserveTickets(ctx context.Context, in *ReserveTicketsRequest, opts ...grpc.CallOption) (*Ticket, error)
+	BuyTicket(ctx context.Context, in *BuyTicketRequest, opts ...grpc.CallOption) (*Ticket, error)
+	GetUserTickets(ctx context.Context, in *GetUserTicketsRequest, opts ...grpc.CallOption) (*Tickets, error)
+	AttendEvent(ctx context.Context, in *AttendanceRequest, opts ...grpc.CallOption) (*AttendanceStatus, error)
+	CancelAttendance(ctx context.Context, in *AttendanceRequest, opts ...grpc.CallOption) (*WaitlistPromotions, error)
+	GetAttendees(ctx context.Context, in *GetAttendeesRequest, opts ...grpc.CallOption) (*Attendees, error)
+	CheckInAttendee(ctx context.Context, in *CheckInAttendeeRequest, opts ...grpc.CallOption) (*Empty, error)
+	CreateCalendarToken(ctx context.Context, in *CreateCalendarTokenRequest, opts ...grpc.CallOption) (*CalendarToken, error)
+	GetUserIDByCalendarToken(ctx context.Context, in *CalendarToken, opts ...grpc.CallOption) (*CalendarTokenOwner, error)
 }
 
 type eventServiceClient struct {
@@ -99,9 +141,9 @@ func (c *eventServiceClient) DeleteEventFromFavorites(ctx context.Context, in *F
 	return out, nil
 }
 
-func (c *eventServiceClient) DeleteEvent(ctx context.Context, in *DeleteEventRequest, opts ...grpc.CallOption) (*Empty, error) {
+func (c *eventServiceClient) DeleteEvent(ctx context.Context, in *DeleteEventRequest, opts ...grpc.CallOption) (*DeleteEventResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(Empty)
+	out := new(DeleteEventResponse)
 	err := c.cc.Invoke(ctx, EventService_DeleteEvent_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -109,6 +151,76 @@ func (c *eventServiceClient) DeleteEvent(ctx context.Context, in *DeleteEventReq
 	return out, nil
 }
 
+func (c *eventServiceClient) PublishEvent(ctx context.Context, in *PublishEventRequest, opts ...grpc.CallOption) (*Event, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Event)
+	err := c.cc.Invoke(ctx, EventService_PublishEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) GetDrafts(ctx context.Context, in *GetEventsByUserRequest, opts ...grpc.CallOption) (*Events, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Events)
+	err := c.cc.Invoke(ctx, EventService_GetDrafts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) CancelEvent(ctx context.Context, in *CancelEventRequest, opts ...grpc.CallOption) (*Event, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Event)
+	err := c.cc.Invoke(ctx, EventService_CancelEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) RescheduleEvent(ctx context.Context, in *RescheduleEventRequest, opts ...grpc.CallOption) (*Event, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Event)
+	err := c.cc.Invoke(ctx, EventService_RescheduleEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) AddEventImage(ctx context.Context, in *AddEventImageRequest, opts ...grpc.CallOption) (*EventImage, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EventImage)
+	err := c.cc.Invoke(ctx, EventService_AddEventImage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) UpdateEventImages(ctx context.Context, in *UpdateEventImagesRequest, opts ...grpc.CallOption) (*EventImages, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EventImages)
+	err := c.cc.Invoke(ctx, EventService_UpdateEventImages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) DeleteEventImage(ctx context.Context, in *DeleteEventImageRequest, opts ...grpc.CallOption) (*EventImage, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EventImage)
+	err := c.cc.Invoke(ctx, EventService_DeleteEventImage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *eventServiceClient) GetCategories(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*GetCategoriesResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetCategoriesResponse)
@@ -179,6 +291,26 @@ func (c *eventServiceClient) GetUpcomingEvents(ctx context.Context, in *Paginati
 	return out, nil
 }
 
+func (c *eventServiceClient) GetRecommendedEvents(ctx context.Context, in *GetRecommendedEventsRequest, opts ...grpc.CallOption) (*Events, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Events)
+	err := c.cc.Invoke(ctx, EventService_GetRecommendedEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) GetTrendingEvents(ctx context.Context, in *GetTrendingEventsRequest, opts ...grpc.CallOption) (*Events, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Events)
+	err := c.cc.Invoke(ctx, EventService_GetTrendingEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *eventServiceClient) GetSubscriptionsEvents(ctx context.Context, in *GetSubscriptionsRequest, opts ...grpc.CallOption) (*Events, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(Events)
@@ -209,6 +341,16 @@ func (c *eventServiceClient) SearchEvents(ctx context.Context, in *SearchParams,
 	return out, nil
 }
 
+func (c *eventServiceClient) SuggestEvents(ctx context.Context, in *SuggestRequest, opts ...grpc.CallOption) (*Suggestions, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Suggestions)
+	err := c.cc.Invoke(ctx, EventService_SuggestEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *eventServiceClient) GetUserIDsByFavoriteEvent(ctx context.Context, in *GetUserIDsByFavoriteEventRequest, opts ...grpc.CallOption) (*GetUserIDsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetUserIDsResponse)
@@ -239,6 +381,116 @@ func (c *eventServiceClient) GetSubscribersIDs(ctx context.Context, in *GetSubsc
 	return out, nil
 }
 
+func (c *eventServiceClient) AddTicketType(ctx context.Context, in *AddTicketTypeRequest, opts ...grpc.CallOption) (*TicketType, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TicketType)
+	err := c.cc.Invoke(ctx, EventService_AddTicketType_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) GetTicketTypes(ctx context.Context, in *GetTicketTypesRequest, opts ...grpc.CallOption) (*TicketTypes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TicketTypes)
+	err := c.cc.Invoke(ctx, EventService_GetTicketTypes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) ReserveTickets(ctx context.Context, in *ReserveTicketsRequest, opts ...grpc.CallOption) (*Ticket, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Ticket)
+	err := c.cc.Invoke(ctx, EventService_ReserveTickets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) BuyTicket(ctx context.Context, in *BuyTicketRequest, opts ...grpc.CallOption) (*Ticket, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Ticket)
+	err := c.cc.Invoke(ctx, EventService_BuyTicket_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) GetUserTickets(ctx context.Context, in *GetUserTicketsRequest, opts ...grpc.CallOption) (*Tickets, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Tickets)
+	err := c.cc.Invoke(ctx, EventService_GetUserTickets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) AttendEvent(ctx context.Context, in *AttendanceRequest, opts ...grpc.CallOption) (*AttendanceStatus, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AttendanceStatus)
+	err := c.cc.Invoke(ctx, EventService_AttendEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) CancelAttendance(ctx context.Context, in *AttendanceRequest, opts ...grpc.CallOption) (*WaitlistPromotions, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WaitlistPromotions)
+	err := c.cc.Invoke(ctx, EventService_CancelAttendance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) GetAttendees(ctx context.Context, in *GetAttendeesRequest, opts ...grpc.CallOption) (*Attendees, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Attendees)
+	err := c.cc.Invoke(ctx, EventService_GetAttendees_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) CheckInAttendee(ctx context.Context, in *CheckInAttendeeRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, EventService_CheckInAttendee_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) CreateCalendarToken(ctx context.Context, in *CreateCalendarTokenRequest, opts ...grpc.CallOption) (*CalendarToken, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CalendarToken)
+	err := c.cc.Invoke(ctx, EventService_CreateCalendarToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) GetUserIDByCalendarToken(ctx context.Context, in *CalendarToken, opts ...grpc.CallOption) (*CalendarTokenOwner, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CalendarTokenOwner)
+	err := c.cc.Invoke(ctx, EventService_GetUserIDByCalendarToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // EventServiceServer is the server API for EventService service.
 // All implementations must embed UnimplementedEventServiceServer
 // for forward compatibility.
@@ -246,7 +498,14 @@ type EventServiceServer interface {
 	AddEvent(context.Context, *Event) (*Event, error)
 	AddEventToFavorites(context.Context, *FavoriteEvent) (*Empty, error)
 	DeleteEventFromFavorites(context.Context, *FavoriteEvent) (*Empty, error)
-	DeleteEvent(context.Context, *DeleteEventRequest) (*Empty, error)
+	DeleteEvent(context.Context, *DeleteEventRequest) (*DeleteEventResponse, error)
+	PublishEvent(context.Context, *PublishEventRequest) (*Event, error)
+	GetDrafts(context.Context, *GetEventsByUserRequest) (*Events, error)
+	CancelEvent(context.Context, *CancelEventRequest) (*Event, error)
+	RescheduleEvent(context.Context, *RescheduleEventRequest) (*Event, error)
+	AddEventImage(context.Context, *AddEventImageRequest) (*EventImage, error)
+	UpdateEventImages(context.Context, *UpdateEventImagesRequest) (*EventImages, error)
+	DeleteEventImage(context.Context, *DeleteEventImageRequest) (*EventImage, error)
 	GetCategories(context.Context, *Empty) (*GetCategoriesResponse, error)
 	GetEventByID(context.Context, *GetEventByIDRequest) (*Event, error)
 	GetEventsByCategory(context.Context, *GetEventsByCategoryRequest) (*Events, error)
@@ -254,12 +513,26 @@ type EventServiceServer interface {
 	GetFavorites(context.Context, *GetFavoritesRequest) (*Events, error)
 	GetPastEvents(context.Context, *PaginationParams) (*Events, error)
 	GetUpcomingEvents(context.Context, *PaginationParams) (*Events, error)
+	GetRecommendedEvents(context.Context, *GetRecommendedEventsRequest) (*Events, error)
+	GetTrendingEvents(context.Context, *GetTrendingEventsRequest) (*Events, error)
 	GetSubscriptionsEvents(context.Context, *GetSubscriptionsRequest) (*Events, error)
 	UpdateEvent(context.Context, *Event) (*Event, error)
 	SearchEvents(context.Context, *SearchParams) (*Events, error)
+	SuggestEvents(context.Context, *SuggestRequest) (*Suggestions, error)
 	GetUserIDsByFavoriteEvent(context.Context, *GetUserIDsByFavoriteEventRequest) (*GetUserIDsResponse, error)
 	GetEventsByIDs(context.Context, *GetEventsByIDsRequest) (*Events, error)
 	GetSubscribersIDs(context.Context, *GetSubscribersIDsRequest) (*GetUserIDsResponse, error)
+	AddTicketType(context.Context, *AddTicketTypeRequest) (*TicketType, error)
+	GetTicketTypes(context.Context, *GetTicketTypesRequest) (*TicketTypes, error)
+	ReserveTickets(context.Context, *ReserveTicketsRequest) (*Ticket, error)
+	BuyTicket(context.Context, *BuyTicketRequest) (*Ticket, error)
+	GetUserTickets(context.Context, *GetUserTicketsRequest) (*Tickets, error)
+	AttendEvent(context.Context, *AttendanceRequest) (*AttendanceStatus, error)
+	CancelAttendance(context.Context, *AttendanceRequest) (*WaitlistPromotions, error)
+	GetAttendees(context.Context, *GetAttendeesRequest) (*Attendees, error)
+	CheckInAttendee(context.Context, *CheckInAttendeeRequest) (*Empty, error)
+	CreateCalendarToken(context.Context, *CreateCalendarTokenRequest) (*CalendarToken, error)
+	GetUserIDByCalendarToken(context.Context, *CalendarToken) (*CalendarTokenOwner, error)
 	mustEmbedUnimplementedEventServiceServer()
 }
 
@@ -279,9 +552,30 @@ func (UnimplementedEventServiceServer) AddEventToFavorites(context.Context, *Fav
 func (UnimplementedEventServiceServer) DeleteEventFromFavorites(context.Context, *FavoriteEvent) (*Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteEventFromFavorites not implemented")
 }
-func (UnimplementedEventServiceServer) DeleteEvent(context.Context, *DeleteEventRequest) (*Empty, error) {
+func (UnimplementedEventServiceServer) DeleteEvent(context.Context, *DeleteEventRequest) (*DeleteEventResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteEvent not implemented")
 }
+func (UnimplementedEventServiceServer) PublishEvent(context.Context, *PublishEventRequest) (*Event, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishEvent not implemented")
+}
+func (UnimplementedEventServiceServer) GetDrafts(context.Context, *GetEventsByUserRequest) (*Events, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDrafts not implemented")
+}
+func (UnimplementedEventServiceServer) CancelEvent(context.Context, *CancelEventRequest) (*Event, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelEvent not implemented")
+}
+func (UnimplementedEventServiceServer) RescheduleEvent(context.Context, *RescheduleEventRequest) (*Event, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RescheduleEvent not implemented")
+}
+func (UnimplementedEventServiceServer) AddEventImage(context.Context, *AddEventImageRequest) (*EventImage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddEventImage not implemented")
+}
+func (UnimplementedEventServiceServer) UpdateEventImages(context.Context, *UpdateEventImagesRequest) (*EventImages, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateEventImages not implemented")
+}
+func (UnimplementedEventServiceServer) DeleteEventImage(context.Context, *DeleteEventImageRequest) (*EventImage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteEventImage not implemented")
+}
 func (UnimplementedEventServiceServer) GetCategories(context.Context, *Empty) (*GetCategoriesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCategories not implemented")
 }
@@ -303,6 +597,12 @@ func (UnimplementedEventServiceServer) GetPastEvents(context.Context, *Paginatio
 func (UnimplementedEventServiceServer) GetUpcomingEvents(context.Context, *PaginationParams) (*Events, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetUpcomingEvents not implemented")
 }
+func (UnimplementedEventServiceServer) GetRecommendedEvents(context.Context, *GetRecommendedEventsRequest) (*Events, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRecommendedEvents not implemented")
+}
+func (UnimplementedEventServiceServer) GetTrendingEvents(context.Context, *GetTrendingEventsRequest) (*Events, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTrendingEvents not implemented")
+}
 func (UnimplementedEventServiceServer) GetSubscriptionsEvents(context.Context, *GetSubscriptionsRequest) (*Events, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSubscriptionsEvents not implemented")
 }
@@ -312,6 +612,9 @@ func (UnimplementedEventServiceServer) UpdateEvent(context.Context, *Event) (*Ev
 func (UnimplementedEventServiceServer) SearchEvents(context.Context, *SearchParams) (*Events, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SearchEvents not implemented")
 }
+func (UnimplementedEventServiceServer) SuggestEvents(context.Context, *SuggestRequest) (*Suggestions, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuggestEvents not implemented")
+}
 func (UnimplementedEventServiceServer) GetUserIDsByFavoriteEvent(context.Context, *GetUserIDsByFavoriteEventRequest) (*GetUserIDsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetUserIDsByFavoriteEvent not implemented")
 }
@@ -321,6 +624,39 @@ func (UnimplementedEventServiceServer) GetEventsByIDs(context.Context, *GetEvent
 func (UnimplementedEventServiceServer) GetSubscribersIDs(context.Context, *GetSubscribersIDsRequest) (*GetUserIDsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSubscribersIDs not implemented")
 }
+func (UnimplementedEventServiceServer) AddTicketType(context.Context, *AddTicketTypeRequest) (*TicketType, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddTicketType not implemented")
+}
+func (UnimplementedEventServiceServer) GetTicketTypes(context.Context, *GetTicketTypesRequest) (*TicketTypes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTicketTypes not implemented")
+}
+func (UnimplementedEventServiceServer) ReserveTickets(context.Context, *ReserveTicketsRequest) (*Ticket, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReserveTickets not implemented")
+}
+func (UnimplementedEventServiceServer) BuyTicket(context.Context, *BuyTicketRequest) (*Ticket, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BuyTicket not implemented")
+}
+func (UnimplementedEventServiceServer) GetUserTickets(context.Context, *GetUserTicketsRequest) (*Tickets, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserTickets not implemented")
+}
+func (UnimplementedEventServiceServer) AttendEvent(context.Context, *AttendanceRequest) (*AttendanceStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttendEvent not implemented")
+}
+func (UnimplementedEventServiceServer) CancelAttendance(context.Context, *AttendanceRequest) (*WaitlistPromotions, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelAttendance not implemented")
+}
+func (UnimplementedEventServiceServer) GetAttendees(context.Context, *GetAttendeesRequest) (*Attendees, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAttendees not implemented")
+}
+func (UnimplementedEventServiceServer) CheckInAttendee(context.Context, *CheckInAttendeeRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckInAttendee not implemented")
+}
+func (UnimplementedEventServiceServer) CreateCalendarToken(context.Context, *CreateCalendarTokenRequest) (*CalendarToken, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCalendarToken not implemented")
+}
+func (UnimplementedEventServiceServer) GetUserIDByCalendarToken(context.Context, *CalendarToken) (*CalendarTokenOwner, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserIDByCalendarToken not implemented")
+}
 func (UnimplementedEventServiceServer) mustEmbedUnimplementedEventServiceServer() {}
 func (UnimplementedEventServiceServer) testEmbeddedByValue()                      {}
 
@@ -414,6 +750,132 @@ func _EventService_DeleteEvent_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EventService_PublishEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).PublishEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_PublishEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).PublishEvent(ctx, req.(*PublishEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_GetDrafts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEventsByUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).GetDrafts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_GetDrafts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).GetDrafts(ctx, req.(*GetEventsByUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_CancelEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).CancelEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_CancelEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).CancelEvent(ctx, req.(*CancelEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_RescheduleEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RescheduleEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).RescheduleEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_RescheduleEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).RescheduleEvent(ctx, req.(*RescheduleEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_AddEventImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddEventImageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).AddEventImage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_AddEventImage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).AddEventImage(ctx, req.(*AddEventImageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_UpdateEventImages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateEventImagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).UpdateEventImages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_UpdateEventImages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).UpdateEventImages(ctx, req.(*UpdateEventImagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_DeleteEventImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteEventImageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).DeleteEventImage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_DeleteEventImage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).DeleteEventImage(ctx, req.(*DeleteEventImageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _EventService_GetCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Empty)
 	if err := dec(in); err != nil {
@@ -540,6 +1002,42 @@ func _EventService_GetUpcomingEvents_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EventService_GetRecommendedEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRecommendedEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).GetRecommendedEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_GetRecommendedEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).GetRecommendedEvents(ctx, req.(*GetRecommendedEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_GetTrendingEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTrendingEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).GetTrendingEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_GetTrendingEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).GetTrendingEvents(ctx, req.(*GetTrendingEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _EventService_GetSubscriptionsEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetSubscriptionsRequest)
 	if err := dec(in); err != nil {
@@ -594,6 +1092,24 @@ func _EventService_SearchEvents_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EventService_SuggestEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuggestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).SuggestEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_SuggestEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).SuggestEvents(ctx, req.(*SuggestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _EventService_GetUserIDsByFavoriteEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetUserIDsByFavoriteEventRequest)
 	if err := dec(in); err != nil {
@@ -648,6 +1164,204 @@ func _EventService_GetSubscribersIDs_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EventService_AddTicketType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddTicketTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).AddTicketType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_AddTicketType_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).AddTicketType(ctx, req.(*AddTicketTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_GetTicketTypes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTicketTypesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).GetTicketTypes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_GetTicketTypes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).GetTicketTypes(ctx, req.(*GetTicketTypesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_ReserveTickets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReserveTicketsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).ReserveTickets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_ReserveTickets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).ReserveTickets(ctx, req.(*ReserveTicketsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_BuyTicket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BuyTicketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).BuyTicket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_BuyTicket_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).BuyTicket(ctx, req.(*BuyTicketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_GetUserTickets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserTicketsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).GetUserTickets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_GetUserTickets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).GetUserTickets(ctx, req.(*GetUserTicketsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_AttendEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AttendanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).AttendEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_AttendEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).AttendEvent(ctx, req.(*AttendanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_CancelAttendance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AttendanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).CancelAttendance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_CancelAttendance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).CancelAttendance(ctx, req.(*AttendanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_GetAttendees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAttendeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).GetAttendees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_GetAttendees_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).GetAttendees(ctx, req.(*GetAttendeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_CheckInAttendee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckInAttendeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).CheckInAttendee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_CheckInAttendee_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).CheckInAttendee(ctx, req.(*CheckInAttendeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_CreateCalendarToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCalendarTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).CreateCalendarToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_CreateCalendarToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).CreateCalendarToken(ctx, req.(*CreateCalendarTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_GetUserIDByCalendarToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CalendarToken)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).GetUserIDByCalendarToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_GetUserIDByCalendarToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).GetUserIDByCalendarToken(ctx, req.(*CalendarToken))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // EventService_ServiceDesc is the grpc.ServiceDesc for EventService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -671,6 +1385,34 @@ var EventService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteEvent",
 			Handler:    _EventService_DeleteEvent_Handler,
 		},
+		{
+			MethodName: "PublishEvent",
+			Handler:    _EventService_PublishEvent_Handler,
+		},
+		{
+			MethodName: "GetDrafts",
+			Handler:    _EventService_GetDrafts_Handler,
+		},
+		{
+			MethodName: "CancelEvent",
+			Handler:    _EventService_CancelEvent_Handler,
+		},
+		{
+			MethodName: "RescheduleEvent",
+			Handler:    _EventService_RescheduleEvent_Handler,
+		},
+		{
+			MethodName: "AddEventImage",
+			Handler:    _EventService_AddEventImage_Handler,
+		},
+		{
+			MethodName: "UpdateEventImages",
+			Handler:    _EventService_UpdateEventImages_Handler,
+		},
+		{
+			MethodName: "DeleteEventImage",
+			Handler:    _EventService_DeleteEventImage_Handler,
+		},
 		{
 			MethodName: "GetCategories",
 			Handler:    _EventService_GetCategories_Handler,
@@ -699,6 +1441,14 @@ var EventService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetUpcomingEvents",
 			Handler:    _EventService_GetUpcomingEvents_Handler,
 		},
+		{
+			MethodName: "GetRecommendedEvents",
+			Handler:    _EventService_GetRecommendedEvents_Handler,
+		},
+		{
+			MethodName: "GetTrendingEvents",
+			Handler:    _EventService_GetTrendingEvents_Handler,
+		},
 		{
 			MethodName: "GetSubscriptionsEvents",
 			Handler:    _EventService_GetSubscriptionsEvents_Handler,
@@ -711,6 +1461,10 @@ var EventService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SearchEvents",
 			Handler:    _EventService_SearchEvents_Handler,
 		},
+		{
+			MethodName: "SuggestEvents",
+			Handler:    _EventService_SuggestEvents_Handler,
+		},
 		{
 			MethodName: "GetUserIDsByFavoriteEvent",
 			Handler:    _EventService_GetUserIDsByFavoriteEvent_Handler,
@@ -723,6 +1477,50 @@ var EventService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetSubscribersIDs",
 			Handler:    _EventService_GetSubscribersIDs_Handler,
 		},
+		{
+			MethodName: "AddTicketType",
+			Handler:    _EventService_AddTicketType_Handler,
+		},
+		{
+			MethodName: "GetTicketTypes",
+			Handler:    _EventService_GetTicketTypes_Handler,
+		},
+		{
+			MethodName: "ReserveTickets",
+			Handler:    _EventService_ReserveTickets_Handler,
+		},
+		{
+			MethodName: "BuyTicket",
+			Handler:    _EventService_BuyTicket_Handler,
+		},
+		{
+			MethodName: "GetUserTickets",
+			Handler:    _EventService_GetUserTickets_Handler,
+		},
+		{
+			MethodName: "AttendEvent",
+			Handler:    _EventService_AttendEvent_Handler,
+		},
+		{
+			MethodName: "CancelAttendance",
+			Handler:    _EventService_CancelAttendance_Handler,
+		},
+		{
+			MethodName: "GetAttendees",
+			Handler:    _EventService_GetAttendees_Handler,
+		},
+		{
+			MethodName: "CheckInAttendee",
+			Handler:    _EventService_CheckInAttendee_Handler,
+		},
+		{
+			MethodName: "CreateCalendarToken",
+			Handler:    _EventService_CreateCalendarToken_Handler,
+		},
+		{
+			MethodName: "GetUserIDByCalendarToken",
+			Handler:    _EventService_GetUserIDByCalendarToken_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "event.proto",
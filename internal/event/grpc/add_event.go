@@ -2,6 +2,7 @@ package grpc
 
 import (
 	"context"
+	"errors"
 
 	pb "kudago/internal/event/api"
 	"kudago/internal/models"
@@ -15,8 +16,9 @@ func (s *ServerAPI) AddEvent(ctx context.Context, req *pb.Event) (*pb.Event, err
 
 	eventData, err := s.service.AddEvent(ctx, newEvent)
 	if err != nil {
-		switch err {
-		case models.ErrInvalidCategory:
+		switch {
+		case errors.Is(err, models.ErrInvalidCategory), errors.Is(err, models.ErrInvalidRecurrence),
+			errors.Is(err, models.ErrInvalidStatus):
 			return nil, status.Error(codes.InvalidArgument, ErrBadData)
 		}
 		s.logger.Error(ctx, "add event", err)
@@ -2,6 +2,7 @@ package grpc
 
 import (
 	"context"
+	"errors"
 
 	pb "kudago/internal/event/api"
 	"kudago/internal/models"
@@ -15,17 +16,16 @@ func (s *ServerAPI) AddEventToFavorites(ctx context.Context, req *pb.FavoriteEve
 
 	err := s.service.AddEventToFavorites(ctx, newFavorite)
 	if err != nil {
-		if err != nil {
-			s.logger.Error(ctx, "add event to favorites", err)
-			switch err {
-			case models.ErrForeignKeyViolation:
-				return nil, status.Error(codes.NotFound, ErrEventNotFound)
-			case models.ErrNothingToInsert:
-				return nil, status.Error(codes.AlreadyExists, ErrAlreadyInFavorites)
-			default:
-				return nil, status.Error(codes.Internal, ErrInternal)
-			}
+		switch {
+		case errors.Is(err, models.ErrEventNotFound), errors.Is(err, models.ErrForeignKeyViolation):
+			return nil, status.Error(codes.NotFound, ErrEventNotFound)
+		case errors.Is(err, models.ErrNothingToInsert):
+			return nil, status.Error(codes.AlreadyExists, ErrAlreadyInFavorites)
+		case errors.Is(err, models.ErrNotPublished):
+			return nil, status.Error(codes.FailedPrecondition, ErrNotPublished)
 		}
+		s.logger.Error(ctx, "add event to favorites", err)
+		return nil, status.Error(codes.Internal, ErrInternal)
 	}
 
 	return nil, nil
@@ -0,0 +1,40 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	pb "kudago/internal/event/api"
+	"kudago/internal/models"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *ServerAPI) AddTicketType(ctx context.Context, req *pb.AddTicketTypeRequest) (*pb.TicketType, error) {
+	if req.Type == nil || req.Type.Name == "" || req.Type.Price < 0 {
+		return nil, status.Error(codes.InvalidArgument, ErrBadData)
+	}
+
+	ticketType := models.TicketType{
+		EventID: int(req.Type.EventID),
+		Name:    req.Type.Name,
+		Price:   req.Type.Price,
+	}
+
+	ticketType, err := s.service.AddTicketType(ctx, ticketType, int(req.AuthorID))
+	if err != nil {
+		switch {
+		case errors.Is(err, models.ErrEventNotFound):
+			return nil, status.Error(codes.NotFound, ErrEventNotFound)
+		case errors.Is(err, models.ErrAccessDenied):
+			return nil, status.Error(codes.PermissionDenied, ErrPermissionDenied)
+		case errors.Is(err, models.ErrNothingToInsert):
+			return nil, status.Error(codes.AlreadyExists, ErrTicketTypeExists)
+		}
+		s.logger.Error(ctx, "add ticket type", err)
+		return nil, status.Error(codes.Internal, ErrInternal)
+	}
+
+	return ticketTypeToTicketTypePB(ticketType), nil
+}
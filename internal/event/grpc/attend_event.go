@@ -0,0 +1,32 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	pb "kudago/internal/event/api"
+	"kudago/internal/models"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *ServerAPI) AttendEvent(ctx context.Context, req *pb.AttendanceRequest) (*pb.AttendanceStatus, error) {
+	result, err := s.service.AttendEvent(ctx, int(req.EventID), int(req.UserID))
+	if err != nil {
+		switch {
+		case errors.Is(err, models.ErrEventNotFound):
+			return nil, status.Error(codes.NotFound, ErrEventNotFound)
+		case errors.Is(err, models.ErrNotPublished):
+			return nil, status.Error(codes.FailedPrecondition, ErrNotPublished)
+		}
+		s.logger.Error(ctx, "attend event", err)
+		return nil, status.Error(codes.Internal, ErrInternal)
+	}
+
+	return &pb.AttendanceStatus{
+		Status:   result.Status,
+		Position: int32(result.Position),
+		Promoted: waitlistEntriesToPB(result.Promoted),
+	}, nil
+}
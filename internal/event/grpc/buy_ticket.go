@@ -0,0 +1,28 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	pb "kudago/internal/event/api"
+	"kudago/internal/models"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *ServerAPI) BuyTicket(ctx context.Context, req *pb.BuyTicketRequest) (*pb.Ticket, error) {
+	ticket, err := s.service.BuyTicket(ctx, int(req.TicketID), int(req.UserID))
+	if err != nil {
+		switch {
+		case errors.Is(err, models.ErrTicketNotFound):
+			return nil, status.Error(codes.NotFound, ErrTicketNotFound)
+		case errors.Is(err, models.ErrNotPublished):
+			return nil, status.Error(codes.FailedPrecondition, ErrNotPublished)
+		}
+		s.logger.Error(ctx, "buy ticket", err)
+		return nil, status.Error(codes.Internal, ErrInternal)
+	}
+
+	return ticketToTicketPB(ticket), nil
+}
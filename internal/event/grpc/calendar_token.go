@@ -0,0 +1,35 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	pb "kudago/internal/event/api"
+	"kudago/internal/models"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *ServerAPI) CreateCalendarToken(ctx context.Context, req *pb.CreateCalendarTokenRequest) (*pb.CalendarToken, error) {
+	token, err := s.service.CreateCalendarToken(ctx, int(req.UserID))
+	if err != nil {
+		s.logger.Error(ctx, "create calendar token", err)
+		return nil, status.Error(codes.Internal, ErrInternal)
+	}
+
+	return &pb.CalendarToken{Token: token}, nil
+}
+
+func (s *ServerAPI) GetUserIDByCalendarToken(ctx context.Context, req *pb.CalendarToken) (*pb.CalendarTokenOwner, error) {
+	userID, err := s.service.GetUserIDByCalendarToken(ctx, req.Token)
+	if err != nil {
+		if errors.Is(err, models.ErrInvalidToken) {
+			return nil, status.Error(codes.NotFound, ErrInvalidToken)
+		}
+		s.logger.Error(ctx, "get user by calendar token", err)
+		return nil, status.Error(codes.Internal, ErrInternal)
+	}
+
+	return &pb.CalendarTokenOwner{UserID: int32(userID)}, nil
+}
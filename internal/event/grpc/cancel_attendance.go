@@ -0,0 +1,27 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	pb "kudago/internal/event/api"
+	"kudago/internal/models"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *ServerAPI) CancelAttendance(ctx context.Context, req *pb.AttendanceRequest) (*pb.WaitlistPromotions, error) {
+	promoted, err := s.service.CancelAttendance(ctx, int(req.EventID), int(req.UserID))
+	if err != nil {
+		if errors.Is(err, models.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, ErrNotAttending)
+		}
+		s.logger.Error(ctx, "cancel attendance", err)
+		return nil, status.Error(codes.Internal, ErrInternal)
+	}
+
+	return &pb.WaitlistPromotions{
+		Promoted: waitlistEntriesToPB(promoted),
+	}, nil
+}
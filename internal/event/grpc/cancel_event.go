@@ -0,0 +1,45 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	pb "kudago/internal/event/api"
+	"kudago/internal/models"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *ServerAPI) CancelEvent(ctx context.Context, req *pb.CancelEventRequest) (*pb.Event, error) {
+	event, err := s.service.CancelEvent(ctx, int(req.EventID), int(req.AuthorID), req.Reason)
+	if err != nil {
+		return nil, s.statusChangeError(ctx, "cancel event", err)
+	}
+
+	return eventToEventPB(event), nil
+}
+
+func (s *ServerAPI) RescheduleEvent(ctx context.Context, req *pb.RescheduleEventRequest) (*pb.Event, error) {
+	event, err := s.service.RescheduleEvent(ctx, int(req.EventID), int(req.AuthorID), req.EventStart, req.EventEnd)
+	if err != nil {
+		return nil, s.statusChangeError(ctx, "reschedule event", err)
+	}
+
+	return eventToEventPB(event), nil
+}
+
+func (s *ServerAPI) statusChangeError(ctx context.Context, method string, err error) error {
+	switch {
+	case errors.Is(err, models.ErrEventNotFound):
+		return status.Error(codes.NotFound, ErrEventNotFound)
+	case errors.Is(err, models.ErrAccessDenied):
+		return status.Error(codes.PermissionDenied, ErrPermissionDenied)
+	case errors.Is(err, models.ErrNotPublished):
+		return status.Error(codes.FailedPrecondition, ErrNotPublished)
+	case errors.Is(err, models.ErrInvalidRecurrence):
+		return status.Error(codes.InvalidArgument, ErrBadData)
+	}
+	s.logger.Error(ctx, method, err)
+	return status.Error(codes.Internal, ErrInternal)
+}
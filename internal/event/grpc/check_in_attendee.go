@@ -0,0 +1,32 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	pb "kudago/internal/event/api"
+	"kudago/internal/models"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *ServerAPI) CheckInAttendee(ctx context.Context, req *pb.CheckInAttendeeRequest) (*pb.Empty, error) {
+	err := s.service.CheckInAttendee(ctx, int(req.EventID), int(req.UserID), int(req.AuthorID))
+	if err != nil {
+		switch {
+		case errors.Is(err, models.ErrEventNotFound):
+			return nil, status.Error(codes.NotFound, ErrEventNotFound)
+		case errors.Is(err, models.ErrNotFound):
+			return nil, status.Error(codes.NotFound, ErrNotAttending)
+		case errors.Is(err, models.ErrAccessDenied):
+			return nil, status.Error(codes.PermissionDenied, ErrPermissionDenied)
+		case errors.Is(err, models.ErrEventNotStarted):
+			return nil, status.Error(codes.FailedPrecondition, ErrEventNotStarted)
+		}
+		s.logger.Error(ctx, "check in attendee", err)
+		return nil, status.Error(codes.Internal, ErrInternal)
+	}
+
+	return &pb.Empty{}, nil
+}
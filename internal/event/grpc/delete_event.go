@@ -11,8 +11,8 @@ import (
 	"google.golang.org/grpc/status"
 )
 
-func (s *ServerAPI) DeleteEvent(ctx context.Context, req *pb.DeleteEventRequest) (*pb.Empty, error) {
-	err := s.service.DeleteEvent(ctx, int(req.EventID), int(req.AuthorID))
+func (s *ServerAPI) DeleteEvent(ctx context.Context, req *pb.DeleteEventRequest) (*pb.DeleteEventResponse, error) {
+	images, err := s.service.DeleteEvent(ctx, int(req.EventID), int(req.AuthorID))
 	if err != nil {
 		if errors.Is(err, models.ErrNotFound) {
 			return nil, status.Error(codes.NotFound, ErrEventNotFound)
@@ -23,5 +23,5 @@ func (s *ServerAPI) DeleteEvent(ctx context.Context, req *pb.DeleteEventRequest)
 		s.logger.Error(ctx, "delete event", err)
 		return nil, status.Error(codes.Internal, ErrInternal)
 	}
-	return nil, nil
+	return &pb.DeleteEventResponse{Images: images}, nil
 }
@@ -0,0 +1,41 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	pb "kudago/internal/event/api"
+	"kudago/internal/models"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *ServerAPI) PublishEvent(ctx context.Context, req *pb.PublishEventRequest) (*pb.Event, error) {
+	event, err := s.service.PublishEvent(ctx, int(req.EventID), int(req.AuthorID))
+	if err != nil {
+		switch {
+		case errors.Is(err, models.ErrEventNotFound):
+			return nil, status.Error(codes.NotFound, ErrEventNotFound)
+		case errors.Is(err, models.ErrAccessDenied):
+			return nil, status.Error(codes.PermissionDenied, ErrPermissionDenied)
+		case errors.Is(err, models.ErrNotDraft):
+			return nil, status.Error(codes.FailedPrecondition, ErrNotDraft)
+		}
+		s.logger.Error(ctx, "publish event", err)
+		return nil, status.Error(codes.Internal, ErrInternal)
+	}
+
+	return eventToEventPB(event), nil
+}
+
+func (s *ServerAPI) GetDrafts(ctx context.Context, req *pb.GetEventsByUserRequest) (*pb.Events, error) {
+	params := getPaginationParams(req.Params)
+	eventsData, err := s.getter.GetDrafts(ctx, int(req.UserID), params)
+	if err != nil {
+		s.logger.Error(ctx, "get drafts", err)
+		return nil, status.Error(codes.Internal, ErrInternal)
+	}
+
+	return writeEventsResponse(eventsData, params.Limit), nil
+}
@@ -4,6 +4,7 @@ package grpc
 
 import (
 	"context"
+	"time"
 
 	pb "kudago/internal/event/api"
 	"kudago/internal/logger"
@@ -16,6 +17,19 @@ const (
 	ErrPermissionDenied   = "permission denied"
 	ErrAlreadyInFavorites = "event is already in favorites"
 	ErrBadData            = "bad data request"
+	ErrTicketTypeNotFound = "ticket type not found"
+	ErrTicketTypeExists   = "ticket type already exists"
+	ErrTicketNotFound     = "ticket not found"
+	ErrNoTicketsLeft      = "no tickets left"
+	ErrNotAttending       = "user is not attending the event"
+	ErrEventNotStarted    = "event has not started yet"
+	ErrInvalidCursor      = "invalid cursor"
+	ErrSuggestTimeout     = "suggestions took too long"
+	ErrInvalidToken       = "invalid calendar token"
+	ErrNotDraft           = "event is not a draft"
+	ErrNotPublished       = "event is not published"
+	ErrImageNotFound      = "image not found"
+	ErrTooManyImages      = "too many images"
 )
 
 type ServerAPI struct {
@@ -27,11 +41,29 @@ type ServerAPI struct {
 
 type EventService interface {
 	AddEvent(ctx context.Context, event models.Event) (models.Event, error)
-	DeleteEvent(ctx context.Context, ID, authorID int) error
+	DeleteEvent(ctx context.Context, ID, authorID int) ([]string, error)
 	UpdateEvent(ctx context.Context, event models.Event) (models.Event, error)
+	GetEventByID(ctx context.Context, ID, userID int) (models.Event, error)
 	SearchEvents(ctx context.Context, params models.SearchParams, paginationParams models.PaginationParams) ([]models.Event, error)
+	GetSearchFacets(ctx context.Context, params models.SearchParams) (models.SearchFacets, error)
+	SuggestEvents(ctx context.Context, query string, limit int) (models.Suggestions, error)
 	AddEventToFavorites(ctx context.Context, newFavorite models.FavoriteEvent) error
 	DeleteEventFromFavorites(ctx context.Context, newFavorite models.FavoriteEvent) error
+	AddTicketType(ctx context.Context, ticketType models.TicketType, authorID int) (models.TicketType, error)
+	ReserveTickets(ctx context.Context, ticket models.Ticket) (models.Ticket, error)
+	BuyTicket(ctx context.Context, ticketID, userID int) (models.Ticket, error)
+	AttendEvent(ctx context.Context, eventID, userID int) (models.AttendanceResult, error)
+	CancelAttendance(ctx context.Context, eventID, userID int) ([]models.WaitlistEntry, error)
+	CheckInAttendee(ctx context.Context, eventID, userID, authorID int) error
+	CreateCalendarToken(ctx context.Context, userID int) (string, error)
+	GetUserIDByCalendarToken(ctx context.Context, token string) (int, error)
+	GetRecommendedEvents(ctx context.Context, userID int, paginationParams models.PaginationParams) ([]models.Event, error)
+	PublishEvent(ctx context.Context, ID, authorID int) (models.Event, error)
+	CancelEvent(ctx context.Context, ID, authorID int, reason string) (models.Event, error)
+	RescheduleEvent(ctx context.Context, ID, authorID int, eventStart, eventEnd string) (models.Event, error)
+	AddEventImage(ctx context.Context, eventID, authorID int, url string) (models.EventImage, error)
+	UpdateEventImages(ctx context.Context, eventID, authorID int, order []int, coverID int) ([]models.EventImage, error)
+	DeleteEventImage(ctx context.Context, eventID, authorID, imageID int) (models.EventImage, error)
 }
 
 type EventsGetter interface {
@@ -40,12 +72,16 @@ type EventsGetter interface {
 	GetEventsByCategory(ctx context.Context, categoryID int, paginationParams models.PaginationParams) ([]models.Event, error)
 	GetEventsByUser(ctx context.Context, userID int, paginationParams models.PaginationParams) ([]models.Event, error)
 	GetCategories(ctx context.Context) ([]models.Category, error)
-	GetEventByID(ctx context.Context, ID int) (models.Event, error)
 	GetFavorites(ctx context.Context, userID int, paginationParams models.PaginationParams) ([]models.Event, error)
 	GetSubscriptionEvents(ctx context.Context, userID int, paginationParams models.PaginationParams) ([]models.Event, error)
-	GetUserIDsByFavoriteEvent(ctx context.Context, eventID int) ([]int, error)
+	GetUserIDsByFavoriteEvent(ctx context.Context, eventID int, occurrenceStart string) ([]int, error)
 	GetEventsByIDs(ctx context.Context, ids []int) ([]models.Event, error)
 	GetSubscribersIDs(ctx context.Context, id int) ([]int, error)
+	GetTicketTypes(ctx context.Context, eventID int) (models.TicketTypes, error)
+	GetUserTickets(ctx context.Context, userID int, paginationParams models.PaginationParams) ([]models.Ticket, error)
+	GetAttendees(ctx context.Context, eventID int, paginationParams models.PaginationParams) ([]models.Attendee, error)
+	GetTrendingEvents(ctx context.Context, categoryID int, paginationParams models.PaginationParams) ([]models.Event, error)
+	GetDrafts(ctx context.Context, userID int, paginationParams models.PaginationParams) ([]models.Event, error)
 }
 
 func NewServerAPI(service EventService, getter EventsGetter, logger *logger.Logger) *ServerAPI {
@@ -71,6 +107,11 @@ func eventPBToEvent(event *pb.Event) models.Event {
 		ImageURL:    event.Image,
 		Latitude:    float64(event.Latitude),
 		Longitude:   float64(event.Longitude),
+		Status:      event.Status,
+		PublishAt:   event.PublishAt,
+
+		RecurrenceRule:       event.RecurrenceRule,
+		RecurrenceExceptions: event.RecurrenceExceptions,
 	}
 }
 
@@ -89,6 +130,21 @@ func eventToEventPB(event models.Event) *pb.Event {
 		Image:       event.ImageURL,
 		Latitude:    float64(event.Latitude),
 		Longitude:   float64(event.Longitude),
+		Attendees:   int32(event.Attendees),
+
+		FavoritesCount:       int32(event.FavoritesCount),
+		Status:               event.Status,
+		PublishAt:            event.PublishAt,
+		CancelReason:         event.CancelReason,
+		Images:               eventImagesToPB(event.Images),
+		ReplacedImage:        event.ReplacedImageURL,
+		TitleHighlight:       event.TitleHighlight,
+		DescriptionHighlight: event.DescriptionHighlight,
+		Distance:             event.Distance,
+		RecurrenceRule:       event.RecurrenceRule,
+		RecurrenceExceptions: event.RecurrenceExceptions,
+		SeriesStart:          event.SeriesStart,
+		SavedOccurrence:      event.SavedOccurrence,
 	}
 }
 
@@ -96,7 +152,50 @@ func favoritePBToFavorite(favorite *pb.FavoriteEvent) models.FavoriteEvent {
 	return models.FavoriteEvent{
 		EventID: int(favorite.EventID),
 		UserID:  int(favorite.UserID),
+
+		OccurrenceStart: favorite.OccurrenceStart,
+	}
+}
+
+func ticketTypeToTicketTypePB(ticketType models.TicketType) *pb.TicketType {
+	return &pb.TicketType{
+		ID:      int32(ticketType.ID),
+		EventID: int32(ticketType.EventID),
+		Name:    ticketType.Name,
+		Price:   ticketType.Price,
+	}
+}
+
+func ticketToTicketPB(ticket models.Ticket) *pb.Ticket {
+	pbTicket := &pb.Ticket{
+		ID:           int32(ticket.ID),
+		EventID:      int32(ticket.EventID),
+		TicketTypeID: int32(ticket.TicketTypeID),
+		UserID:       int32(ticket.UserID),
+		Type:         ticket.Type,
+		Price:        ticket.Price,
+		Quantity:     int32(ticket.Quantity),
+		Status:       ticket.Status,
+	}
+	if !ticket.ReservedUntil.IsZero() {
+		pbTicket.ReservedUntil = ticket.ReservedUntil.Format(time.RFC3339)
+	}
+	if !ticket.BoughtAt.IsZero() {
+		pbTicket.BoughtAt = ticket.BoughtAt.Format(time.DateOnly)
+	}
+	return pbTicket
+}
+
+func waitlistEntriesToPB(entries []models.WaitlistEntry) []*pb.WaitlistEntry {
+	pbEntries := make([]*pb.WaitlistEntry, 0, len(entries))
+	for _, entry := range entries {
+		pbEntries = append(pbEntries, &pb.WaitlistEntry{
+			UserID:     int32(entry.UserID),
+			EventID:    int32(entry.EventID),
+			ClaimUntil: entry.ClaimUntil.Format(time.RFC3339),
+		})
 	}
+	return pbEntries
 }
 
 func writeEventsResponse(events []models.Event, limit int) *pb.Events {
@@ -116,3 +215,31 @@ func getPaginationParams(params *pb.PaginationParams) models.PaginationParams {
 		Offset: int(params.Offset),
 	}
 }
+
+func getCursorPaginationParams(params *pb.PaginationParams) (models.PaginationParams, error) {
+	paginationParams := getPaginationParams(params)
+	if params.GetCursor() == "" {
+		return paginationParams, nil
+	}
+
+	cursor, err := models.DecodeCursor(params.GetCursor())
+	if err != nil {
+		return models.PaginationParams{}, err
+	}
+
+	paginationParams.Cursor = &cursor
+	paginationParams.Offset = 0
+	return paginationParams, nil
+}
+
+// writeCursorEventsResponse sets next_cursor only on full pages, so an empty
+// cursor tells the client there is nothing left to load.
+func writeCursorEventsResponse(events []models.Event, limit int) *pb.Events {
+	resp := writeEventsResponse(events, limit)
+	if limit <= 0 || len(events) < limit {
+		return resp
+	}
+
+	resp.NextCursor = events[len(events)-1].Cursor().Encode()
+	return resp
+}
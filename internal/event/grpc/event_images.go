@@ -0,0 +1,82 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	pb "kudago/internal/event/api"
+	"kudago/internal/models"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *ServerAPI) AddEventImage(ctx context.Context, req *pb.AddEventImageRequest) (*pb.EventImage, error) {
+	image, err := s.service.AddEventImage(ctx, int(req.EventID), int(req.AuthorID), req.Url)
+	if err != nil {
+		return nil, s.eventImageError(ctx, "add event image", err)
+	}
+
+	return eventImageToPB(image), nil
+}
+
+func (s *ServerAPI) UpdateEventImages(ctx context.Context, req *pb.UpdateEventImagesRequest) (*pb.EventImages, error) {
+	order := make([]int, 0, len(req.Order))
+	for _, id := range req.Order {
+		order = append(order, int(id))
+	}
+
+	images, err := s.service.UpdateEventImages(ctx, int(req.EventID), int(req.AuthorID), order, int(req.CoverId))
+	if err != nil {
+		return nil, s.eventImageError(ctx, "update event images", err)
+	}
+
+	return &pb.EventImages{Images: eventImagesToPB(images)}, nil
+}
+
+func (s *ServerAPI) DeleteEventImage(ctx context.Context, req *pb.DeleteEventImageRequest) (*pb.EventImage, error) {
+	image, err := s.service.DeleteEventImage(ctx, int(req.EventID), int(req.AuthorID), int(req.ImageID))
+	if err != nil {
+		return nil, s.eventImageError(ctx, "delete event image", err)
+	}
+
+	return eventImageToPB(image), nil
+}
+
+func (s *ServerAPI) eventImageError(ctx context.Context, method string, err error) error {
+	switch {
+	case errors.Is(err, models.ErrEventNotFound):
+		return status.Error(codes.NotFound, ErrEventNotFound)
+	case errors.Is(err, models.ErrImageNotFound):
+		return status.Error(codes.NotFound, ErrImageNotFound)
+	case errors.Is(err, models.ErrAccessDenied):
+		return status.Error(codes.PermissionDenied, ErrPermissionDenied)
+	case errors.Is(err, models.ErrTooManyImages):
+		return status.Error(codes.ResourceExhausted, ErrTooManyImages)
+	case errors.Is(err, models.ErrInvalidImageOrder):
+		return status.Error(codes.InvalidArgument, ErrBadData)
+	}
+	s.logger.Error(ctx, method, err)
+	return status.Error(codes.Internal, ErrInternal)
+}
+
+func eventImageToPB(image models.EventImage) *pb.EventImage {
+	return &pb.EventImage{
+		ID:       int32(image.ID),
+		Url:      image.URL,
+		Position: int32(image.Position),
+		IsCover:  image.IsCover,
+	}
+}
+
+func eventImagesToPB(images []models.EventImage) []*pb.EventImage {
+	if len(images) == 0 {
+		return nil
+	}
+
+	pbImages := make([]*pb.EventImage, 0, len(images))
+	for _, image := range images {
+		pbImages = append(pbImages, eventImageToPB(image))
+	}
+	return pbImages
+}
@@ -0,0 +1,38 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	pb "kudago/internal/event/api"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *ServerAPI) GetAttendees(ctx context.Context, req *pb.GetAttendeesRequest) (*pb.Attendees, error) {
+	params := getPaginationParams(req.Params)
+	attendees, err := s.getter.GetAttendees(ctx, int(req.EventID), params)
+	if err != nil {
+		s.logger.Error(ctx, "get attendees", err)
+		return nil, status.Error(codes.Internal, ErrInternal)
+	}
+
+	resp := &pb.Attendees{
+		Attendees: make([]*pb.Attendee, 0, len(attendees)),
+	}
+	for _, attendee := range attendees {
+		pbAttendee := &pb.Attendee{
+			UserID:    int32(attendee.UserID),
+			Username:  attendee.Username,
+			AvatarUrl: attendee.ImageURL,
+			RsvpAt:    attendee.RSVPAt.Format(time.RFC3339),
+		}
+		if !attendee.CheckedInAt.IsZero() {
+			pbAttendee.CheckedInAt = attendee.CheckedInAt.Format(time.RFC3339)
+		}
+		resp.Attendees = append(resp.Attendees, pbAttendee)
+	}
+
+	return resp, nil
+}
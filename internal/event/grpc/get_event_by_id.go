@@ -12,7 +12,7 @@ import (
 )
 
 func (s *ServerAPI) GetEventByID(ctx context.Context, req *pb.GetEventByIDRequest) (*pb.Event, error) {
-	eventData, err := s.getter.GetEventByID(ctx, int(req.ID))
+	eventData, err := s.service.GetEventByID(ctx, int(req.ID), int(req.UserID))
 	if err != nil {
 		if errors.Is(err, models.ErrEventNotFound) {
 			return nil, status.Error(codes.NotFound, ErrEventNotFound)
@@ -10,14 +10,18 @@ import (
 )
 
 func (s *ServerAPI) GetEventsByCategory(ctx context.Context, req *pb.GetEventsByCategoryRequest) (*pb.Events, error) {
-	params := getPaginationParams(req.Params)
+	params, err := getCursorPaginationParams(req.Params)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, ErrInvalidCursor)
+	}
+
 	eventsData, err := s.getter.GetEventsByCategory(ctx, int(req.CategoryID), params)
 	if err != nil {
 		s.logger.Error(ctx, "get events by category", err)
 		return nil, status.Error(codes.Internal, ErrInternal)
 	}
 
-	event := writeEventsResponse(eventsData, params.Limit)
+	event := writeCursorEventsResponse(eventsData, params.Limit)
 
 	return event, nil
 }
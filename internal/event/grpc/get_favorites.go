@@ -10,14 +10,18 @@ import (
 )
 
 func (s *ServerAPI) GetFavorites(ctx context.Context, req *pb.GetFavoritesRequest) (*pb.Events, error) {
-	params := getPaginationParams(req.Params)
+	params, err := getCursorPaginationParams(req.Params)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, ErrInvalidCursor)
+	}
+
 	eventsData, err := s.getter.GetFavorites(ctx, int(req.UserID), params)
 	if err != nil {
 		s.logger.Error(ctx, "get favorites", err)
 		return nil, status.Error(codes.Internal, ErrInternal)
 	}
 
-	event := writeEventsResponse(eventsData, params.Limit)
+	event := writeCursorEventsResponse(eventsData, params.Limit)
 
 	return event, nil
 }
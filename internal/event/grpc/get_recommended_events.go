@@ -0,0 +1,22 @@
+package grpc
+
+import (
+	"context"
+
+	pb "kudago/internal/event/api"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *ServerAPI) GetRecommendedEvents(ctx context.Context, req *pb.GetRecommendedEventsRequest) (*pb.Events, error) {
+	params := getPaginationParams(req.Params)
+
+	eventsData, err := s.service.GetRecommendedEvents(ctx, int(req.UserID), params)
+	if err != nil {
+		s.logger.Error(ctx, "get recommended events", err)
+		return nil, status.Error(codes.Internal, ErrInternal)
+	}
+
+	return writeEventsResponse(eventsData, params.Limit), nil
+}
@@ -10,14 +10,18 @@ import (
 )
 
 func (s *ServerAPI) GetSubscriptionsEvents(ctx context.Context, req *pb.GetSubscriptionsRequest) (*pb.Events, error) {
-	params := getPaginationParams(req.Params)
+	params, err := getCursorPaginationParams(req.Params)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, ErrInvalidCursor)
+	}
+
 	eventsData, err := s.getter.GetSubscriptionEvents(ctx, int(req.ID), params)
 	if err != nil {
 		s.logger.Error(ctx, "get subscription event", err)
 		return nil, status.Error(codes.Internal, ErrInternal)
 	}
 
-	event := writeEventsResponse(eventsData, params.Limit)
+	event := writeCursorEventsResponse(eventsData, params.Limit)
 
 	return event, nil
 }
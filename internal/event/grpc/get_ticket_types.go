@@ -0,0 +1,36 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	pb "kudago/internal/event/api"
+	"kudago/internal/models"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *ServerAPI) GetTicketTypes(ctx context.Context, req *pb.GetTicketTypesRequest) (*pb.TicketTypes, error) {
+	ticketTypes, err := s.getter.GetTicketTypes(ctx, int(req.EventID))
+	if err != nil {
+		if errors.Is(err, models.ErrEventNotFound) {
+			return nil, status.Error(codes.NotFound, ErrEventNotFound)
+		}
+		s.logger.Error(ctx, "get ticket types", err)
+		return nil, status.Error(codes.Internal, ErrInternal)
+	}
+
+	resp := &pb.TicketTypes{
+		Types: make([]*pb.TicketType, 0, len(ticketTypes.Types)),
+	}
+	for _, ticketType := range ticketTypes.Types {
+		resp.Types = append(resp.Types, ticketTypeToTicketTypePB(ticketType))
+	}
+	if ticketTypes.Remaining != nil {
+		resp.Limited = true
+		resp.Remaining = int32(*ticketTypes.Remaining)
+	}
+
+	return resp, nil
+}
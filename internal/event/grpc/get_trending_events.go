@@ -0,0 +1,22 @@
+package grpc
+
+import (
+	"context"
+
+	pb "kudago/internal/event/api"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *ServerAPI) GetTrendingEvents(ctx context.Context, req *pb.GetTrendingEventsRequest) (*pb.Events, error) {
+	params := getPaginationParams(req.Params)
+
+	eventsData, err := s.getter.GetTrendingEvents(ctx, int(req.CategoryID), params)
+	if err != nil {
+		s.logger.Error(ctx, "get trending events", err)
+		return nil, status.Error(codes.Internal, ErrInternal)
+	}
+
+	return writeEventsResponse(eventsData, params.Limit), nil
+}
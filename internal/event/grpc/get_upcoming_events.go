@@ -10,14 +10,18 @@ import (
 )
 
 func (s *ServerAPI) GetUpcomingEvents(ctx context.Context, req *pb.PaginationParams) (*pb.Events, error) {
-	params := getPaginationParams(req)
+	params, err := getCursorPaginationParams(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, ErrInvalidCursor)
+	}
+
 	eventsData, err := s.getter.GetUpcomingEvents(ctx, params)
 	if err != nil {
 		s.logger.Error(ctx, "get upcoming events", err)
 		return nil, status.Error(codes.Internal, ErrInternal)
 	}
 
-	event := writeEventsResponse(eventsData, params.Limit)
+	event := writeCursorEventsResponse(eventsData, params.Limit)
 
 	return event, nil
 }
@@ -10,7 +10,7 @@ import (
 )
 
 func (s *ServerAPI) GetUserIDsByFavoriteEvent(ctx context.Context, req *pb.GetUserIDsByFavoriteEventRequest) (*pb.GetUserIDsResponse, error) {
-	ids, err := s.getter.GetUserIDsByFavoriteEvent(ctx, int(req.ID))
+	ids, err := s.getter.GetUserIDsByFavoriteEvent(ctx, int(req.ID), req.OccurrenceStart)
 	if err != nil {
 		s.logger.Error(ctx, "get user ids by favorite", err)
 		return nil, status.Error(codes.Internal, ErrInternal)
@@ -0,0 +1,28 @@
+package grpc
+
+import (
+	"context"
+
+	pb "kudago/internal/event/api"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *ServerAPI) GetUserTickets(ctx context.Context, req *pb.GetUserTicketsRequest) (*pb.Tickets, error) {
+	params := getPaginationParams(req.Params)
+	tickets, err := s.getter.GetUserTickets(ctx, int(req.UserID), params)
+	if err != nil {
+		s.logger.Error(ctx, "get user tickets", err)
+		return nil, status.Error(codes.Internal, ErrInternal)
+	}
+
+	resp := &pb.Tickets{
+		Tickets: make([]*pb.Ticket, 0, len(tickets)),
+	}
+	for _, ticket := range tickets {
+		resp.Tickets = append(resp.Tickets, ticketToTicketPB(ticket))
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,57 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	pb "kudago/internal/event/api"
+	"kudago/internal/models"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *ServerAPI) ReserveTickets(ctx context.Context, req *pb.ReserveTicketsRequest) (*pb.Ticket, error) {
+	if req.Quantity <= 0 {
+		return nil, status.Error(codes.InvalidArgument, ErrBadData)
+	}
+
+	ticket := models.Ticket{
+		EventID:      int(req.EventID),
+		TicketTypeID: int(req.TicketTypeID),
+		UserID:       int(req.UserID),
+		Quantity:     int(req.Quantity),
+	}
+
+	ticket, err := s.service.ReserveTickets(ctx, ticket)
+	if err != nil {
+		switch {
+		case errors.Is(err, models.ErrEventNotFound):
+			return nil, status.Error(codes.NotFound, ErrEventNotFound)
+		case errors.Is(err, models.ErrTicketTypeNotFound):
+			return nil, ticketTypeNotFoundStatus(int(req.TicketTypeID))
+		case errors.Is(err, models.ErrNoTicketsLeft):
+			return nil, status.Error(codes.ResourceExhausted, ErrNoTicketsLeft)
+		case errors.Is(err, models.ErrNotPublished):
+			return nil, status.Error(codes.FailedPrecondition, ErrNotPublished)
+		}
+		s.logger.Error(ctx, "reserve tickets", err)
+		return nil, status.Error(codes.Internal, ErrInternal)
+	}
+
+	return ticketToTicketPB(ticket), nil
+}
+
+func ticketTypeNotFoundStatus(ticketTypeID int) error {
+	st := status.New(codes.NotFound, ErrTicketTypeNotFound)
+	detailed, err := st.WithDetails(&errdetails.ResourceInfo{
+		ResourceType: models.TicketTypeResource,
+		ResourceName: strconv.Itoa(ticketTypeID),
+	})
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
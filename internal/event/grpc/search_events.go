@@ -23,6 +23,11 @@ func (s *ServerAPI) SearchEvents(ctx context.Context, req *pb.SearchParams) (*pb
 		LatitudeMax:  float64(req.LatitudeMax),
 		LongitudeMin: float64(req.LongitudeMin),
 		LongitudeMax: float64(req.LongitudeMax),
+
+		Latitude:       req.Latitude,
+		Longitude:      req.Longitude,
+		Radius:         req.Radius,
+		SortByDistance: req.SortByDistance,
 	}
 
 	eventsData, err := s.service.SearchEvents(ctx, searchParams, params)
@@ -32,6 +37,44 @@ func (s *ServerAPI) SearchEvents(ctx context.Context, req *pb.SearchParams) (*pb
 	}
 
 	event := writeEventsResponse(eventsData, params.Limit)
+	if !req.WithFacets {
+		return event, nil
+	}
+
+	facets, err := s.service.GetSearchFacets(ctx, searchParams)
+	if err != nil {
+		s.logger.Error(ctx, "get search facets", err)
+		return nil, status.Error(codes.Internal, ErrInternal)
+	}
+	event.Facets = searchFacetsToPB(facets)
 
 	return event, nil
 }
+
+func searchFacetsToPB(facets models.SearchFacets) *pb.SearchFacets {
+	categories := make([]*pb.CategoryFacet, 0, len(facets.Categories))
+	for _, category := range facets.Categories {
+		categories = append(categories, &pb.CategoryFacet{
+			CategoryID: int32(category.CategoryID),
+			Count:      int32(category.Count),
+		})
+	}
+
+	tags := make([]*pb.TagFacet, 0, len(facets.Tags))
+	for _, tag := range facets.Tags {
+		tags = append(tags, &pb.TagFacet{
+			Tag:   tag.Tag,
+			Count: int32(tag.Count),
+		})
+	}
+
+	return &pb.SearchFacets{
+		Categories: categories,
+		Tags:       tags,
+		Dates: &pb.DateFacets{
+			Today:       int32(facets.Dates.Today),
+			ThisWeekend: int32(facets.Dates.ThisWeekend),
+			NextWeek:    int32(facets.Dates.NextWeek),
+		},
+	}
+}
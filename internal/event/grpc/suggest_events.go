@@ -0,0 +1,50 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	pb "kudago/internal/event/api"
+	"kudago/internal/models"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *ServerAPI) SuggestEvents(ctx context.Context, req *pb.SuggestRequest) (*pb.Suggestions, error) {
+	suggestions, err := s.service.SuggestEvents(ctx, req.Query, int(req.Limit))
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			return nil, status.Error(codes.DeadlineExceeded, ErrSuggestTimeout)
+		}
+
+		s.logger.Error(ctx, "suggest events", err)
+		return nil, status.Error(codes.Internal, ErrInternal)
+	}
+
+	return suggestionsToPB(suggestions), nil
+}
+
+func suggestionsToPB(suggestions models.Suggestions) *pb.Suggestions {
+	resp := &pb.Suggestions{
+		Events: make([]*pb.EventSuggestion, 0, len(suggestions.Events)),
+		Tags:   make([]*pb.TagSuggestion, 0, len(suggestions.Tags)),
+	}
+
+	for _, event := range suggestions.Events {
+		resp.Events = append(resp.Events, &pb.EventSuggestion{
+			ID:             int32(event.ID),
+			Title:          event.Title,
+			FavoritesCount: int32(event.FavoritesCount),
+		})
+	}
+
+	for _, tag := range suggestions.Tags {
+		resp.Tags = append(resp.Tags, &pb.TagSuggestion{
+			Name:           tag.Name,
+			FavoritesCount: int32(tag.FavoritesCount),
+		})
+	}
+
+	return resp
+}
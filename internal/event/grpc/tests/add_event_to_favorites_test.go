@@ -86,6 +86,25 @@ func TestEventGRPC_AddEventToFavorites(t *testing.T) {
 			},
 			expectedErr: status.Error(codes.AlreadyExists, event.ErrAlreadyInFavorites),
 		},
+		{
+			name: "event cancelled",
+			req: &pb.FavoriteEvent{
+				EventID: 1,
+				UserID:  1,
+			},
+			setupFunc: func(ctrl *gomock.Controller) *event.ServerAPI {
+				mockEventService := mocks.NewMockEventService(ctrl)
+				mockEventGetter := mocks.NewMockEventsGetter(ctrl)
+
+				logger, _ := logger.NewLogger()
+
+				mockEventService.EXPECT().
+					AddEventToFavorites(context.Background(), models.FavoriteEvent{EventID: 1, UserID: 1}).
+					Return(models.ErrNotPublished)
+				return event.NewServerAPI(mockEventService, mockEventGetter, logger)
+			},
+			expectedErr: status.Error(codes.FailedPrecondition, event.ErrNotPublished),
+		},
 		{
 			name: "internal error",
 			req: &pb.FavoriteEvent{
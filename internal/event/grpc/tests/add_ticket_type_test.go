@@ -0,0 +1,95 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	pb "kudago/internal/event/api"
+	"kudago/internal/event/grpc/tests/mocks"
+	"kudago/internal/logger"
+	"kudago/internal/models"
+
+	event "kudago/internal/event/grpc"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestEventGRPC_AddTicketType(t *testing.T) {
+	t.Parallel()
+
+	ticketType := models.TicketType{EventID: 1, Name: "VIP", Price: 1500}
+	req := &pb.AddTicketTypeRequest{
+		Type:     &pb.TicketType{EventID: 1, Name: "VIP", Price: 1500},
+		AuthorID: 1,
+	}
+
+	tests := []struct {
+		name        string
+		req         *pb.AddTicketTypeRequest
+		setupFunc   func(ctrl *gomock.Controller) *event.ServerAPI
+		expectedErr error
+	}{
+		{
+			name: "success add ticket type",
+			req:  req,
+			setupFunc: func(ctrl *gomock.Controller) *event.ServerAPI {
+				mockEventService := mocks.NewMockEventService(ctrl)
+				logger, _ := logger.NewLogger()
+
+				created := ticketType
+				created.ID = 5
+				mockEventService.EXPECT().AddTicketType(context.Background(), ticketType, 1).Return(created, nil)
+				return event.NewServerAPI(mockEventService, mocks.NewMockEventsGetter(ctrl), logger)
+			},
+		},
+		{
+			name: "empty name",
+			req:  &pb.AddTicketTypeRequest{Type: &pb.TicketType{EventID: 1, Price: 1500}, AuthorID: 1},
+			setupFunc: func(ctrl *gomock.Controller) *event.ServerAPI {
+				logger, _ := logger.NewLogger()
+				return event.NewServerAPI(mocks.NewMockEventService(ctrl), mocks.NewMockEventsGetter(ctrl), logger)
+			},
+			expectedErr: status.Error(codes.InvalidArgument, event.ErrBadData),
+		},
+		{
+			name: "not an organizer",
+			req:  req,
+			setupFunc: func(ctrl *gomock.Controller) *event.ServerAPI {
+				mockEventService := mocks.NewMockEventService(ctrl)
+				logger, _ := logger.NewLogger()
+
+				mockEventService.EXPECT().AddTicketType(context.Background(), ticketType, 1).Return(models.TicketType{}, models.ErrAccessDenied)
+				return event.NewServerAPI(mockEventService, mocks.NewMockEventsGetter(ctrl), logger)
+			},
+			expectedErr: status.Error(codes.PermissionDenied, event.ErrPermissionDenied),
+		},
+		{
+			name: "duplicate ticket type",
+			req:  req,
+			setupFunc: func(ctrl *gomock.Controller) *event.ServerAPI {
+				mockEventService := mocks.NewMockEventService(ctrl)
+				logger, _ := logger.NewLogger()
+
+				mockEventService.EXPECT().AddTicketType(context.Background(), ticketType, 1).Return(models.TicketType{}, models.ErrNothingToInsert)
+				return event.NewServerAPI(mockEventService, mocks.NewMockEventsGetter(ctrl), logger)
+			},
+			expectedErr: status.Error(codes.AlreadyExists, event.ErrTicketTypeExists),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			_, err := tt.setupFunc(ctrl).AddTicketType(context.Background(), tt.req)
+
+			assert.Equal(t, tt.expectedErr, err)
+		})
+	}
+}
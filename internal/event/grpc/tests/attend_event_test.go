@@ -0,0 +1,100 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	pb "kudago/internal/event/api"
+	"kudago/internal/event/grpc/tests/mocks"
+	"kudago/internal/logger"
+	"kudago/internal/models"
+
+	event "kudago/internal/event/grpc"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestEventGRPC_AttendEvent(t *testing.T) {
+	t.Parallel()
+
+	req := &pb.AttendanceRequest{UserID: 2, EventID: 1}
+	claimUntil := time.Date(2024, 12, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		serviceResult models.AttendanceResult
+		serviceErr    error
+		expected      *pb.AttendanceStatus
+		expectedErr   error
+	}{
+		{
+			name:          "success attend",
+			serviceResult: models.AttendanceResult{Status: models.AttendanceStatusAttending},
+			expected: &pb.AttendanceStatus{
+				Status:   models.AttendanceStatusAttending,
+				Promoted: []*pb.WaitlistEntry{},
+			},
+		},
+		{
+			name: "added to waitlist",
+			serviceResult: models.AttendanceResult{
+				Status:   models.AttendanceStatusWaitlisted,
+				Position: 3,
+				Promoted: []models.WaitlistEntry{{EventID: 1, UserID: 5, ClaimUntil: claimUntil}},
+			},
+			expected: &pb.AttendanceStatus{
+				Status:   models.AttendanceStatusWaitlisted,
+				Position: 3,
+				Promoted: []*pb.WaitlistEntry{{UserID: 5, EventID: 1, ClaimUntil: "2024-12-01T12:00:00Z"}},
+			},
+		},
+		{
+			name:        "event not found",
+			serviceErr:  fmt.Errorf("%s: %w", models.LevelDB, models.ErrEventNotFound),
+			expectedErr: status.Error(codes.NotFound, event.ErrEventNotFound),
+		},
+		{
+			name:        "event cancelled",
+			serviceErr:  fmt.Errorf("%s: %w", models.LevelService, models.ErrNotPublished),
+			expectedErr: status.Error(codes.FailedPrecondition, event.ErrNotPublished),
+		},
+		{
+			name:        "internal error",
+			serviceErr:  models.ErrInternal,
+			expectedErr: status.Error(codes.Internal, event.ErrInternal),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockEventService := mocks.NewMockEventService(ctrl)
+			logger, _ := logger.NewLogger()
+
+			mockEventService.EXPECT().AttendEvent(context.Background(), 1, 2).Return(tt.serviceResult, tt.serviceErr)
+			server := event.NewServerAPI(mockEventService, mocks.NewMockEventsGetter(ctrl), logger)
+
+			resp, err := server.AttendEvent(context.Background(), req)
+
+			assert.Equal(t, tt.expectedErr, err)
+			if tt.expectedErr == nil {
+				assert.Equal(t, tt.expected.Status, resp.Status)
+				assert.Equal(t, tt.expected.Position, resp.Position)
+				assert.Equal(t, len(tt.expected.Promoted), len(resp.Promoted))
+				for i := range tt.expected.Promoted {
+					assert.Equal(t, tt.expected.Promoted[i].UserID, resp.Promoted[i].UserID)
+					assert.Equal(t, tt.expected.Promoted[i].ClaimUntil, resp.Promoted[i].ClaimUntil)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,67 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	pb "kudago/internal/event/api"
+	"kudago/internal/event/grpc/tests/mocks"
+	"kudago/internal/logger"
+	"kudago/internal/models"
+
+	event "kudago/internal/event/grpc"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestEventGRPC_GetUserIDByCalendarToken(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		userID       int
+		serviceErr   error
+		expectedResp *pb.CalendarTokenOwner
+		expectedErr  error
+	}{
+		{
+			name:         "valid token",
+			userID:       7,
+			expectedResp: &pb.CalendarTokenOwner{UserID: 7},
+		},
+		{
+			name:        "unknown token",
+			serviceErr:  fmt.Errorf("%s: %w", models.LevelDB, models.ErrInvalidToken),
+			expectedErr: status.Error(codes.NotFound, event.ErrInvalidToken),
+		},
+		{
+			name:        "internal error",
+			serviceErr:  fmt.Errorf("%s: %w", models.LevelDB, fmt.Errorf("database error")),
+			expectedErr: status.Error(codes.Internal, event.ErrInternal),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockEventService := mocks.NewMockEventService(ctrl)
+			logger, _ := logger.NewLogger()
+
+			mockEventService.EXPECT().GetUserIDByCalendarToken(context.Background(), "token").Return(tt.userID, tt.serviceErr)
+			server := event.NewServerAPI(mockEventService, mocks.NewMockEventsGetter(ctrl), logger)
+
+			resp, err := server.GetUserIDByCalendarToken(context.Background(), &pb.CalendarToken{Token: "token"})
+
+			assert.Equal(t, tt.expectedErr, err)
+			assert.Equal(t, tt.expectedResp, resp)
+		})
+	}
+}
@@ -0,0 +1,133 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	pb "kudago/internal/event/api"
+	"kudago/internal/event/grpc/tests/mocks"
+	"kudago/internal/logger"
+	"kudago/internal/models"
+
+	event "kudago/internal/event/grpc"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestEventGRPC_CancelEvent(t *testing.T) {
+	t.Parallel()
+
+	req := &pb.CancelEventRequest{EventID: 1, AuthorID: 2, Reason: "Площадка закрыта"}
+
+	tests := []struct {
+		name         string
+		eventData    models.Event
+		serviceErr   error
+		expectedResp *pb.Event
+		expectedErr  error
+	}{
+		{
+			name:         "success cancel event",
+			eventData:    models.Event{ID: 1, AuthorID: 2, Status: models.EventStatusCancelled, CancelReason: "Площадка закрыта"},
+			expectedResp: &pb.Event{ID: 1, AuthorID: 2, Status: models.EventStatusCancelled, CancelReason: "Площадка закрыта"},
+		},
+		{
+			name:        "event not found",
+			serviceErr:  fmt.Errorf("%s: %w", models.LevelDB, models.ErrEventNotFound),
+			expectedErr: status.Error(codes.NotFound, event.ErrEventNotFound),
+		},
+		{
+			name:        "not the author",
+			serviceErr:  fmt.Errorf("%s: %w", models.LevelService, models.ErrAccessDenied),
+			expectedErr: status.Error(codes.PermissionDenied, event.ErrPermissionDenied),
+		},
+		{
+			name:        "already cancelled",
+			serviceErr:  fmt.Errorf("%s: %w", models.LevelService, models.ErrNotPublished),
+			expectedErr: status.Error(codes.FailedPrecondition, event.ErrNotPublished),
+		},
+		{
+			name:        "internal error",
+			serviceErr:  fmt.Errorf("%s: %w", models.LevelDB, models.ErrInternal),
+			expectedErr: status.Error(codes.Internal, event.ErrInternal),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockEventService := mocks.NewMockEventService(ctrl)
+			logger, _ := logger.NewLogger()
+
+			mockEventService.EXPECT().
+				CancelEvent(context.Background(), 1, 2, "Площадка закрыта").
+				Return(tt.eventData, tt.serviceErr)
+			server := event.NewServerAPI(mockEventService, mocks.NewMockEventsGetter(ctrl), logger)
+
+			resp, err := server.CancelEvent(context.Background(), req)
+
+			assert.Equal(t, tt.expectedErr, err)
+			assert.Equal(t, tt.expectedResp, resp)
+		})
+	}
+}
+
+func TestEventGRPC_RescheduleEvent(t *testing.T) {
+	t.Parallel()
+
+	req := &pb.RescheduleEventRequest{EventID: 1, AuthorID: 2, EventStart: "2030-01-06T18:00:00Z", EventEnd: "2030-01-06T20:00:00Z"}
+
+	tests := []struct {
+		name         string
+		eventData    models.Event
+		serviceErr   error
+		expectedResp *pb.Event
+		expectedErr  error
+	}{
+		{
+			name:         "success reschedule event",
+			eventData:    models.Event{ID: 1, AuthorID: 2, EventStart: req.EventStart, EventEnd: req.EventEnd},
+			expectedResp: &pb.Event{ID: 1, AuthorID: 2, EventStart: req.EventStart, EventEnd: req.EventEnd},
+		},
+		{
+			name:        "cancelled event",
+			serviceErr:  fmt.Errorf("%s: %w", models.LevelService, models.ErrNotPublished),
+			expectedErr: status.Error(codes.FailedPrecondition, event.ErrNotPublished),
+		},
+		{
+			name:        "broken series",
+			serviceErr:  fmt.Errorf("%s: %w", models.LevelDB, models.ErrInvalidRecurrence),
+			expectedErr: status.Error(codes.InvalidArgument, event.ErrBadData),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockEventService := mocks.NewMockEventService(ctrl)
+			logger, _ := logger.NewLogger()
+
+			mockEventService.EXPECT().
+				RescheduleEvent(context.Background(), 1, 2, req.EventStart, req.EventEnd).
+				Return(tt.eventData, tt.serviceErr)
+			server := event.NewServerAPI(mockEventService, mocks.NewMockEventsGetter(ctrl), logger)
+
+			resp, err := server.RescheduleEvent(context.Background(), req)
+
+			assert.Equal(t, tt.expectedErr, err)
+			assert.Equal(t, tt.expectedResp, resp)
+		})
+	}
+}
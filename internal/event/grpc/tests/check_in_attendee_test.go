@@ -0,0 +1,69 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	pb "kudago/internal/event/api"
+	"kudago/internal/event/grpc/tests/mocks"
+	"kudago/internal/logger"
+	"kudago/internal/models"
+
+	event "kudago/internal/event/grpc"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestEventGRPC_CheckInAttendee(t *testing.T) {
+	t.Parallel()
+
+	req := &pb.CheckInAttendeeRequest{EventID: 1, UserID: 2, AuthorID: 3}
+
+	tests := []struct {
+		name        string
+		serviceErr  error
+		expectedErr error
+	}{
+		{
+			name: "success check in",
+		},
+		{
+			name:        "not an organizer",
+			serviceErr:  fmt.Errorf("%s: %w", models.LevelService, models.ErrAccessDenied),
+			expectedErr: status.Error(codes.PermissionDenied, event.ErrPermissionDenied),
+		},
+		{
+			name:        "event has not started",
+			serviceErr:  fmt.Errorf("%s: %w", models.LevelService, models.ErrEventNotStarted),
+			expectedErr: status.Error(codes.FailedPrecondition, event.ErrEventNotStarted),
+		},
+		{
+			name:        "user is not attending",
+			serviceErr:  fmt.Errorf("%s: %w", models.LevelDB, models.ErrNotFound),
+			expectedErr: status.Error(codes.NotFound, event.ErrNotAttending),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockEventService := mocks.NewMockEventService(ctrl)
+			logger, _ := logger.NewLogger()
+
+			mockEventService.EXPECT().CheckInAttendee(context.Background(), 1, 2, 3).Return(tt.serviceErr)
+			server := event.NewServerAPI(mockEventService, mocks.NewMockEventsGetter(ctrl), logger)
+
+			_, err := server.CheckInAttendee(context.Background(), req)
+
+			assert.Equal(t, tt.expectedErr, err)
+		})
+	}
+}
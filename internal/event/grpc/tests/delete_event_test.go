@@ -27,6 +27,7 @@ func TestEventGRPC_DeleteEvent(t *testing.T) {
 		name        string
 		req         *pb.DeleteEventRequest
 		setupFunc   func(ctrl *gomock.Controller) *event.ServerAPI
+		expectedRes *pb.DeleteEventResponse
 		expectedErr error
 	}{
 		{
@@ -43,9 +44,10 @@ func TestEventGRPC_DeleteEvent(t *testing.T) {
 
 				mockEventService.EXPECT().
 					DeleteEvent(context.Background(), 1, 1).
-					Return(nil)
+					Return([]string{"/cover_full.jpg"}, nil)
 				return event.NewServerAPI(mockEventService, mockEventGetter, logger)
 			},
+			expectedRes: &pb.DeleteEventResponse{Images: []string{"/cover_full.jpg"}},
 			expectedErr: nil,
 		},
 		{
@@ -62,7 +64,7 @@ func TestEventGRPC_DeleteEvent(t *testing.T) {
 
 				mockEventService.EXPECT().
 					DeleteEvent(context.Background(), 1, 1).
-					Return(models.ErrInternal)
+					Return(nil, models.ErrInternal)
 				return event.NewServerAPI(mockEventService, mockEventGetter, logger)
 			},
 			expectedErr: status.Error(codes.Internal, event.ErrInternal),
@@ -76,9 +78,10 @@ func TestEventGRPC_DeleteEvent(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 
-			_, err := tt.setupFunc(ctrl).DeleteEvent(context.Background(), tt.req)
+			res, err := tt.setupFunc(ctrl).DeleteEvent(context.Background(), tt.req)
 
 			assert.Equal(t, tt.expectedErr, err)
+			assert.Equal(t, tt.expectedRes, res)
 		})
 	}
 }
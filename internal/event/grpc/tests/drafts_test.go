@@ -0,0 +1,96 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	pb "kudago/internal/event/api"
+	"kudago/internal/event/grpc/tests/mocks"
+	"kudago/internal/logger"
+	"kudago/internal/models"
+
+	event "kudago/internal/event/grpc"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestEventGRPC_PublishEvent(t *testing.T) {
+	t.Parallel()
+
+	req := &pb.PublishEventRequest{EventID: 1, AuthorID: 2}
+
+	tests := []struct {
+		name         string
+		eventData    models.Event
+		serviceErr   error
+		expectedResp *pb.Event
+		expectedErr  error
+	}{
+		{
+			name:         "success publish event",
+			eventData:    models.Event{ID: 1, AuthorID: 2, Status: models.EventStatusPublished},
+			expectedResp: &pb.Event{ID: 1, AuthorID: 2, Status: models.EventStatusPublished},
+		},
+		{
+			name:        "event not found",
+			serviceErr:  fmt.Errorf("%s: %w", models.LevelDB, models.ErrEventNotFound),
+			expectedErr: status.Error(codes.NotFound, event.ErrEventNotFound),
+		},
+		{
+			name:        "not the author",
+			serviceErr:  fmt.Errorf("%s: %w", models.LevelService, models.ErrAccessDenied),
+			expectedErr: status.Error(codes.PermissionDenied, event.ErrPermissionDenied),
+		},
+		{
+			name:        "already published",
+			serviceErr:  fmt.Errorf("%s: %w", models.LevelService, models.ErrNotDraft),
+			expectedErr: status.Error(codes.FailedPrecondition, event.ErrNotDraft),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockEventService := mocks.NewMockEventService(ctrl)
+			logger, _ := logger.NewLogger()
+
+			mockEventService.EXPECT().
+				PublishEvent(context.Background(), 1, 2).
+				Return(tt.eventData, tt.serviceErr)
+			server := event.NewServerAPI(mockEventService, mocks.NewMockEventsGetter(ctrl), logger)
+
+			resp, err := server.PublishEvent(context.Background(), req)
+
+			assert.Equal(t, tt.expectedErr, err)
+			assert.Equal(t, tt.expectedResp, resp)
+		})
+	}
+}
+
+func TestEventGRPC_GetDrafts(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockEventsGetter := mocks.NewMockEventsGetter(ctrl)
+	logger, _ := logger.NewLogger()
+
+	mockEventsGetter.EXPECT().
+		GetDrafts(context.Background(), 2, models.PaginationParams{Limit: 10}).
+		Return([]models.Event{{ID: 1, Status: models.EventStatusDraft, PublishAt: "2030-01-01T10:00:00Z"}}, nil)
+	server := event.NewServerAPI(mocks.NewMockEventService(ctrl), mockEventsGetter, logger)
+
+	resp, err := server.GetDrafts(context.Background(), &pb.GetEventsByUserRequest{UserID: 2, Params: &pb.PaginationParams{Limit: 10}})
+
+	assert.NoError(t, err)
+	assert.Equal(t, &pb.Events{Events: []*pb.Event{{ID: 1, Status: models.EventStatusDraft, PublishAt: "2030-01-01T10:00:00Z"}}}, resp)
+}
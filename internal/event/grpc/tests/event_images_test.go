@@ -0,0 +1,149 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	pb "kudago/internal/event/api"
+	"kudago/internal/event/grpc/tests/mocks"
+	"kudago/internal/logger"
+	"kudago/internal/models"
+
+	event "kudago/internal/event/grpc"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestEventGRPC_AddEventImage(t *testing.T) {
+	t.Parallel()
+
+	req := &pb.AddEventImageRequest{EventID: 1, AuthorID: 2, Url: "/images/a.jpg"}
+
+	tests := []struct {
+		name         string
+		imageData    models.EventImage
+		serviceErr   error
+		expectedResp *pb.EventImage
+		expectedErr  error
+	}{
+		{
+			name:         "success add image",
+			imageData:    models.EventImage{ID: 5, URL: "/images/a.jpg", Position: 1},
+			expectedResp: &pb.EventImage{ID: 5, Url: "/images/a.jpg", Position: 1},
+		},
+		{
+			name:        "gallery is full",
+			serviceErr:  fmt.Errorf("%s: %w", models.LevelDB, models.ErrTooManyImages),
+			expectedErr: status.Error(codes.ResourceExhausted, event.ErrTooManyImages),
+		},
+		{
+			name:        "not the author",
+			serviceErr:  fmt.Errorf("%s: %w", models.LevelService, models.ErrAccessDenied),
+			expectedErr: status.Error(codes.PermissionDenied, event.ErrPermissionDenied),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockEventService := mocks.NewMockEventService(ctrl)
+			logger, _ := logger.NewLogger()
+
+			mockEventService.EXPECT().
+				AddEventImage(context.Background(), 1, 2, "/images/a.jpg").
+				Return(tt.imageData, tt.serviceErr)
+			server := event.NewServerAPI(mockEventService, mocks.NewMockEventsGetter(ctrl), logger)
+
+			resp, err := server.AddEventImage(context.Background(), req)
+
+			assert.Equal(t, tt.expectedErr, err)
+			assert.Equal(t, tt.expectedResp, resp)
+		})
+	}
+}
+
+func TestEventGRPC_UpdateEventImages(t *testing.T) {
+	t.Parallel()
+
+	req := &pb.UpdateEventImagesRequest{EventID: 1, AuthorID: 2, Order: []int32{6, 5}, CoverId: 6}
+
+	tests := []struct {
+		name         string
+		imagesData   []models.EventImage
+		serviceErr   error
+		expectedResp *pb.EventImages
+		expectedErr  error
+	}{
+		{
+			name: "success update images",
+			imagesData: []models.EventImage{
+				{ID: 6, URL: "/images/b.jpg", Position: 0, IsCover: true},
+				{ID: 5, URL: "/images/a.jpg", Position: 1},
+			},
+			expectedResp: &pb.EventImages{Images: []*pb.EventImage{
+				{ID: 6, Url: "/images/b.jpg", Position: 0, IsCover: true},
+				{ID: 5, Url: "/images/a.jpg", Position: 1},
+			}},
+		},
+		{
+			name:        "incomplete order",
+			serviceErr:  fmt.Errorf("%s: %w", models.LevelService, models.ErrInvalidImageOrder),
+			expectedErr: status.Error(codes.InvalidArgument, event.ErrBadData),
+		},
+		{
+			name:        "unknown cover",
+			serviceErr:  fmt.Errorf("%s: %w", models.LevelService, models.ErrImageNotFound),
+			expectedErr: status.Error(codes.NotFound, event.ErrImageNotFound),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockEventService := mocks.NewMockEventService(ctrl)
+			logger, _ := logger.NewLogger()
+
+			mockEventService.EXPECT().
+				UpdateEventImages(context.Background(), 1, 2, []int{6, 5}, 6).
+				Return(tt.imagesData, tt.serviceErr)
+			server := event.NewServerAPI(mockEventService, mocks.NewMockEventsGetter(ctrl), logger)
+
+			resp, err := server.UpdateEventImages(context.Background(), req)
+
+			assert.Equal(t, tt.expectedErr, err)
+			assert.Equal(t, tt.expectedResp, resp)
+		})
+	}
+}
+
+func TestEventGRPC_DeleteEventImage(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockEventService := mocks.NewMockEventService(ctrl)
+	logger, _ := logger.NewLogger()
+
+	mockEventService.EXPECT().
+		DeleteEventImage(context.Background(), 1, 2, 5).
+		Return(models.EventImage{ID: 5, URL: "/images/a.jpg"}, nil)
+	server := event.NewServerAPI(mockEventService, mocks.NewMockEventsGetter(ctrl), logger)
+
+	resp, err := server.DeleteEventImage(context.Background(), &pb.DeleteEventImageRequest{EventID: 1, AuthorID: 2, ImageID: 5})
+
+	assert.NoError(t, err)
+	assert.Equal(t, &pb.EventImage{ID: 5, Url: "/images/a.jpg"}, resp)
+}
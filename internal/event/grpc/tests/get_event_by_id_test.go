@@ -38,7 +38,8 @@ func TestEventGRPC_GetEventByID(t *testing.T) {
 		{
 			name: "success get event by id",
 			req: &pb.GetEventByIDRequest{
-				ID: 1,
+				ID:     1,
+				UserID: 2,
 			},
 			setupFunc: func(ctrl *gomock.Controller) *event.ServerAPI {
 				mockEventService := mocks.NewMockEventService(ctrl)
@@ -46,8 +47,8 @@ func TestEventGRPC_GetEventByID(t *testing.T) {
 
 				logger, _ := logger.NewLogger()
 
-				mockEventGetter.EXPECT().
-					GetEventByID(context.Background(), 1).
+				mockEventService.EXPECT().
+					GetEventByID(context.Background(), 1, 2).
 					Return(eventData, nil)
 				return event.NewServerAPI(mockEventService, mockEventGetter, logger)
 			},
@@ -60,7 +61,8 @@ func TestEventGRPC_GetEventByID(t *testing.T) {
 		{
 			name: "not found",
 			req: &pb.GetEventByIDRequest{
-				ID: 1,
+				ID:     1,
+				UserID: 2,
 			},
 			setupFunc: func(ctrl *gomock.Controller) *event.ServerAPI {
 				mockEventService := mocks.NewMockEventService(ctrl)
@@ -68,8 +70,8 @@ func TestEventGRPC_GetEventByID(t *testing.T) {
 
 				logger, _ := logger.NewLogger()
 
-				mockEventGetter.EXPECT().
-					GetEventByID(context.Background(), 1).
+				mockEventService.EXPECT().
+					GetEventByID(context.Background(), 1, 2).
 					Return(models.Event{}, models.ErrEventNotFound)
 				return event.NewServerAPI(mockEventService, mockEventGetter, logger)
 			},
@@ -78,7 +80,8 @@ func TestEventGRPC_GetEventByID(t *testing.T) {
 		{
 			name: "internal error",
 			req: &pb.GetEventByIDRequest{
-				ID: 1,
+				ID:     1,
+				UserID: 2,
 			},
 			setupFunc: func(ctrl *gomock.Controller) *event.ServerAPI {
 				mockEventService := mocks.NewMockEventService(ctrl)
@@ -86,8 +89,8 @@ func TestEventGRPC_GetEventByID(t *testing.T) {
 
 				logger, _ := logger.NewLogger()
 
-				mockEventGetter.EXPECT().
-					GetEventByID(context.Background(), 1).
+				mockEventService.EXPECT().
+					GetEventByID(context.Background(), 1, 2).
 					Return(models.Event{}, models.ErrInternal)
 				return event.NewServerAPI(mockEventService, mockEventGetter, logger)
 			},
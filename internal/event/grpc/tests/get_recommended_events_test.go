@@ -0,0 +1,71 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	pb "kudago/internal/event/api"
+	"kudago/internal/event/grpc/tests/mocks"
+	"kudago/internal/logger"
+	"kudago/internal/models"
+
+	event "kudago/internal/event/grpc"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestEventGRPC_GetRecommendedEvents(t *testing.T) {
+	t.Parallel()
+
+	req := &pb.GetRecommendedEventsRequest{
+		UserID: 1,
+		Params: &pb.PaginationParams{Limit: 10, Offset: 20},
+	}
+
+	tests := []struct {
+		name         string
+		eventsData   []models.Event
+		serviceErr   error
+		expectedResp *pb.Events
+		expectedErr  error
+	}{
+		{
+			name:       "success get recommended events",
+			eventsData: []models.Event{{ID: 1, Title: "test"}},
+			expectedResp: &pb.Events{
+				Events: []*pb.Event{{ID: 1, Title: "test"}},
+			},
+		},
+		{
+			name:        "internal error",
+			serviceErr:  fmt.Errorf("%s: %w", models.LevelDB, fmt.Errorf("database error")),
+			expectedErr: status.Error(codes.Internal, event.ErrInternal),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockEventService := mocks.NewMockEventService(ctrl)
+			logger, _ := logger.NewLogger()
+
+			mockEventService.EXPECT().
+				GetRecommendedEvents(context.Background(), 1, models.PaginationParams{Limit: 10, Offset: 20}).
+				Return(tt.eventsData, tt.serviceErr)
+			server := event.NewServerAPI(mockEventService, mocks.NewMockEventsGetter(ctrl), logger)
+
+			resp, err := server.GetRecommendedEvents(context.Background(), req)
+
+			assert.Equal(t, tt.expectedErr, err)
+			assert.Equal(t, tt.expectedResp, resp)
+		})
+	}
+}
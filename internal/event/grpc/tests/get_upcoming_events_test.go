@@ -3,6 +3,7 @@ package grpc
 import (
 	"context"
 	"testing"
+	"time"
 
 	pb "kudago/internal/event/api"
 	"kudago/internal/event/grpc/tests/mocks"
@@ -23,13 +24,19 @@ func TestEventGRPC_GetUpcomingEvents(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
+	cursorStart := time.Date(2025, time.May, 1, 18, 0, 0, 0, time.UTC)
 	eventsData := []models.Event{
 		{
-			ID:    1,
-			Title: "test",
+			ID:         1,
+			Title:      "test",
+			EventStart: "2025-05-01T18:00:00Z",
+			Start:      cursorStart,
 		},
 	}
 
+	cursor := models.Cursor{EventStart: cursorStart, ID: 7}
+	nextStart := cursorStart.Add(24*time.Hour + 123456*time.Microsecond)
+
 	tests := []struct {
 		name         string
 		req          *pb.PaginationParams
@@ -57,13 +64,58 @@ func TestEventGRPC_GetUpcomingEvents(t *testing.T) {
 			expectedResp: &pb.Events{
 				Events: []*pb.Event{
 					{
-						ID:    1,
-						Title: "test",
+						ID:         1,
+						Title:      "test",
+						EventStart: "2025-05-01T18:00:00Z",
 					},
 				},
+				NextCursor: models.Cursor{EventStart: cursorStart, ID: 1}.Encode(),
 			},
 			expectedErr: nil,
 		},
+		{
+			name: "success get page after cursor",
+			req: &pb.PaginationParams{
+				Limit:  1,
+				Offset: 30,
+				Cursor: cursor.Encode(),
+			},
+			setupFunc: func(ctrl *gomock.Controller) *event.ServerAPI {
+				mockEventService := mocks.NewMockEventService(ctrl)
+				mockEventGetter := mocks.NewMockEventsGetter(ctrl)
+
+				logger, _ := logger.NewLogger()
+
+				mockEventGetter.EXPECT().
+					GetUpcomingEvents(context.Background(), models.PaginationParams{Limit: 1, Offset: 0, Cursor: &cursor}).
+					Return([]models.Event{{ID: 8, Title: "next", EventStart: "2025-05-02T18:00:00Z", Start: nextStart}}, nil)
+				return event.NewServerAPI(mockEventService, mockEventGetter, logger)
+			},
+			expectedResp: &pb.Events{
+				Events: []*pb.Event{
+					{
+						ID:         8,
+						Title:      "next",
+						EventStart: "2025-05-02T18:00:00Z",
+					},
+				},
+				NextCursor: models.Cursor{EventStart: nextStart, ID: 8}.Encode(),
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "invalid cursor",
+			req: &pb.PaginationParams{
+				Limit:  1,
+				Cursor: "not a cursor",
+			},
+			setupFunc: func(ctrl *gomock.Controller) *event.ServerAPI {
+				logger, _ := logger.NewLogger()
+
+				return event.NewServerAPI(mocks.NewMockEventService(ctrl), mocks.NewMockEventsGetter(ctrl), logger)
+			},
+			expectedErr: status.Error(codes.InvalidArgument, event.ErrInvalidCursor),
+		},
 		{
 			name: "internal error",
 			req: &pb.PaginationParams{
@@ -92,9 +144,12 @@ func TestEventGRPC_GetUpcomingEvents(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 
-			_, err := tt.setupFunc(ctrl).GetUpcomingEvents(context.Background(), tt.req)
+			resp, err := tt.setupFunc(ctrl).GetUpcomingEvents(context.Background(), tt.req)
 
 			assert.Equal(t, tt.expectedErr, err)
+			if tt.expectedResp != nil {
+				assert.Equal(t, tt.expectedResp.NextCursor, resp.NextCursor)
+			}
 		})
 	}
 }
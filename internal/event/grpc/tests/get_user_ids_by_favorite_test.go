@@ -44,7 +44,7 @@ func TestEventGRPC_GetUserIDsByFavorite(t *testing.T) {
 				logger, _ := logger.NewLogger()
 
 				mockEventGetter.EXPECT().
-					GetUserIDsByFavoriteEvent(context.Background(), 1).
+					GetUserIDsByFavoriteEvent(context.Background(), 1, "").
 					Return(users, nil)
 				return event.NewServerAPI(mockEventService, mockEventGetter, logger)
 			},
@@ -65,7 +65,7 @@ func TestEventGRPC_GetUserIDsByFavorite(t *testing.T) {
 				logger, _ := logger.NewLogger()
 
 				mockEventGetter.EXPECT().
-					GetUserIDsByFavoriteEvent(context.Background(), 1).
+					GetUserIDsByFavoriteEvent(context.Background(), 1, "").
 					Return(nil, models.ErrInternal)
 				return event.NewServerAPI(mockEventService, mockEventGetter, logger)
 			},
@@ -50,6 +50,21 @@ func (mr *MockEventServiceMockRecorder) AddEvent(ctx, event interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddEvent", reflect.TypeOf((*MockEventService)(nil).AddEvent), ctx, event)
 }
 
+// AddEventImage mocks base method.
+func (m *MockEventService) AddEventImage(ctx context.Context, eventID, authorID int, url string) (models.EventImage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddEventImage", ctx, eventID, authorID, url)
+	ret0, _ := ret[0].(models.EventImage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddEventImage indicates an expected call of AddEventImage.
+func (mr *MockEventServiceMockRecorder) AddEventImage(ctx, eventID, authorID, url interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddEventImage", reflect.TypeOf((*MockEventService)(nil).AddEventImage), ctx, eventID, authorID, url)
+}
+
 // AddEventToFavorites mocks base method.
 func (m *MockEventService) AddEventToFavorites(ctx context.Context, newFavorite models.FavoriteEvent) error {
 	m.ctrl.T.Helper()
@@ -64,14 +79,119 @@ func (mr *MockEventServiceMockRecorder) AddEventToFavorites(ctx, newFavorite int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddEventToFavorites", reflect.TypeOf((*MockEventService)(nil).AddEventToFavorites), ctx, newFavorite)
 }
 
-// DeleteEvent mocks base method.
-func (m *MockEventService) DeleteEvent(ctx context.Context, ID, authorID int) error {
+// AddTicketType mocks base method.
+func (m *MockEventService) AddTicketType(ctx context.Context, ticketType models.TicketType, authorID int) (models.TicketType, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteEvent", ctx, ID, authorID)
+	ret := m.ctrl.Call(m, "AddTicketType", ctx, ticketType, authorID)
+	ret0, _ := ret[0].(models.TicketType)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddTicketType indicates an expected call of AddTicketType.
+func (mr *MockEventServiceMockRecorder) AddTicketType(ctx, ticketType, authorID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTicketType", reflect.TypeOf((*MockEventService)(nil).AddTicketType), ctx, ticketType, authorID)
+}
+
+// AttendEvent mocks base method.
+func (m *MockEventService) AttendEvent(ctx context.Context, eventID, userID int) (models.AttendanceResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AttendEvent", ctx, eventID, userID)
+	ret0, _ := ret[0].(models.AttendanceResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AttendEvent indicates an expected call of AttendEvent.
+func (mr *MockEventServiceMockRecorder) AttendEvent(ctx, eventID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AttendEvent", reflect.TypeOf((*MockEventService)(nil).AttendEvent), ctx, eventID, userID)
+}
+
+// BuyTicket mocks base method.
+func (m *MockEventService) BuyTicket(ctx context.Context, ticketID, userID int) (models.Ticket, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BuyTicket", ctx, ticketID, userID)
+	ret0, _ := ret[0].(models.Ticket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BuyTicket indicates an expected call of BuyTicket.
+func (mr *MockEventServiceMockRecorder) BuyTicket(ctx, ticketID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuyTicket", reflect.TypeOf((*MockEventService)(nil).BuyTicket), ctx, ticketID, userID)
+}
+
+// CancelAttendance mocks base method.
+func (m *MockEventService) CancelAttendance(ctx context.Context, eventID, userID int) ([]models.WaitlistEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelAttendance", ctx, eventID, userID)
+	ret0, _ := ret[0].([]models.WaitlistEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelAttendance indicates an expected call of CancelAttendance.
+func (mr *MockEventServiceMockRecorder) CancelAttendance(ctx, eventID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelAttendance", reflect.TypeOf((*MockEventService)(nil).CancelAttendance), ctx, eventID, userID)
+}
+
+// CancelEvent mocks base method.
+func (m *MockEventService) CancelEvent(ctx context.Context, ID, authorID int, reason string) (models.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelEvent", ctx, ID, authorID, reason)
+	ret0, _ := ret[0].(models.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelEvent indicates an expected call of CancelEvent.
+func (mr *MockEventServiceMockRecorder) CancelEvent(ctx, ID, authorID, reason interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelEvent", reflect.TypeOf((*MockEventService)(nil).CancelEvent), ctx, ID, authorID, reason)
+}
+
+// CheckInAttendee mocks base method.
+func (m *MockEventService) CheckInAttendee(ctx context.Context, eventID, userID, authorID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckInAttendee", ctx, eventID, userID, authorID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
+// CheckInAttendee indicates an expected call of CheckInAttendee.
+func (mr *MockEventServiceMockRecorder) CheckInAttendee(ctx, eventID, userID, authorID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckInAttendee", reflect.TypeOf((*MockEventService)(nil).CheckInAttendee), ctx, eventID, userID, authorID)
+}
+
+// CreateCalendarToken mocks base method.
+func (m *MockEventService) CreateCalendarToken(ctx context.Context, userID int) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCalendarToken", ctx, userID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCalendarToken indicates an expected call of CreateCalendarToken.
+func (mr *MockEventServiceMockRecorder) CreateCalendarToken(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCalendarToken", reflect.TypeOf((*MockEventService)(nil).CreateCalendarToken), ctx, userID)
+}
+
+// DeleteEvent mocks base method.
+func (m *MockEventService) DeleteEvent(ctx context.Context, ID, authorID int) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEvent", ctx, ID, authorID)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
 // DeleteEvent indicates an expected call of DeleteEvent.
 func (mr *MockEventServiceMockRecorder) DeleteEvent(ctx, ID, authorID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
@@ -92,6 +212,126 @@ func (mr *MockEventServiceMockRecorder) DeleteEventFromFavorites(ctx, newFavorit
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEventFromFavorites", reflect.TypeOf((*MockEventService)(nil).DeleteEventFromFavorites), ctx, newFavorite)
 }
 
+// DeleteEventImage mocks base method.
+func (m *MockEventService) DeleteEventImage(ctx context.Context, eventID, authorID, imageID int) (models.EventImage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEventImage", ctx, eventID, authorID, imageID)
+	ret0, _ := ret[0].(models.EventImage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteEventImage indicates an expected call of DeleteEventImage.
+func (mr *MockEventServiceMockRecorder) DeleteEventImage(ctx, eventID, authorID, imageID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEventImage", reflect.TypeOf((*MockEventService)(nil).DeleteEventImage), ctx, eventID, authorID, imageID)
+}
+
+// GetEventByID mocks base method.
+func (m *MockEventService) GetEventByID(ctx context.Context, ID, userID int) (models.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEventByID", ctx, ID, userID)
+	ret0, _ := ret[0].(models.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEventByID indicates an expected call of GetEventByID.
+func (mr *MockEventServiceMockRecorder) GetEventByID(ctx, ID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventByID", reflect.TypeOf((*MockEventService)(nil).GetEventByID), ctx, ID, userID)
+}
+
+// GetRecommendedEvents mocks base method.
+func (m *MockEventService) GetRecommendedEvents(ctx context.Context, userID int, paginationParams models.PaginationParams) ([]models.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRecommendedEvents", ctx, userID, paginationParams)
+	ret0, _ := ret[0].([]models.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRecommendedEvents indicates an expected call of GetRecommendedEvents.
+func (mr *MockEventServiceMockRecorder) GetRecommendedEvents(ctx, userID, paginationParams interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecommendedEvents", reflect.TypeOf((*MockEventService)(nil).GetRecommendedEvents), ctx, userID, paginationParams)
+}
+
+// GetSearchFacets mocks base method.
+func (m *MockEventService) GetSearchFacets(ctx context.Context, params models.SearchParams) (models.SearchFacets, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSearchFacets", ctx, params)
+	ret0, _ := ret[0].(models.SearchFacets)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSearchFacets indicates an expected call of GetSearchFacets.
+func (mr *MockEventServiceMockRecorder) GetSearchFacets(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSearchFacets", reflect.TypeOf((*MockEventService)(nil).GetSearchFacets), ctx, params)
+}
+
+// GetUserIDByCalendarToken mocks base method.
+func (m *MockEventService) GetUserIDByCalendarToken(ctx context.Context, token string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserIDByCalendarToken", ctx, token)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserIDByCalendarToken indicates an expected call of GetUserIDByCalendarToken.
+func (mr *MockEventServiceMockRecorder) GetUserIDByCalendarToken(ctx, token interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserIDByCalendarToken", reflect.TypeOf((*MockEventService)(nil).GetUserIDByCalendarToken), ctx, token)
+}
+
+// PublishEvent mocks base method.
+func (m *MockEventService) PublishEvent(ctx context.Context, ID, authorID int) (models.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishEvent", ctx, ID, authorID)
+	ret0, _ := ret[0].(models.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PublishEvent indicates an expected call of PublishEvent.
+func (mr *MockEventServiceMockRecorder) PublishEvent(ctx, ID, authorID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishEvent", reflect.TypeOf((*MockEventService)(nil).PublishEvent), ctx, ID, authorID)
+}
+
+// RescheduleEvent mocks base method.
+func (m *MockEventService) RescheduleEvent(ctx context.Context, ID, authorID int, eventStart, eventEnd string) (models.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RescheduleEvent", ctx, ID, authorID, eventStart, eventEnd)
+	ret0, _ := ret[0].(models.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RescheduleEvent indicates an expected call of RescheduleEvent.
+func (mr *MockEventServiceMockRecorder) RescheduleEvent(ctx, ID, authorID, eventStart, eventEnd interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RescheduleEvent", reflect.TypeOf((*MockEventService)(nil).RescheduleEvent), ctx, ID, authorID, eventStart, eventEnd)
+}
+
+// ReserveTickets mocks base method.
+func (m *MockEventService) ReserveTickets(ctx context.Context, ticket models.Ticket) (models.Ticket, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReserveTickets", ctx, ticket)
+	ret0, _ := ret[0].(models.Ticket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReserveTickets indicates an expected call of ReserveTickets.
+func (mr *MockEventServiceMockRecorder) ReserveTickets(ctx, ticket interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReserveTickets", reflect.TypeOf((*MockEventService)(nil).ReserveTickets), ctx, ticket)
+}
+
 // SearchEvents mocks base method.
 func (m *MockEventService) SearchEvents(ctx context.Context, params models.SearchParams, paginationParams models.PaginationParams) ([]models.Event, error) {
 	m.ctrl.T.Helper()
@@ -107,6 +347,21 @@ func (mr *MockEventServiceMockRecorder) SearchEvents(ctx, params, paginationPara
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchEvents", reflect.TypeOf((*MockEventService)(nil).SearchEvents), ctx, params, paginationParams)
 }
 
+// SuggestEvents mocks base method.
+func (m *MockEventService) SuggestEvents(ctx context.Context, query string, limit int) (models.Suggestions, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SuggestEvents", ctx, query, limit)
+	ret0, _ := ret[0].(models.Suggestions)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SuggestEvents indicates an expected call of SuggestEvents.
+func (mr *MockEventServiceMockRecorder) SuggestEvents(ctx, query, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SuggestEvents", reflect.TypeOf((*MockEventService)(nil).SuggestEvents), ctx, query, limit)
+}
+
 // UpdateEvent mocks base method.
 func (m *MockEventService) UpdateEvent(ctx context.Context, event models.Event) (models.Event, error) {
 	m.ctrl.T.Helper()
@@ -122,6 +377,21 @@ func (mr *MockEventServiceMockRecorder) UpdateEvent(ctx, event interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEvent", reflect.TypeOf((*MockEventService)(nil).UpdateEvent), ctx, event)
 }
 
+// UpdateEventImages mocks base method.
+func (m *MockEventService) UpdateEventImages(ctx context.Context, eventID, authorID int, order []int, coverID int) ([]models.EventImage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateEventImages", ctx, eventID, authorID, order, coverID)
+	ret0, _ := ret[0].([]models.EventImage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateEventImages indicates an expected call of UpdateEventImages.
+func (mr *MockEventServiceMockRecorder) UpdateEventImages(ctx, eventID, authorID, order, coverID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEventImages", reflect.TypeOf((*MockEventService)(nil).UpdateEventImages), ctx, eventID, authorID, order, coverID)
+}
+
 // MockEventsGetter is a mock of EventsGetter interface.
 type MockEventsGetter struct {
 	ctrl     *gomock.Controller
@@ -145,6 +415,21 @@ func (m *MockEventsGetter) EXPECT() *MockEventsGetterMockRecorder {
 	return m.recorder
 }
 
+// GetAttendees mocks base method.
+func (m *MockEventsGetter) GetAttendees(ctx context.Context, eventID int, paginationParams models.PaginationParams) ([]models.Attendee, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAttendees", ctx, eventID, paginationParams)
+	ret0, _ := ret[0].([]models.Attendee)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAttendees indicates an expected call of GetAttendees.
+func (mr *MockEventsGetterMockRecorder) GetAttendees(ctx, eventID, paginationParams interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttendees", reflect.TypeOf((*MockEventsGetter)(nil).GetAttendees), ctx, eventID, paginationParams)
+}
+
 // GetCategories mocks base method.
 func (m *MockEventsGetter) GetCategories(ctx context.Context) ([]models.Category, error) {
 	m.ctrl.T.Helper()
@@ -160,19 +445,19 @@ func (mr *MockEventsGetterMockRecorder) GetCategories(ctx interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategories", reflect.TypeOf((*MockEventsGetter)(nil).GetCategories), ctx)
 }
 
-// GetEventByID mocks base method.
-func (m *MockEventsGetter) GetEventByID(ctx context.Context, ID int) (models.Event, error) {
+// GetDrafts mocks base method.
+func (m *MockEventsGetter) GetDrafts(ctx context.Context, userID int, paginationParams models.PaginationParams) ([]models.Event, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetEventByID", ctx, ID)
-	ret0, _ := ret[0].(models.Event)
+	ret := m.ctrl.Call(m, "GetDrafts", ctx, userID, paginationParams)
+	ret0, _ := ret[0].([]models.Event)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetEventByID indicates an expected call of GetEventByID.
-func (mr *MockEventsGetterMockRecorder) GetEventByID(ctx, ID interface{}) *gomock.Call {
+// GetDrafts indicates an expected call of GetDrafts.
+func (mr *MockEventsGetterMockRecorder) GetDrafts(ctx, userID, paginationParams interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventByID", reflect.TypeOf((*MockEventsGetter)(nil).GetEventByID), ctx, ID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDrafts", reflect.TypeOf((*MockEventsGetter)(nil).GetDrafts), ctx, userID, paginationParams)
 }
 
 // GetEventsByCategory mocks base method.
@@ -280,6 +565,36 @@ func (mr *MockEventsGetterMockRecorder) GetSubscriptionEvents(ctx, userID, pagin
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubscriptionEvents", reflect.TypeOf((*MockEventsGetter)(nil).GetSubscriptionEvents), ctx, userID, paginationParams)
 }
 
+// GetTicketTypes mocks base method.
+func (m *MockEventsGetter) GetTicketTypes(ctx context.Context, eventID int) (models.TicketTypes, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTicketTypes", ctx, eventID)
+	ret0, _ := ret[0].(models.TicketTypes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTicketTypes indicates an expected call of GetTicketTypes.
+func (mr *MockEventsGetterMockRecorder) GetTicketTypes(ctx, eventID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketTypes", reflect.TypeOf((*MockEventsGetter)(nil).GetTicketTypes), ctx, eventID)
+}
+
+// GetTrendingEvents mocks base method.
+func (m *MockEventsGetter) GetTrendingEvents(ctx context.Context, categoryID int, paginationParams models.PaginationParams) ([]models.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTrendingEvents", ctx, categoryID, paginationParams)
+	ret0, _ := ret[0].([]models.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTrendingEvents indicates an expected call of GetTrendingEvents.
+func (mr *MockEventsGetterMockRecorder) GetTrendingEvents(ctx, categoryID, paginationParams interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrendingEvents", reflect.TypeOf((*MockEventsGetter)(nil).GetTrendingEvents), ctx, categoryID, paginationParams)
+}
+
 // GetUpcomingEvents mocks base method.
 func (m *MockEventsGetter) GetUpcomingEvents(ctx context.Context, paginationParams models.PaginationParams) ([]models.Event, error) {
 	m.ctrl.T.Helper()
@@ -296,16 +611,31 @@ func (mr *MockEventsGetterMockRecorder) GetUpcomingEvents(ctx, paginationParams
 }
 
 // GetUserIDsByFavoriteEvent mocks base method.
-func (m *MockEventsGetter) GetUserIDsByFavoriteEvent(ctx context.Context, eventID int) ([]int, error) {
+func (m *MockEventsGetter) GetUserIDsByFavoriteEvent(ctx context.Context, eventID int, occurrenceStart string) ([]int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUserIDsByFavoriteEvent", ctx, eventID)
+	ret := m.ctrl.Call(m, "GetUserIDsByFavoriteEvent", ctx, eventID, occurrenceStart)
 	ret0, _ := ret[0].([]int)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetUserIDsByFavoriteEvent indicates an expected call of GetUserIDsByFavoriteEvent.
-func (mr *MockEventsGetterMockRecorder) GetUserIDsByFavoriteEvent(ctx, eventID interface{}) *gomock.Call {
+func (mr *MockEventsGetterMockRecorder) GetUserIDsByFavoriteEvent(ctx, eventID, occurrenceStart interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserIDsByFavoriteEvent", reflect.TypeOf((*MockEventsGetter)(nil).GetUserIDsByFavoriteEvent), ctx, eventID, occurrenceStart)
+}
+
+// GetUserTickets mocks base method.
+func (m *MockEventsGetter) GetUserTickets(ctx context.Context, userID int, paginationParams models.PaginationParams) ([]models.Ticket, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserTickets", ctx, userID, paginationParams)
+	ret0, _ := ret[0].([]models.Ticket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserTickets indicates an expected call of GetUserTickets.
+func (mr *MockEventsGetterMockRecorder) GetUserTickets(ctx, userID, paginationParams interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserIDsByFavoriteEvent", reflect.TypeOf((*MockEventsGetter)(nil).GetUserIDsByFavoriteEvent), ctx, eventID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserTickets", reflect.TypeOf((*MockEventsGetter)(nil).GetUserTickets), ctx, userID, paginationParams)
 }
@@ -0,0 +1,151 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+	"time"
+
+	pb "kudago/internal/event/api"
+	"kudago/internal/event/grpc/tests/mocks"
+	"kudago/internal/logger"
+	"kudago/internal/models"
+
+	event "kudago/internal/event/grpc"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestEventGRPC_ReserveTickets(t *testing.T) {
+	t.Parallel()
+
+	reservedUntil := time.Date(2030, 1, 1, 12, 0, 0, 0, time.UTC)
+	request := models.Ticket{EventID: 1, TicketTypeID: 2, UserID: 3, Quantity: 2}
+
+	tests := []struct {
+		name        string
+		req         *pb.ReserveTicketsRequest
+		setupFunc   func(ctrl *gomock.Controller) *event.ServerAPI
+		expected    *pb.Ticket
+		expectedErr error
+	}{
+		{
+			name: "success reserve",
+			req:  &pb.ReserveTicketsRequest{UserID: 3, EventID: 1, TicketTypeID: 2, Quantity: 2},
+			setupFunc: func(ctrl *gomock.Controller) *event.ServerAPI {
+				mockEventService := mocks.NewMockEventService(ctrl)
+				logger, _ := logger.NewLogger()
+
+				reserved := request
+				reserved.ID = 7
+				reserved.Type = "VIP"
+				reserved.Price = 1500
+				reserved.Status = models.TicketStatusReserved
+				reserved.ReservedUntil = reservedUntil
+
+				mockEventService.EXPECT().ReserveTickets(context.Background(), request).Return(reserved, nil)
+				return event.NewServerAPI(mockEventService, mocks.NewMockEventsGetter(ctrl), logger)
+			},
+			expected: &pb.Ticket{
+				ID:            7,
+				EventID:       1,
+				TicketTypeID:  2,
+				UserID:        3,
+				Type:          "VIP",
+				Price:         1500,
+				Quantity:      2,
+				Status:        models.TicketStatusReserved,
+				ReservedUntil: "2030-01-01T12:00:00Z",
+			},
+		},
+		{
+			name: "bad quantity",
+			req:  &pb.ReserveTicketsRequest{UserID: 3, EventID: 1, TicketTypeID: 2},
+			setupFunc: func(ctrl *gomock.Controller) *event.ServerAPI {
+				logger, _ := logger.NewLogger()
+				return event.NewServerAPI(mocks.NewMockEventService(ctrl), mocks.NewMockEventsGetter(ctrl), logger)
+			},
+			expectedErr: status.Error(codes.InvalidArgument, event.ErrBadData),
+		},
+		{
+			name: "sold out",
+			req:  &pb.ReserveTicketsRequest{UserID: 3, EventID: 1, TicketTypeID: 2, Quantity: 2},
+			setupFunc: func(ctrl *gomock.Controller) *event.ServerAPI {
+				mockEventService := mocks.NewMockEventService(ctrl)
+				logger, _ := logger.NewLogger()
+
+				mockEventService.EXPECT().ReserveTickets(context.Background(), request).
+					Return(models.Ticket{}, fmt.Errorf("%s: %w", models.LevelDB, models.ErrNoTicketsLeft))
+				return event.NewServerAPI(mockEventService, mocks.NewMockEventsGetter(ctrl), logger)
+			},
+			expectedErr: status.Error(codes.ResourceExhausted, event.ErrNoTicketsLeft),
+		},
+		{
+			name: "ticket type not found",
+			req:  &pb.ReserveTicketsRequest{UserID: 3, EventID: 1, TicketTypeID: 2, Quantity: 2},
+			setupFunc: func(ctrl *gomock.Controller) *event.ServerAPI {
+				mockEventService := mocks.NewMockEventService(ctrl)
+				logger, _ := logger.NewLogger()
+
+				mockEventService.EXPECT().ReserveTickets(context.Background(), request).
+					Return(models.Ticket{}, fmt.Errorf("%s: %w", models.LevelDB, models.ErrTicketTypeNotFound))
+				return event.NewServerAPI(mockEventService, mocks.NewMockEventsGetter(ctrl), logger)
+			},
+			expectedErr: ticketTypeNotFound(2),
+		},
+		{
+			name: "event cancelled",
+			req:  &pb.ReserveTicketsRequest{UserID: 3, EventID: 1, TicketTypeID: 2, Quantity: 2},
+			setupFunc: func(ctrl *gomock.Controller) *event.ServerAPI {
+				mockEventService := mocks.NewMockEventService(ctrl)
+				logger, _ := logger.NewLogger()
+
+				mockEventService.EXPECT().ReserveTickets(context.Background(), request).
+					Return(models.Ticket{}, fmt.Errorf("%s: %w", models.LevelService, models.ErrNotPublished))
+				return event.NewServerAPI(mockEventService, mocks.NewMockEventsGetter(ctrl), logger)
+			},
+			expectedErr: status.Error(codes.FailedPrecondition, event.ErrNotPublished),
+		},
+		{
+			name: "internal error",
+			req:  &pb.ReserveTicketsRequest{UserID: 3, EventID: 1, TicketTypeID: 2, Quantity: 2},
+			setupFunc: func(ctrl *gomock.Controller) *event.ServerAPI {
+				mockEventService := mocks.NewMockEventService(ctrl)
+				logger, _ := logger.NewLogger()
+
+				mockEventService.EXPECT().ReserveTickets(context.Background(), request).
+					Return(models.Ticket{}, models.ErrInternal)
+				return event.NewServerAPI(mockEventService, mocks.NewMockEventsGetter(ctrl), logger)
+			},
+			expectedErr: status.Error(codes.Internal, event.ErrInternal),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ticket, err := tt.setupFunc(ctrl).ReserveTickets(context.Background(), tt.req)
+
+			assert.True(t, proto.Equal(status.Convert(tt.expectedErr).Proto(), status.Convert(err).Proto()), err)
+			assert.Equal(t, tt.expected, ticket)
+		})
+	}
+}
+
+func ticketTypeNotFound(ticketTypeID int) error {
+	st, _ := status.New(codes.NotFound, event.ErrTicketTypeNotFound).WithDetails(&errdetails.ResourceInfo{
+		ResourceType: models.TicketTypeResource,
+		ResourceName: strconv.Itoa(ticketTypeID),
+	})
+	return st.Err()
+}
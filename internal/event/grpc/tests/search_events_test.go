@@ -72,6 +72,69 @@ func TestEventGRPC_SearchEvents(t *testing.T) {
 			},
 			expectedErr: nil,
 		},
+		{
+			name: "success search events with facets",
+			req: &pb.SearchParams{
+				Query:      "test",
+				WithFacets: true,
+				Params: &pb.PaginationParams{
+					Limit:  1,
+					Offset: 0,
+				},
+			},
+			setupFunc: func(ctrl *gomock.Controller) *event.ServerAPI {
+				mockEventService := mocks.NewMockEventService(ctrl)
+				mockEventGetter := mocks.NewMockEventsGetter(ctrl)
+
+				logger, _ := logger.NewLogger()
+
+				mockEventService.EXPECT().
+					SearchEvents(context.Background(), models.SearchParams{Query: "test"}, pagParams).
+					Return(eventsData, nil)
+				mockEventService.EXPECT().
+					GetSearchFacets(context.Background(), models.SearchParams{Query: "test"}).
+					Return(models.SearchFacets{
+						Categories: []models.CategoryFacet{{CategoryID: 2, Count: 5}},
+						Tags:       []models.TagFacet{{Tag: "jazz", Count: 3}},
+						Dates:      models.DateFacets{Today: 1, ThisWeekend: 2, NextWeek: 4},
+					}, nil)
+				return event.NewServerAPI(mockEventService, mockEventGetter, logger)
+			},
+			expectedResp: &pb.Events{
+				Facets: &pb.SearchFacets{
+					Categories: []*pb.CategoryFacet{{CategoryID: 2, Count: 5}},
+					Tags:       []*pb.TagFacet{{Tag: "jazz", Count: 3}},
+					Dates:      &pb.DateFacets{Today: 1, ThisWeekend: 2, NextWeek: 4},
+				},
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "facets error",
+			req: &pb.SearchParams{
+				Query:      "test",
+				WithFacets: true,
+				Params: &pb.PaginationParams{
+					Limit:  1,
+					Offset: 0,
+				},
+			},
+			setupFunc: func(ctrl *gomock.Controller) *event.ServerAPI {
+				mockEventService := mocks.NewMockEventService(ctrl)
+				mockEventGetter := mocks.NewMockEventsGetter(ctrl)
+
+				logger, _ := logger.NewLogger()
+
+				mockEventService.EXPECT().
+					SearchEvents(context.Background(), models.SearchParams{Query: "test"}, pagParams).
+					Return(eventsData, nil)
+				mockEventService.EXPECT().
+					GetSearchFacets(context.Background(), models.SearchParams{Query: "test"}).
+					Return(models.SearchFacets{}, models.ErrInternal)
+				return event.NewServerAPI(mockEventService, mockEventGetter, logger)
+			},
+			expectedErr: status.Error(codes.Internal, event.ErrInternal),
+		},
 		{
 			name: "internal error",
 			req: &pb.SearchParams{
@@ -103,9 +166,12 @@ func TestEventGRPC_SearchEvents(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 
-			_, err := tt.setupFunc(ctrl).SearchEvents(context.Background(), tt.req)
+			resp, err := tt.setupFunc(ctrl).SearchEvents(context.Background(), tt.req)
 
 			assert.Equal(t, tt.expectedErr, err)
+			if tt.expectedResp != nil {
+				assert.Equal(t, tt.expectedResp.Facets.String(), resp.Facets.String())
+			}
 		})
 	}
 }
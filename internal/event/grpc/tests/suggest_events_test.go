@@ -0,0 +1,96 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	pb "kudago/internal/event/api"
+	"kudago/internal/event/grpc/tests/mocks"
+	"kudago/internal/logger"
+	"kudago/internal/models"
+
+	event "kudago/internal/event/grpc"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestEventGRPC_SuggestEvents(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		req          *pb.SuggestRequest
+		setupFunc    func(ctrl *gomock.Controller) *event.ServerAPI
+		expectedResp *pb.Suggestions
+		expectedErr  error
+	}{
+		{
+			name: "success suggest events",
+			req:  &pb.SuggestRequest{Query: "jaz", Limit: 5},
+			setupFunc: func(ctrl *gomock.Controller) *event.ServerAPI {
+				mockEventService := mocks.NewMockEventService(ctrl)
+				logger, _ := logger.NewLogger()
+
+				mockEventService.EXPECT().
+					SuggestEvents(context.Background(), "jaz", 5).
+					Return(models.Suggestions{
+						Events: []models.EventSuggestion{{ID: 1, Title: "Jazz night", FavoritesCount: 7}},
+						Tags:   []models.TagSuggestion{{Name: "jazz", FavoritesCount: 20}},
+					}, nil)
+				return event.NewServerAPI(mockEventService, mocks.NewMockEventsGetter(ctrl), logger)
+			},
+			expectedResp: &pb.Suggestions{
+				Events: []*pb.EventSuggestion{{ID: 1, Title: "Jazz night", FavoritesCount: 7}},
+				Tags:   []*pb.TagSuggestion{{Name: "jazz", FavoritesCount: 20}},
+			},
+		},
+		{
+			name: "deadline exceeded",
+			req:  &pb.SuggestRequest{Query: "jaz", Limit: 5},
+			setupFunc: func(ctrl *gomock.Controller) *event.ServerAPI {
+				mockEventService := mocks.NewMockEventService(ctrl)
+				logger, _ := logger.NewLogger()
+
+				mockEventService.EXPECT().
+					SuggestEvents(context.Background(), "jaz", 5).
+					Return(models.Suggestions{}, fmt.Errorf("%s: %w", models.LevelDB, context.DeadlineExceeded))
+				return event.NewServerAPI(mockEventService, mocks.NewMockEventsGetter(ctrl), logger)
+			},
+			expectedErr: status.Error(codes.DeadlineExceeded, event.ErrSuggestTimeout),
+		},
+		{
+			name: "internal error",
+			req:  &pb.SuggestRequest{Query: "jaz", Limit: 5},
+			setupFunc: func(ctrl *gomock.Controller) *event.ServerAPI {
+				mockEventService := mocks.NewMockEventService(ctrl)
+				logger, _ := logger.NewLogger()
+
+				mockEventService.EXPECT().
+					SuggestEvents(context.Background(), "jaz", 5).
+					Return(models.Suggestions{}, models.ErrInternal)
+				return event.NewServerAPI(mockEventService, mocks.NewMockEventsGetter(ctrl), logger)
+			},
+			expectedErr: status.Error(codes.Internal, event.ErrInternal),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			resp, err := tt.setupFunc(ctrl).SuggestEvents(context.Background(), tt.req)
+
+			assert.Equal(t, tt.expectedErr, err)
+			if tt.expectedResp != nil {
+				assert.Equal(t, tt.expectedResp.String(), resp.String())
+			}
+		})
+	}
+}
@@ -22,6 +22,8 @@ func (s *ServerAPI) UpdateEvent(ctx context.Context, req *pb.Event) (*pb.Event,
 			return nil, status.Error(codes.NotFound, ErrEventNotFound)
 		case errors.Is(err, models.ErrAccessDenied):
 			return nil, status.Error(codes.PermissionDenied, ErrPermissionDenied)
+		case errors.Is(err, models.ErrInvalidRecurrence):
+			return nil, status.Error(codes.InvalidArgument, ErrBadData)
 		default:
 			return nil, status.Error(codes.Internal, ErrInternal)
 		}
@@ -0,0 +1,28 @@
+// Package jobs runs background maintenance of the event service.
+package jobs
+
+import (
+	"context"
+	"time"
+
+	"kudago/internal/logger"
+)
+
+// Run calls job right away and then every interval until ctx is done.
+// Failures are logged and retried on the next tick.
+func Run(ctx context.Context, name string, interval time.Duration, job func(context.Context) error, logger *logger.Logger) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := job(ctx); err != nil {
+			logger.Error(ctx, name, err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
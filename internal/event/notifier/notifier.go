@@ -0,0 +1,44 @@
+// Package notifier sends notifications from the event service through the
+// notification service.
+package notifier
+
+import (
+	"context"
+
+	"kudago/internal/models"
+	pb "kudago/internal/notification/api"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+type Notifier struct {
+	client pb.NotificationServiceClient
+}
+
+func NewNotifier(notificationServiceAddr string) (*Notifier, error) {
+	conn, err := grpc.NewClient(notificationServiceAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, err
+	}
+
+	return &Notifier{client: pb.NewNotificationServiceClient(conn)}, nil
+}
+
+func (n *Notifier) CreateNotifications(ctx context.Context, userIDs []int, notification models.Notification) error {
+	req := &pb.CreateNotificationsRequest{
+		UserIDs: make([]int32, 0, len(userIDs)),
+		Notification: &pb.Notification{
+			EventID:  int32(notification.EventID),
+			Message:  notification.Message,
+			NotifyAt: notification.NotifyAt.String(),
+		},
+	}
+
+	for _, id := range userIDs {
+		req.UserIDs = append(req.UserIDs, int32(id))
+	}
+
+	_, err := n.client.CreateNotifications(ctx, req)
+	return err
+}
@@ -0,0 +1,258 @@
+// Package recurrence implements the subset of RFC 5545 recurrence rules
+// events can use: FREQ, INTERVAL, COUNT, UNTIL and plain BYDAY weekdays.
+package recurrence
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	// the zone of events must load on hosts without a zoneinfo database
+	_ "time/tzdata"
+)
+
+type Frequency string
+
+const (
+	Daily   Frequency = "DAILY"
+	Weekly  Frequency = "WEEKLY"
+	Monthly Frequency = "MONTHLY"
+	Yearly  Frequency = "YEARLY"
+)
+
+// Timezone is the zone events take place in. Rules are expanded in it, so an
+// occurrence keeps its wall-clock time and weekday in Moscow whatever the zone
+// of the server, and matches the times of the iCalendar export.
+const Timezone = "Europe/Moscow"
+
+var Location = mustLoadLocation(Timezone)
+
+// maxPeriods bounds expansion of a single rule, so a daily rule without an
+// end can't spin for long on a far-away window.
+const maxPeriods = 5000
+
+var ErrInvalidRule = errors.New("invalid recurrence rule")
+
+func mustLoadLocation(name string) *time.Location {
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		panic(err)
+	}
+	return loc
+}
+
+var weekdays = map[string]time.Weekday{
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+	"SU": time.Sunday,
+}
+
+type Rule struct {
+	Freq     Frequency
+	Interval int
+	Count    int
+	Until    time.Time
+	ByDay    []time.Weekday
+}
+
+// Parse reads an RRULE value such as "FREQ=WEEKLY;BYDAY=MO,WE;COUNT=10".
+// The "RRULE:" prefix is optional.
+func Parse(value string) (Rule, error) {
+	value = strings.TrimPrefix(strings.TrimSpace(value), "RRULE:")
+	rule := Rule{Interval: 1}
+
+	for _, part := range strings.Split(value, ";") {
+		key, val, ok := strings.Cut(part, "=")
+		if !ok || val == "" {
+			return Rule{}, fmt.Errorf("%w: %q", ErrInvalidRule, part)
+		}
+
+		var err error
+		switch strings.ToUpper(key) {
+		case "FREQ":
+			rule.Freq = Frequency(strings.ToUpper(val))
+			if !slices.Contains([]Frequency{Daily, Weekly, Monthly, Yearly}, rule.Freq) {
+				err = fmt.Errorf("unsupported frequency %q", val)
+			}
+		case "INTERVAL":
+			rule.Interval, err = strconv.Atoi(val)
+			if err == nil && rule.Interval <= 0 {
+				err = errors.New("interval must be positive")
+			}
+		case "COUNT":
+			rule.Count, err = strconv.Atoi(val)
+			if err == nil && rule.Count <= 0 {
+				err = errors.New("count must be positive")
+			}
+		case "UNTIL":
+			rule.Until, err = parseUntil(val)
+		case "BYDAY":
+			rule.ByDay, err = parseByDay(val)
+		default:
+			err = fmt.Errorf("unsupported part %q", key)
+		}
+		if err != nil {
+			return Rule{}, fmt.Errorf("%w: %w", ErrInvalidRule, err)
+		}
+	}
+
+	if rule.Freq == "" {
+		return Rule{}, fmt.Errorf("%w: FREQ is required", ErrInvalidRule)
+	}
+	if rule.Count > 0 && !rule.Until.IsZero() {
+		return Rule{}, fmt.Errorf("%w: COUNT and UNTIL are mutually exclusive", ErrInvalidRule)
+	}
+	if len(rule.ByDay) > 0 && rule.Freq != Weekly {
+		return Rule{}, fmt.Errorf("%w: BYDAY is supported with FREQ=WEEKLY only", ErrInvalidRule)
+	}
+
+	return rule, nil
+}
+
+// parseUntil reads UNTIL; values without the Z suffix are local times of
+// the events' zone.
+func parseUntil(value string) (time.Time, error) {
+	if until, err := time.Parse("20060102T150405Z", value); err == nil {
+		return until, nil
+	}
+
+	for _, layout := range []string{"20060102T150405", "20060102"} {
+		if until, err := time.ParseInLocation(layout, value, Location); err == nil {
+			if layout == "20060102" {
+				until = until.Add(24*time.Hour - time.Second)
+			}
+			return until, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("bad UNTIL %q", value)
+}
+
+func parseByDay(value string) ([]time.Weekday, error) {
+	var days []time.Weekday
+	for _, day := range strings.Split(value, ",") {
+		weekday, ok := weekdays[strings.ToUpper(day)]
+		if !ok {
+			return nil, fmt.Errorf("unsupported BYDAY %q", day)
+		}
+		if !slices.Contains(days, weekday) {
+			days = append(days, weekday)
+		}
+	}
+
+	// Weeks start on Monday (WKST=MO), so Sunday sorts last.
+	slices.SortFunc(days, func(a, b time.Weekday) int {
+		return mondayOffset(a) - mondayOffset(b)
+	})
+	return days, nil
+}
+
+func mondayOffset(day time.Weekday) int {
+	return (int(day) + 6) % 7
+}
+
+// Between returns the starts of occurrences of a series beginning at dtstart
+// that fall into [from, to], skipping exdates and returning at most limit
+// starts. A zero to means no upper bound, in which case limit must be set.
+// Occurrences are computed in Location and returned in the zone of dtstart.
+func (r Rule) Between(dtstart, from, to time.Time, exdates []time.Time, limit int) []time.Time {
+	var starts []time.Time
+	generated := 0
+
+	loc := dtstart.Location()
+	dtstart = dtstart.In(Location)
+
+	for period := 0; period < maxPeriods; period++ {
+		for _, start := range r.period(dtstart, period) {
+			if start.Before(dtstart) {
+				continue
+			}
+			if !r.Until.IsZero() && start.After(r.Until) {
+				return starts
+			}
+			if !to.IsZero() && start.After(to) {
+				return starts
+			}
+
+			generated++
+			if r.Count > 0 && generated > r.Count {
+				return starts
+			}
+
+			if start.Before(from) || isExcluded(start, exdates) {
+				continue
+			}
+
+			starts = append(starts, start.In(loc))
+			if limit > 0 && len(starts) == limit {
+				return starts
+			}
+		}
+	}
+
+	return starts
+}
+
+// Last returns the start of the final occurrence, or a zero time when the
+// series never ends.
+func (r Rule) Last(dtstart time.Time) time.Time {
+	if r.Count == 0 && r.Until.IsZero() {
+		return time.Time{}
+	}
+
+	starts := r.Between(dtstart, dtstart, time.Time{}, nil, 0)
+	if len(starts) == 0 {
+		return dtstart
+	}
+	return starts[len(starts)-1]
+}
+
+// period returns the candidate starts of the n-th interval of the rule in
+// chronological order; dates that don't exist (Feb 30) are skipped.
+func (r Rule) period(dtstart time.Time, n int) []time.Time {
+	step := n * r.Interval
+
+	switch r.Freq {
+	case Daily:
+		return []time.Time{dtstart.AddDate(0, 0, step)}
+	case Weekly:
+		if len(r.ByDay) == 0 {
+			return []time.Time{dtstart.AddDate(0, 0, 7*step)}
+		}
+
+		weekStart := dtstart.AddDate(0, 0, 7*step-mondayOffset(dtstart.Weekday()))
+		starts := make([]time.Time, 0, len(r.ByDay))
+		for _, day := range r.ByDay {
+			starts = append(starts, weekStart.AddDate(0, 0, mondayOffset(day)))
+		}
+		return starts
+	case Monthly:
+		start := dtstart.AddDate(0, step, 0)
+		if start.Day() != dtstart.Day() {
+			return nil
+		}
+		return []time.Time{start}
+	case Yearly:
+		start := dtstart.AddDate(step, 0, 0)
+		if start.Day() != dtstart.Day() {
+			return nil
+		}
+		return []time.Time{start}
+	}
+
+	return nil
+}
+
+func isExcluded(start time.Time, exdates []time.Time) bool {
+	for _, exdate := range exdates {
+		if start.Equal(exdate) {
+			return true
+		}
+	}
+	return false
+}
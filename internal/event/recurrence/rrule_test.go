@@ -0,0 +1,170 @@
+package recurrence
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		value     string
+		expected  Rule
+		expectErr bool
+	}{
+		{
+			name:     "weekly with days",
+			value:    "RRULE:FREQ=WEEKLY;BYDAY=SU,MO,WE;COUNT=6",
+			expected: Rule{Freq: Weekly, Interval: 1, Count: 6, ByDay: []time.Weekday{time.Monday, time.Wednesday, time.Sunday}},
+		},
+		{
+			name:     "daily until date",
+			value:    "FREQ=DAILY;INTERVAL=2;UNTIL=20250110",
+			expected: Rule{Freq: Daily, Interval: 2, Until: time.Date(2025, time.January, 10, 23, 59, 59, 0, Location)},
+		},
+		{
+			name:      "missing frequency",
+			value:     "COUNT=3",
+			expectErr: true,
+		},
+		{
+			name:      "count and until together",
+			value:     "FREQ=DAILY;COUNT=3;UNTIL=20250110T000000Z",
+			expectErr: true,
+		},
+		{
+			name:      "unsupported part",
+			value:     "FREQ=MONTHLY;BYSETPOS=-1",
+			expectErr: true,
+		},
+		{
+			name:      "byday with monthly frequency",
+			value:     "FREQ=MONTHLY;BYDAY=MO",
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rule, err := Parse(tt.value)
+			if tt.expectErr {
+				assert.ErrorIs(t, err, ErrInvalidRule)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, rule)
+		})
+	}
+}
+
+func TestRule_Between(t *testing.T) {
+	t.Parallel()
+
+	// Monday, 6 January 2025, 19:00.
+	dtstart := time.Date(2025, time.January, 6, 19, 0, 0, 0, time.UTC)
+	day := func(d int) time.Time {
+		return time.Date(2025, time.January, d, 19, 0, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name     string
+		rule     string
+		dtstart  time.Time
+		from     time.Time
+		to       time.Time
+		exdates  []time.Time
+		limit    int
+		expected []time.Time
+	}{
+		{
+			name:     "weekly on monday and thursday",
+			rule:     "FREQ=WEEKLY;BYDAY=MO,TH",
+			from:     dtstart,
+			to:       day(20),
+			expected: []time.Time{day(6), day(9), day(13), day(16), day(20)},
+		},
+		{
+			name:     "window in the middle skips exceptions",
+			rule:     "FREQ=DAILY",
+			from:     day(10),
+			to:       day(14),
+			exdates:  []time.Time{day(12)},
+			expected: []time.Time{day(10), day(11), day(13), day(14)},
+		},
+		{
+			name:     "count includes occurrences before the window",
+			rule:     "FREQ=WEEKLY;COUNT=3",
+			from:     day(10),
+			to:       day(31),
+			expected: []time.Time{day(13), day(20)},
+		},
+		{
+			name:     "unbounded window with limit",
+			rule:     "FREQ=WEEKLY;INTERVAL=2",
+			from:     day(7),
+			limit:    2,
+			expected: []time.Time{day(20), time.Date(2025, time.February, 3, 19, 0, 0, 0, time.UTC)},
+		},
+		{
+			// 22:00 UTC on Sunday is already Monday 01:00 in Moscow.
+			name:    "weekdays are those of the events' zone",
+			rule:    "FREQ=WEEKLY;BYDAY=MO,WE;COUNT=3",
+			dtstart: time.Date(2025, time.January, 5, 22, 0, 0, 0, time.UTC),
+			from:    time.Date(2025, time.January, 5, 0, 0, 0, 0, time.UTC),
+			expected: []time.Time{
+				time.Date(2025, time.January, 5, 22, 0, 0, 0, time.UTC),
+				time.Date(2025, time.January, 7, 22, 0, 0, 0, time.UTC),
+				time.Date(2025, time.January, 12, 22, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:    "monthly skips short months",
+			rule:    "FREQ=MONTHLY;COUNT=3",
+			dtstart: time.Date(2025, time.January, 31, 19, 0, 0, 0, time.UTC),
+			from:    time.Date(2025, time.January, 31, 0, 0, 0, 0, time.UTC),
+			expected: []time.Time{
+				time.Date(2025, time.January, 31, 19, 0, 0, 0, time.UTC),
+				time.Date(2025, time.March, 31, 19, 0, 0, 0, time.UTC),
+				time.Date(2025, time.May, 31, 19, 0, 0, 0, time.UTC),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rule, err := Parse(tt.rule)
+			require.NoError(t, err)
+
+			start := dtstart
+			if !tt.dtstart.IsZero() {
+				start = tt.dtstart
+			}
+
+			assert.Equal(t, tt.expected, rule.Between(start, tt.from, tt.to, tt.exdates, tt.limit))
+		})
+	}
+}
+
+func TestRule_Last(t *testing.T) {
+	t.Parallel()
+
+	dtstart := time.Date(2025, time.January, 6, 19, 0, 0, 0, time.UTC)
+
+	rule, err := Parse("FREQ=WEEKLY;BYDAY=MO,FR;COUNT=4")
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2025, time.January, 17, 19, 0, 0, 0, time.UTC), rule.Last(dtstart))
+
+	rule, err = Parse("FREQ=DAILY")
+	require.NoError(t, err)
+	assert.True(t, rule.Last(dtstart).IsZero())
+}
@@ -0,0 +1,127 @@
+package eventRepository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"kudago/internal/models"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+)
+
+const attendeeExistsQuery = `SELECT EXISTS(SELECT 1 FROM attendance WHERE user_id = $1 AND event_id = $2)`
+
+const insertAttendeeQuery = `
+	INSERT INTO attendance (user_id, event_id)
+	VALUES ($1, $2)
+	ON CONFLICT DO NOTHING`
+
+const claimWaitlistSpotQuery = `
+	DELETE FROM waitlist
+	WHERE user_id = $1 AND event_id = $2 AND claim_until > NOW()`
+
+const insertWaitlistQuery = `
+	INSERT INTO waitlist (user_id, event_id)
+	VALUES ($1, $2)
+	ON CONFLICT DO NOTHING`
+
+const selectWaitlistPositionQuery = `
+	SELECT COUNT(*) FROM waitlist
+	WHERE event_id = $2 AND claim_until IS NULL
+	  AND id <= (SELECT id FROM waitlist WHERE user_id = $1 AND event_id = $2)`
+
+// AddAttendee records an RSVP. Repeated RSVPs of the same user are no-ops.
+// Once the capacity is reached the user is queued on the waitlist instead;
+// users promoted from the waitlist claim their held spot the same way.
+func (db *EventDB) AddAttendee(ctx context.Context, eventID, userID int, claimUntil time.Time) (models.AttendanceResult, error) {
+	result := models.AttendanceResult{Status: models.AttendanceStatusAttending}
+
+	tx, err := db.pool.Begin(ctx)
+	if err != nil {
+		return models.AttendanceResult{}, fmt.Errorf("%s: %w", models.LevelDB, err)
+	}
+	defer tx.Rollback(ctx)
+
+	var capacity int
+	err = tx.QueryRow(ctx, selectEventCapacityForUpdateQuery, eventID).Scan(&capacity)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.AttendanceResult{}, fmt.Errorf("%s: %w", models.LevelDB, models.ErrEventNotFound)
+		}
+		return models.AttendanceResult{}, fmt.Errorf("%s: %w", models.LevelDB, err)
+	}
+
+	var exists bool
+	err = tx.QueryRow(ctx, attendeeExistsQuery, userID, eventID).Scan(&exists)
+	if err != nil {
+		return models.AttendanceResult{}, fmt.Errorf("%s: %w", models.LevelDB, err)
+	}
+	if exists {
+		return result, nil
+	}
+
+	if capacity > 0 {
+		promoted, err := promoteFromWaitlist(ctx, tx, eventID, capacity, claimUntil)
+		if err != nil {
+			return models.AttendanceResult{}, err
+		}
+
+		attending, err := takeSpot(ctx, tx, eventID, userID, capacity)
+		if err != nil {
+			return models.AttendanceResult{}, err
+		}
+
+		for _, entry := range promoted {
+			if entry.UserID != userID {
+				result.Promoted = append(result.Promoted, entry)
+			}
+		}
+
+		if !attending {
+			_, err = tx.Exec(ctx, insertWaitlistQuery, userID, eventID)
+			if err != nil {
+				return models.AttendanceResult{}, fmt.Errorf("%s: %w", models.LevelDB, err)
+			}
+
+			err = tx.QueryRow(ctx, selectWaitlistPositionQuery, userID, eventID).Scan(&result.Position)
+			if err != nil {
+				return models.AttendanceResult{}, fmt.Errorf("%s: %w", models.LevelDB, err)
+			}
+			result.Status = models.AttendanceStatusWaitlisted
+		}
+	}
+
+	if result.Status == models.AttendanceStatusAttending {
+		_, err = tx.Exec(ctx, insertAttendeeQuery, userID, eventID)
+		if err != nil {
+			return models.AttendanceResult{}, fmt.Errorf("%s: %w", models.LevelDB, err)
+		}
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return models.AttendanceResult{}, fmt.Errorf("%s: %w", models.LevelDB, err)
+	}
+	return result, nil
+}
+
+// takeSpot reports whether the user may attend: either they hold a spot freed
+// for them from the waitlist, or the event still has a free one.
+func takeSpot(ctx context.Context, tx pgx.Tx, eventID, userID, capacity int) (bool, error) {
+	claimed, err := tx.Exec(ctx, claimWaitlistSpotQuery, userID, eventID)
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", models.LevelDB, err)
+	}
+	if claimed.RowsAffected() > 0 {
+		return true, nil
+	}
+
+	var taken int
+	err = tx.QueryRow(ctx, countTakenSpotsQuery, eventID).Scan(&taken)
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", models.LevelDB, err)
+	}
+	return taken < capacity, nil
+}
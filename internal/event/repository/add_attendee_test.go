@@ -0,0 +1,177 @@
+package eventRepository
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"kudago/internal/models"
+)
+
+func TestEventRepository_AddAttendee(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	claimUntil := time.Date(2024, 12, 1, 12, 0, 0, 0, time.UTC)
+
+	expectCapacity := func(m pgxmock.PgxConnIface, capacity int) {
+		m.ExpectBegin()
+		m.ExpectQuery(`SELECT COALESCE\(capacity, 0\) FROM event WHERE id = \$1 FOR UPDATE`).
+			WithArgs(1).
+			WillReturnRows(pgxmock.NewRows([]string{"capacity"}).AddRow(capacity))
+		m.ExpectQuery(`SELECT EXISTS`).
+			WithArgs(2, 1).
+			WillReturnRows(pgxmock.NewRows([]string{"exists"}).AddRow(false))
+	}
+
+	tests := []struct {
+		name           string
+		mockSetup      func(m pgxmock.PgxConnIface)
+		expectedResult models.AttendanceResult
+		expectedErr    error
+	}{
+		{
+			name: "успешная запись",
+			mockSetup: func(m pgxmock.PgxConnIface) {
+				expectCapacity(m, 10)
+				m.ExpectExec(`DELETE FROM waitlist WHERE event_id = \$1 AND claim_until <= NOW\(\)`).
+					WithArgs(1).
+					WillReturnResult(pgxmock.NewResult("DELETE", 0))
+				m.ExpectQuery(`SELECT \(SELECT COUNT\(\*\) FROM attendance`).
+					WithArgs(1).
+					WillReturnRows(pgxmock.NewRows([]string{"count"}).AddRow(9))
+				m.ExpectQuery(`UPDATE waitlist SET claim_until`).
+					WithArgs(1, 1, claimUntil).
+					WillReturnRows(pgxmock.NewRows([]string{"event_id", "user_id", "claim_until"}))
+				m.ExpectExec(`DELETE FROM waitlist\s+WHERE user_id = \$1 AND event_id = \$2 AND claim_until > NOW\(\)`).
+					WithArgs(2, 1).
+					WillReturnResult(pgxmock.NewResult("DELETE", 0))
+				m.ExpectQuery(`SELECT \(SELECT COUNT\(\*\) FROM attendance`).
+					WithArgs(1).
+					WillReturnRows(pgxmock.NewRows([]string{"count"}).AddRow(9))
+				m.ExpectExec(`INSERT INTO attendance`).
+					WithArgs(2, 1).
+					WillReturnResult(pgxmock.NewResult("INSERT", 1))
+				m.ExpectCommit()
+				m.ExpectRollback()
+			},
+			expectedResult: models.AttendanceResult{Status: models.AttendanceStatusAttending},
+		},
+		{
+			name: "повторная запись",
+			mockSetup: func(m pgxmock.PgxConnIface) {
+				m.ExpectBegin()
+				m.ExpectQuery(`SELECT COALESCE\(capacity, 0\) FROM event`).
+					WithArgs(1).
+					WillReturnRows(pgxmock.NewRows([]string{"capacity"}).AddRow(10))
+				m.ExpectQuery(`SELECT EXISTS`).
+					WithArgs(2, 1).
+					WillReturnRows(pgxmock.NewRows([]string{"exists"}).AddRow(true))
+				m.ExpectRollback()
+			},
+			expectedResult: models.AttendanceResult{Status: models.AttendanceStatusAttending},
+		},
+		{
+			name: "мест нет, запись в лист ожидания",
+			mockSetup: func(m pgxmock.PgxConnIface) {
+				expectCapacity(m, 10)
+				m.ExpectExec(`DELETE FROM waitlist WHERE event_id = \$1 AND claim_until <= NOW\(\)`).
+					WithArgs(1).
+					WillReturnResult(pgxmock.NewResult("DELETE", 0))
+				m.ExpectQuery(`SELECT \(SELECT COUNT\(\*\) FROM attendance`).
+					WithArgs(1).
+					WillReturnRows(pgxmock.NewRows([]string{"count"}).AddRow(10))
+				m.ExpectExec(`DELETE FROM waitlist\s+WHERE user_id = \$1`).
+					WithArgs(2, 1).
+					WillReturnResult(pgxmock.NewResult("DELETE", 0))
+				m.ExpectQuery(`SELECT \(SELECT COUNT\(\*\) FROM attendance`).
+					WithArgs(1).
+					WillReturnRows(pgxmock.NewRows([]string{"count"}).AddRow(10))
+				m.ExpectExec(`INSERT INTO waitlist`).
+					WithArgs(2, 1).
+					WillReturnResult(pgxmock.NewResult("INSERT", 1))
+				m.ExpectQuery(`SELECT COUNT\(\*\) FROM waitlist`).
+					WithArgs(2, 1).
+					WillReturnRows(pgxmock.NewRows([]string{"count"}).AddRow(3))
+				m.ExpectCommit()
+				m.ExpectRollback()
+			},
+			expectedResult: models.AttendanceResult{Status: models.AttendanceStatusWaitlisted, Position: 3},
+		},
+		{
+			name: "подтверждение места из листа ожидания",
+			mockSetup: func(m pgxmock.PgxConnIface) {
+				expectCapacity(m, 10)
+				m.ExpectExec(`DELETE FROM waitlist WHERE event_id = \$1 AND claim_until <= NOW\(\)`).
+					WithArgs(1).
+					WillReturnResult(pgxmock.NewResult("DELETE", 1))
+				m.ExpectQuery(`SELECT \(SELECT COUNT\(\*\) FROM attendance`).
+					WithArgs(1).
+					WillReturnRows(pgxmock.NewRows([]string{"count"}).AddRow(9))
+				m.ExpectQuery(`UPDATE waitlist SET claim_until`).
+					WithArgs(1, 1, claimUntil).
+					WillReturnRows(pgxmock.NewRows([]string{"event_id", "user_id", "claim_until"}).
+						AddRow(1, 5, claimUntil))
+				m.ExpectExec(`DELETE FROM waitlist\s+WHERE user_id = \$1`).
+					WithArgs(2, 1).
+					WillReturnResult(pgxmock.NewResult("DELETE", 1))
+				m.ExpectExec(`INSERT INTO attendance`).
+					WithArgs(2, 1).
+					WillReturnResult(pgxmock.NewResult("INSERT", 1))
+				m.ExpectCommit()
+				m.ExpectRollback()
+			},
+			expectedResult: models.AttendanceResult{
+				Status:   models.AttendanceStatusAttending,
+				Promoted: []models.WaitlistEntry{{EventID: 1, UserID: 5, ClaimUntil: claimUntil}},
+			},
+		},
+		{
+			name: "событие не найдено",
+			mockSetup: func(m pgxmock.PgxConnIface) {
+				m.ExpectBegin()
+				m.ExpectQuery(`SELECT COALESCE\(capacity, 0\) FROM event`).
+					WithArgs(1).
+					WillReturnRows(pgxmock.NewRows([]string{"capacity"}))
+				m.ExpectRollback()
+			},
+			expectedErr: models.ErrEventNotFound,
+		},
+		{
+			name: "ошибка базы данных",
+			mockSetup: func(m pgxmock.PgxConnIface) {
+				m.ExpectBegin().WillReturnError(fmt.Errorf("database error"))
+			},
+			expectedErr: fmt.Errorf("database error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockConn, err := pgxmock.NewConn()
+			require.NoError(t, err)
+			defer mockConn.Close(ctx)
+
+			tt.mockSetup(mockConn)
+
+			db := NewDB(mockConn)
+			result, err := db.AddAttendee(ctx, 1, 2, claimUntil)
+
+			if tt.expectedErr != nil {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedResult, result)
+			}
+			assert.NoError(t, mockConn.ExpectationsWereMet())
+		})
+	}
+}
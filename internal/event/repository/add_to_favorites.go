@@ -11,12 +11,12 @@ import (
 )
 
 const insertNewFavorite = `
-	INSERT INTO FAVORITE_EVENT (user_id, event_id)
-	VALUES ($1, $2)
+	INSERT INTO FAVORITE_EVENT (user_id, event_id, occurrence_start)
+	VALUES ($1, $2, $3)
 	ON CONFLICT DO NOTHING`
 
 func (db *EventDB) AddEventToFavorites(ctx context.Context, newFavorite models.FavoriteEvent) error {
-	result, err := db.pool.Exec(ctx, insertNewFavorite, newFavorite.UserID, newFavorite.EventID)
+	result, err := db.pool.Exec(ctx, insertNewFavorite, newFavorite.UserID, newFavorite.EventID, nilIfEmpty(newFavorite.OccurrenceStart))
 	if err != nil {
 		var pgErr *pgconn.PgError
 		if errors.As(err, &pgErr) {
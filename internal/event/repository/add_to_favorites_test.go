@@ -30,8 +30,22 @@ func TestEventDB_AddEventToFavorites(t *testing.T) {
 				EventID: 100,
 			},
 			mockSetup: func(m pgxmock.PgxConnIface) {
-				m.ExpectExec(`INSERT INTO FAVORITE_EVENT \(user_id, event_id\) VALUES \(\$1, \$2\) ON CONFLICT DO NOTHING`).
-					WithArgs(1, 100).
+				m.ExpectExec(`INSERT INTO FAVORITE_EVENT \(user_id, event_id, occurrence_start\) VALUES \(\$1, \$2, \$3\) ON CONFLICT DO NOTHING`).
+					WithArgs(1, 100, nil).
+					WillReturnResult(pgxmock.NewResult("INSERT", 1))
+			},
+			expectedError: nil,
+		},
+		{
+			name: "Добавление одного повторения серии",
+			newFavorite: models.FavoriteEvent{
+				UserID:          1,
+				EventID:         101,
+				OccurrenceStart: "2025-01-13T19:00:00Z",
+			},
+			mockSetup: func(m pgxmock.PgxConnIface) {
+				m.ExpectExec(`INSERT INTO FAVORITE_EVENT \(user_id, event_id, occurrence_start\) VALUES \(\$1, \$2, \$3\) ON CONFLICT DO NOTHING`).
+					WithArgs(1, 101, "2025-01-13T19:00:00Z").
 					WillReturnResult(pgxmock.NewResult("INSERT", 1))
 			},
 			expectedError: nil,
@@ -43,8 +57,8 @@ func TestEventDB_AddEventToFavorites(t *testing.T) {
 				EventID: 200,
 			},
 			mockSetup: func(m pgxmock.PgxConnIface) {
-				m.ExpectExec(`INSERT INTO FAVORITE_EVENT \(user_id, event_id\) VALUES \(\$1, \$2\) ON CONFLICT DO NOTHING`).
-					WithArgs(2, 200).
+				m.ExpectExec(`INSERT INTO FAVORITE_EVENT \(user_id, event_id, occurrence_start\) VALUES \(\$1, \$2, \$3\) ON CONFLICT DO NOTHING`).
+					WithArgs(2, 200, nil).
 					WillReturnResult(pgxmock.NewResult("INSERT", 0))
 			},
 			expectedError: models.ErrNothingToInsert,
@@ -56,8 +70,8 @@ func TestEventDB_AddEventToFavorites(t *testing.T) {
 				EventID: 300,
 			},
 			mockSetup: func(m pgxmock.PgxConnIface) {
-				m.ExpectExec(`INSERT INTO FAVORITE_EVENT \(user_id, event_id\) VALUES \(\$1, \$2\) ON CONFLICT DO NOTHING`).
-					WithArgs(3, 300).
+				m.ExpectExec(`INSERT INTO FAVORITE_EVENT \(user_id, event_id, occurrence_start\) VALUES \(\$1, \$2, \$3\) ON CONFLICT DO NOTHING`).
+					WithArgs(3, 300, nil).
 					WillReturnError(&pgconn.PgError{Code: "23503"}) // Код ошибки внешнего ключа
 			},
 			expectedError: models.ErrForeignKeyViolation,
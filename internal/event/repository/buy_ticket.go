@@ -0,0 +1,63 @@
+package eventRepository
+
+import (
+	"context"
+	"fmt"
+
+	"kudago/internal/models"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+)
+
+const buyTicketQuery = `
+	UPDATE ticket
+	SET status = 'paid', ticket_buy_date = CURRENT_DATE, reserved_until = NULL
+	WHERE id = $1 AND user_id = $2 AND status = 'reserved' AND reserved_until > NOW()
+	  AND EXISTS (SELECT 1 FROM event WHERE event.id = ticket.event_id AND event.status = 'published')
+	RETURNING id, event_id, COALESCE(ticket_type_id, 0), user_id, type, price, quantity, status, ticket_buy_date, reserved_until`
+
+const ticketEventPublishedQuery = `
+	SELECT event.status = 'published'
+	FROM ticket
+	JOIN event ON event.id = ticket.event_id
+	WHERE ticket.id = $1 AND ticket.user_id = $2`
+
+func (db *EventDB) BuyTicket(ctx context.Context, ticketID, userID int) (models.Ticket, error) {
+	var ticketInfo TicketInfo
+	err := db.pool.QueryRow(ctx, buyTicketQuery, ticketID, userID).Scan(
+		&ticketInfo.ID,
+		&ticketInfo.EventID,
+		&ticketInfo.TicketTypeID,
+		&ticketInfo.UserID,
+		&ticketInfo.Type,
+		&ticketInfo.Price,
+		&ticketInfo.Quantity,
+		&ticketInfo.Status,
+		&ticketInfo.BoughtAt,
+		&ticketInfo.ReservedUntil,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.Ticket{}, db.buyTicketError(ctx, ticketID, userID)
+		}
+		return models.Ticket{}, fmt.Errorf("%s: %w", models.LevelDB, err)
+	}
+
+	return toDomainTicket(ticketInfo), nil
+}
+
+// buyTicketError tells a reservation of an event that is no longer published
+// from one that does not exist or has expired.
+func (db *EventDB) buyTicketError(ctx context.Context, ticketID, userID int) error {
+	var published bool
+	err := db.pool.QueryRow(ctx, ticketEventPublishedQuery, ticketID, userID).Scan(&published)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return fmt.Errorf("%s: %w", models.LevelDB, err)
+	}
+
+	if err == nil && !published {
+		return fmt.Errorf("%s: %w", models.LevelDB, models.ErrNotPublished)
+	}
+	return fmt.Errorf("%s: %w", models.LevelDB, models.ErrTicketNotFound)
+}
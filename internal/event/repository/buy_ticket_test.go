@@ -0,0 +1,110 @@
+package eventRepository
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"kudago/internal/models"
+)
+
+func TestEventRepository_BuyTicket(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	boughtAt := time.Date(2024, 12, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		mockSetup   func(m pgxmock.PgxConnIface)
+		expected    models.Ticket
+		expectedErr error
+	}{
+		{
+			name: "успешная покупка",
+			mockSetup: func(m pgxmock.PgxConnIface) {
+				m.ExpectQuery(`UPDATE ticket`).
+					WithArgs(7, 3).
+					WillReturnRows(pgxmock.NewRows([]string{
+						"id", "event_id", "ticket_type_id", "user_id", "type", "price", "quantity", "status", "ticket_buy_date", "reserved_until",
+					}).AddRow(7, 1, 2, 3, "VIP", 1500.0, 2, "paid", boughtAt, (*time.Time)(nil)))
+			},
+			expected: models.Ticket{
+				ID:           7,
+				EventID:      1,
+				TicketTypeID: 2,
+				UserID:       3,
+				Type:         "VIP",
+				Price:        1500.0,
+				Quantity:     2,
+				Status:       models.TicketStatusPaid,
+				BoughtAt:     boughtAt,
+			},
+		},
+		{
+			name: "бронь не найдена или истекла",
+			mockSetup: func(m pgxmock.PgxConnIface) {
+				m.ExpectQuery(`UPDATE ticket`).
+					WithArgs(7, 3).
+					WillReturnRows(pgxmock.NewRows([]string{
+						"id", "event_id", "ticket_type_id", "user_id", "type", "price", "quantity", "status", "ticket_buy_date", "reserved_until",
+					}))
+				m.ExpectQuery(`SELECT event.status = 'published'`).
+					WithArgs(7, 3).
+					WillReturnRows(pgxmock.NewRows([]string{"published"}))
+			},
+			expectedErr: models.ErrTicketNotFound,
+		},
+		{
+			name: "событие отменено",
+			mockSetup: func(m pgxmock.PgxConnIface) {
+				m.ExpectQuery(`UPDATE ticket`).
+					WithArgs(7, 3).
+					WillReturnRows(pgxmock.NewRows([]string{
+						"id", "event_id", "ticket_type_id", "user_id", "type", "price", "quantity", "status", "ticket_buy_date", "reserved_until",
+					}))
+				m.ExpectQuery(`SELECT event.status = 'published'`).
+					WithArgs(7, 3).
+					WillReturnRows(pgxmock.NewRows([]string{"published"}).AddRow(false))
+			},
+			expectedErr: models.ErrNotPublished,
+		},
+		{
+			name: "ошибка базы данных",
+			mockSetup: func(m pgxmock.PgxConnIface) {
+				m.ExpectQuery(`UPDATE ticket`).
+					WithArgs(7, 3).
+					WillReturnError(fmt.Errorf("database error"))
+			},
+			expectedErr: fmt.Errorf("database error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockConn, err := pgxmock.NewConn()
+			require.NoError(t, err)
+			defer mockConn.Close(ctx)
+
+			tt.mockSetup(mockConn)
+
+			db := NewDB(mockConn)
+			ticket, err := db.BuyTicket(ctx, 7, 3)
+
+			if tt.expectedErr != nil {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, ticket)
+			}
+		})
+	}
+}
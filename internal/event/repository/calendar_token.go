@@ -0,0 +1,39 @@
+package eventRepository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"kudago/internal/models"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// A user has a single feed token; saving a new one revokes the old feed URL.
+const saveCalendarTokenQuery = `
+	INSERT INTO CALENDAR_TOKEN (user_id, token_hash)
+	VALUES ($1, $2)
+	ON CONFLICT (user_id) DO UPDATE SET token_hash = EXCLUDED.token_hash, created_at = NOW()`
+
+func (db *EventDB) SaveCalendarToken(ctx context.Context, userID int, tokenHash string) error {
+	_, err := db.pool.Exec(ctx, saveCalendarTokenQuery, userID, tokenHash)
+	if err != nil {
+		return fmt.Errorf("%s: %w", models.LevelDB, err)
+	}
+	return nil
+}
+
+const selectUserIDByCalendarTokenQuery = `SELECT user_id FROM CALENDAR_TOKEN WHERE token_hash = $1`
+
+func (db *EventDB) GetUserIDByCalendarToken(ctx context.Context, tokenHash string) (int, error) {
+	var userID int
+	err := db.pool.QueryRow(ctx, selectUserIDByCalendarTokenQuery, tokenHash).Scan(&userID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, fmt.Errorf("%s: %w", models.LevelDB, models.ErrInvalidToken)
+		}
+		return 0, fmt.Errorf("%s: %w", models.LevelDB, err)
+	}
+	return userID, nil
+}
@@ -0,0 +1,121 @@
+package eventRepository
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"kudago/internal/models"
+)
+
+func TestEventRepository_SaveCalendarToken(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	tests := []struct {
+		name      string
+		mockSetup func(m pgxmock.PgxConnIface)
+		expectErr bool
+	}{
+		{
+			name: "успешное сохранение",
+			mockSetup: func(m pgxmock.PgxConnIface) {
+				m.ExpectExec(`INSERT INTO CALENDAR_TOKEN \(user_id, token_hash\) VALUES \(\$1, \$2\) ON CONFLICT \(user_id\) DO UPDATE`).
+					WithArgs(1, "hash").
+					WillReturnResult(pgxmock.NewResult("INSERT", 1))
+			},
+		},
+		{
+			name: "ошибка базы данных",
+			mockSetup: func(m pgxmock.PgxConnIface) {
+				m.ExpectExec(`INSERT INTO CALENDAR_TOKEN`).
+					WithArgs(1, "hash").
+					WillReturnError(fmt.Errorf("database error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockConn, err := pgxmock.NewConn()
+			require.NoError(t, err)
+			defer mockConn.Close(ctx)
+
+			tt.mockSetup(mockConn)
+
+			db := EventDB{pool: mockConn}
+			err = db.SaveCalendarToken(ctx, 1, "hash")
+
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			require.NoError(t, mockConn.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestEventRepository_GetUserIDByCalendarToken(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	tests := []struct {
+		name           string
+		mockSetup      func(m pgxmock.PgxConnIface)
+		expectedUserID int
+		expectedErr    error
+	}{
+		{
+			name: "токен найден",
+			mockSetup: func(m pgxmock.PgxConnIface) {
+				m.ExpectQuery(`SELECT user_id FROM CALENDAR_TOKEN WHERE token_hash = \$1`).
+					WithArgs("hash").
+					WillReturnRows(pgxmock.NewRows([]string{"user_id"}).AddRow(7))
+			},
+			expectedUserID: 7,
+		},
+		{
+			name: "токен не найден",
+			mockSetup: func(m pgxmock.PgxConnIface) {
+				m.ExpectQuery(`SELECT user_id FROM CALENDAR_TOKEN WHERE token_hash = \$1`).
+					WithArgs("hash").
+					WillReturnError(pgx.ErrNoRows)
+			},
+			expectedErr: models.ErrInvalidToken,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockConn, err := pgxmock.NewConn()
+			require.NoError(t, err)
+			defer mockConn.Close(ctx)
+
+			tt.mockSetup(mockConn)
+
+			db := EventDB{pool: mockConn}
+			userID, err := db.GetUserIDByCalendarToken(ctx, "hash")
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectedUserID, userID)
+			require.NoError(t, mockConn.ExpectationsWereMet())
+		})
+	}
+}
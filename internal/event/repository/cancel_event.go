@@ -0,0 +1,53 @@
+package eventRepository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"kudago/internal/models"
+)
+
+const cancelEventQuery = `
+	UPDATE event
+	SET status = 'cancelled', cancel_reason = $2, updated_at = $3
+	WHERE id = $1 AND status = 'published'`
+
+const rescheduleEventQuery = `
+	UPDATE event
+	SET event_start = $2, event_finish = $3, recurrence_until = $4, updated_at = $5
+	WHERE id = $1 AND status = 'published'`
+
+// CancelEvent marks a published event as cancelled. The row is kept, so
+// favorites, attendance and notifications survive the cancellation.
+func (db *EventDB) CancelEvent(ctx context.Context, ID int, reason string) error {
+	tag, err := db.pool.Exec(ctx, cancelEventQuery, ID, nilIfEmpty(reason), time.Now())
+	if err != nil {
+		return fmt.Errorf("%s: %w", models.LevelDB, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("%s: %w", models.LevelDB, models.ErrNotPublished)
+	}
+
+	return nil
+}
+
+// RescheduleEvent moves a published event to the EventStart and EventEnd of
+// the given one. For a series they are the first occurrence, and the end of
+// the series is recomputed from its rule.
+func (db *EventDB) RescheduleEvent(ctx context.Context, event models.Event) error {
+	_, _, until, err := recurrenceArgs(event)
+	if err != nil {
+		return fmt.Errorf("%s: %w", models.LevelDB, err)
+	}
+
+	tag, err := db.pool.Exec(ctx, rescheduleEventQuery, event.ID, event.EventStart, event.EventEnd, until, time.Now())
+	if err != nil {
+		return fmt.Errorf("%s: %w", models.LevelDB, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("%s: %w", models.LevelDB, models.ErrNotPublished)
+	}
+
+	return nil
+}
@@ -0,0 +1,154 @@
+package eventRepository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"kudago/internal/models"
+)
+
+func TestEventRepository_CancelEvent(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	tests := []struct {
+		name      string
+		reason    string
+		mockSetup func(m pgxmock.PgxConnIface)
+		expectErr error
+	}{
+		{
+			name:   "отмена с причиной",
+			reason: "Площадка закрыта",
+			mockSetup: func(m pgxmock.PgxConnIface) {
+				m.ExpectExec(`UPDATE event\s+SET status = 'cancelled', cancel_reason = \$2, updated_at = \$3\s+WHERE id = \$1 AND status = 'published'`).
+					WithArgs(1, "Площадка закрыта", pgxmock.AnyArg()).
+					WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+			},
+		},
+		{
+			name: "отмена без причины",
+			mockSetup: func(m pgxmock.PgxConnIface) {
+				m.ExpectExec(`UPDATE event\s+SET status = 'cancelled'`).
+					WithArgs(1, nil, pgxmock.AnyArg()).
+					WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+			},
+		},
+		{
+			name: "событие не опубликовано",
+			mockSetup: func(m pgxmock.PgxConnIface) {
+				m.ExpectExec(`UPDATE event\s+SET status = 'cancelled'`).
+					WithArgs(1, nil, pgxmock.AnyArg()).
+					WillReturnResult(pgxmock.NewResult("UPDATE", 0))
+			},
+			expectErr: models.ErrNotPublished,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockConn, err := pgxmock.NewConn()
+			require.NoError(t, err)
+			defer mockConn.Close(ctx)
+
+			tt.mockSetup(mockConn)
+
+			db := EventDB{pool: mockConn}
+			err = db.CancelEvent(ctx, 1, tt.reason)
+
+			if tt.expectErr != nil {
+				assert.ErrorIs(t, err, tt.expectErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, mockConn.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestEventRepository_RescheduleEvent(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	eventStart := time.Date(2030, 1, 6, 18, 0, 0, 0, time.UTC)
+	eventEnd := eventStart.Add(2 * time.Hour)
+
+	tests := []struct {
+		name      string
+		event     models.Event
+		mockSetup func(m pgxmock.PgxConnIface)
+		expectErr error
+	}{
+		{
+			name: "перенос события",
+			event: models.Event{
+				ID:         1,
+				EventStart: eventStart.Format(time.RFC3339),
+				EventEnd:   eventEnd.Format(time.RFC3339),
+			},
+			mockSetup: func(m pgxmock.PgxConnIface) {
+				m.ExpectExec(`UPDATE event\s+SET event_start = \$2, event_finish = \$3, recurrence_until = \$4, updated_at = \$5\s+WHERE id = \$1 AND status = 'published'`).
+					WithArgs(1, eventStart.Format(time.RFC3339), eventEnd.Format(time.RFC3339), nil, pgxmock.AnyArg()).
+					WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+			},
+		},
+		{
+			name: "перенос серии пересчитывает её окончание",
+			event: models.Event{
+				ID:             1,
+				EventStart:     eventStart.Format(time.RFC3339),
+				EventEnd:       eventEnd.Format(time.RFC3339),
+				RecurrenceRule: "FREQ=WEEKLY;COUNT=3",
+			},
+			mockSetup: func(m pgxmock.PgxConnIface) {
+				m.ExpectExec(`UPDATE event\s+SET event_start`).
+					WithArgs(1, eventStart.Format(time.RFC3339), eventEnd.Format(time.RFC3339), eventEnd.AddDate(0, 0, 14), pgxmock.AnyArg()).
+					WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+			},
+		},
+		{
+			name: "отменённое событие не переносится",
+			event: models.Event{
+				ID:         1,
+				EventStart: eventStart.Format(time.RFC3339),
+				EventEnd:   eventEnd.Format(time.RFC3339),
+			},
+			mockSetup: func(m pgxmock.PgxConnIface) {
+				m.ExpectExec(`UPDATE event\s+SET event_start`).
+					WithArgs(1, eventStart.Format(time.RFC3339), eventEnd.Format(time.RFC3339), nil, pgxmock.AnyArg()).
+					WillReturnResult(pgxmock.NewResult("UPDATE", 0))
+			},
+			expectErr: models.ErrNotPublished,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockConn, err := pgxmock.NewConn()
+			require.NoError(t, err)
+			defer mockConn.Close(ctx)
+
+			tt.mockSetup(mockConn)
+
+			db := EventDB{pool: mockConn}
+			err = db.RescheduleEvent(ctx, tt.event)
+
+			if tt.expectErr != nil {
+				assert.ErrorIs(t, err, tt.expectErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, mockConn.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,25 @@
+package eventRepository
+
+import (
+	"context"
+	"fmt"
+
+	"kudago/internal/models"
+)
+
+const checkInAttendeeQuery = `
+	UPDATE attendance
+	SET attended_at = COALESCE(attended_at, NOW())
+	WHERE user_id = $1 AND event_id = $2`
+
+func (db *EventDB) CheckInAttendee(ctx context.Context, eventID, userID int) error {
+	result, err := db.pool.Exec(ctx, checkInAttendeeQuery, userID, eventID)
+	if err != nil {
+		return fmt.Errorf("%s: %w", models.LevelDB, err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("%s: %w", models.LevelDB, models.ErrNotFound)
+	}
+	return nil
+}
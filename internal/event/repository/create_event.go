@@ -8,8 +8,9 @@ import (
 )
 
 const createEventQuery = `
-	INSERT INTO event (title, description, event_start, event_finish, location, capacity, user_id, category_id, lat, lon)
-	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+	INSERT INTO event (title, description, event_start, event_finish, location, capacity, user_id, category_id, lat, lon,
+		recurrence_rule, recurrence_exdates, recurrence_until, status, publish_at)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)
 	RETURNING id`
 
 func (db *EventDB) CreateEvent(ctx context.Context, event models.Event) (models.Event, error) {
@@ -19,8 +20,14 @@ func (db *EventDB) CreateEvent(ctx context.Context, event models.Event) (models.
 	}
 	defer tx.Rollback(ctx)
 
+	rule, exdates, until, err := recurrenceArgs(event)
+	if err != nil {
+		return models.Event{}, fmt.Errorf("%s: %w", models.LevelDB, err)
+	}
+
 	var id int
-	err = tx.QueryRow(ctx, createEventQuery, event.Title, event.Description, event.EventStart, event.EventEnd, event.Location, event.Capacity, event.AuthorID, event.CategoryID, event.Latitude, event.Longitude).Scan(&id)
+	err = tx.QueryRow(ctx, createEventQuery, event.Title, event.Description, event.EventStart, event.EventEnd, event.Location, event.Capacity, event.AuthorID, event.CategoryID, event.Latitude, event.Longitude,
+		rule, exdates, until, event.Status, nilIfEmpty(event.PublishAt)).Scan(&id)
 	if err != nil {
 		return models.Event{}, fmt.Errorf("%s: %w", models.LevelDB, err)
 	}
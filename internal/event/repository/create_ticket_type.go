@@ -0,0 +1,39 @@
+package eventRepository
+
+import (
+	"context"
+	"fmt"
+
+	"kudago/internal/models"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/pkg/errors"
+)
+
+const (
+	foreignKeyViolationCode = "23503"
+	uniqueViolationCode     = "23505"
+)
+
+const insertTicketTypeQuery = `
+	INSERT INTO ticket_type (name, price, event_id)
+	VALUES ($1, $2, $3)
+	RETURNING id`
+
+func (db *EventDB) CreateTicketType(ctx context.Context, ticketType models.TicketType) (models.TicketType, error) {
+	err := db.pool.QueryRow(ctx, insertTicketTypeQuery, ticketType.Name, ticketType.Price, ticketType.EventID).Scan(&ticketType.ID)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			switch pgErr.Code {
+			case foreignKeyViolationCode:
+				return models.TicketType{}, fmt.Errorf("%s: %w", models.LevelDB, models.ErrEventNotFound)
+			case uniqueViolationCode:
+				return models.TicketType{}, fmt.Errorf("%s: %w", models.LevelDB, models.ErrNothingToInsert)
+			}
+		}
+		return models.TicketType{}, fmt.Errorf("%s: %w", models.LevelDB, err)
+	}
+
+	return ticketType, nil
+}
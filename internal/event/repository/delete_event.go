@@ -7,12 +7,37 @@ import (
 	"kudago/internal/models"
 )
 
-const deleteEventQuery = `DELETE FROM event WHERE id=$1`
+// The gallery is removed by the cascade, its URLs are read in the same
+// statement so that the files can be deleted afterwards.
+const deleteEventQuery = `
+	WITH images AS (
+		SELECT url FROM media_url WHERE event_id = $1
+	), deleted AS (
+		DELETE FROM event WHERE id = $1
+	)
+	SELECT url FROM images`
 
-func (db *EventDB) DeleteEvent(ctx context.Context, ID int) error {
-	_, err := db.pool.Exec(ctx, deleteEventQuery, ID)
+// DeleteEvent deletes the event and returns the URLs of its images, whose
+// files are left for the caller to delete.
+func (db *EventDB) DeleteEvent(ctx context.Context, ID int) ([]string, error) {
+	rows, err := db.pool.Query(ctx, deleteEventQuery, ID)
 	if err != nil {
-		return fmt.Errorf("%s: %w", models.LevelDB, err)
+		return nil, fmt.Errorf("%s: %w", models.LevelDB, err)
 	}
-	return nil
+	defer rows.Close()
+
+	urls := make([]string, 0)
+	for rows.Next() {
+		var url string
+		err = rows.Scan(&url)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", models.LevelDB, err)
+		}
+		urls = append(urls, url)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", models.LevelDB, err)
+	}
+
+	return urls, nil
 }
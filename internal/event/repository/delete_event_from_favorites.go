@@ -7,10 +7,11 @@ import (
 	"kudago/internal/models"
 )
 
-const deleteFavorite = `DELETE FROM FAVORITE_EVENT WHERE user_id=$1 AND event_id=$2`
+const deleteFavorite = `DELETE FROM FAVORITE_EVENT WHERE user_id=$1 AND event_id=$2
+	AND occurrence_start IS NOT DISTINCT FROM $3::TIMESTAMPTZ`
 
 func (db *EventDB) DeleteEventFromFavorites(ctx context.Context, favorite models.FavoriteEvent) error {
-	result, err := db.pool.Exec(ctx, deleteFavorite, favorite.UserID, favorite.EventID)
+	result, err := db.pool.Exec(ctx, deleteFavorite, favorite.UserID, favorite.EventID, nilIfEmpty(favorite.OccurrenceStart))
 	if err != nil {
 		return fmt.Errorf("%s: %w", models.LevelDB, err)
 	}
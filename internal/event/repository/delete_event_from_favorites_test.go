@@ -31,7 +31,7 @@ func TestEventRepository_DeleteEventFromFavorites(t *testing.T) {
 			},
 			mockSetup: func(m pgxmock.PgxConnIface) {
 				m.ExpectExec(`DELETE FROM FAVORITE_EVENT WHERE user_id=\$1 AND event_id=\$2`).
-					WithArgs(1, 1).
+					WithArgs(1, 1, nil).
 					WillReturnResult(pgxmock.NewResult("DELETE", 1))
 			},
 			expectErr: nil,
@@ -43,7 +43,7 @@ func TestEventRepository_DeleteEventFromFavorites(t *testing.T) {
 				UserID:  1,
 			}, mockSetup: func(m pgxmock.PgxConnIface) {
 				m.ExpectExec(`DELETE FROM FAVORITE_EVENT WHERE user_id=\$1 AND event_id=\$2`).
-					WithArgs(1, 2).
+					WithArgs(1, 2, nil).
 					WillReturnResult(pgxmock.NewResult("DELETE", 0))
 			},
 			expectErr: models.ErrNotFound,
@@ -55,7 +55,7 @@ func TestEventRepository_DeleteEventFromFavorites(t *testing.T) {
 				UserID:  1,
 			}, mockSetup: func(m pgxmock.PgxConnIface) {
 				m.ExpectExec(`DELETE FROM FAVORITE_EVENT WHERE user_id=$1 AND event_id=$2`).
-					WithArgs(1, 3).
+					WithArgs(1, 3, nil).
 					WillReturnError(fmt.Errorf("database error"))
 			},
 			expectErr: fmt.Errorf("database error"),
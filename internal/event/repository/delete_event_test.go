@@ -21,33 +21,36 @@ func TestEventRepository_DeleteEvent(t *testing.T) {
 		name      string
 		eventID   int
 		mockSetup func(m pgxmock.PgxConnIface)
+		expected  []string
 		expectErr bool
 	}{
 		{
 			name:    "Успешное удаление",
 			eventID: 1,
 			mockSetup: func(m pgxmock.PgxConnIface) {
-				m.ExpectExec(`DELETE FROM event WHERE id=\$1`).
+				m.ExpectQuery(`(?s)SELECT url FROM media_url WHERE event_id = \$1.*DELETE FROM event WHERE id = \$1`).
 					WithArgs(1).
-					WillReturnResult(pgxmock.NewResult("DELETE", 1))
+					WillReturnRows(pgxmock.NewRows([]string{"url"}).AddRow("/cover_full.jpg").AddRow("/gallery_full.jpg"))
 			},
+			expected:  []string{"/cover_full.jpg", "/gallery_full.jpg"},
 			expectErr: false,
 		},
 		{
 			name:    "Событие не найдено",
 			eventID: 2,
 			mockSetup: func(m pgxmock.PgxConnIface) {
-				m.ExpectExec(`DELETE FROM event WHERE id=\$1`).
+				m.ExpectQuery(`(?s)SELECT url FROM media_url WHERE event_id = \$1.*DELETE FROM event WHERE id = \$1`).
 					WithArgs(2).
-					WillReturnResult(pgxmock.NewResult("DELETE", 0))
+					WillReturnRows(pgxmock.NewRows([]string{"url"}))
 			},
+			expected:  []string{},
 			expectErr: false,
 		},
 		{
 			name:    "ошибка",
 			eventID: 3,
 			mockSetup: func(m pgxmock.PgxConnIface) {
-				m.ExpectExec(`DELETE FROM event WHERE id=\$1`).
+				m.ExpectQuery(`(?s)SELECT url FROM media_url WHERE event_id = \$1.*DELETE FROM event WHERE id = \$1`).
 					WithArgs(3).
 					WillReturnError(fmt.Errorf("database error"))
 			},
@@ -67,13 +70,14 @@ func TestEventRepository_DeleteEvent(t *testing.T) {
 
 			db := NewDB(mockConn)
 
-			err = db.DeleteEvent(context.Background(), tt.eventID)
+			urls, err := db.DeleteEvent(context.Background(), tt.eventID)
 
 			if tt.expectErr {
 				assert.Error(t, err)
 				assert.Contains(t, err.Error(), models.LevelDB)
 			} else {
 				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, urls)
 			}
 		})
 	}
@@ -0,0 +1,102 @@
+package eventRepository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"kudago/internal/models"
+)
+
+const getDraftsQuery = `
+	SELECT event.id, event.title, event.description, event.event_start, event.event_finish,
+		event.location, event.capacity, event.created_at, event.user_id, event.category_id, event.lat, event.lon,
+		COALESCE(array_agg(DISTINCT COALESCE(tag.name, '')), '{}') AS tags, media_url.url AS media_link,
+		(SELECT COUNT(*) FROM attendance WHERE attendance.event_id = event.id) AS attendees,
+		(SELECT COUNT(*) FROM FAVORITE_EVENT WHERE FAVORITE_EVENT.event_id = event.id) AS favorites_count,
+		event.status, event.publish_at,
+		event.recurrence_rule, event.recurrence_exdates
+	FROM event
+	LEFT JOIN event_tag ON event.id = event_tag.event_id
+	LEFT JOIN tag ON tag.id = event_tag.tag_id
+	LEFT JOIN media_url ON event.id = media_url.event_id AND media_url.is_cover
+	WHERE event.user_id = $1 AND event.status = 'draft'
+	GROUP BY event.id, media_url.url
+	ORDER BY event.publish_at ASC NULLS LAST, event.created_at DESC, event.id DESC
+	LIMIT $2 OFFSET $3`
+
+const publishEventQuery = `
+	UPDATE event
+	SET status = 'published', updated_at = NOW()
+	WHERE id = $1 AND status = 'draft'`
+
+// publishDueEventsQuery skips drafts locked by a concurrent run, so replicas of
+// the event service never return, and notify about, the same draft twice.
+const publishDueEventsQuery = `
+	UPDATE event
+	SET status = 'published', updated_at = NOW()
+	WHERE id IN (
+		SELECT id FROM event
+		WHERE status = 'draft' AND publish_at <= $1
+		FOR UPDATE SKIP LOCKED
+	)
+	RETURNING id, title, user_id`
+
+// GetDrafts returns the unpublished events of the user, scheduled ones first.
+func (db *EventDB) GetDrafts(ctx context.Context, userID int, paginationParams models.PaginationParams) ([]models.Event, error) {
+	rows, err := db.pool.Query(ctx, getDraftsQuery, userID, paginationParams.Limit, paginationParams.Offset)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", models.LevelDB, err)
+	}
+	infos, err := scanEventInfos(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]models.Event, 0, len(infos))
+	for _, info := range infos {
+		event, err := db.toDomainEvent(ctx, info)
+		if err != nil {
+			continue
+		}
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+func (db *EventDB) PublishEvent(ctx context.Context, ID int) error {
+	tag, err := db.pool.Exec(ctx, publishEventQuery, ID)
+	if err != nil {
+		return fmt.Errorf("%s: %w", models.LevelDB, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("%s: %w", models.LevelDB, models.ErrNotDraft)
+	}
+
+	return nil
+}
+
+// PublishDueEvents publishes the drafts scheduled at or before now and
+// returns them with the ID, title and author set.
+func (db *EventDB) PublishDueEvents(ctx context.Context, now time.Time) ([]models.Event, error) {
+	rows, err := db.pool.Query(ctx, publishDueEventsQuery, now)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", models.LevelDB, err)
+	}
+	defer rows.Close()
+
+	var events []models.Event
+	for rows.Next() {
+		event := models.Event{Status: models.EventStatusPublished}
+		if err = rows.Scan(&event.ID, &event.Title, &event.AuthorID); err != nil {
+			return nil, fmt.Errorf("%s: %w", models.LevelDB, err)
+		}
+		events = append(events, event)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", models.LevelDB, err)
+	}
+
+	return events, nil
+}
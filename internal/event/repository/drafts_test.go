@@ -0,0 +1,128 @@
+package eventRepository
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"kudago/internal/models"
+)
+
+func TestEventRepository_PublishEvent(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	tests := []struct {
+		name      string
+		mockSetup func(m pgxmock.PgxConnIface)
+		expectErr error
+	}{
+		{
+			name: "публикация черновика",
+			mockSetup: func(m pgxmock.PgxConnIface) {
+				m.ExpectExec(`UPDATE event\s+SET status = 'published', updated_at = NOW\(\)\s+WHERE id = \$1 AND status = 'draft'`).
+					WithArgs(1).
+					WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+			},
+		},
+		{
+			name: "событие уже опубликовано",
+			mockSetup: func(m pgxmock.PgxConnIface) {
+				m.ExpectExec(`UPDATE event\s+SET status = 'published'`).
+					WithArgs(1).
+					WillReturnResult(pgxmock.NewResult("UPDATE", 0))
+			},
+			expectErr: models.ErrNotDraft,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockConn, err := pgxmock.NewConn()
+			require.NoError(t, err)
+			defer mockConn.Close(ctx)
+
+			tt.mockSetup(mockConn)
+
+			db := EventDB{pool: mockConn}
+			err = db.PublishEvent(ctx, 1)
+
+			if tt.expectErr != nil {
+				assert.ErrorIs(t, err, tt.expectErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			require.NoError(t, mockConn.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestEventRepository_PublishDueEvents(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	now := time.Now()
+	pattern := `(?s)UPDATE event\s+SET status = 'published'.*WHERE status = 'draft' AND publish_at <= \$1\s+FOR UPDATE SKIP LOCKED.*RETURNING id, title, user_id`
+
+	tests := []struct {
+		name           string
+		mockSetup      func(m pgxmock.PgxConnIface)
+		expectedEvents []models.Event
+		expectErr      bool
+	}{
+		{
+			name: "публикация запланированных черновиков",
+			mockSetup: func(m pgxmock.PgxConnIface) {
+				m.ExpectQuery(pattern).
+					WithArgs(now).
+					WillReturnRows(pgxmock.NewRows([]string{"id", "title", "user_id"}).
+						AddRow(1, "Концерт", 7).
+						AddRow(2, "Выставка", 8))
+			},
+			expectedEvents: []models.Event{
+				{ID: 1, Title: "Концерт", AuthorID: 7, Status: models.EventStatusPublished},
+				{ID: 2, Title: "Выставка", AuthorID: 8, Status: models.EventStatusPublished},
+			},
+		},
+		{
+			name: "ошибка базы данных",
+			mockSetup: func(m pgxmock.PgxConnIface) {
+				m.ExpectQuery(pattern).
+					WithArgs(now).
+					WillReturnError(fmt.Errorf("database error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockConn, err := pgxmock.NewConn()
+			require.NoError(t, err)
+			defer mockConn.Close(ctx)
+
+			tt.mockSetup(mockConn)
+
+			db := EventDB{pool: mockConn}
+			events, err := db.PublishDueEvents(ctx, now)
+
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedEvents, events)
+			}
+			require.NoError(t, mockConn.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,217 @@
+package eventRepository
+
+import (
+	"context"
+	"fmt"
+
+	"kudago/internal/models"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+)
+
+const selectEventImagesQuery = `
+	SELECT id, url, position, is_cover
+	FROM media_url
+	WHERE event_id = $1
+	ORDER BY position, id`
+
+// gallery changes lock the event row so that concurrent uploads can't exceed
+// the limit or leave the event with two covers
+const lockEventQuery = `SELECT id FROM event WHERE id = $1 FOR UPDATE`
+
+// insertEventImageQuery appends the image to the gallery unless it is full.
+// The first image of an event becomes its cover.
+const insertEventImageQuery = `
+	INSERT INTO media_url (event_id, url, position, is_cover)
+	SELECT $1, $2, COALESCE(MAX(position) + 1, 0), COUNT(*) = 0
+	FROM media_url
+	WHERE event_id = $1
+	HAVING COUNT(*) < $3
+	RETURNING id, url, position, is_cover`
+
+const deleteEventImageQuery = `
+	DELETE FROM media_url
+	WHERE id = $2 AND event_id = $1
+	RETURNING id, url, position, is_cover`
+
+const promoteCoverQuery = `
+	UPDATE media_url SET is_cover = TRUE
+	WHERE id = (SELECT id FROM media_url WHERE event_id = $1 ORDER BY position, id LIMIT 1)`
+
+const reorderEventImagesQuery = `
+	UPDATE media_url SET position = ordered.position - 1
+	FROM unnest($2::INT[]) WITH ORDINALITY AS ordered(id, position)
+	WHERE media_url.id = ordered.id AND media_url.event_id = $1`
+
+// the old cover is cleared first: media_url_cover_idx is checked row by row
+const clearCoverQuery = `UPDATE media_url SET is_cover = FALSE WHERE event_id = $1 AND is_cover AND id <> $2`
+
+const setCoverQuery = `UPDATE media_url SET is_cover = TRUE WHERE event_id = $1 AND id = $2`
+
+func (db *EventDB) GetEventImages(ctx context.Context, eventID int) ([]models.EventImage, error) {
+	rows, err := db.pool.Query(ctx, selectEventImagesQuery, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", models.LevelDB, err)
+	}
+
+	return scanEventImages(rows)
+}
+
+// AddEventImage appends the image to the end of the gallery, which holds at
+// most limit images.
+func (db *EventDB) AddEventImage(ctx context.Context, eventID int, url string, limit int) (models.EventImage, error) {
+	tx, err := db.pool.Begin(ctx)
+	if err != nil {
+		return models.EventImage{}, fmt.Errorf("%s: %w", models.LevelDB, err)
+	}
+	defer tx.Rollback(ctx)
+
+	err = lockEvent(ctx, tx, eventID)
+	if err != nil {
+		return models.EventImage{}, err
+	}
+
+	var image models.EventImage
+	err = tx.QueryRow(ctx, insertEventImageQuery, eventID, url, limit).
+		Scan(&image.ID, &image.URL, &image.Position, &image.IsCover)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.EventImage{}, fmt.Errorf("%s: %w", models.LevelDB, models.ErrTooManyImages)
+		}
+		return models.EventImage{}, fmt.Errorf("%s: %w", models.LevelDB, err)
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return models.EventImage{}, fmt.Errorf("%s: %w", models.LevelDB, err)
+	}
+
+	return image, nil
+}
+
+// DeleteEventImage removes the image from the gallery and returns it, so
+// that the caller can delete the file. When the cover is removed the first
+// remaining image takes its place.
+func (db *EventDB) DeleteEventImage(ctx context.Context, eventID, imageID int) (models.EventImage, error) {
+	tx, err := db.pool.Begin(ctx)
+	if err != nil {
+		return models.EventImage{}, fmt.Errorf("%s: %w", models.LevelDB, err)
+	}
+	defer tx.Rollback(ctx)
+
+	err = lockEvent(ctx, tx, eventID)
+	if err != nil {
+		return models.EventImage{}, err
+	}
+
+	var image models.EventImage
+	err = tx.QueryRow(ctx, deleteEventImageQuery, eventID, imageID).
+		Scan(&image.ID, &image.URL, &image.Position, &image.IsCover)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.EventImage{}, fmt.Errorf("%s: %w", models.LevelDB, models.ErrImageNotFound)
+		}
+		return models.EventImage{}, fmt.Errorf("%s: %w", models.LevelDB, err)
+	}
+
+	if image.IsCover {
+		_, err = tx.Exec(ctx, promoteCoverQuery, eventID)
+		if err != nil {
+			return models.EventImage{}, fmt.Errorf("%s: %w", models.LevelDB, err)
+		}
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return models.EventImage{}, fmt.Errorf("%s: %w", models.LevelDB, err)
+	}
+
+	return image, nil
+}
+
+// UpdateEventImages puts the gallery in the given order of image IDs and
+// makes coverID the cover. An empty order or a zero coverID leaves that part
+// unchanged. The IDs must belong to the event.
+func (db *EventDB) UpdateEventImages(ctx context.Context, eventID int, order []int, coverID int) ([]models.EventImage, error) {
+	tx, err := db.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", models.LevelDB, err)
+	}
+	defer tx.Rollback(ctx)
+
+	err = lockEvent(ctx, tx, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(order) > 0 {
+		_, err = tx.Exec(ctx, reorderEventImagesQuery, eventID, order)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", models.LevelDB, err)
+		}
+	}
+
+	if coverID != 0 {
+		_, err = tx.Exec(ctx, clearCoverQuery, eventID, coverID)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", models.LevelDB, err)
+		}
+
+		tag, err := tx.Exec(ctx, setCoverQuery, eventID, coverID)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", models.LevelDB, err)
+		}
+		if tag.RowsAffected() == 0 {
+			return nil, fmt.Errorf("%s: %w", models.LevelDB, models.ErrImageNotFound)
+		}
+	}
+
+	rows, err := tx.Query(ctx, selectEventImagesQuery, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", models.LevelDB, err)
+	}
+	images, err := scanEventImages(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", models.LevelDB, err)
+	}
+
+	return images, nil
+}
+
+func lockEvent(ctx context.Context, tx pgx.Tx, eventID int) error {
+	var id int
+	err := tx.QueryRow(ctx, lockEventQuery, eventID).Scan(&id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return fmt.Errorf("%s: %w", models.LevelDB, models.ErrEventNotFound)
+		}
+		return fmt.Errorf("%s: %w", models.LevelDB, err)
+	}
+
+	return nil
+}
+
+func scanEventImages(rows pgx.Rows) ([]models.EventImage, error) {
+	defer rows.Close()
+
+	images := []models.EventImage{}
+	for rows.Next() {
+		var image models.EventImage
+		err := rows.Scan(&image.ID, &image.URL, &image.Position, &image.IsCover)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", models.LevelDB, err)
+		}
+		images = append(images, image)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", models.LevelDB, err)
+	}
+
+	return images, nil
+}
@@ -0,0 +1,216 @@
+package eventRepository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"kudago/internal/models"
+)
+
+var imageColumns = []string{"id", "url", "position", "is_cover"}
+
+func TestEventRepository_AddEventImage(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	tests := []struct {
+		name          string
+		mockSetup     func(m pgxmock.PgxConnIface)
+		expectedImage models.EventImage
+		expectErr     error
+	}{
+		{
+			name: "первое изображение становится обложкой",
+			mockSetup: func(m pgxmock.PgxConnIface) {
+				m.ExpectBegin()
+				m.ExpectQuery(`SELECT id FROM event WHERE id = \$1 FOR UPDATE`).
+					WithArgs(1).
+					WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(1))
+				m.ExpectQuery(`INSERT INTO media_url \(event_id, url, position, is_cover\)\s+SELECT \$1, \$2, COALESCE\(MAX\(position\) \+ 1, 0\), COUNT\(\*\) = 0`).
+					WithArgs(1, "/images/a.jpg", 10).
+					WillReturnRows(pgxmock.NewRows(imageColumns).AddRow(5, "/images/a.jpg", 0, true))
+				m.ExpectCommit()
+				m.ExpectRollback()
+			},
+			expectedImage: models.EventImage{ID: 5, URL: "/images/a.jpg", Position: 0, IsCover: true},
+		},
+		{
+			name: "галерея заполнена",
+			mockSetup: func(m pgxmock.PgxConnIface) {
+				m.ExpectBegin()
+				m.ExpectQuery(`SELECT id FROM event WHERE id = \$1 FOR UPDATE`).
+					WithArgs(1).
+					WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(1))
+				m.ExpectQuery(`INSERT INTO media_url`).
+					WithArgs(1, "/images/a.jpg", 10).
+					WillReturnRows(pgxmock.NewRows(imageColumns))
+				m.ExpectRollback()
+			},
+			expectErr: models.ErrTooManyImages,
+		},
+		{
+			name: "событие не найдено",
+			mockSetup: func(m pgxmock.PgxConnIface) {
+				m.ExpectBegin()
+				m.ExpectQuery(`SELECT id FROM event WHERE id = \$1 FOR UPDATE`).
+					WithArgs(1).
+					WillReturnRows(pgxmock.NewRows([]string{"id"}))
+				m.ExpectRollback()
+			},
+			expectErr: models.ErrEventNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockConn, err := pgxmock.NewConn()
+			require.NoError(t, err)
+			defer mockConn.Close(ctx)
+
+			tt.mockSetup(mockConn)
+
+			db := EventDB{pool: mockConn}
+			image, err := db.AddEventImage(ctx, 1, "/images/a.jpg", 10)
+
+			if tt.expectErr != nil {
+				assert.ErrorIs(t, err, tt.expectErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedImage, image)
+			}
+			assert.NoError(t, mockConn.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestEventRepository_DeleteEventImage(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	tests := []struct {
+		name          string
+		mockSetup     func(m pgxmock.PgxConnIface)
+		expectedImage models.EventImage
+		expectErr     error
+	}{
+		{
+			name: "удаление обложки назначает новую",
+			mockSetup: func(m pgxmock.PgxConnIface) {
+				m.ExpectBegin()
+				m.ExpectQuery(`SELECT id FROM event WHERE id = \$1 FOR UPDATE`).
+					WithArgs(1).
+					WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(1))
+				m.ExpectQuery(`DELETE FROM media_url\s+WHERE id = \$2 AND event_id = \$1`).
+					WithArgs(1, 5).
+					WillReturnRows(pgxmock.NewRows(imageColumns).AddRow(5, "/images/a.jpg", 0, true))
+				m.ExpectExec(`UPDATE media_url SET is_cover = TRUE\s+WHERE id = \(SELECT id FROM media_url WHERE event_id = \$1 ORDER BY position, id LIMIT 1\)`).
+					WithArgs(1).
+					WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+				m.ExpectCommit()
+				m.ExpectRollback()
+			},
+			expectedImage: models.EventImage{ID: 5, URL: "/images/a.jpg", Position: 0, IsCover: true},
+		},
+		{
+			name: "удаление изображения галереи",
+			mockSetup: func(m pgxmock.PgxConnIface) {
+				m.ExpectBegin()
+				m.ExpectQuery(`SELECT id FROM event WHERE id = \$1 FOR UPDATE`).
+					WithArgs(1).
+					WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(1))
+				m.ExpectQuery(`DELETE FROM media_url`).
+					WithArgs(1, 5).
+					WillReturnRows(pgxmock.NewRows(imageColumns).AddRow(5, "/images/b.jpg", 2, false))
+				m.ExpectCommit()
+				m.ExpectRollback()
+			},
+			expectedImage: models.EventImage{ID: 5, URL: "/images/b.jpg", Position: 2},
+		},
+		{
+			name: "изображение не найдено",
+			mockSetup: func(m pgxmock.PgxConnIface) {
+				m.ExpectBegin()
+				m.ExpectQuery(`SELECT id FROM event WHERE id = \$1 FOR UPDATE`).
+					WithArgs(1).
+					WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(1))
+				m.ExpectQuery(`DELETE FROM media_url`).
+					WithArgs(1, 5).
+					WillReturnRows(pgxmock.NewRows(imageColumns))
+				m.ExpectRollback()
+			},
+			expectErr: models.ErrImageNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockConn, err := pgxmock.NewConn()
+			require.NoError(t, err)
+			defer mockConn.Close(ctx)
+
+			tt.mockSetup(mockConn)
+
+			db := EventDB{pool: mockConn}
+			image, err := db.DeleteEventImage(ctx, 1, 5)
+
+			if tt.expectErr != nil {
+				assert.ErrorIs(t, err, tt.expectErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedImage, image)
+			}
+			assert.NoError(t, mockConn.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestEventRepository_UpdateEventImages(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	mockConn, err := pgxmock.NewConn()
+	require.NoError(t, err)
+	defer mockConn.Close(ctx)
+
+	mockConn.ExpectBegin()
+	mockConn.ExpectQuery(`SELECT id FROM event WHERE id = \$1 FOR UPDATE`).
+		WithArgs(1).
+		WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(1))
+	mockConn.ExpectExec(`UPDATE media_url SET position = ordered.position - 1\s+FROM unnest\(\$2::INT\[\]\) WITH ORDINALITY`).
+		WithArgs(1, []int{6, 5}).
+		WillReturnResult(pgxmock.NewResult("UPDATE", 2))
+	mockConn.ExpectExec(`UPDATE media_url SET is_cover = FALSE WHERE event_id = \$1 AND is_cover AND id <> \$2`).
+		WithArgs(1, 6).
+		WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+	mockConn.ExpectExec(`UPDATE media_url SET is_cover = TRUE WHERE event_id = \$1 AND id = \$2`).
+		WithArgs(1, 6).
+		WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+	mockConn.ExpectQuery(`SELECT id, url, position, is_cover\s+FROM media_url`).
+		WithArgs(1).
+		WillReturnRows(pgxmock.NewRows(imageColumns).
+			AddRow(6, "/images/b.jpg", 0, true).
+			AddRow(5, "/images/a.jpg", 1, false))
+	mockConn.ExpectCommit()
+	mockConn.ExpectRollback()
+
+	db := EventDB{pool: mockConn}
+	images, err := db.UpdateEventImages(ctx, 1, []int{6, 5}, 6)
+
+	require.NoError(t, err)
+	assert.Equal(t, []models.EventImage{
+		{ID: 6, URL: "/images/b.jpg", Position: 0, IsCover: true},
+		{ID: 5, URL: "/images/a.jpg", Position: 1},
+	}, images)
+	assert.NoError(t, mockConn.ExpectationsWereMet())
+}
@@ -5,6 +5,7 @@ package eventRepository
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -26,21 +27,47 @@ type Pool interface {
 	SendBatch(ctx context.Context, b *pgx.Batch) pgx.BatchResults
 }
 
+// listedCondition keeps drafts, archived and cancelled events out of public
+// listings. A cancelled event is still reachable by its ID.
+const listedCondition = `event.status = 'published'`
+
+// savedCondition also keeps cancelled events in the favorites of users who
+// saved them, so that they learn about the cancellation.
+const savedCondition = `event.status IN ('published', 'cancelled')`
+
 type EventInfo struct {
-	ID          int       `db:"id"`
-	Title       string    `db:"title"`
-	Description string    `db:"description"`
-	EventStart  time.Time `db:"event_start"`
-	EventFinish time.Time `db:"event_finish"`
-	Location    string    `db:"location"`
-	Capacity    int       `db:"capacity"`
-	CreatedAt   time.Time `db:"created_at"`
-	UserID      int       `db:"user_id"`
-	CategoryID  int       `db:"category_id"`
-	Latitude    float64   `db:"lat"`
-	Longitude   float64   `db:"lon"`
-	Tags        []string  `db:"tags"`
-	ImageURL    *string   `db:"image"`
+	ID             int        `db:"id"`
+	Title          string     `db:"title"`
+	Description    string     `db:"description"`
+	EventStart     time.Time  `db:"event_start"`
+	EventFinish    time.Time  `db:"event_finish"`
+	Location       string     `db:"location"`
+	Capacity       int        `db:"capacity"`
+	CreatedAt      time.Time  `db:"created_at"`
+	UserID         int        `db:"user_id"`
+	CategoryID     int        `db:"category_id"`
+	Latitude       float64    `db:"lat"`
+	Longitude      float64    `db:"lon"`
+	Tags           []string   `db:"tags"`
+	ImageURL       *string    `db:"image"`
+	Attendees      int        `db:"attendees"`
+	FavoritesCount int        `db:"favorites_count"`
+	Status         string     `db:"status"`
+	PublishAt      *time.Time `db:"publish_at"`
+	CancelReason   *string    `db:"cancel_reason"`
+
+	TitleHighlight       string   `db:"title_highlight"`
+	DescriptionHighlight string   `db:"description_highlight"`
+	Distance             *float64 `db:"distance"`
+
+	RecurrenceRule    *string     `db:"recurrence_rule"`
+	RecurrenceExdates []time.Time `db:"recurrence_exdates"`
+
+	// SeriesStart is the first occurrence of the series an occurrence is
+	// expanded from.
+	SeriesStart time.Time
+	// SavedOccurrence marks a favorite saved for this occurrence only.
+	SavedOccurrence bool
 }
 
 func NewDB(pool Pool) *EventDB {
@@ -60,15 +87,26 @@ func (db *EventDB) updateTagsForEvent(ctx context.Context, tx pgx.Tx, eventID in
 	return db.addTagsToEvent(ctx, tx, eventID, tags)
 }
 
-const deleteMediaURLQuery = `DELETE FROM media_url WHERE event_id = $1`
-
-func (db *EventDB) updateMediaURL(ctx context.Context, tx pgx.Tx, eventID int, imageURL string) error {
-	_, err := tx.Exec(ctx, deleteMediaURLQuery, eventID)
-	if err != nil {
-		return fmt.Errorf("%s: %w", models.LevelDB, err)
+const updateCoverURLQuery = `
+	UPDATE media_url SET url = $2
+	FROM media_url AS replaced
+	WHERE media_url.id = replaced.id AND media_url.event_id = $1 AND media_url.is_cover
+	RETURNING replaced.url`
+
+// updateMediaURL replaces the cover image and leaves the rest of the gallery
+// as it is. It returns the URL of the replaced cover, empty when the event
+// had none.
+func (db *EventDB) updateMediaURL(ctx context.Context, tx pgx.Tx, eventID int, imageURL string) (string, error) {
+	var replaced string
+	err := tx.QueryRow(ctx, updateCoverURLQuery, eventID, imageURL).Scan(&replaced)
+	if err == nil {
+		return replaced, nil
+	}
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return "", fmt.Errorf("%s: %w", models.LevelDB, err)
 	}
 
-	return db.addMediaURL(ctx, tx, eventID, imageURL)
+	return "", db.addMediaURL(ctx, tx, eventID, imageURL)
 }
 
 const insertTagsQuery = `
@@ -132,10 +170,15 @@ func (db *EventDB) linkTagsToEvent(ctx context.Context, tx pgx.Tx, eventID int,
 }
 
 const insertMediaURL = `
-	INSERT INTO media_url (event_id, url)
-	VALUES ($1, $2)`
+	INSERT INTO media_url (event_id, url, position, is_cover)
+	VALUES ($1, $2, 0, TRUE)`
 
+// addMediaURL sets the cover of an event without images.
 func (db *EventDB) addMediaURL(ctx context.Context, tx pgx.Tx, eventID int, imageURL string) error {
+	if imageURL == "" {
+		return nil
+	}
+
 	_, err := tx.Exec(ctx, insertMediaURL, eventID, imageURL)
 	if err != nil {
 		return fmt.Errorf("%s: %w", models.LevelDB, err)
@@ -149,23 +192,62 @@ func (db *EventDB) toDomainEvent(ctx context.Context, eventInfo EventInfo) (mode
 	if eventInfo.ImageURL != nil {
 		url = *eventInfo.ImageURL
 	}
+	cancelReason := ""
+	if eventInfo.CancelReason != nil {
+		cancelReason = *eventInfo.CancelReason
+	}
 	return models.Event{
-		ID:          eventInfo.ID,
-		Title:       eventInfo.Title,
-		Description: eventInfo.Description,
-		EventStart:  eventInfo.EventStart.Format(time.RFC3339),
-		EventEnd:    eventInfo.EventFinish.Format(time.RFC3339),
-		AuthorID:    eventInfo.UserID,
-		Tag:         eventInfo.Tags,
-		Location:    eventInfo.Location,
-		Capacity:    eventInfo.Capacity,
-		CategoryID:  eventInfo.CategoryID,
-		ImageURL:    url,
-		Longitude:   eventInfo.Longitude,
-		Latitude:    eventInfo.Latitude,
+		ID:             eventInfo.ID,
+		Title:          eventInfo.Title,
+		Description:    eventInfo.Description,
+		EventStart:     eventInfo.EventStart.Format(time.RFC3339),
+		EventEnd:       eventInfo.EventFinish.Format(time.RFC3339),
+		AuthorID:       eventInfo.UserID,
+		Tag:            eventInfo.Tags,
+		Location:       eventInfo.Location,
+		Capacity:       eventInfo.Capacity,
+		CategoryID:     eventInfo.CategoryID,
+		ImageURL:       url,
+		Longitude:      eventInfo.Longitude,
+		Latitude:       eventInfo.Latitude,
+		Attendees:      eventInfo.Attendees,
+		FavoritesCount: eventInfo.FavoritesCount,
+		Status:         eventInfo.Status,
+		PublishAt:      formatOptionalTime(eventInfo.PublishAt),
+		CancelReason:   cancelReason,
+
+		TitleHighlight:       eventInfo.TitleHighlight,
+		DescriptionHighlight: eventInfo.DescriptionHighlight,
+		Distance:             eventInfo.Distance,
+
+		RecurrenceRule:       recurrenceRule(eventInfo),
+		RecurrenceExceptions: formatTimes(eventInfo.RecurrenceExdates),
+		SeriesStart:          formatOptionalTime(seriesStart(eventInfo)),
+		SavedOccurrence:      eventInfo.SavedOccurrence,
+		Start:                eventInfo.EventStart,
 	}, nil
 }
 
+// toDomainEvents converts rows keeping their order.
+func (db *EventDB) toDomainEvents(ctx context.Context, infos []EventInfo) []models.Event {
+	events := make([]models.Event, 0, len(infos))
+	for _, eventInfo := range infos {
+		event, err := db.toDomainEvent(ctx, eventInfo)
+		if err != nil {
+			continue
+		}
+		events = append(events, event)
+	}
+	return events
+}
+
+func formatOptionalTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
+
 func nilIfZero(value int) interface{} {
 	if value == 0 {
 		return nil
@@ -194,3 +276,53 @@ func tagsToArray(tags []string) interface{} {
 
 	return tags
 }
+
+func cursorArgs(cursor *models.Cursor) (interface{}, interface{}) {
+	if cursor == nil {
+		return nil, nil
+	}
+
+	return cursor.EventStart, cursor.ID
+}
+
+// scanEventInfos reads rows of the listing columns followed by the recurrence
+// columns.
+func scanEventInfos(rows pgx.Rows) ([]EventInfo, error) {
+	defer rows.Close()
+
+	var infos []EventInfo
+	for rows.Next() {
+		var eventInfo EventInfo
+		err := rows.Scan(
+			&eventInfo.ID,
+			&eventInfo.Title,
+			&eventInfo.Description,
+			&eventInfo.EventStart,
+			&eventInfo.EventFinish,
+			&eventInfo.Location,
+			&eventInfo.Capacity,
+			&eventInfo.CreatedAt,
+			&eventInfo.UserID,
+			&eventInfo.CategoryID,
+			&eventInfo.Latitude,
+			&eventInfo.Longitude,
+			&eventInfo.Tags,
+			&eventInfo.ImageURL,
+			&eventInfo.Attendees,
+			&eventInfo.FavoritesCount,
+			&eventInfo.Status,
+			&eventInfo.PublishAt,
+			&eventInfo.RecurrenceRule,
+			&eventInfo.RecurrenceExdates,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", models.LevelDB, err)
+		}
+		infos = append(infos, eventInfo)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", models.LevelDB, err)
+	}
+
+	return infos, nil
+}
@@ -0,0 +1,44 @@
+package eventRepository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"kudago/internal/models"
+)
+
+const selectAttendeesQuery = `
+	SELECT u.id, u.username, COALESCE(u.url_to_avatar, ''), a.created_at, a.attended_at
+	FROM attendance a
+	JOIN "USER" u ON u.id = a.user_id
+	WHERE a.event_id = $1
+	ORDER BY a.created_at, u.id
+	LIMIT $2 OFFSET $3`
+
+func (db *EventDB) GetAttendees(ctx context.Context, eventID int, paginationParams models.PaginationParams) ([]models.Attendee, error) {
+	rows, err := db.pool.Query(ctx, selectAttendeesQuery, eventID, paginationParams.Limit, paginationParams.Offset)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", models.LevelDB, err)
+	}
+	defer rows.Close()
+
+	attendees := make([]models.Attendee, 0, paginationParams.Limit)
+	for rows.Next() {
+		var attendee models.Attendee
+		var checkedInAt *time.Time
+		err = rows.Scan(&attendee.UserID, &attendee.Username, &attendee.ImageURL, &attendee.RSVPAt, &checkedInAt)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", models.LevelDB, err)
+		}
+		if checkedInAt != nil {
+			attendee.CheckedInAt = *checkedInAt
+		}
+		attendees = append(attendees, attendee)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", models.LevelDB, err)
+	}
+
+	return attendees, nil
+}
@@ -13,11 +13,15 @@ import (
 const getEventByIDQuery = `
 	SELECT event.id, event.title, event.description, event.event_start, event.event_finish, 
 	event.location, event.capacity, event.created_at, event.user_id, event.category_id, event.lat, event.lon, 
-	COALESCE(array_agg(COALESCE(tag.name, '')), '{}') AS tags, media_url.url AS media_link
+	COALESCE(array_agg(COALESCE(tag.name, '')), '{}') AS tags, media_url.url AS media_link,
+	(SELECT COUNT(*) FROM attendance WHERE attendance.event_id = event.id) AS attendees,
+	(SELECT COUNT(*) FROM FAVORITE_EVENT WHERE FAVORITE_EVENT.event_id = event.id) AS favorites_count,
+	event.status, event.publish_at, event.cancel_reason,
+	event.recurrence_rule, event.recurrence_exdates
 	FROM event
 	LEFT JOIN event_tag ON event.id = event_tag.event_id
 	LEFT JOIN tag ON tag.id = event_tag.tag_id
-	LEFT JOIN media_url ON event.id = media_url.event_id
+	LEFT JOIN media_url ON event.id = media_url.event_id AND media_url.is_cover
 	WHERE event.id=$1
 	GROUP BY event.id, media_url.url`
 
@@ -38,6 +42,13 @@ func (db *EventDB) GetEventByID(ctx context.Context, ID int) (models.Event, erro
 		&eventInfo.Longitude,
 		&eventInfo.Tags,
 		&eventInfo.ImageURL,
+		&eventInfo.Attendees,
+		&eventInfo.FavoritesCount,
+		&eventInfo.Status,
+		&eventInfo.PublishAt,
+		&eventInfo.CancelReason,
+		&eventInfo.RecurrenceRule,
+		&eventInfo.RecurrenceExdates,
 	)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
@@ -50,5 +61,10 @@ func (db *EventDB) GetEventByID(ctx context.Context, ID int) (models.Event, erro
 	if err != nil {
 		return models.Event{}, models.ErrInternal
 	}
+
+	event.Images, err = db.GetEventImages(ctx, ID)
+	if err != nil {
+		return models.Event{}, err
+	}
 	return event, nil
 }
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"kudago/internal/models"
 	"testing"
+	"time"
 
 	"github.com/pashagolub/pgxmock/v4"
 	"github.com/stretchr/testify/assert"
@@ -15,6 +16,30 @@ func TestEventsRepository_GetEventsByCategory(t *testing.T) {
 	t.Parallel()
 
 	ctx := context.Background()
+	// The series started three days ago, so its next occurrences are in an hour
+	// and a day after.
+	seriesStart := time.Now().UTC().Truncate(time.Second).Add(-72*time.Hour + time.Hour)
+	dailyRule := "FREQ=DAILY"
+	yogaOccurrence := func(day int) models.Event {
+		start := seriesStart.AddDate(0, 0, day)
+		return models.Event{
+			ID:             4,
+			Title:          "Йога",
+			EventStart:     start.Format(time.RFC3339),
+			EventEnd:       start.Add(2 * time.Hour).Format(time.RFC3339),
+			AuthorID:       1,
+			CategoryID:     2,
+			Tag:            []string{},
+			Status:         "published",
+			RecurrenceRule: dailyRule,
+			SeriesStart:    seriesStart.Format(time.RFC3339),
+			Start:          start,
+		}
+	}
+	categoryColumns := []string{
+		"id", "title", "description", "event_start", "event_finish", "location", "capacity", "created_at",
+		"user_id", "category_id", "lat", "lon", "tags", "media_link", "attendees", "favorites_count", "status", "publish_at", "recurrence_rule", "recurrence_exdates",
+	}
 	//eventStart := time.Now().Add(10 * time.Hour)
 	//eventFinish := eventStart.Add(5 * time.Hour)
 
@@ -80,6 +105,24 @@ func TestEventsRepository_GetEventsByCategory(t *testing.T) {
 		//		},
 		//	},
 		//},
+		{
+			name:       "идущая серия категории",
+			categoryID: 2,
+			pagination: models.PaginationParams{
+				Limit: 2,
+			},
+			mockSetup: func(m pgxmock.PgxConnIface) {
+				m.ExpectQuery(`(?s)WHERE event.event_finish >= NOW\(\) AND event.recurrence_rule IS NULL.*AND \(\$4::INT IS NULL OR event.category_id = \$4\)`).
+					WithArgs(2, nil, nil, 2).
+					WillReturnRows(m.NewRows(categoryColumns))
+				m.ExpectQuery(`(?s)WHERE event.recurrence_rule IS NOT NULL.*AND \(\$3::INT IS NULL OR event.category_id = \$3\)`).
+					WithArgs(pgxmock.AnyArg(), pgxmock.AnyArg(), 2).
+					WillReturnRows(m.NewRows(categoryColumns).
+						AddRow(4, "Йога", "", seriesStart, seriesStart.Add(2*time.Hour), "", 0, seriesStart, 1, 2, 0.0, 0.0,
+							[]string{}, nil, 0, 0, "published", nil, &dailyRule, []time.Time{}))
+			},
+			expectedEvents: []models.Event{yogaOccurrence(3), yogaOccurrence(4)},
+		},
 		{
 			name:       "query execution error",
 			categoryID: 2,
@@ -89,7 +132,7 @@ func TestEventsRepository_GetEventsByCategory(t *testing.T) {
 			},
 			mockSetup: func(m pgxmock.PgxConnIface) {
 				m.ExpectQuery(`SELECT event.id, event.title, event.description, event.event_start, event.event_finish`).
-					WithArgs(2, 2, 0).
+					WithArgs(2, nil, nil, 2).
 					WillReturnError(errors.New("query error"))
 			},
 			expectErr:      true,
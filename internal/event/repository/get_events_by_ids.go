@@ -10,11 +10,14 @@ import (
 const getEventsByIDsQuery = `
 	SELECT event.id, event.title, event.description, event.event_start, event.event_finish, 
 	event.location, event.capacity, event.created_at, event.user_id, event.category_id, event.lat, event.lon, 
-	COALESCE(array_agg(COALESCE(tag.name, '')), '{}') AS tags, media_url.url AS media_link
+	COALESCE(array_agg(COALESCE(tag.name, '')), '{}') AS tags, media_url.url AS media_link,
+	(SELECT COUNT(*) FROM attendance WHERE attendance.event_id = event.id) AS attendees,
+	(SELECT COUNT(*) FROM FAVORITE_EVENT WHERE FAVORITE_EVENT.event_id = event.id) AS favorites_count,
+	event.status, event.publish_at
 	FROM event
 	LEFT JOIN event_tag ON event.id = event_tag.event_id
 	LEFT JOIN tag ON tag.id = event_tag.tag_id
-	LEFT JOIN media_url ON event.id = media_url.event_id
+	LEFT JOIN media_url ON event.id = media_url.event_id AND media_url.is_cover
 	WHERE event.id=ANY($1)
 	GROUP BY event.id, media_url.url`
 
@@ -43,6 +46,10 @@ func (db *EventDB) GetEventsByIDs(ctx context.Context, IDs []int) ([]models.Even
 			&eventInfo.Longitude,
 			&eventInfo.Tags,
 			&eventInfo.ImageURL,
+			&eventInfo.Attendees,
+			&eventInfo.FavoritesCount,
+			&eventInfo.Status,
+			&eventInfo.PublishAt,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("%s: %w", models.LevelDB, err)
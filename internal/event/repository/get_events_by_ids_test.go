@@ -30,12 +30,12 @@ func TestEventRepository_GetEventsByIDs(t *testing.T) {
 					WithArgs([]int{1, 2}).
 					WillReturnRows(pgxmock.NewRows([]string{
 						"id", "title", "description", "event_start", "event_finish", "location", "capacity",
-						"created_at", "user_id", "category_id", "lat", "lon", "tags", "media_link",
+						"created_at", "user_id", "category_id", "lat", "lon", "tags", "media_link", "attendees", "favorites_count", "status", "publish_at",
 					}).
 						AddRow(1, "Event 1", "Description 1", "2024-01-01", "2024-01-02", "Location 1", 100,
-							"2024-01-01", 1, 1, 55.7558, 37.6173, "{tag1, tag2}", "url1").
+							"2024-01-01", 1, 1, 55.7558, 37.6173, "{tag1, tag2}", "url1", 0, 0, "published", nil).
 						AddRow(2, "Event 2", "Description 2", "2024-02-01", "2024-02-02", "Location 2", 200,
-							"2024-02-01", 2, 2, 40.7128, -74.0060, "{tag3, tag4}", "url2"))
+							"2024-02-01", 2, 2, 40.7128, -74.0060, "{tag3, tag4}", "url2", 3, 1, "published", nil))
 			},
 			expectErr: nil,
 		},
@@ -49,7 +49,7 @@ func TestEventRepository_GetEventsByIDs(t *testing.T) {
 					WithArgs([]int{999}).
 					WillReturnRows(pgxmock.NewRows([]string{
 						"id", "title", "description", "event_start", "event_finish", "location", "capacity",
-						"created_at", "user_id", "category_id", "lat", "lon", "tags", "media_link",
+						"created_at", "user_id", "category_id", "lat", "lon", "tags", "media_link", "attendees", "favorites_count", "status", "publish_at",
 					}))
 			},
 			expectErr: nil,
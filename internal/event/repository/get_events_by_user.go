@@ -3,6 +3,7 @@ package eventRepository
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"kudago/internal/models"
 )
@@ -10,12 +11,16 @@ import (
 const getEventsByUserQuery = `
 	SELECT event.id, event.title, event.description, event.event_start, event.event_finish, 
 		event.location, event.capacity, event.created_at, event.user_id, event.category_id, event.lat, event.lon, 
-		COALESCE(array_agg(COALESCE(tag.name, '')), '{}') AS tags, media_url.url AS media_link
+		COALESCE(array_agg(DISTINCT COALESCE(tag.name, '')), '{}') AS tags, media_url.url AS media_link,
+		(SELECT COUNT(*) FROM attendance WHERE attendance.event_id = event.id) AS attendees,
+		(SELECT COUNT(*) FROM FAVORITE_EVENT WHERE FAVORITE_EVENT.event_id = event.id) AS favorites_count,
+		event.status, event.publish_at,
+		event.recurrence_rule, event.recurrence_exdates
 	FROM event
 	LEFT JOIN event_tag ON event.id = event_tag.event_id
 	LEFT JOIN tag ON tag.id = event_tag.tag_id
-	LEFT JOIN media_url ON event.id = media_url.event_id
-	WHERE event.user_id=$1 
+	LEFT JOIN media_url ON event.id = media_url.event_id AND media_url.is_cover
+	WHERE event.user_id=$1 AND ` + listedCondition + `
 	GROUP BY event.id, media_url.url
 	ORDER BY event.event_finish ASC
 	LIMIT $2 OFFSET $3`
@@ -25,37 +30,14 @@ func (db *EventDB) GetEventsByUser(ctx context.Context, userID int, paginationPa
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", models.LevelDB, err)
 	}
-	defer rows.Close()
-
-	var events []models.Event
-	for rows.Next() {
-		var eventInfo EventInfo
-		err = rows.Scan(
-			&eventInfo.ID,
-			&eventInfo.Title,
-			&eventInfo.Description,
-			&eventInfo.EventStart,
-			&eventInfo.EventFinish,
-			&eventInfo.Location,
-			&eventInfo.Capacity,
-			&eventInfo.CreatedAt,
-			&eventInfo.UserID,
-			&eventInfo.CategoryID,
-			&eventInfo.Latitude,
-			&eventInfo.Longitude,
-			&eventInfo.Tags,
-			&eventInfo.ImageURL,
-		)
-		if err != nil {
-			return nil, fmt.Errorf("%s: %w", models.LevelDB, err)
-		}
-
-		event, err := db.toDomainEvent(ctx, eventInfo)
-		if err != nil {
-			continue
-		}
-		events = append(events, event)
+	infos, err := scanEventInfos(rows)
+	if err != nil {
+		return nil, err
 	}
 
-	return events, nil
+	now := time.Now()
+	for i := range infos {
+		infos[i] = nextOccurrence(infos[i], now)
+	}
+	return db.toDomainEvents(ctx, infos), nil
 }
@@ -7,56 +7,67 @@ import (
 	"kudago/internal/models"
 )
 
-const getFavoriteEventsQuery = `
-	SELECT event.id, event.title, event.description, event.event_start, event.event_finish,
+// favoriteStart is the start a favorite is listed by: the saved occurrence or
+// the start of the event. Favorites are ordered by it rather than by their
+// finish: the page cursor is a (start, id) pair, and keyset paging only
+// works when the listing is sorted by the same key.
+const favoriteStart = `COALESCE(FAVORITE_EVENT.occurrence_start, event.event_start)`
+
+// A favorite saved for one occurrence of a series is selected as that
+// occurrence, so it is listed like a single event.
+const selectFavoritesBase = `
+	SELECT event.id, event.title, event.description,
+		` + favoriteStart + ` AS event_start,
+		COALESCE(FAVORITE_EVENT.occurrence_start + (event.event_finish - event.event_start), event.event_finish) AS event_finish,
 		event.location, event.capacity, event.created_at, event.user_id, event.category_id, event.lat, event.lon,
-		COALESCE(array_agg(COALESCE(tag.name, '')), '{}') AS tags, media_url.url AS media_link
+		COALESCE(array_agg(DISTINCT COALESCE(tag.name, '')), '{}') AS tags, media_url.url AS media_link,
+		(SELECT COUNT(*) FROM attendance WHERE attendance.event_id = event.id) AS attendees,
+		(SELECT COUNT(*) FROM FAVORITE_EVENT WHERE FAVORITE_EVENT.event_id = event.id) AS favorites_count,
+		event.status, event.publish_at,
+		event.recurrence_rule, event.recurrence_exdates
 	FROM event
 	JOIN FAVORITE_EVENT ON event.id = FAVORITE_EVENT.event_id
 	LEFT JOIN event_tag ON event.id = event_tag.event_id
 	LEFT JOIN tag ON tag.id = event_tag.tag_id
-	LEFT JOIN media_url ON event.id = media_url.event_id
-	WHERE FAVORITE_EVENT.user_id = $1 
-	GROUP BY event.id, media_url.url
-	ORDER BY event.event_finish ASC
-	LIMIT $2 OFFSET $3`
+	LEFT JOIN media_url ON event.id = media_url.event_id AND media_url.is_cover
+	WHERE FAVORITE_EVENT.user_id = $1 AND ` + savedCondition
+
+// Favorites with a fixed start are paged in SQL, taken as offset+limit rows;
+// series saved as a whole are listed by their next occurrence and merged in.
+const getFavoriteEventsQuery = selectFavoritesBase + `
+		AND (event.recurrence_rule IS NULL OR FAVORITE_EVENT.occurrence_start IS NOT NULL)
+		AND ($3::TIMESTAMPTZ IS NULL OR (` + favoriteStart + `, event.id) > ($3::TIMESTAMPTZ, $4::INT))
+	GROUP BY event.id, media_url.url, FAVORITE_EVENT.occurrence_start
+	ORDER BY ` + favoriteStart + ` ASC, event.id ASC
+	LIMIT $2`
+
+const getFavoriteSeriesQuery = selectFavoritesBase + `
+		AND event.recurrence_rule IS NOT NULL AND FAVORITE_EVENT.occurrence_start IS NULL
+	GROUP BY event.id, media_url.url, FAVORITE_EVENT.occurrence_start`
 
 func (db *EventDB) GetFavorites(ctx context.Context, userID int, paginationParams models.PaginationParams) ([]models.Event, error) {
-	rows, err := db.pool.Query(ctx, getFavoriteEventsQuery, userID, paginationParams.Limit, paginationParams.Offset)
+	cursorStart, cursorID := cursorArgs(paginationParams.Cursor)
+	rows, err := db.pool.Query(ctx, getFavoriteEventsQuery, userID, paginationParams.Offset+paginationParams.Limit, cursorStart, cursorID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", models.LevelDB, err)
+	}
+	infos, err := scanEventInfos(rows)
 	if err != nil {
 		return nil, err
 	}
-	defer rows.Close()
-
-	var events []models.Event
-	for rows.Next() {
-		var eventInfo EventInfo
-		err = rows.Scan(
-			&eventInfo.ID,
-			&eventInfo.Title,
-			&eventInfo.Description,
-			&eventInfo.EventStart,
-			&eventInfo.EventFinish,
-			&eventInfo.Location,
-			&eventInfo.Capacity,
-			&eventInfo.CreatedAt,
-			&eventInfo.UserID,
-			&eventInfo.CategoryID,
-			&eventInfo.Latitude,
-			&eventInfo.Longitude,
-			&eventInfo.Tags,
-			&eventInfo.ImageURL,
-		)
-		if err != nil {
-			return nil, fmt.Errorf("%s: %w", models.LevelDB, err)
-		}
-
-		event, err := db.toDomainEvent(ctx, eventInfo)
-		if err != nil {
-			continue
-		}
-		events = append(events, event)
+	// Series rows of this query are favorites saved for a single occurrence.
+	for i := range infos {
+		infos[i].SavedOccurrence = infos[i].RecurrenceRule != nil
+	}
+
+	rows, err = db.pool.Query(ctx, getFavoriteSeriesQuery, userID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", models.LevelDB, err)
+	}
+	series, err := scanEventInfos(rows)
+	if err != nil {
+		return nil, err
 	}
 
-	return events, nil
+	return db.toDomainEvents(ctx, mergeSeries(infos, series, paginationParams)), nil
 }
@@ -0,0 +1,103 @@
+package eventRepository
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"kudago/internal/models"
+
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventRepository_GetFavorites(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	now := time.Now().UTC().Truncate(time.Second)
+	// The series started three days ago, so its next occurrence is in an hour.
+	seriesStart := now.Add(-72*time.Hour + time.Hour)
+	occurrenceStart := now.Add(48 * time.Hour)
+	singleStart := now.Add(5 * 24 * time.Hour)
+	dailyRule, weeklyRule := "FREQ=DAILY", "FREQ=WEEKLY"
+	columns := []string{
+		"id", "title", "description", "event_start", "event_finish", "location", "capacity", "created_at",
+		"user_id", "category_id", "lat", "lon", "tags", "media_link", "attendees", "favorites_count", "status", "publish_at", "recurrence_rule", "recurrence_exdates",
+	}
+	fixedPattern := `(?s)COALESCE\(FAVORITE_EVENT.occurrence_start, event.event_start\) AS event_start.*` +
+		`array_agg\(DISTINCT COALESCE\(tag.name, ''\)\).*` +
+		`WHERE FAVORITE_EVENT.user_id = \$1 AND event.status IN \('published', 'cancelled'\).*` +
+		`AND \(event.recurrence_rule IS NULL OR FAVORITE_EVENT.occurrence_start IS NOT NULL\).*` +
+		`GROUP BY event.id, media_url.url, FAVORITE_EVENT.occurrence_start\s+` +
+		`ORDER BY COALESCE\(FAVORITE_EVENT.occurrence_start, event.event_start\) ASC, event.id ASC\s+LIMIT \$2`
+	seriesPattern := `(?s)AND event.recurrence_rule IS NOT NULL AND FAVORITE_EVENT.occurrence_start IS NULL`
+
+	tests := []struct {
+		name           string
+		mockSetup      func(m pgxmock.PgxConnIface)
+		expectedEvents []models.Event
+		expectErr      bool
+	}{
+		{
+			name: "повторения и серии по дате",
+			mockSetup: func(m pgxmock.PgxConnIface) {
+				m.ExpectQuery(fixedPattern).
+					WithArgs(3, 2, nil, nil).
+					WillReturnRows(pgxmock.NewRows(columns).
+						AddRow(5, "Лекция", "", occurrenceStart, occurrenceStart.Add(time.Hour), "", 0, now, 1, 1, 0.0, 0.0, []string{"наука"}, nil, 0, 1, "published", nil, &weeklyRule, []time.Time{}).
+						AddRow(6, "Концерт", "", singleStart, singleStart.Add(time.Hour), "", 0, now, 1, 1, 0.0, 0.0, []string{}, nil, 0, 1, "published", nil, nil, []time.Time{}))
+				m.ExpectQuery(seriesPattern).
+					WithArgs(3).
+					WillReturnRows(pgxmock.NewRows(columns).
+						AddRow(7, "Йога", "", seriesStart, seriesStart.Add(time.Hour), "", 0, now, 1, 1, 0.0, 0.0, []string{}, nil, 0, 1, "published", nil, &dailyRule, []time.Time{}))
+			},
+			expectedEvents: []models.Event{
+				{
+					ID: 7, Title: "Йога", AuthorID: 1, CategoryID: 1, Tag: []string{}, FavoritesCount: 1, Status: "published", RecurrenceRule: dailyRule,
+					EventStart: now.Add(time.Hour).Format(time.RFC3339), EventEnd: now.Add(2 * time.Hour).Format(time.RFC3339),
+					SeriesStart: seriesStart.Format(time.RFC3339), Start: now.Add(time.Hour),
+				},
+				{
+					ID: 5, Title: "Лекция", AuthorID: 1, CategoryID: 1, Tag: []string{"наука"}, FavoritesCount: 1, Status: "published", RecurrenceRule: weeklyRule,
+					EventStart: occurrenceStart.Format(time.RFC3339), EventEnd: occurrenceStart.Add(time.Hour).Format(time.RFC3339),
+					SavedOccurrence: true, Start: occurrenceStart,
+				},
+			},
+		},
+		{
+			name: "ошибка базы данных",
+			mockSetup: func(m pgxmock.PgxConnIface) {
+				m.ExpectQuery(fixedPattern).
+					WithArgs(3, 2, nil, nil).
+					WillReturnError(errors.New("database error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockConn, err := pgxmock.NewConn()
+			require.NoError(t, err)
+			defer mockConn.Close(ctx)
+
+			tt.mockSetup(mockConn)
+
+			db := EventDB{pool: mockConn}
+			events, err := db.GetFavorites(ctx, 3, models.PaginationParams{Limit: 2})
+
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedEvents, events)
+			}
+			require.NoError(t, mockConn.ExpectationsWereMet())
+		})
+	}
+}
@@ -3,58 +3,51 @@ package eventRepository
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"kudago/internal/models"
 )
 
+// pastStart is the start an event is listed by among past events: a series
+// that has ended is listed by its last occurrence, as recurrence_until is
+// when that occurrence finishes.
+const pastStart = `COALESCE(event.recurrence_until - (event.event_finish - event.event_start), event.event_start)`
+
+// Series that are still running aren't past: they are listed among upcoming
+// events by their occurrences.
 const selectPastEventsQuery = `
 	SELECT event.id, event.title, event.description, event.event_start, event.event_finish,
 		event.location, event.capacity, event.created_at, event.user_id, event.category_id, event.lat, event.lon, 
-		COALESCE(array_agg(DISTINCT COALESCE(tag.name, '')), '{}') AS tags, media_url.url AS media_link
+		COALESCE(array_agg(DISTINCT COALESCE(tag.name, '')), '{}') AS tags, media_url.url AS media_link,
+		(SELECT COUNT(*) FROM attendance WHERE attendance.event_id = event.id) AS attendees,
+		(SELECT COUNT(*) FROM FAVORITE_EVENT WHERE FAVORITE_EVENT.event_id = event.id) AS favorites_count,
+		event.status, event.publish_at,
+		event.recurrence_rule, event.recurrence_exdates
 	FROM event
 	LEFT JOIN event_tag ON event.id = event_tag.event_id
 	LEFT JOIN tag ON tag.id = event_tag.tag_id
-	LEFT JOIN media_url ON event.id = media_url.event_id
-	WHERE event.event_finish < NOW()
+	LEFT JOIN media_url ON event.id = media_url.event_id AND media_url.is_cover
+	WHERE ((event.recurrence_rule IS NULL AND event.event_finish < NOW()) OR event.recurrence_until < NOW())
+		AND ` + listedCondition + `
+		AND ($3::TIMESTAMPTZ IS NULL OR (` + pastStart + `, event.id) < ($3::TIMESTAMPTZ, $4::INT))
 	GROUP BY event.id, media_url.url
-	ORDER BY event.event_start DESC
+	ORDER BY ` + pastStart + ` DESC, event.id DESC
 	LIMIT $1 OFFSET $2`
 
 func (db *EventDB) GetPastEvents(ctx context.Context, paginationParams models.PaginationParams) ([]models.Event, error) {
-	rows, err := db.pool.Query(ctx, selectPastEventsQuery, paginationParams.Limit, paginationParams.Offset)
+	cursorStart, cursorID := cursorArgs(paginationParams.Cursor)
+	rows, err := db.pool.Query(ctx, selectPastEventsQuery, paginationParams.Limit, paginationParams.Offset, cursorStart, cursorID)
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", models.LevelDB, err)
 	}
-	defer rows.Close()
-
-	events := make([]models.Event, 0, paginationParams.Limit)
-	for rows.Next() {
-		var eventInfo EventInfo
-		err = rows.Scan(
-			&eventInfo.ID,
-			&eventInfo.Title,
-			&eventInfo.Description,
-			&eventInfo.EventStart,
-			&eventInfo.EventFinish,
-			&eventInfo.Location,
-			&eventInfo.Capacity,
-			&eventInfo.CreatedAt,
-			&eventInfo.UserID,
-			&eventInfo.CategoryID,
-			&eventInfo.Latitude,
-			&eventInfo.Longitude,
-			&eventInfo.Tags,
-			&eventInfo.ImageURL,
-		)
-		if err != nil {
-			return nil, fmt.Errorf("%s: %w", models.LevelDB, err)
-		}
-		event, err := db.toDomainEvent(ctx, eventInfo)
-		if err != nil {
-			continue
-		}
-		events = append(events, event)
+	infos, err := scanEventInfos(rows)
+	if err != nil {
+		return nil, err
 	}
 
-	return events, nil
+	now := time.Now()
+	for i := range infos {
+		infos[i] = nextOccurrence(infos[i], now)
+	}
+	return db.toDomainEvents(ctx, infos), nil
 }
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"kudago/internal/models"
 	"testing"
+	"time"
 
 	"github.com/pashagolub/pgxmock/v4"
 	"github.com/stretchr/testify/assert"
@@ -17,6 +18,13 @@ func TestEventsRepository_GetPastEvents(t *testing.T) {
 	ctx := context.Background()
 	//eventStart := time.Now().Add(-10 * time.Hour)
 	//eventFinish := eventStart.Add(5 * time.Hour)
+	cursorStart := time.Date(2024, time.March, 1, 18, 0, 0, 0, time.UTC)
+	seriesStart := time.Date(2024, time.January, 8, 19, 0, 0, 0, time.UTC)
+	weeklyRule := "FREQ=WEEKLY;COUNT=3"
+	pastColumns := []string{
+		"id", "title", "description", "event_start", "event_finish", "location", "capacity", "created_at",
+		"user_id", "category_id", "lat", "lon", "tags", "media_link", "attendees", "favorites_count", "status", "publish_at", "recurrence_rule", "recurrence_exdates",
+	}
 
 	tests := []struct {
 		name           string
@@ -86,17 +94,59 @@ func TestEventsRepository_GetPastEvents(t *testing.T) {
 				Offset: 0,
 			},
 			mockSetup: func(m pgxmock.PgxConnIface) {
-				rows := m.NewRows([]string{
-					"id", "title", "description", "event_start", "event_finish",
-					"location", "capacity", "created_at", "user_id", "category_id", "tags", "media_link",
-				})
+				rows := m.NewRows(pastColumns)
 				m.ExpectQuery(`SELECT event.id, event.title, event.description, event.event_start, event.event_finish`).
-					WithArgs(2, 0).
+					WithArgs(2, 0, nil, nil).
 					WillReturnRows(rows)
 			},
 			expectErr:      false,
 			expectedEvents: []models.Event{},
 		},
+		{
+			name: "страница после курсора",
+			pagination: models.PaginationParams{
+				Limit:  2,
+				Cursor: &models.Cursor{EventStart: cursorStart, ID: 7},
+			},
+			mockSetup: func(m pgxmock.PgxConnIface) {
+				rows := m.NewRows(pastColumns)
+				m.ExpectQuery(`\(COALESCE\(event.recurrence_until - \(event.event_finish - event.event_start\), event.event_start\), event.id\) < \(\$3::TIMESTAMPTZ, \$4::INT\)`).
+					WithArgs(2, 0, cursorStart, 7).
+					WillReturnRows(rows)
+			},
+			expectErr:      false,
+			expectedEvents: []models.Event{},
+		},
+		{
+			name: "закончившаяся серия по последнему повторению",
+			pagination: models.PaginationParams{
+				Limit: 2,
+			},
+			mockSetup: func(m pgxmock.PgxConnIface) {
+				rows := m.NewRows(pastColumns).
+					AddRow(3, "Лекции", "", seriesStart, seriesStart.Add(2*time.Hour), "", 0, seriesStart, 1, 1, 0.0, 0.0,
+						[]string{}, nil, 0, 0, "published", nil, &weeklyRule, []time.Time{})
+				m.ExpectQuery(`(?s)WHERE \(\(event.recurrence_rule IS NULL AND event.event_finish < NOW\(\)\) OR event.recurrence_until < NOW\(\)\).*`+
+					`ORDER BY COALESCE\(event.recurrence_until - \(event.event_finish - event.event_start\), event.event_start\) DESC, event.id DESC`).
+					WithArgs(2, 0, nil, nil).
+					WillReturnRows(rows)
+			},
+			expectedEvents: []models.Event{
+				{
+					ID:             3,
+					Title:          "Лекции",
+					EventStart:     seriesStart.AddDate(0, 0, 14).Format(time.RFC3339),
+					EventEnd:       seriesStart.AddDate(0, 0, 14).Add(2 * time.Hour).Format(time.RFC3339),
+					AuthorID:       1,
+					CategoryID:     1,
+					Tag:            []string{},
+					Status:         "published",
+					RecurrenceRule: weeklyRule,
+					SeriesStart:    seriesStart.Format(time.RFC3339),
+					Start:          seriesStart.AddDate(0, 0, 14),
+				},
+			},
+		},
 		{
 			name: "ошибка запроса",
 			pagination: models.PaginationParams{
@@ -105,7 +155,7 @@ func TestEventsRepository_GetPastEvents(t *testing.T) {
 			},
 			mockSetup: func(m pgxmock.PgxConnIface) {
 				m.ExpectQuery(`SELECT event.id, event.title, event.description, event.event_start, event.event_finish`).
-					WithArgs(2, 0).
+					WithArgs(2, 0, nil, nil).
 					WillReturnError(errors.New("query error"))
 			},
 			expectErr:      true,
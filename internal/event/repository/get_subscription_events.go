@@ -7,56 +7,53 @@ import (
 	"kudago/internal/models"
 )
 
-const getSubscriptionEventsQuery = `
+const selectSubscriptionEventsBase = `
 	SELECT event.id, event.title, event.description, event.event_start, event.event_finish, 
 		event.location, event.capacity, event.created_at, event.user_id, event.category_id, event.lat, event.lon, 
-		COALESCE(array_agg(COALESCE(tag.name, '')), '{}') AS tags, media_url.url AS media_link
+		COALESCE(array_agg(DISTINCT COALESCE(tag.name, '')), '{}') AS tags, media_url.url AS media_link,
+		(SELECT COUNT(*) FROM attendance WHERE attendance.event_id = event.id) AS attendees,
+		(SELECT COUNT(*) FROM FAVORITE_EVENT WHERE FAVORITE_EVENT.event_id = event.id) AS favorites_count,
+		event.status, event.publish_at,
+		event.recurrence_rule, event.recurrence_exdates
 	FROM event
 	INNER JOIN SUBSCRIPTION ON event.user_id = SUBSCRIPTION.follows_id
 	LEFT JOIN event_tag ON event.id = event_tag.event_id
 	LEFT JOIN tag ON tag.id = event_tag.tag_id
-	LEFT JOIN media_url ON event.id = media_url.event_id
-	WHERE SUBSCRIPTION.subscriber_id=$1 
+	LEFT JOIN media_url ON event.id = media_url.event_id AND media_url.is_cover
+	WHERE SUBSCRIPTION.subscriber_id=$1 AND ` + listedCondition
+
+// Single events are paged in SQL, taken as offset+limit rows; series are
+// listed by their next occurrence and merged in.
+const getSubscriptionEventsQuery = selectSubscriptionEventsBase + `
+		AND event.recurrence_rule IS NULL
+		AND ($3::TIMESTAMPTZ IS NULL OR (event.event_start, event.id) > ($3::TIMESTAMPTZ, $4::INT))
 	GROUP BY event.id, media_url.url
-	ORDER BY event.event_finish ASC
-	LIMIT $2 OFFSET $3`
+	ORDER BY event.event_start ASC, event.id ASC
+	LIMIT $2`
+
+const getSubscriptionSeriesQuery = selectSubscriptionEventsBase + `
+		AND event.recurrence_rule IS NOT NULL
+	GROUP BY event.id, media_url.url`
 
 func (db *EventDB) GetSubscriptionEvents(ctx context.Context, userID int, paginationParams models.PaginationParams) ([]models.Event, error) {
-	rows, err := db.pool.Query(ctx, getSubscriptionEventsQuery, userID, paginationParams.Limit, paginationParams.Offset)
+	cursorStart, cursorID := cursorArgs(paginationParams.Cursor)
+	rows, err := db.pool.Query(ctx, getSubscriptionEventsQuery, userID, paginationParams.Offset+paginationParams.Limit, cursorStart, cursorID)
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", models.LevelDB, err)
 	}
-	defer rows.Close()
-
-	var events []models.Event
-	for rows.Next() {
-		var eventInfo EventInfo
-		err = rows.Scan(
-			&eventInfo.ID,
-			&eventInfo.Title,
-			&eventInfo.Description,
-			&eventInfo.EventStart,
-			&eventInfo.EventFinish,
-			&eventInfo.Location,
-			&eventInfo.Capacity,
-			&eventInfo.CreatedAt,
-			&eventInfo.UserID,
-			&eventInfo.CategoryID,
-			&eventInfo.Latitude,
-			&eventInfo.Longitude,
-			&eventInfo.Tags,
-			&eventInfo.ImageURL,
-		)
-		if err != nil {
-			return nil, fmt.Errorf("%s: %w", models.LevelDB, err)
-		}
-
-		event, err := db.toDomainEvent(ctx, eventInfo)
-		if err != nil {
-			continue
-		}
-		events = append(events, event)
+	infos, err := scanEventInfos(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err = db.pool.Query(ctx, getSubscriptionSeriesQuery, userID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", models.LevelDB, err)
+	}
+	series, err := scanEventInfos(rows)
+	if err != nil {
+		return nil, err
 	}
 
-	return events, nil
+	return db.toDomainEvents(ctx, mergeSeries(infos, series, paginationParams)), nil
 }
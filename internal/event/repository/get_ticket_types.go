@@ -0,0 +1,62 @@
+package eventRepository
+
+import (
+	"context"
+	"fmt"
+
+	"kudago/internal/models"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+)
+
+const selectEventCapacityQuery = `SELECT COALESCE(capacity, 0) FROM event WHERE id = $1`
+
+const selectTicketTypesQuery = `
+	SELECT id, event_id, name, price
+	FROM ticket_type
+	WHERE event_id = $1
+	ORDER BY price, id`
+
+func (db *EventDB) GetTicketTypes(ctx context.Context, eventID int) (models.TicketTypes, error) {
+	var capacity int
+	err := db.pool.QueryRow(ctx, selectEventCapacityQuery, eventID).Scan(&capacity)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.TicketTypes{}, fmt.Errorf("%s: %w", models.LevelDB, models.ErrEventNotFound)
+		}
+		return models.TicketTypes{}, fmt.Errorf("%s: %w", models.LevelDB, err)
+	}
+
+	rows, err := db.pool.Query(ctx, selectTicketTypesQuery, eventID)
+	if err != nil {
+		return models.TicketTypes{}, fmt.Errorf("%s: %w", models.LevelDB, err)
+	}
+	defer rows.Close()
+
+	var result models.TicketTypes
+	for rows.Next() {
+		var ticketType models.TicketType
+		err = rows.Scan(&ticketType.ID, &ticketType.EventID, &ticketType.Name, &ticketType.Price)
+		if err != nil {
+			return models.TicketTypes{}, fmt.Errorf("%s: %w", models.LevelDB, err)
+		}
+		result.Types = append(result.Types, ticketType)
+	}
+	if err = rows.Err(); err != nil {
+		return models.TicketTypes{}, fmt.Errorf("%s: %w", models.LevelDB, err)
+	}
+
+	if capacity > 0 {
+		var taken int
+		err = db.pool.QueryRow(ctx, countTakenSpotsQuery, eventID).Scan(&taken)
+		if err != nil {
+			return models.TicketTypes{}, fmt.Errorf("%s: %w", models.LevelDB, err)
+		}
+
+		remaining := max(capacity-taken, 0)
+		result.Remaining = &remaining
+	}
+
+	return result, nil
+}
@@ -0,0 +1,109 @@
+package eventRepository
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"kudago/internal/models"
+)
+
+func TestEventRepository_GetTicketTypes(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	remaining := 4
+
+	tests := []struct {
+		name        string
+		mockSetup   func(m pgxmock.PgxConnIface)
+		expected    models.TicketTypes
+		expectedErr error
+	}{
+		{
+			name: "успешное выполнение",
+			mockSetup: func(m pgxmock.PgxConnIface) {
+				m.ExpectQuery(`SELECT COALESCE\(capacity, 0\) FROM event`).
+					WithArgs(1).
+					WillReturnRows(pgxmock.NewRows([]string{"capacity"}).AddRow(10))
+				m.ExpectQuery(`SELECT id, event_id, name, price`).
+					WithArgs(1).
+					WillReturnRows(pgxmock.NewRows([]string{"id", "event_id", "name", "price"}).
+						AddRow(1, 1, "Standard", 500.0).
+						AddRow(2, 1, "VIP", 1500.0))
+				m.ExpectQuery(`SELECT \(SELECT COUNT\(\*\) FROM attendance`).
+					WithArgs(1).
+					WillReturnRows(pgxmock.NewRows([]string{"sum"}).AddRow(6))
+			},
+			expected: models.TicketTypes{
+				Types: []models.TicketType{
+					{ID: 1, EventID: 1, Name: "Standard", Price: 500.0},
+					{ID: 2, EventID: 1, Name: "VIP", Price: 1500.0},
+				},
+				Remaining: &remaining,
+			},
+		},
+		{
+			name: "без ограничения вместимости",
+			mockSetup: func(m pgxmock.PgxConnIface) {
+				m.ExpectQuery(`SELECT COALESCE\(capacity, 0\) FROM event`).
+					WithArgs(1).
+					WillReturnRows(pgxmock.NewRows([]string{"capacity"}).AddRow(0))
+				m.ExpectQuery(`SELECT id, event_id, name, price`).
+					WithArgs(1).
+					WillReturnRows(pgxmock.NewRows([]string{"id", "event_id", "name", "price"}).
+						AddRow(1, 1, "Standard", 500.0))
+			},
+			expected: models.TicketTypes{
+				Types: []models.TicketType{
+					{ID: 1, EventID: 1, Name: "Standard", Price: 500.0},
+				},
+			},
+		},
+		{
+			name: "событие не найдено",
+			mockSetup: func(m pgxmock.PgxConnIface) {
+				m.ExpectQuery(`SELECT COALESCE\(capacity, 0\) FROM event`).
+					WithArgs(1).
+					WillReturnRows(pgxmock.NewRows([]string{"capacity"}))
+			},
+			expectedErr: models.ErrEventNotFound,
+		},
+		{
+			name: "ошибка базы данных",
+			mockSetup: func(m pgxmock.PgxConnIface) {
+				m.ExpectQuery(`SELECT COALESCE\(capacity, 0\) FROM event`).
+					WithArgs(1).
+					WillReturnError(fmt.Errorf("database error"))
+			},
+			expectedErr: fmt.Errorf("database error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockConn, err := pgxmock.NewConn()
+			require.NoError(t, err)
+			defer mockConn.Close(ctx)
+
+			tt.mockSetup(mockConn)
+
+			db := NewDB(mockConn)
+			ticketTypes, err := db.GetTicketTypes(ctx, 1)
+
+			if tt.expectedErr != nil {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, ticketTypes)
+			}
+		})
+	}
+}
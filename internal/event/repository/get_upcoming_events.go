@@ -3,58 +3,108 @@ package eventRepository
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"kudago/internal/models"
 )
 
-const selectUpcomingEventsQuery = `
-	SELECT event.id, event.title, event.description, event.event_start, event.event_finish, 
-		event.location, event.capacity, event.created_at, event.user_id, event.category_id, event.lat, event.lon, 
-		COALESCE(array_agg(DISTINCT COALESCE(tag.name, '')), '{}') AS tags, media_url.url AS media_link
+const selectUpcomingEventsBase = `
+	SELECT event.id, event.title, event.description, event.event_start, event.event_finish,
+		event.location, event.capacity, event.created_at, event.user_id, event.category_id, event.lat, event.lon,
+		COALESCE(array_agg(DISTINCT COALESCE(tag.name, '')), '{}') AS tags, media_url.url AS media_link,
+		(SELECT COUNT(*) FROM attendance WHERE attendance.event_id = event.id) AS attendees,
+		(SELECT COUNT(*) FROM FAVORITE_EVENT WHERE FAVORITE_EVENT.event_id = event.id) AS favorites_count,
+		event.status, event.publish_at,
+		event.recurrence_rule, event.recurrence_exdates
 	FROM event
 	LEFT JOIN event_tag ON event.id = event_tag.event_id
 	LEFT JOIN tag ON tag.id = event_tag.tag_id
-	LEFT JOIN media_url ON event.id = media_url.event_id
-	WHERE event.event_finish >= NOW()
+	LEFT JOIN media_url ON event.id = media_url.event_id AND media_url.is_cover`
+
+// Single events are paged in SQL. The page is taken with offset+limit rows
+// and without OFFSET because series occurrences are merged in before slicing.
+const selectUpcomingEventsQuery = selectUpcomingEventsBase + `
+	WHERE event.event_finish >= NOW() AND event.recurrence_rule IS NULL AND ` + listedCondition + `
+		AND ($2::TIMESTAMPTZ IS NULL OR (event.event_start, event.id) > ($2::TIMESTAMPTZ, $3::INT))
+		AND ($4::INT IS NULL OR event.category_id = $4)
 	GROUP BY event.id, media_url.url
-	ORDER BY event.event_start ASC
-	LIMIT $1 OFFSET $2`
+	ORDER BY event.event_start ASC, event.id ASC
+	LIMIT $1`
+
+// Series are only loaded when they have occurrences within [$1, $2], the
+// part of the feed the page can take occurrences from.
+const selectUpcomingSeriesQuery = selectUpcomingEventsBase + `
+	WHERE event.recurrence_rule IS NOT NULL AND ` + listedCondition + `
+		AND event.event_start <= $2
+		AND (event.recurrence_until IS NULL OR event.recurrence_until >= $1)
+		AND ($3::INT IS NULL OR event.category_id = $3)
+	GROUP BY event.id, media_url.url`
 
 func (db *EventDB) GetUpcomingEvents(ctx context.Context, paginationParams models.PaginationParams) ([]models.Event, error) {
-	rows, err := db.pool.Query(ctx, selectUpcomingEventsQuery, paginationParams.Limit, paginationParams.Offset)
+	return db.getUpcomingEvents(ctx, nil, paginationParams)
+}
+
+// getUpcomingEvents lists upcoming events and occurrences of series by date;
+// a nil categoryID lists all categories.
+func (db *EventDB) getUpcomingEvents(ctx context.Context, categoryID interface{}, paginationParams models.PaginationParams) ([]models.Event, error) {
+	cursorStart, cursorID := cursorArgs(paginationParams.Cursor)
+	rows, err := db.pool.Query(ctx, selectUpcomingEventsQuery, paginationParams.Offset+paginationParams.Limit, cursorStart, cursorID, categoryID)
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", models.LevelDB, err)
 	}
-	defer rows.Close()
-
-	events := make([]models.Event, 0, paginationParams.Limit)
-	for rows.Next() {
-		var eventInfo EventInfo
-		err = rows.Scan(
-			&eventInfo.ID,
-			&eventInfo.Title,
-			&eventInfo.Description,
-			&eventInfo.EventStart,
-			&eventInfo.EventFinish,
-			&eventInfo.Location,
-			&eventInfo.Capacity,
-			&eventInfo.CreatedAt,
-			&eventInfo.UserID,
-			&eventInfo.CategoryID,
-			&eventInfo.Latitude,
-			&eventInfo.Longitude,
-			&eventInfo.Tags,
-			&eventInfo.ImageURL,
-		)
-		if err != nil {
-			return nil, fmt.Errorf("%s: %w", models.LevelDB, err)
+	infos, err := scanEventInfos(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	from, to := upcomingWindow(infos, paginationParams)
+	rows, err = db.pool.Query(ctx, selectUpcomingSeriesQuery, from, to, categoryID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", models.LevelDB, err)
+	}
+	series, err := scanEventInfos(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	pageSize := paginationParams.Offset + paginationParams.Limit
+	for _, eventInfo := range series {
+		for _, occurrence := range expandOccurrences(eventInfo, from, to, pageSize) {
+			if afterCursor(occurrence, paginationParams.Cursor) {
+				infos = append(infos, occurrence)
+			}
 		}
-		event, err := db.toDomainEvent(ctx, eventInfo)
-		if err != nil {
-			continue
+	}
+
+	sortByStart(infos)
+	return db.toDomainEvents(ctx, pageInfos(infos, paginationParams)), nil
+}
+
+// upcomingWindow returns the dates series occurrences can fill the page from.
+// It starts at the cursor and ends at the horizon, or at the last single event
+// when those already fill the page: later occurrences would not make it.
+func upcomingWindow(singles []EventInfo, paginationParams models.PaginationParams) (time.Time, time.Time) {
+	now := time.Now()
+	from, to := now, now.Add(upcomingHorizon)
+	if cursor := paginationParams.Cursor; cursor != nil && cursor.EventStart.After(from) {
+		from = cursor.EventStart
+	}
+
+	pageSize := paginationParams.Offset + paginationParams.Limit
+	if len(singles) > 0 && len(singles) == pageSize {
+		if last := singles[len(singles)-1].EventStart; last.Before(to) {
+			to = last
 		}
-		events = append(events, event)
 	}
+	return from, to
+}
 
-	return events, nil
+func afterCursor(eventInfo EventInfo, cursor *models.Cursor) bool {
+	if cursor == nil {
+		return true
+	}
+	if c := eventInfo.EventStart.Compare(cursor.EventStart); c != 0 {
+		return c > 0
+	}
+	return eventInfo.ID > cursor.ID
 }
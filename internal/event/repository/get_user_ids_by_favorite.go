@@ -8,11 +8,15 @@ import (
 )
 
 const selectUserIDsByFavoriteEvent = `
-	SELECT user_id FROM FAVORITE_EVENT 
-	WHERE event_id=$1`
+	SELECT DISTINCT user_id FROM FAVORITE_EVENT
+	WHERE event_id=$1
+		AND ($2::TIMESTAMPTZ IS NULL OR occurrence_start IS NULL OR occurrence_start = $2)`
 
-func (db *EventDB) GetUserIDsByFavoriteEvent(ctx context.Context, eventID int) ([]int, error) {
-	rows, err := db.pool.Query(ctx, selectUserIDsByFavoriteEvent, eventID)
+// GetUserIDsByFavoriteEvent returns users who saved the event. For a series an
+// occurrence start narrows it down to users who saved the whole series or
+// that occurrence.
+func (db *EventDB) GetUserIDsByFavoriteEvent(ctx context.Context, eventID int, occurrenceStart string) ([]int, error) {
+	rows, err := db.pool.Query(ctx, selectUserIDsByFavoriteEvent, eventID, nilIfEmpty(occurrenceStart))
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", models.LevelDB, err)
 	}
@@ -0,0 +1,49 @@
+package eventRepository
+
+import (
+	"context"
+	"fmt"
+
+	"kudago/internal/models"
+)
+
+const selectUserTicketsQuery = `
+	SELECT id, event_id, COALESCE(ticket_type_id, 0), user_id, type, price, quantity, status, ticket_buy_date, reserved_until
+	FROM ticket
+	WHERE user_id = $1 AND (status = 'paid' OR reserved_until > NOW())
+	ORDER BY id DESC
+	LIMIT $2 OFFSET $3`
+
+func (db *EventDB) GetUserTickets(ctx context.Context, userID int, paginationParams models.PaginationParams) ([]models.Ticket, error) {
+	rows, err := db.pool.Query(ctx, selectUserTicketsQuery, userID, paginationParams.Limit, paginationParams.Offset)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", models.LevelDB, err)
+	}
+	defer rows.Close()
+
+	tickets := make([]models.Ticket, 0, paginationParams.Limit)
+	for rows.Next() {
+		var ticketInfo TicketInfo
+		err = rows.Scan(
+			&ticketInfo.ID,
+			&ticketInfo.EventID,
+			&ticketInfo.TicketTypeID,
+			&ticketInfo.UserID,
+			&ticketInfo.Type,
+			&ticketInfo.Price,
+			&ticketInfo.Quantity,
+			&ticketInfo.Status,
+			&ticketInfo.BoughtAt,
+			&ticketInfo.ReservedUntil,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", models.LevelDB, err)
+		}
+		tickets = append(tickets, toDomainTicket(ticketInfo))
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", models.LevelDB, err)
+	}
+
+	return tickets, nil
+}
@@ -0,0 +1,108 @@
+package eventRepository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"kudago/internal/models"
+)
+
+// upcomingCondition keeps events that haven't finished yet, including series
+// with occurrences still ahead.
+const upcomingCondition = `(event.event_finish >= NOW()
+		OR (event.recurrence_rule IS NOT NULL AND (event.recurrence_until IS NULL OR event.recurrence_until >= NOW())))`
+
+const selectRecommendationSignalsQuery = `
+	SELECT EXISTS (SELECT 1 FROM FAVORITE_EVENT WHERE user_id = $1)
+		OR EXISTS (SELECT 1 FROM SUBSCRIPTION WHERE subscriber_id = $1)`
+
+// HasRecommendationSignals reports whether the user has favorites or
+// subscriptions to build recommendations from.
+func (db *EventDB) HasRecommendationSignals(ctx context.Context, userID int) (bool, error) {
+	var hasSignals bool
+	err := db.pool.QueryRow(ctx, selectRecommendationSignalsQuery, userID).Scan(&hasSignals)
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", models.LevelDB, err)
+	}
+	return hasSignals, nil
+}
+
+// The score adds up the share of the user's favorites in the event category,
+// the shares of its tags, a bonus for followed authors and a damped
+// popularity, so a long favorites list doesn't drown out the rest. Events
+// the user already saved or organizes are not recommended.
+const selectRecommendedEventsQuery = `
+	WITH liked AS (
+		SELECT DISTINCT event_id FROM FAVORITE_EVENT WHERE user_id = $1
+	), liked_total AS (
+		SELECT GREATEST(COUNT(*), 1)::FLOAT AS total FROM liked
+	), liked_categories AS (
+		SELECT event.category_id, COUNT(*) / (SELECT total FROM liked_total) AS share
+		FROM liked
+		JOIN event ON event.id = liked.event_id
+		GROUP BY event.category_id
+	), liked_tags AS (
+		SELECT event_tag.tag_id, COUNT(*) / (SELECT total FROM liked_total) AS share
+		FROM liked
+		JOIN event_tag ON event_tag.event_id = liked.event_id
+		GROUP BY event_tag.tag_id
+	), candidates AS (
+		SELECT event.id,
+			3 * COALESCE((SELECT share FROM liked_categories WHERE liked_categories.category_id = event.category_id), 0)
+			+ 2 * COALESCE((SELECT SUM(share) FROM liked_tags
+				JOIN event_tag ON event_tag.tag_id = liked_tags.tag_id
+				WHERE event_tag.event_id = event.id), 0)
+			+ CASE WHEN EXISTS (SELECT 1 FROM SUBSCRIPTION
+				WHERE subscriber_id = $1 AND follows_id = event.user_id) THEN 2 ELSE 0 END
+			+ 0.5 * LN(1 + (SELECT COUNT(*) FROM FAVORITE_EVENT WHERE FAVORITE_EVENT.event_id = event.id)
+				+ (SELECT COUNT(*) FROM attendance WHERE attendance.event_id = event.id)) AS score
+		FROM event
+		WHERE ` + upcomingCondition + ` AND ` + listedCondition + `
+			AND event.user_id <> $1
+			AND event.id NOT IN (SELECT event_id FROM liked)
+	)
+	SELECT event.id, event.title, event.description, event.event_start, event.event_finish,
+		event.location, event.capacity, event.created_at, event.user_id, event.category_id, event.lat, event.lon,
+		COALESCE(array_agg(DISTINCT COALESCE(tag.name, '')), '{}') AS tags, media_url.url AS media_link,
+		(SELECT COUNT(*) FROM attendance WHERE attendance.event_id = event.id) AS attendees,
+		(SELECT COUNT(*) FROM FAVORITE_EVENT WHERE FAVORITE_EVENT.event_id = event.id) AS favorites_count,
+		event.status, event.publish_at,
+		event.recurrence_rule, event.recurrence_exdates
+	FROM candidates
+	JOIN event ON event.id = candidates.id
+	LEFT JOIN event_tag ON event.id = event_tag.event_id
+	LEFT JOIN tag ON tag.id = event_tag.tag_id
+	LEFT JOIN media_url ON event.id = media_url.event_id AND media_url.is_cover
+	GROUP BY event.id, media_url.url, candidates.score
+	ORDER BY candidates.score DESC, event.event_start ASC, event.id ASC
+	LIMIT $2 OFFSET $3`
+
+func (db *EventDB) GetRecommendedEvents(ctx context.Context, userID int, paginationParams models.PaginationParams) ([]models.Event, error) {
+	rows, err := db.pool.Query(ctx, selectRecommendedEventsQuery, userID, paginationParams.Limit, paginationParams.Offset)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", models.LevelDB, err)
+	}
+	infos, err := scanEventInfos(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	return db.toNextOccurrences(ctx, infos, time.Now(), paginationParams.Limit), nil
+}
+
+// toNextOccurrences converts ranked rows keeping their order; a series is
+// shown by its next occurrence.
+func (db *EventDB) toNextOccurrences(ctx context.Context, infos []EventInfo, now time.Time, limit int) []models.Event {
+	events := make([]models.Event, 0, limit)
+	for _, eventInfo := range infos {
+		for _, occurrence := range expandOccurrences(eventInfo, now, time.Time{}, 1) {
+			event, err := db.toDomainEvent(ctx, occurrence)
+			if err != nil {
+				continue
+			}
+			events = append(events, event)
+		}
+	}
+	return events
+}
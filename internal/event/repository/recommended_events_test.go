@@ -0,0 +1,112 @@
+package eventRepository
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"kudago/internal/models"
+)
+
+func TestEventRepository_GetRecommendedEvents(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	eventStart := time.Now().Add(24 * time.Hour).Truncate(time.Second)
+	eventEnd := eventStart.Add(2 * time.Hour)
+	weekly := "FREQ=WEEKLY"
+	seriesStart := eventStart.Add(-7*24*time.Hour - time.Hour)
+	columns := []string{
+		"id", "title", "description", "event_start", "event_finish", "location", "capacity", "created_at",
+		"user_id", "category_id", "lat", "lon", "tags", "media_link", "attendees", "favorites_count", "status", "publish_at", "recurrence_rule", "recurrence_exdates",
+	}
+	pattern := `(?s)WITH liked AS .*ORDER BY candidates.score DESC, event.event_start ASC, event.id ASC\s+LIMIT \$2 OFFSET \$3`
+
+	tests := []struct {
+		name           string
+		mockSetup      func(m pgxmock.PgxConnIface)
+		expectedEvents []models.Event
+		expectErr      bool
+	}{
+		{
+			name: "порядок по релевантности и ближайшее повторение серии",
+			mockSetup: func(m pgxmock.PgxConnIface) {
+				m.ExpectQuery(pattern).
+					WithArgs(1, 10, 0).
+					WillReturnRows(pgxmock.NewRows(columns).
+						AddRow(5, "Джаз", "", eventStart, eventEnd, "", 0, eventStart, 2, 3, 0.0, 0.0, []string{"джаз"}, nil, 0, 0, "published", nil, nil, []time.Time{}).
+						AddRow(4, "Йога", "", seriesStart, seriesStart.Add(time.Hour), "", 0, seriesStart, 2, 1, 0.0, 0.0, []string{}, nil, 0, 0, "published", nil, &weekly, []time.Time{}))
+			},
+			expectedEvents: []models.Event{
+				{
+					ID: 5, Title: "Джаз", AuthorID: 2, CategoryID: 3, Tag: []string{"джаз"}, Status: "published",
+					EventStart: eventStart.Format(time.RFC3339), EventEnd: eventEnd.Format(time.RFC3339), Start: eventStart,
+				},
+				{
+					ID: 4, Title: "Йога", AuthorID: 2, CategoryID: 1, Tag: []string{}, RecurrenceRule: weekly, Status: "published",
+					EventStart:  seriesStart.Add(7 * 24 * time.Hour).Format(time.RFC3339),
+					EventEnd:    seriesStart.Add(7*24*time.Hour + time.Hour).Format(time.RFC3339),
+					SeriesStart: seriesStart.Format(time.RFC3339),
+					Start:       seriesStart.Add(7 * 24 * time.Hour),
+				},
+			},
+		},
+		{
+			name: "ошибка базы данных",
+			mockSetup: func(m pgxmock.PgxConnIface) {
+				m.ExpectQuery(pattern).
+					WithArgs(1, 10, 0).
+					WillReturnError(fmt.Errorf("database error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockConn, err := pgxmock.NewConn()
+			require.NoError(t, err)
+			defer mockConn.Close(ctx)
+
+			tt.mockSetup(mockConn)
+
+			db := EventDB{pool: mockConn}
+			events, err := db.GetRecommendedEvents(ctx, 1, models.PaginationParams{Limit: 10})
+
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedEvents, events)
+			}
+			require.NoError(t, mockConn.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestEventRepository_HasRecommendationSignals(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	mockConn, err := pgxmock.NewConn()
+	require.NoError(t, err)
+	defer mockConn.Close(ctx)
+
+	mockConn.ExpectQuery(`SELECT EXISTS \(SELECT 1 FROM FAVORITE_EVENT WHERE user_id = \$1\)`).
+		WithArgs(1).
+		WillReturnRows(pgxmock.NewRows([]string{"exists"}).AddRow(false))
+
+	db := EventDB{pool: mockConn}
+	hasSignals, err := db.HasRecommendationSignals(ctx, 1)
+
+	require.NoError(t, err)
+	assert.False(t, hasSignals)
+	require.NoError(t, mockConn.ExpectationsWereMet())
+}
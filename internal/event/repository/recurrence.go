@@ -0,0 +1,174 @@
+package eventRepository
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"kudago/internal/event/recurrence"
+	"kudago/internal/models"
+)
+
+const (
+	// upcomingHorizon bounds how far ahead endless series are expanded in the
+	// upcoming feed.
+	upcomingHorizon = 90 * 24 * time.Hour
+	// maxOccurrencesPerSeries caps the occurrences a series adds to search
+	// results ordered by relevance or distance.
+	maxOccurrencesPerSeries = 50
+)
+
+func recurrenceRule(eventInfo EventInfo) string {
+	if eventInfo.RecurrenceRule == nil {
+		return ""
+	}
+	return *eventInfo.RecurrenceRule
+}
+
+func seriesStart(eventInfo EventInfo) *time.Time {
+	if eventInfo.SeriesStart.IsZero() {
+		return nil
+	}
+	return &eventInfo.SeriesStart
+}
+
+func formatTimes(times []time.Time) []string {
+	if len(times) == 0 {
+		return nil
+	}
+
+	formatted := make([]string, 0, len(times))
+	for _, t := range times {
+		formatted = append(formatted, t.Format(time.RFC3339))
+	}
+	return formatted
+}
+
+// recurrenceArgs returns recurrence_rule, recurrence_exdates and
+// recurrence_until for an event; recurrence_until is when the last
+// occurrence finishes, nil for endless series.
+func recurrenceArgs(event models.Event) (interface{}, []time.Time, interface{}, error) {
+	exdates := make([]time.Time, 0, len(event.RecurrenceExceptions))
+	for _, exception := range event.RecurrenceExceptions {
+		exdate, err := time.Parse(time.RFC3339, exception)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("%w: %w", models.ErrInvalidRecurrence, err)
+		}
+		exdates = append(exdates, exdate)
+	}
+
+	if event.RecurrenceRule == "" {
+		return nil, exdates, nil, nil
+	}
+
+	rule, err := recurrence.Parse(event.RecurrenceRule)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("%w: %w", models.ErrInvalidRecurrence, err)
+	}
+
+	eventStart, err := time.Parse(time.RFC3339, event.EventStart)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("%w: %w", models.ErrInvalidRecurrence, err)
+	}
+	eventEnd, err := time.Parse(time.RFC3339, event.EventEnd)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("%w: %w", models.ErrInvalidRecurrence, err)
+	}
+
+	last := rule.Last(eventStart)
+	if last.IsZero() {
+		return event.RecurrenceRule, exdates, nil, nil
+	}
+	return event.RecurrenceRule, exdates, last.Add(eventEnd.Sub(eventStart)), nil
+}
+
+// expandOccurrences turns a series into one copy per occurrence overlapping
+// [from, to]. With a zero to only the next occurrence is returned. Rows that
+// aren't series, or carry a broken rule, are returned unchanged.
+func expandOccurrences(eventInfo EventInfo, from, to time.Time, limit int) []EventInfo {
+	if eventInfo.RecurrenceRule == nil {
+		return []EventInfo{eventInfo}
+	}
+
+	rule, err := recurrence.Parse(*eventInfo.RecurrenceRule)
+	if err != nil {
+		return []EventInfo{eventInfo}
+	}
+
+	if to.IsZero() {
+		limit = 1
+	}
+
+	duration := eventInfo.EventFinish.Sub(eventInfo.EventStart)
+	starts := rule.Between(eventInfo.EventStart, from.Add(-duration), to, eventInfo.RecurrenceExdates, limit)
+
+	occurrences := make([]EventInfo, 0, len(starts))
+	for _, start := range starts {
+		occurrence := eventInfo
+		occurrence.SeriesStart = eventInfo.EventStart
+		occurrence.EventStart = start
+		occurrence.EventFinish = start.Add(duration)
+		occurrences = append(occurrences, occurrence)
+	}
+	return occurrences
+}
+
+// sortByStart orders events and occurrences by date, with the ID breaking
+// ties the way the (event_start, id) cursor does.
+func sortByStart(infos []EventInfo) {
+	slices.SortFunc(infos, func(a, b EventInfo) int {
+		if c := a.EventStart.Compare(b.EventStart); c != 0 {
+			return c
+		}
+		return a.ID - b.ID
+	})
+}
+
+// pageInfos cuts the page out of results gathered from the start, as series
+// occurrences can only be paged once they are expanded.
+func pageInfos(infos []EventInfo, paginationParams models.PaginationParams) []EventInfo {
+	if paginationParams.Offset >= len(infos) {
+		return nil
+	}
+	return infos[paginationParams.Offset:min(len(infos), paginationParams.Offset+paginationParams.Limit)]
+}
+
+// nextOccurrence returns the occurrence a series is listed by when it is
+// shown once: the next one, or the last one of a series that has ended.
+// Single events are returned as they are.
+func nextOccurrence(eventInfo EventInfo, now time.Time) EventInfo {
+	if occurrences := expandOccurrences(eventInfo, now, time.Time{}, 1); len(occurrences) > 0 {
+		return occurrences[0]
+	}
+
+	rule, err := recurrence.Parse(*eventInfo.RecurrenceRule)
+	if err != nil {
+		return eventInfo
+	}
+	last := rule.Last(eventInfo.EventStart)
+	if last.IsZero() {
+		return eventInfo
+	}
+
+	duration := eventInfo.EventFinish.Sub(eventInfo.EventStart)
+	eventInfo.SeriesStart = eventInfo.EventStart
+	eventInfo.EventStart = last
+	eventInfo.EventFinish = last.Add(duration)
+	return eventInfo
+}
+
+// mergeSeries adds series, listed by their next occurrence, to the events
+// paged in SQL by (event_start, id) and cuts the page out of them. The SQL
+// rows have to be taken as offset+limit rows for the page to be complete.
+func mergeSeries(infos, series []EventInfo, paginationParams models.PaginationParams) []EventInfo {
+	now := time.Now()
+	for _, eventInfo := range series {
+		occurrence := nextOccurrence(eventInfo, now)
+		if afterCursor(occurrence, paginationParams.Cursor) {
+			infos = append(infos, occurrence)
+		}
+	}
+
+	sortByStart(infos)
+	return pageInfos(infos, paginationParams)
+}
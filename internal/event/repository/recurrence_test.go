@@ -0,0 +1,122 @@
+package eventRepository
+
+import (
+	"testing"
+	"time"
+
+	"kudago/internal/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpandOccurrences(t *testing.T) {
+	t.Parallel()
+
+	day := func(d int) time.Time {
+		return time.Date(2025, time.January, d, 19, 0, 0, 0, time.UTC)
+	}
+	weekly := "FREQ=WEEKLY;BYDAY=MO,TH"
+	series := EventInfo{
+		ID:                1,
+		EventStart:        day(6),
+		EventFinish:       day(6).Add(2 * time.Hour),
+		RecurrenceRule:    &weekly,
+		RecurrenceExdates: []time.Time{day(9)},
+	}
+
+	tests := []struct {
+		name     string
+		info     EventInfo
+		from     time.Time
+		to       time.Time
+		expected []time.Time
+	}{
+		{
+			name:     "повторения в окне без исключений",
+			info:     series,
+			from:     day(6),
+			to:       day(16),
+			expected: []time.Time{day(6), day(13), day(16)},
+		},
+		{
+			name:     "идущее повторение попадает в окно",
+			info:     series,
+			from:     day(13).Add(time.Hour),
+			to:       day(14),
+			expected: []time.Time{day(13)},
+		},
+		{
+			name:     "без конца окна только ближайшее повторение",
+			info:     series,
+			from:     day(10),
+			expected: []time.Time{day(13)},
+		},
+		{
+			name:     "обычное событие не меняется",
+			info:     EventInfo{ID: 2, EventStart: day(6), EventFinish: day(7)},
+			from:     day(10),
+			to:       day(20),
+			expected: []time.Time{day(6)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			occurrences := expandOccurrences(tt.info, tt.from, tt.to, maxOccurrencesPerSeries)
+
+			starts := make([]time.Time, 0, len(occurrences))
+			for _, occurrence := range occurrences {
+				assert.Equal(t, tt.info.EventFinish.Sub(tt.info.EventStart), occurrence.EventFinish.Sub(occurrence.EventStart))
+				starts = append(starts, occurrence.EventStart)
+			}
+			assert.Equal(t, tt.expected, starts)
+		})
+	}
+}
+
+func TestRecurrenceArgs(t *testing.T) {
+	t.Parallel()
+
+	event := models.Event{
+		EventStart:           "2025-01-06T19:00:00Z",
+		EventEnd:             "2025-01-06T21:00:00Z",
+		RecurrenceRule:       "FREQ=WEEKLY;COUNT=3",
+		RecurrenceExceptions: []string{"2025-01-13T19:00:00Z"},
+	}
+
+	rule, exdates, until, err := recurrenceArgs(event)
+	require.NoError(t, err)
+	assert.Equal(t, "FREQ=WEEKLY;COUNT=3", rule)
+	assert.Equal(t, []time.Time{time.Date(2025, time.January, 13, 19, 0, 0, 0, time.UTC)}, exdates)
+	assert.Equal(t, time.Date(2025, time.January, 20, 21, 0, 0, 0, time.UTC), until)
+
+	event.RecurrenceRule = "FREQ=DAILY"
+	_, _, until, err = recurrenceArgs(event)
+	require.NoError(t, err)
+	assert.Nil(t, until)
+
+	event.RecurrenceRule = "FREQ=SECONDLY"
+	_, _, _, err = recurrenceArgs(event)
+	assert.ErrorIs(t, err, models.ErrInvalidRecurrence)
+}
+
+func TestUpcomingWindow(t *testing.T) {
+	t.Parallel()
+
+	soon := time.Now().Add(24 * time.Hour)
+	later := time.Now().Add(48 * time.Hour)
+	singles := []EventInfo{{ID: 1, EventStart: soon}, {ID: 2, EventStart: later}}
+
+	from, to := upcomingWindow(singles, models.PaginationParams{Limit: 2})
+	assert.WithinDuration(t, time.Now(), from, time.Second)
+	assert.Equal(t, later, to)
+
+	_, to = upcomingWindow(singles, models.PaginationParams{Limit: 3})
+	assert.WithinDuration(t, time.Now().Add(upcomingHorizon), to, time.Second)
+
+	from, _ = upcomingWindow(nil, models.PaginationParams{Limit: 2, Cursor: &models.Cursor{EventStart: later, ID: 2}})
+	assert.Equal(t, later, from)
+}
@@ -0,0 +1,61 @@
+package eventRepository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"kudago/internal/models"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+)
+
+const deleteAttendeeQuery = `DELETE FROM attendance WHERE user_id = $1 AND event_id = $2`
+
+const deleteWaitlistEntryQuery = `DELETE FROM waitlist WHERE user_id = $1 AND event_id = $2`
+
+// RemoveAttendee cancels an RSVP or leaves the waitlist. The freed spot goes
+// to the next users in the queue, who are returned for notification.
+func (db *EventDB) RemoveAttendee(ctx context.Context, eventID, userID int, claimUntil time.Time) ([]models.WaitlistEntry, error) {
+	tx, err := db.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", models.LevelDB, err)
+	}
+	defer tx.Rollback(ctx)
+
+	var capacity int
+	err = tx.QueryRow(ctx, selectEventCapacityForUpdateQuery, eventID).Scan(&capacity)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%s: %w", models.LevelDB, models.ErrNotFound)
+		}
+		return nil, fmt.Errorf("%s: %w", models.LevelDB, err)
+	}
+
+	result, err := tx.Exec(ctx, deleteAttendeeQuery, userID, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", models.LevelDB, err)
+	}
+
+	if result.RowsAffected() == 0 {
+		result, err = tx.Exec(ctx, deleteWaitlistEntryQuery, userID, eventID)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", models.LevelDB, err)
+		}
+		if result.RowsAffected() == 0 {
+			return nil, fmt.Errorf("%s: %w", models.LevelDB, models.ErrNotFound)
+		}
+	}
+
+	promoted, err := promoteFromWaitlist(ctx, tx, eventID, capacity, claimUntil)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", models.LevelDB, err)
+	}
+	return promoted, nil
+}
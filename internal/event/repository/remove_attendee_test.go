@@ -0,0 +1,127 @@
+package eventRepository
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"kudago/internal/models"
+)
+
+func TestEventRepository_RemoveAttendee(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	claimUntil := time.Date(2024, 12, 1, 12, 0, 0, 0, time.UTC)
+
+	expectCapacity := func(m pgxmock.PgxConnIface) {
+		m.ExpectBegin()
+		m.ExpectQuery(`SELECT COALESCE\(capacity, 0\) FROM event WHERE id = \$1 FOR UPDATE`).
+			WithArgs(1).
+			WillReturnRows(pgxmock.NewRows([]string{"capacity"}).AddRow(10))
+	}
+
+	tests := []struct {
+		name             string
+		mockSetup        func(m pgxmock.PgxConnIface)
+		expectedPromoted []models.WaitlistEntry
+		expectedErr      error
+	}{
+		{
+			name: "успешная отмена с продвижением очереди",
+			mockSetup: func(m pgxmock.PgxConnIface) {
+				expectCapacity(m)
+				m.ExpectExec(`DELETE FROM attendance`).
+					WithArgs(2, 1).
+					WillReturnResult(pgxmock.NewResult("DELETE", 1))
+				m.ExpectExec(`DELETE FROM waitlist WHERE event_id = \$1 AND claim_until <= NOW\(\)`).
+					WithArgs(1).
+					WillReturnResult(pgxmock.NewResult("DELETE", 0))
+				m.ExpectQuery(`SELECT \(SELECT COUNT\(\*\) FROM attendance`).
+					WithArgs(1).
+					WillReturnRows(pgxmock.NewRows([]string{"count"}).AddRow(9))
+				m.ExpectQuery(`UPDATE waitlist SET claim_until`).
+					WithArgs(1, 1, claimUntil).
+					WillReturnRows(pgxmock.NewRows([]string{"event_id", "user_id", "claim_until"}).
+						AddRow(1, 5, claimUntil))
+				m.ExpectCommit()
+				m.ExpectRollback()
+			},
+			expectedPromoted: []models.WaitlistEntry{{EventID: 1, UserID: 5, ClaimUntil: claimUntil}},
+		},
+		{
+			name: "выход из листа ожидания",
+			mockSetup: func(m pgxmock.PgxConnIface) {
+				expectCapacity(m)
+				m.ExpectExec(`DELETE FROM attendance`).
+					WithArgs(2, 1).
+					WillReturnResult(pgxmock.NewResult("DELETE", 0))
+				m.ExpectExec(`DELETE FROM waitlist WHERE user_id = \$1 AND event_id = \$2`).
+					WithArgs(2, 1).
+					WillReturnResult(pgxmock.NewResult("DELETE", 1))
+				m.ExpectExec(`DELETE FROM waitlist WHERE event_id = \$1 AND claim_until <= NOW\(\)`).
+					WithArgs(1).
+					WillReturnResult(pgxmock.NewResult("DELETE", 0))
+				m.ExpectQuery(`SELECT \(SELECT COUNT\(\*\) FROM attendance`).
+					WithArgs(1).
+					WillReturnRows(pgxmock.NewRows([]string{"count"}).AddRow(10))
+				m.ExpectCommit()
+				m.ExpectRollback()
+			},
+		},
+		{
+			name: "пользователь не записан",
+			mockSetup: func(m pgxmock.PgxConnIface) {
+				expectCapacity(m)
+				m.ExpectExec(`DELETE FROM attendance`).
+					WithArgs(2, 1).
+					WillReturnResult(pgxmock.NewResult("DELETE", 0))
+				m.ExpectExec(`DELETE FROM waitlist WHERE user_id = \$1 AND event_id = \$2`).
+					WithArgs(2, 1).
+					WillReturnResult(pgxmock.NewResult("DELETE", 0))
+				m.ExpectRollback()
+			},
+			expectedErr: models.ErrNotFound,
+		},
+		{
+			name: "ошибка базы данных",
+			mockSetup: func(m pgxmock.PgxConnIface) {
+				expectCapacity(m)
+				m.ExpectExec(`DELETE FROM attendance`).
+					WithArgs(2, 1).
+					WillReturnError(fmt.Errorf("database error"))
+				m.ExpectRollback()
+			},
+			expectedErr: fmt.Errorf("database error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockConn, err := pgxmock.NewConn()
+			require.NoError(t, err)
+			defer mockConn.Close(ctx)
+
+			tt.mockSetup(mockConn)
+
+			db := NewDB(mockConn)
+			promoted, err := db.RemoveAttendee(ctx, 1, 2, claimUntil)
+
+			if tt.expectedErr != nil {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedPromoted, promoted)
+			}
+			assert.NoError(t, mockConn.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,80 @@
+package eventRepository
+
+import (
+	"context"
+	"fmt"
+
+	"kudago/internal/models"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+)
+
+// the event row is locked so that concurrent reservations for the same event
+// are serialized and can't oversell its capacity
+const selectEventCapacityForUpdateQuery = `SELECT COALESCE(capacity, 0) FROM event WHERE id = $1 FOR UPDATE`
+
+const selectTicketTypeQuery = `SELECT name, price FROM ticket_type WHERE id = $1 AND event_id = $2`
+
+const insertTicketQuery = `
+	INSERT INTO ticket (type, price, quantity, event_id, user_id, ticket_type_id, status, reserved_until)
+	VALUES ($1, $2, $3, $4, $5, $6, 'reserved', $7)
+	RETURNING id`
+
+func (db *EventDB) ReserveTickets(ctx context.Context, ticket models.Ticket) (models.Ticket, error) {
+	tx, err := db.pool.Begin(ctx)
+	if err != nil {
+		return models.Ticket{}, fmt.Errorf("%s: %w", models.LevelDB, err)
+	}
+	defer tx.Rollback(ctx)
+
+	var capacity int
+	err = tx.QueryRow(ctx, selectEventCapacityForUpdateQuery, ticket.EventID).Scan(&capacity)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.Ticket{}, fmt.Errorf("%s: %w", models.LevelDB, models.ErrEventNotFound)
+		}
+		return models.Ticket{}, fmt.Errorf("%s: %w", models.LevelDB, err)
+	}
+
+	err = tx.QueryRow(ctx, selectTicketTypeQuery, ticket.TicketTypeID, ticket.EventID).Scan(&ticket.Type, &ticket.Price)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.Ticket{}, fmt.Errorf("%s: %w", models.LevelDB, models.ErrTicketTypeNotFound)
+		}
+		return models.Ticket{}, fmt.Errorf("%s: %w", models.LevelDB, err)
+	}
+
+	if capacity > 0 {
+		var taken int
+		err = tx.QueryRow(ctx, countTakenSpotsQuery, ticket.EventID).Scan(&taken)
+		if err != nil {
+			return models.Ticket{}, fmt.Errorf("%s: %w", models.LevelDB, err)
+		}
+
+		if taken+ticket.Quantity > capacity {
+			return models.Ticket{}, fmt.Errorf("%s: %w", models.LevelDB, models.ErrNoTicketsLeft)
+		}
+	}
+
+	err = tx.QueryRow(ctx, insertTicketQuery,
+		ticket.Type,
+		ticket.Price,
+		ticket.Quantity,
+		ticket.EventID,
+		ticket.UserID,
+		ticket.TicketTypeID,
+		ticket.ReservedUntil,
+	).Scan(&ticket.ID)
+	if err != nil {
+		return models.Ticket{}, fmt.Errorf("%s: %w", models.LevelDB, err)
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return models.Ticket{}, fmt.Errorf("%s: %w", models.LevelDB, err)
+	}
+
+	ticket.Status = models.TicketStatusReserved
+	return ticket, nil
+}
@@ -0,0 +1,167 @@
+package eventRepository
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"kudago/internal/models"
+)
+
+func TestEventRepository_ReserveTickets(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	reservedUntil := time.Now().Add(15 * time.Minute)
+	request := models.Ticket{
+		EventID:       1,
+		TicketTypeID:  2,
+		UserID:        3,
+		Quantity:      2,
+		ReservedUntil: reservedUntil,
+	}
+
+	tests := []struct {
+		name        string
+		mockSetup   func(m pgxmock.PgxConnIface)
+		expected    models.Ticket
+		expectedErr error
+	}{
+		{
+			name: "успешное бронирование",
+			mockSetup: func(m pgxmock.PgxConnIface) {
+				m.ExpectBegin()
+				m.ExpectQuery(`SELECT COALESCE\(capacity, 0\) FROM event WHERE id = \$1 FOR UPDATE`).
+					WithArgs(1).
+					WillReturnRows(pgxmock.NewRows([]string{"capacity"}).AddRow(10))
+				m.ExpectQuery(`SELECT name, price FROM ticket_type`).
+					WithArgs(2, 1).
+					WillReturnRows(pgxmock.NewRows([]string{"name", "price"}).AddRow("VIP", 1500.0))
+				m.ExpectQuery(`SELECT \(SELECT COUNT\(\*\) FROM attendance`).
+					WithArgs(1).
+					WillReturnRows(pgxmock.NewRows([]string{"sum"}).AddRow(8))
+				m.ExpectQuery(`INSERT INTO ticket`).
+					WithArgs("VIP", 1500.0, 2, 1, 3, 2, reservedUntil).
+					WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(7))
+				m.ExpectCommit()
+				m.ExpectRollback()
+			},
+			expected: models.Ticket{
+				ID:            7,
+				EventID:       1,
+				TicketTypeID:  2,
+				UserID:        3,
+				Type:          "VIP",
+				Price:         1500.0,
+				Quantity:      2,
+				Status:        models.TicketStatusReserved,
+				ReservedUntil: reservedUntil,
+			},
+		},
+		{
+			name: "мест не осталось",
+			mockSetup: func(m pgxmock.PgxConnIface) {
+				m.ExpectBegin()
+				m.ExpectQuery(`SELECT COALESCE\(capacity, 0\) FROM event`).
+					WithArgs(1).
+					WillReturnRows(pgxmock.NewRows([]string{"capacity"}).AddRow(10))
+				m.ExpectQuery(`SELECT name, price FROM ticket_type`).
+					WithArgs(2, 1).
+					WillReturnRows(pgxmock.NewRows([]string{"name", "price"}).AddRow("VIP", 1500.0))
+				m.ExpectQuery(`SELECT \(SELECT COUNT\(\*\) FROM attendance`).
+					WithArgs(1).
+					WillReturnRows(pgxmock.NewRows([]string{"sum"}).AddRow(9))
+				m.ExpectRollback()
+			},
+			expectedErr: models.ErrNoTicketsLeft,
+		},
+		{
+			name: "без ограничения вместимости",
+			mockSetup: func(m pgxmock.PgxConnIface) {
+				m.ExpectBegin()
+				m.ExpectQuery(`SELECT COALESCE\(capacity, 0\) FROM event`).
+					WithArgs(1).
+					WillReturnRows(pgxmock.NewRows([]string{"capacity"}).AddRow(0))
+				m.ExpectQuery(`SELECT name, price FROM ticket_type`).
+					WithArgs(2, 1).
+					WillReturnRows(pgxmock.NewRows([]string{"name", "price"}).AddRow("Standard", 0.0))
+				m.ExpectQuery(`INSERT INTO ticket`).
+					WithArgs("Standard", 0.0, 2, 1, 3, 2, reservedUntil).
+					WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(8))
+				m.ExpectCommit()
+				m.ExpectRollback()
+			},
+			expected: models.Ticket{
+				ID:            8,
+				EventID:       1,
+				TicketTypeID:  2,
+				UserID:        3,
+				Type:          "Standard",
+				Quantity:      2,
+				Status:        models.TicketStatusReserved,
+				ReservedUntil: reservedUntil,
+			},
+		},
+		{
+			name: "событие не найдено",
+			mockSetup: func(m pgxmock.PgxConnIface) {
+				m.ExpectBegin()
+				m.ExpectQuery(`SELECT COALESCE\(capacity, 0\) FROM event`).
+					WithArgs(1).
+					WillReturnRows(pgxmock.NewRows([]string{"capacity"}))
+				m.ExpectRollback()
+			},
+			expectedErr: models.ErrEventNotFound,
+		},
+		{
+			name: "тип билета не найден",
+			mockSetup: func(m pgxmock.PgxConnIface) {
+				m.ExpectBegin()
+				m.ExpectQuery(`SELECT COALESCE\(capacity, 0\) FROM event`).
+					WithArgs(1).
+					WillReturnRows(pgxmock.NewRows([]string{"capacity"}).AddRow(10))
+				m.ExpectQuery(`SELECT name, price FROM ticket_type`).
+					WithArgs(2, 1).
+					WillReturnRows(pgxmock.NewRows([]string{"name", "price"}))
+				m.ExpectRollback()
+			},
+			expectedErr: models.ErrTicketTypeNotFound,
+		},
+		{
+			name: "ошибка базы данных",
+			mockSetup: func(m pgxmock.PgxConnIface) {
+				m.ExpectBegin().WillReturnError(fmt.Errorf("database error"))
+			},
+			expectedErr: fmt.Errorf("database error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockConn, err := pgxmock.NewConn()
+			require.NoError(t, err)
+			defer mockConn.Close(ctx)
+
+			tt.mockSetup(mockConn)
+
+			db := NewDB(mockConn)
+			ticket, err := db.ReserveTickets(ctx, request)
+
+			if tt.expectedErr != nil {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, ticket)
+			}
+			assert.NoError(t, mockConn.ExpectationsWereMet())
+		})
+	}
+}
@@ -3,61 +3,129 @@ package eventRepository
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"kudago/internal/models"
 )
 
-const baseSearchQuery = `
-    SELECT event.id, event.title, event.description, event.event_start, event.event_finish, 
-           event.location, event.capacity, event.created_at, event.user_id, event.category_id, event.lat, event.lon,
-           COALESCE(array_agg(DISTINCT tag.name) FILTER (WHERE tag.name IS NOT NULL), ARRAY[]::TEXT[]) AS tags,
-           COALESCE(media_url.url, '') AS media_link	
+// searchPoint is the "near me" point of a radius search; $10 and $11 hold its
+// latitude and longitude.
+const searchPoint = `ST_SetSRID(ST_MakePoint($11::DOUBLE PRECISION, $10::DOUBLE PRECISION), 4326)::GEOGRAPHY`
+
+// searchConditions and searchGrouping select the events matching
+// SearchParams ($1-$12); the page query and the facet counts share them, adding
+// their own conditions in between, so both always agree.
+const searchConditions = `
     FROM event
     LEFT JOIN event_tag ON event.id = event_tag.event_id
     LEFT JOIN tag ON tag.id = event_tag.tag_id
-    LEFT JOIN media_url ON event.id = media_url.event_id
+    LEFT JOIN media_url ON event.id = media_url.event_id AND media_url.is_cover
     WHERE
-        ($1::TEXT IS NULL OR event.title ILIKE '%' || $1 || '%' OR event.description ILIKE '%' || $1 || '%')
+        ` + listedCondition + `
+        AND ($1::TEXT IS NULL OR event.search_vector @@ websearch_to_tsquery('russian', $1))
         AND ($2::INT IS NULL OR event.category_id = $2)
-        AND ($3::TIMESTAMP IS NULL OR event.event_start >= $3)
-        AND ($4::TIMESTAMP IS NULL OR event.event_finish <= $4)
-        AND ($8::DOUBLE PRECISION IS NULL OR event.lat >= $8) -- Минимальная широта
-        AND ($9::DOUBLE PRECISION IS NULL OR event.lat <= $9) -- Максимальная широта
-        AND ($10::DOUBLE PRECISION IS NULL OR event.lon >= $10) -- Минимальная долгота
-        AND ($11::DOUBLE PRECISION IS NULL OR event.lon <= $11) -- Максимальная долгота
+        AND ($3::TIMESTAMP IS NULL OR event.event_start >= $3
+             OR (event.recurrence_rule IS NOT NULL AND (event.recurrence_until IS NULL OR event.recurrence_until >= $3)))
+        AND ($4::TIMESTAMP IS NULL OR event.event_finish <= $4
+             OR (event.recurrence_rule IS NOT NULL AND event.event_start <= $4))
+        AND ($6::DOUBLE PRECISION IS NULL OR event.lat >= $6) -- Минимальная широта
+        AND ($7::DOUBLE PRECISION IS NULL OR event.lat <= $7) -- Максимальная широта
+        AND ($8::DOUBLE PRECISION IS NULL OR event.lon >= $8) -- Минимальная долгота
+        AND ($9::DOUBLE PRECISION IS NULL OR event.lon <= $9) -- Максимальная долгота
+        AND ($12::DOUBLE PRECISION IS NULL OR ST_DWithin(event.geo, ` + searchPoint + `, $12))`
+
+const searchGrouping = `
     GROUP BY event.id, media_url.url
     HAVING (
         $5::TEXT[] IS NULL 
         OR array_length($5::TEXT[], 1) = 0 
         OR array_length(array_agg(DISTINCT LOWER(tag.name)), 1) = 0 
         OR array_agg(DISTINCT LOWER(tag.name)) @> $5::TEXT[]
-    )
-    ORDER BY event.event_finish ASC
-    LIMIT $6 OFFSET $7;
+    )`
+
+// htmlEscapeStart and htmlEscapeEnd wrap a text column into its HTML escaped
+// copy. Highlights are built over the escaped text, so the <mark> tags added
+// by ts_headline are the only markup in them and they are safe to render.
+const (
+	htmlEscapeStart = `replace(replace(replace(replace(replace(`
+	htmlEscapeEnd   = `, '&', '&amp;'), '<', '&lt;'), '>', '&gt;'), '"', '&quot;'), '''', '&#39;')`
+)
+
+const baseSearchQuery = `
+    SELECT event.id, event.title, event.description, event.event_start, event.event_finish, 
+           event.location, event.capacity, event.created_at, event.user_id, event.category_id, event.lat, event.lon,
+           COALESCE(array_agg(DISTINCT tag.name) FILTER (WHERE tag.name IS NOT NULL), ARRAY[]::TEXT[]) AS tags,
+           COALESCE(media_url.url, '') AS media_link,
+           (SELECT COUNT(*) FROM attendance WHERE attendance.event_id = event.id) AS attendees,
+           (SELECT COUNT(*) FROM FAVORITE_EVENT WHERE FAVORITE_EVENT.event_id = event.id) AS favorites_count,
+           event.status, event.publish_at,
+           COALESCE(ts_headline('russian', ` + htmlEscapeStart + `event.title` + htmlEscapeEnd + `,
+                    websearch_to_tsquery('russian', $1),
+                    'StartSel=<mark>, StopSel=</mark>, HighlightAll=TRUE'), '') AS title_highlight,
+           COALESCE(ts_headline('russian', ` + htmlEscapeStart + `event.description` + htmlEscapeEnd + `,
+                    websearch_to_tsquery('russian', $1),
+                    'StartSel=<mark>, StopSel=</mark>, MaxFragments=2, MaxWords=20, MinWords=5'), '') AS description_highlight,
+           ST_Distance(event.geo, ` + searchPoint + `) AS distance,
+           event.recurrence_rule, event.recurrence_exdates` + searchConditions + `
+        AND ($15::BOOLEAN IS NULL OR (event.recurrence_rule IS NOT NULL) = $15)` + searchGrouping + `
+    ORDER BY CASE WHEN $13::BOOLEAN THEN ST_Distance(event.geo, ` + searchPoint + `) END ASC NULLS LAST,
+             ts_rank(event.search_vector, websearch_to_tsquery('russian', $1)) DESC NULLS LAST,
+             CASE WHEN $1::TEXT IS NULL THEN event.event_start END ASC, event.event_finish ASC, event.id ASC
+    LIMIT $14;
 `
 
+// SearchEvents pages the results after series are expanded into occurrences,
+// so the rows are taken from the start up to the end of the page rather than
+// with an OFFSET. Results asked to be ordered by relevance or distance keep
+// the order of the rows, a series taking its place with all its occurrences.
+// Otherwise they are ordered by date: as in the upcoming feed, single events
+// are limited in SQL and the occurrences of series are merged in.
 func (db *EventDB) SearchEvents(ctx context.Context, params models.SearchParams, paginationParams models.PaginationParams) ([]models.Event, error) {
-	args := []interface{}{
-		nilIfEmpty(params.Query),
-		nilIfZero(params.Category),
-		nilIfEmpty(params.EventStart),
-		nilIfEmpty(params.EventEnd),
-		tagsToArray(params.Tags),
-		paginationParams.Limit,
-		paginationParams.Offset,
-		nilIfFloatZero(params.LatitudeMin),
-		nilIfFloatZero(params.LatitudeMax),
-		nilIfFloatZero(params.LongitudeMin),
-		nilIfFloatZero(params.LongitudeMax),
+	pageSize := paginationParams.Offset + paginationParams.Limit
+	from, to := searchWindow(params)
+
+	var infos []EventInfo
+	if params.Query != "" || params.SortByDistance {
+		rows, err := db.searchEventInfos(ctx, params, pageSize, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, eventInfo := range rows {
+			infos = append(infos, expandOccurrences(eventInfo, from, to, maxOccurrencesPerSeries)...)
+		}
+	} else {
+		singles, err := db.searchEventInfos(ctx, params, pageSize, false)
+		if err != nil {
+			return nil, err
+		}
+		series, err := db.searchEventInfos(ctx, params, nil, true)
+		if err != nil {
+			return nil, err
+		}
+
+		infos = singles
+		for _, eventInfo := range series {
+			infos = append(infos, expandOccurrences(eventInfo, from, to, pageSize)...)
+		}
+		sortByStart(infos)
 	}
 
+	return db.toDomainEvents(ctx, pageInfos(infos, paginationParams)), nil
+}
+
+// searchEventInfos runs the page query. A nil limit loads every match and a
+// nil recurring loads both single events and series.
+func (db *EventDB) searchEventInfos(ctx context.Context, params models.SearchParams, limit, recurring interface{}) ([]EventInfo, error) {
+	args := append(searchFilterArgs(params), params.SortByDistance, limit, recurring)
+
 	rows, err := db.pool.Query(ctx, baseSearchQuery, args...)
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", models.LevelDB, err)
 	}
 	defer rows.Close()
 
-	events := make([]models.Event, 0, paginationParams.Limit)
+	var infos []EventInfo
 	for rows.Next() {
 		var eventInfo EventInfo
 		err = rows.Scan(
@@ -75,19 +143,78 @@ func (db *EventDB) SearchEvents(ctx context.Context, params models.SearchParams,
 			&eventInfo.Longitude,
 			&eventInfo.Tags,
 			&eventInfo.ImageURL,
+			&eventInfo.Attendees,
+			&eventInfo.FavoritesCount,
+			&eventInfo.Status,
+			&eventInfo.PublishAt,
+			&eventInfo.TitleHighlight,
+			&eventInfo.DescriptionHighlight,
+			&eventInfo.Distance,
+			&eventInfo.RecurrenceRule,
+			&eventInfo.RecurrenceExdates,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("%s: %w", models.LevelDB, err)
 		}
+		infos = append(infos, eventInfo)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", models.LevelDB, err)
+	}
 
-		event, err := db.toDomainEvent(ctx, eventInfo)
-		if err != nil {
-			continue
+	return infos, nil
+}
+
+func searchFilterArgs(params models.SearchParams) []interface{} {
+	args := []interface{}{
+		nilIfEmpty(params.Query),
+		nilIfZero(params.Category),
+		nilIfEmpty(params.EventStart),
+		nilIfEmpty(params.EventEnd),
+		tagsToArray(params.Tags),
+		nilIfFloatZero(params.LatitudeMin),
+		nilIfFloatZero(params.LatitudeMax),
+		nilIfFloatZero(params.LongitudeMin),
+		nilIfFloatZero(params.LongitudeMax),
+	}
+	return append(args, searchPointArgs(params)...)
+}
+
+// searchPointArgs returns the point and radius of a radius search. The radius
+// tells whether there is one, as 0 is a valid latitude and longitude.
+func searchPointArgs(params models.SearchParams) []interface{} {
+	if params.Radius <= 0 {
+		return []interface{}{nil, nil, nil}
+	}
+	return []interface{}{params.Latitude, params.Longitude, params.Radius}
+}
+
+// searchWindow returns the dates series are expanded over. The search accepts
+// dates as well as timestamps; a date end covers the whole day.
+func searchWindow(params models.SearchParams) (time.Time, time.Time) {
+	from := time.Now()
+	if start, ok := parseSearchTime(params.EventStart); ok {
+		from = start
+	}
+
+	var to time.Time
+	if end, ok := parseSearchTime(params.EventEnd); ok {
+		to = end
+		if len(params.EventEnd) == len(time.DateOnly) {
+			to = end.Add(24*time.Hour - time.Nanosecond)
 		}
-		events = append(events, event)
 	}
 
-	return events, nil
+	return from, to
+}
+
+func parseSearchTime(value string) (time.Time, bool) {
+	for _, layout := range []string{time.DateOnly, time.RFC3339, time.DateTime} {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
 }
 
 func nilIfFloatZero(value float64) interface{} {
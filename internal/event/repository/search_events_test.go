@@ -12,10 +12,42 @@ import (
 	"kudago/internal/models"
 )
 
+const searchQueryPattern = `(?s)SELECT event.id.*` +
+	`ts_headline\('russian', replace\(.*event.title, '&', '&amp;'\).*'<', '&lt;'\).*websearch_to_tsquery\('russian', \$1\).*` +
+	`WHERE\s+event.status = 'published'\s+AND \(\$1::TEXT IS NULL OR event.search_vector @@ websearch_to_tsquery\('russian', \$1\)\).*` +
+	`ORDER BY CASE WHEN \$13::BOOLEAN THEN ST_Distance\(event.geo, .*END ASC NULLS LAST,\s+` +
+	`ts_rank\(event.search_vector, websearch_to_tsquery\('russian', \$1\)\) DESC NULLS LAST,\s+` +
+	`CASE WHEN \$1::TEXT IS NULL THEN event.event_start END ASC, event.event_finish ASC, event.id ASC\s+LIMIT \$14;`
+
+var searchColumns = []string{
+	"id", "title", "description", "event_start", "event_finish", "location", "capacity", "created_at", "user_id", "category_id", "lat", "lon", "tags", "media_link", "attendees", "favorites_count", "status", "publish_at", "title_highlight", "description_highlight", "distance", "recurrence_rule", "recurrence_exdates",
+}
+
 func TestEventRepository_SearchEvents(t *testing.T) {
 	t.Parallel()
 
 	ctx := context.Background()
+	eventStart := time.Date(2024, 12, 1, 18, 0, 0, 0, time.UTC)
+	eventEnd := eventStart.Add(2 * time.Hour)
+	nearDistance := 1112.4
+	weeklyRule := "FREQ=WEEKLY;COUNT=3"
+	seriesStart := time.Date(2025, time.January, 6, 19, 0, 0, 0, time.UTC)
+	singleStart := time.Date(2025, time.January, 8, 19, 0, 0, 0, time.UTC)
+	seriesOccurrence := func(start time.Time) models.Event {
+		return models.Event{
+			ID:             1,
+			Title:          "Джаз по понедельникам",
+			EventStart:     start.Format(time.RFC3339),
+			EventEnd:       start.Add(2 * time.Hour).Format(time.RFC3339),
+			AuthorID:       1,
+			CategoryID:     1,
+			Tag:            []string{},
+			Status:         "published",
+			RecurrenceRule: weeklyRule,
+			SeriesStart:    seriesStart.Format(time.RFC3339),
+			Start:          start,
+		}
+	}
 
 	tests := []struct {
 		name             string
@@ -39,38 +71,13 @@ func TestEventRepository_SearchEvents(t *testing.T) {
 				Offset: 0,
 			},
 			mockSetup: func(m pgxmock.PgxConnIface) {
-				m.ExpectQuery(`SELECT event.id, event.title, event.description, event.event_start, event.event_finish, 
-					event.location, event.capacity, event.created_at, event.user_id, event.category_id, event.lat, event.lon,
-					COALESCE\(array_agg\(DISTINCT tag.name\) FILTER \(WHERE tag.name IS NOT NULL\), ARRAY\[\]::TEXT\[\]\) AS tags,
-					COALESCE\(media_url.url, ''\) AS media_link	
-				FROM event
-				LEFT JOIN event_tag ON event.id = event_tag.event_id
-				LEFT JOIN tag ON tag.id = event_tag.tag_id
-				LEFT JOIN media_url ON event.id = media_url.event_id
-				WHERE
-					\(\$1::TEXT IS NULL OR event.title ILIKE '%' || \$1 || '%' OR event.description ILIKE '%' || \$1 || '%'\)
-					AND \(\$2::INT IS NULL OR event.category_id = \$2\)
-					AND \(\$3::TIMESTAMP IS NULL OR event.event_start >= \$3\)
-					AND \(\$4::TIMESTAMP IS NULL OR event.event_finish <= \$4\)
-					AND \(\$8::DOUBLE PRECISION IS NULL OR event.lat >= \$8\) -- Минимальная широта
-					AND \(\$9::DOUBLE PRECISION IS NULL OR event.lat <= \$9\) -- Максимальная широта
-					AND \(\$10::DOUBLE PRECISION IS NULL OR event.lon >= \$10\) -- Минимальная долгота
-					AND \(\$11::DOUBLE PRECISION IS NULL OR event.lon <= \$11\) -- Максимальная долгота
-				GROUP BY event.id, media_url.url
-				HAVING (
-					\$5::TEXT\[\] IS NULL 
-					OR array_length\(\$5::TEXT\[\], 1\) = 0 
-					OR array_length\(array_agg\(DISTINCT LOWER\(tag.name\)\), 1\) = 0 
-					OR array_agg\(DISTINCT LOWER\(tag.name\)\) @> \$5::TEXT\[\]
-				)
-				ORDER BY event.event_finish ASC
-				LIMIT \$6 OFFSET \$7;`).
-					WithArgs("test", 1, time.Now().Format("2006-01-02 15:04:05"), time.Now().Add(24*time.Hour).Format("2006-01-02 15:04:05"), []string{"tag1", "tag2"}, 10, 0, nil, nil, nil, nil).
+				m.ExpectQuery(searchQueryPattern).
+					WithArgs("test", 1, time.Now().Format("2006-01-02 15:04:05"), time.Now().Add(24*time.Hour).Format("2006-01-02 15:04:05"), []string{"tag1", "tag2"}, nil, nil, nil, nil, nil, nil, nil, false, 10, nil).
 					WillReturnRows(pgxmock.NewRows([]string{
-						"id", "title", "description", "event_start", "event_finish", "location", "capacity", "created_at", "user_id", "category_id", "lat", "lon", "tags", "media_link",
+						"id", "title", "description", "event_start", "event_finish", "location", "capacity", "created_at", "user_id", "category_id", "lat", "lon", "tags", "media_link", "attendees", "favorites_count", "status", "publish_at", "title_highlight", "description_highlight", "distance", "recurrence_rule", "recurrence_exdates",
 					}).
-						AddRow(1, "Event 1", "Description 1", time.Now().Format("2006-01-02 15:04:05"), time.Now().Add(1*time.Hour).Format("2006-01-02 15:04:05"), "Location 1", 100, time.Now(), 1, 1, 10.0, 20.0, []string{"tag1", "tag2"}, "http://example.com").
-						AddRow(2, "Event 2", "Description 2", time.Now().Format("2006-01-02 15:04:05"), time.Now().Add(2*time.Hour).Format("2006-01-02 15:04:05"), "Location 2", 200, time.Now(), 2, 2, 15.0, 25.0, []string{"tag2"}, "http://example2.com"))
+						AddRow(1, "Event 1", "Description 1", time.Now().Format("2006-01-02 15:04:05"), time.Now().Add(1*time.Hour).Format("2006-01-02 15:04:05"), "Location 1", 100, time.Now(), 1, 1, 10.0, 20.0, []string{"tag1", "tag2"}, "http://example.com", 0, 0, "published", nil, "", "", nil, nil, []time.Time{}).
+						AddRow(2, "Event 2", "Description 2", time.Now().Format("2006-01-02 15:04:05"), time.Now().Add(2*time.Hour).Format("2006-01-02 15:04:05"), "Location 2", 200, time.Now(), 2, 2, 15.0, 25.0, []string{"tag2"}, "http://example2.com", 0, 0, "published", nil, "", "", nil, nil, []time.Time{}))
 			},
 			expectedEvents: []models.Event{
 				{
@@ -108,6 +115,164 @@ func TestEventRepository_SearchEvents(t *testing.T) {
 			},
 			expectErr: true,
 		},
+		{
+			name: "полнотекстовый поиск с подсветкой",
+			params: models.SearchParams{
+				Query: "концерты",
+			},
+			paginationParams: models.PaginationParams{
+				Limit:  10,
+				Offset: 0,
+			},
+			mockSetup: func(m pgxmock.PgxConnIface) {
+				m.ExpectQuery(searchQueryPattern).
+					WithArgs("концерты", nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, false, 10, nil).
+					WillReturnRows(pgxmock.NewRows([]string{
+						"id", "title", "description", "event_start", "event_finish", "location", "capacity", "created_at", "user_id", "category_id", "lat", "lon", "tags", "media_link", "attendees", "favorites_count", "status", "publish_at", "title_highlight", "description_highlight", "distance", "recurrence_rule", "recurrence_exdates",
+					}).
+						AddRow(1, "Концерт в парке", "Живой концерт под открытым небом", eventStart, eventEnd, "Парк", 100, eventStart, 1, 1, 10.0, 20.0, []string{}, nil, 3, 2, "published", nil,
+							"<mark>Концерт</mark> в парке", "Живой <mark>концерт</mark> под открытым небом", nil, nil, []time.Time{}))
+			},
+			expectedEvents: []models.Event{
+				{
+					ID:                   1,
+					Title:                "Концерт в парке",
+					Description:          "Живой концерт под открытым небом",
+					EventStart:           eventStart.Format(time.RFC3339),
+					EventEnd:             eventEnd.Format(time.RFC3339),
+					Location:             "Парк",
+					Capacity:             100,
+					CategoryID:           1,
+					AuthorID:             1,
+					Latitude:             10.0,
+					Longitude:            20.0,
+					Tag:                  []string{},
+					Attendees:            3,
+					FavoritesCount:       2,
+					Status:               "published",
+					TitleHighlight:       "<mark>Концерт</mark> в парке",
+					DescriptionHighlight: "Живой <mark>концерт</mark> под открытым небом",
+					Start:                eventStart,
+				},
+			},
+		},
+		{
+			name: "поиск в радиусе от точки",
+			params: models.SearchParams{
+				Latitude:       55.75,
+				Longitude:      37.62,
+				Radius:         5000,
+				SortByDistance: true,
+			},
+			paginationParams: models.PaginationParams{
+				Limit:  10,
+				Offset: 0,
+			},
+			mockSetup: func(m pgxmock.PgxConnIface) {
+				m.ExpectQuery(`(?s)ST_DWithin\(event.geo, ST_SetSRID\(ST_MakePoint\(\$11::DOUBLE PRECISION, \$10::DOUBLE PRECISION\), 4326\)::GEOGRAPHY, \$12\)`).
+					WithArgs(nil, nil, nil, nil, nil, nil, nil, nil, nil, 55.75, 37.62, 5000.0, true, 10, nil).
+					WillReturnRows(pgxmock.NewRows([]string{
+						"id", "title", "description", "event_start", "event_finish", "location", "capacity", "created_at", "user_id", "category_id", "lat", "lon", "tags", "media_link", "attendees", "favorites_count", "status", "publish_at", "title_highlight", "description_highlight", "distance", "recurrence_rule", "recurrence_exdates",
+					}).
+						AddRow(1, "Рядом", "", eventStart, eventEnd, "Москва", 0, eventStart, 1, 1, 55.76, 37.62, []string{}, nil, 0, 0, "published", nil, "", "", &nearDistance, nil, []time.Time{}))
+			},
+			expectedEvents: []models.Event{
+				{
+					ID:         1,
+					Title:      "Рядом",
+					EventStart: eventStart.Format(time.RFC3339),
+					EventEnd:   eventEnd.Format(time.RFC3339),
+					Location:   "Москва",
+					CategoryID: 1,
+					AuthorID:   1,
+					Latitude:   55.76,
+					Longitude:  37.62,
+					Tag:        []string{},
+					Distance:   &nearDistance,
+					Status:     "published",
+					Start:      eventStart,
+				},
+			},
+		},
+		{
+			name: "поиск в радиусе от точки на экваторе",
+			params: models.SearchParams{
+				Latitude:  0,
+				Longitude: 6.73,
+				Radius:    5000,
+			},
+			paginationParams: models.PaginationParams{
+				Limit:  10,
+				Offset: 0,
+			},
+			mockSetup: func(m pgxmock.PgxConnIface) {
+				m.ExpectQuery(searchQueryPattern).
+					WithArgs(nil, nil, nil, nil, nil, nil, nil, nil, nil, 0.0, 6.73, 5000.0, false, 10, false).
+					WillReturnRows(pgxmock.NewRows(searchColumns))
+				m.ExpectQuery(searchQueryPattern).
+					WithArgs(nil, nil, nil, nil, nil, nil, nil, nil, nil, 0.0, 6.73, 5000.0, false, nil, true).
+					WillReturnRows(pgxmock.NewRows(searchColumns))
+			},
+			expectedEvents: []models.Event{},
+		},
+		{
+			name: "серия по релевантности занимает место своей строки",
+			params: models.SearchParams{
+				Query:      "джаз",
+				EventStart: "2025-01-01",
+				EventEnd:   "2025-01-31",
+			},
+			paginationParams: models.PaginationParams{
+				Limit:  2,
+				Offset: 1,
+			},
+			mockSetup: func(m pgxmock.PgxConnIface) {
+				m.ExpectQuery(searchQueryPattern).
+					WithArgs("джаз", nil, "2025-01-01", "2025-01-31", nil, nil, nil, nil, nil, nil, nil, nil, false, 3, nil).
+					WillReturnRows(pgxmock.NewRows(searchColumns).
+						AddRow(1, "Джаз по понедельникам", "", seriesStart, seriesStart.Add(2*time.Hour), "", 0, seriesStart, 1, 1, 0.0, 0.0, []string{}, nil, 0, 0, "published", nil, "", "", nil, &weeklyRule, []time.Time{}).
+						AddRow(2, "Джазовый вечер", "", singleStart, singleStart.Add(2*time.Hour), "", 0, singleStart, 1, 1, 0.0, 0.0, []string{}, nil, 0, 0, "published", nil, "", "", nil, nil, []time.Time{}))
+			},
+			expectedEvents: []models.Event{
+				seriesOccurrence(seriesStart.AddDate(0, 0, 7)),
+				seriesOccurrence(seriesStart.AddDate(0, 0, 14)),
+			},
+		},
+		{
+			name: "поиск по дате с повторениями серии",
+			params: models.SearchParams{
+				EventStart: "2025-01-01",
+				EventEnd:   "2025-01-31",
+			},
+			paginationParams: models.PaginationParams{
+				Limit:  2,
+				Offset: 1,
+			},
+			mockSetup: func(m pgxmock.PgxConnIface) {
+				m.ExpectQuery(searchQueryPattern).
+					WithArgs(nil, nil, "2025-01-01", "2025-01-31", nil, nil, nil, nil, nil, nil, nil, nil, false, 3, false).
+					WillReturnRows(pgxmock.NewRows(searchColumns).
+						AddRow(2, "Джазовый вечер", "", singleStart, singleStart.Add(2*time.Hour), "", 0, singleStart, 1, 1, 0.0, 0.0, []string{}, nil, 0, 0, "published", nil, "", "", nil, nil, []time.Time{}))
+				m.ExpectQuery(searchQueryPattern).
+					WithArgs(nil, nil, "2025-01-01", "2025-01-31", nil, nil, nil, nil, nil, nil, nil, nil, false, nil, true).
+					WillReturnRows(pgxmock.NewRows(searchColumns).
+						AddRow(1, "Джаз по понедельникам", "", seriesStart, seriesStart.Add(2*time.Hour), "", 0, seriesStart, 1, 1, 0.0, 0.0, []string{}, nil, 0, 0, "published", nil, "", "", nil, &weeklyRule, []time.Time{}))
+			},
+			expectedEvents: []models.Event{
+				{
+					ID:         2,
+					Title:      "Джазовый вечер",
+					EventStart: singleStart.Format(time.RFC3339),
+					EventEnd:   singleStart.Add(2 * time.Hour).Format(time.RFC3339),
+					AuthorID:   1,
+					CategoryID: 1,
+					Tag:        []string{},
+					Status:     "published",
+					Start:      singleStart,
+				},
+				seriesOccurrence(seriesStart.AddDate(0, 0, 7)),
+			},
+		},
 		{
 			name: "Ошибка при поиске",
 			params: models.SearchParams{
@@ -118,33 +283,8 @@ func TestEventRepository_SearchEvents(t *testing.T) {
 				Offset: 0,
 			},
 			mockSetup: func(m pgxmock.PgxConnIface) {
-				m.ExpectQuery(`SELECT event.id, event.title, event.description, event.event_start, event.event_finish, 
-					event.location, event.capacity, event.created_at, event.user_id, event.category_id, event.lat, event.lon,
-					COALESCE\(array_agg\(DISTINCT tag.name\) FILTER \(WHERE tag.name IS NOT NULL\), ARRAY\[\]::TEXT\[\]\) AS tags,
-					COALESCE\(media_url.url, ''\) AS media_link	
-				FROM event
-				LEFT JOIN event_tag ON event.id = event_tag.event_id
-				LEFT JOIN tag ON tag.id = event_tag.tag_id
-				LEFT JOIN media_url ON event.id = media_url.event_id
-				WHERE
-					\(\$1::TEXT IS NULL OR event.title ILIKE '%' || \$1 || '%' OR event.description ILIKE '%' || \$1 || '%'\)
-					AND \(\$2::INT IS NULL OR event.category_id = \$2\)
-					AND \(\$3::TIMESTAMP IS NULL OR event.event_start >= \$3\)
-					AND \(\$4::TIMESTAMP IS NULL OR event.event_finish <= \$4\)
-					AND \(\$8::DOUBLE PRECISION IS NULL OR event.lat >= \$8\) -- Минимальная широта
-					AND \(\$9::DOUBLE PRECISION IS NULL OR event.lat <= \$9\) -- Максимальная широта
-					AND \(\$10::DOUBLE PRECISION IS NULL OR event.lon >= \$10\) -- Минимальная долгота
-					AND \(\$11::DOUBLE PRECISION IS NULL OR event.lon <= \$11\) -- Максимальная долгота
-				GROUP BY event.id, media_url.url
-				HAVING (
-					\$5::TEXT\[\] IS NULL 
-					OR array_length\(\$5::TEXT\[\], 1\) = 0 
-					OR array_length\(array_agg\(DISTINCT LOWER\(tag.name\)\), 1\) = 0 
-					OR array_agg\(DISTINCT LOWER\(tag.name\)\) @> \$5::TEXT\[\]
-				)
-				ORDER BY event.event_finish ASC
-				LIMIT \$6 OFFSET \$7;`).
-					WithArgs("test", nil, nil, nil, nil, 10, 0, nil, nil, nil, nil).
+				m.ExpectQuery(searchQueryPattern).
+					WithArgs("test", nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, false, 10, nil).
 					WillReturnError(fmt.Errorf("database error"))
 			},
 			expectedEvents: nil,
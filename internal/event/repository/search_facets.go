@@ -0,0 +1,229 @@
+package eventRepository
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"time"
+
+	"kudago/internal/event/recurrence"
+	"kudago/internal/models"
+)
+
+const (
+	facetCategory    = "category"
+	facetTag         = "tag"
+	facetToday       = "today"
+	facetThisWeekend = "this_weekend"
+	facetNextWeek    = "next_week"
+
+	// maxTagFacets is how many of the most frequent tags are returned.
+	maxTagFacets = 10
+)
+
+// Date buckets are calendar days and weeks in the zone events take place in,
+// the one series are expanded in.
+const facetBounds = `
+        SELECT date_trunc('day', NOW() AT TIME ZONE '` + recurrence.Timezone + `') AT TIME ZONE '` + recurrence.Timezone + `' AS today,
+               date_trunc('week', NOW() AT TIME ZONE '` + recurrence.Timezone + `') AT TIME ZONE '` + recurrence.Timezone + `' AS week`
+
+// searchFacetsQuery returns (facet, value, count) rows of single events: one
+// per category, one per tag and one per date bucket. Series are counted by
+// their occurrences apart, the way search expands them.
+const searchFacetsQuery = `
+    WITH matched AS (
+        SELECT DISTINCT event.id, event.category_id, event.event_start, event.event_finish` + searchConditions + `
+        AND event.recurrence_rule IS NULL` + searchGrouping + `
+    ), bounds AS (` + facetBounds + `
+    )
+    SELECT 'category', category_id::TEXT, COUNT(*) FROM matched GROUP BY category_id
+    UNION ALL
+    SELECT 'tag', tag.name, COUNT(DISTINCT matched.id)
+    FROM matched
+    JOIN event_tag ON event_tag.event_id = matched.id
+    JOIN tag ON tag.id = event_tag.tag_id
+    GROUP BY tag.name
+    UNION ALL
+    SELECT 'today', '', COUNT(*) FROM matched, bounds
+    WHERE matched.event_start < bounds.today + INTERVAL '1 day' AND matched.event_finish >= bounds.today
+    UNION ALL
+    SELECT 'this_weekend', '', COUNT(*) FROM matched, bounds
+    WHERE matched.event_start < bounds.week + INTERVAL '7 days' AND matched.event_finish >= bounds.week + INTERVAL '5 days'
+    UNION ALL
+    SELECT 'next_week', '', COUNT(*) FROM matched, bounds
+    WHERE matched.event_start < bounds.week + INTERVAL '14 days' AND matched.event_finish >= bounds.week + INTERVAL '7 days'`
+
+const searchFacetSeriesQuery = `
+    SELECT event.id, event.category_id, event.event_start, event.event_finish,
+           COALESCE(array_agg(DISTINCT tag.name) FILTER (WHERE tag.name IS NOT NULL), ARRAY[]::TEXT[]) AS tags,
+           event.recurrence_rule, event.recurrence_exdates` + searchConditions + `
+        AND event.recurrence_rule IS NOT NULL` + searchGrouping
+
+func (db *EventDB) GetSearchFacets(ctx context.Context, params models.SearchParams) (models.SearchFacets, error) {
+	facets, err := db.singleEventFacets(ctx, params)
+	if err != nil {
+		return models.SearchFacets{}, err
+	}
+
+	series, err := db.searchFacetSeries(ctx, params)
+	if err != nil {
+		return models.SearchFacets{}, err
+	}
+
+	from, to := searchWindow(params)
+	buckets := newDateBuckets(time.Now())
+	for _, eventInfo := range series {
+		occurrences := expandOccurrences(eventInfo, from, to, maxOccurrencesPerSeries)
+		if len(occurrences) == 0 {
+			continue
+		}
+
+		facets.Categories = addCategoryCount(facets.Categories, eventInfo.CategoryID, len(occurrences))
+		for _, tag := range eventInfo.Tags {
+			facets.Tags = addTagCount(facets.Tags, tag, len(occurrences))
+		}
+		for _, occurrence := range occurrences {
+			buckets.add(&facets.Dates, occurrence)
+		}
+	}
+
+	slices.SortFunc(facets.Categories, func(a, b models.CategoryFacet) int {
+		return a.CategoryID - b.CategoryID
+	})
+	slices.SortFunc(facets.Tags, func(a, b models.TagFacet) int {
+		if c := b.Count - a.Count; c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Tag, b.Tag)
+	})
+	facets.Tags = facets.Tags[:min(len(facets.Tags), maxTagFacets)]
+
+	return facets, nil
+}
+
+func (db *EventDB) singleEventFacets(ctx context.Context, params models.SearchParams) (models.SearchFacets, error) {
+	rows, err := db.pool.Query(ctx, searchFacetsQuery, searchFilterArgs(params)...)
+	if err != nil {
+		return models.SearchFacets{}, fmt.Errorf("%s: %w", models.LevelDB, err)
+	}
+	defer rows.Close()
+
+	facets := models.SearchFacets{
+		Categories: []models.CategoryFacet{},
+		Tags:       []models.TagFacet{},
+	}
+	for rows.Next() {
+		var (
+			facet, value string
+			count        int
+		)
+		if err = rows.Scan(&facet, &value, &count); err != nil {
+			return models.SearchFacets{}, fmt.Errorf("%s: %w", models.LevelDB, err)
+		}
+
+		switch facet {
+		case facetCategory:
+			categoryID, err := strconv.Atoi(value)
+			if err != nil {
+				continue
+			}
+			facets.Categories = append(facets.Categories, models.CategoryFacet{CategoryID: categoryID, Count: count})
+		case facetTag:
+			facets.Tags = append(facets.Tags, models.TagFacet{Tag: value, Count: count})
+		case facetToday:
+			facets.Dates.Today = count
+		case facetThisWeekend:
+			facets.Dates.ThisWeekend = count
+		case facetNextWeek:
+			facets.Dates.NextWeek = count
+		}
+	}
+	if err = rows.Err(); err != nil {
+		return models.SearchFacets{}, fmt.Errorf("%s: %w", models.LevelDB, err)
+	}
+
+	return facets, nil
+}
+
+func (db *EventDB) searchFacetSeries(ctx context.Context, params models.SearchParams) ([]EventInfo, error) {
+	rows, err := db.pool.Query(ctx, searchFacetSeriesQuery, searchFilterArgs(params)...)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", models.LevelDB, err)
+	}
+	defer rows.Close()
+
+	var series []EventInfo
+	for rows.Next() {
+		var eventInfo EventInfo
+		err = rows.Scan(
+			&eventInfo.ID,
+			&eventInfo.CategoryID,
+			&eventInfo.EventStart,
+			&eventInfo.EventFinish,
+			&eventInfo.Tags,
+			&eventInfo.RecurrenceRule,
+			&eventInfo.RecurrenceExdates,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", models.LevelDB, err)
+		}
+		series = append(series, eventInfo)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", models.LevelDB, err)
+	}
+
+	return series, nil
+}
+
+func addCategoryCount(categories []models.CategoryFacet, categoryID, count int) []models.CategoryFacet {
+	for i := range categories {
+		if categories[i].CategoryID == categoryID {
+			categories[i].Count += count
+			return categories
+		}
+	}
+	return append(categories, models.CategoryFacet{CategoryID: categoryID, Count: count})
+}
+
+func addTagCount(tags []models.TagFacet, tag string, count int) []models.TagFacet {
+	for i := range tags {
+		if tags[i].Tag == tag {
+			tags[i].Count += count
+			return tags
+		}
+	}
+	return append(tags, models.TagFacet{Tag: tag, Count: count})
+}
+
+// dateBuckets are the windows of DateFacets, matching the bounds of
+// searchFacetsQuery.
+type dateBuckets struct {
+	today, week time.Time
+}
+
+func newDateBuckets(now time.Time) dateBuckets {
+	now = now.In(recurrence.Location)
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, recurrence.Location)
+	// Weeks start on Monday, as date_trunc('week') does.
+	week := today.AddDate(0, 0, -(int(today.Weekday())+6)%7)
+	return dateBuckets{today: today, week: week}
+}
+
+func (b dateBuckets) add(dates *models.DateFacets, occurrence EventInfo) {
+	overlaps := func(from, to time.Time) bool {
+		return occurrence.EventStart.Before(to) && !occurrence.EventFinish.Before(from)
+	}
+
+	if overlaps(b.today, b.today.AddDate(0, 0, 1)) {
+		dates.Today++
+	}
+	if overlaps(b.week.AddDate(0, 0, 5), b.week.AddDate(0, 0, 7)) {
+		dates.ThisWeekend++
+	}
+	if overlaps(b.week.AddDate(0, 0, 7), b.week.AddDate(0, 0, 14)) {
+		dates.NextWeek++
+	}
+}
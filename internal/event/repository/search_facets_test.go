@@ -0,0 +1,151 @@
+package eventRepository
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"kudago/internal/models"
+
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const searchFacetsQueryPattern = `(?s)WITH matched AS \(\s+SELECT DISTINCT event.id.*` +
+	`WHERE\s+event.status = 'published'\s+AND \(\$1::TEXT IS NULL OR event.search_vector @@ websearch_to_tsquery\('russian', \$1\)\).*` +
+	`AND event.recurrence_rule IS NULL\s+GROUP BY.*` +
+	`date_trunc\('day', NOW\(\) AT TIME ZONE 'Europe/Moscow'\) AT TIME ZONE 'Europe/Moscow' AS today.*` +
+	`SELECT 'category', category_id::TEXT, COUNT\(\*\) FROM matched GROUP BY category_id`
+
+const searchFacetSeriesQueryPattern = `(?s)SELECT event.id, event.category_id, event.event_start, event.event_finish,.*` +
+	`AND event.recurrence_rule IS NOT NULL\s+GROUP BY event.id`
+
+var searchFacetSeriesColumns = []string{"id", "category_id", "event_start", "event_finish", "tags", "recurrence_rule", "recurrence_exdates"}
+
+func TestEventRepository_GetSearchFacets(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	tests := []struct {
+		name           string
+		params         models.SearchParams
+		mockSetup      func(m pgxmock.PgxConnIface)
+		expectedFacets models.SearchFacets
+		expectErr      bool
+	}{
+		{
+			name: "подсчет фасетов",
+			params: models.SearchParams{
+				Query: "джаз",
+				Tags:  []string{"музыка"},
+			},
+			mockSetup: func(m pgxmock.PgxConnIface) {
+				m.ExpectQuery(searchFacetsQueryPattern).
+					WithArgs("джаз", nil, nil, nil, []string{"музыка"}, nil, nil, nil, nil, nil, nil, nil).
+					WillReturnRows(pgxmock.NewRows([]string{"facet", "value", "count"}).
+						AddRow("category", "1", 4).
+						AddRow("category", "3", 1).
+						AddRow("tag", "музыка", 5).
+						AddRow("tag", "джаз", 2).
+						AddRow("today", "", 1).
+						AddRow("this_weekend", "", 2).
+						AddRow("next_week", "", 0))
+				m.ExpectQuery(searchFacetSeriesQueryPattern).
+					WithArgs("джаз", nil, nil, nil, []string{"музыка"}, nil, nil, nil, nil, nil, nil, nil).
+					WillReturnRows(pgxmock.NewRows(searchFacetSeriesColumns))
+			},
+			expectedFacets: models.SearchFacets{
+				Categories: []models.CategoryFacet{{CategoryID: 1, Count: 4}, {CategoryID: 3, Count: 1}},
+				Tags:       []models.TagFacet{{Tag: "музыка", Count: 5}, {Tag: "джаз", Count: 2}},
+				Dates:      models.DateFacets{Today: 1, ThisWeekend: 2},
+			},
+		},
+		{
+			name: "серия считается по повторениям в окне поиска",
+			params: models.SearchParams{
+				EventStart: "2030-01-01",
+				EventEnd:   "2030-01-31",
+			},
+			mockSetup: func(m pgxmock.PgxConnIface) {
+				seriesStart := time.Date(2030, time.January, 7, 16, 0, 0, 0, time.UTC)
+				weeklyRule := "FREQ=WEEKLY;COUNT=3"
+
+				m.ExpectQuery(searchFacetsQueryPattern).
+					WithArgs(nil, nil, "2030-01-01", "2030-01-31", nil, nil, nil, nil, nil, nil, nil, nil).
+					WillReturnRows(pgxmock.NewRows([]string{"facet", "value", "count"}).
+						AddRow("category", "1", 4).
+						AddRow("tag", "музыка", 5).
+						AddRow("today", "", 0).
+						AddRow("this_weekend", "", 0).
+						AddRow("next_week", "", 0))
+				m.ExpectQuery(searchFacetSeriesQueryPattern).
+					WithArgs(nil, nil, "2030-01-01", "2030-01-31", nil, nil, nil, nil, nil, nil, nil, nil).
+					WillReturnRows(pgxmock.NewRows(searchFacetSeriesColumns).
+						AddRow(7, 3, seriesStart, seriesStart.Add(2*time.Hour), []string{"джаз"}, &weeklyRule, []time.Time{}).
+						AddRow(8, 1, seriesStart, seriesStart.Add(2*time.Hour), []string{"музыка"}, &weeklyRule, []time.Time{seriesStart}))
+			},
+			expectedFacets: models.SearchFacets{
+				Categories: []models.CategoryFacet{{CategoryID: 1, Count: 6}, {CategoryID: 3, Count: 3}},
+				Tags:       []models.TagFacet{{Tag: "музыка", Count: 7}, {Tag: "джаз", Count: 3}},
+			},
+		},
+		{
+			name:   "ошибка при подсчете",
+			params: models.SearchParams{},
+			mockSetup: func(m pgxmock.PgxConnIface) {
+				m.ExpectQuery(searchFacetsQueryPattern).
+					WithArgs(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil).
+					WillReturnError(errors.New("query error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockConn, err := pgxmock.NewConn()
+			require.NoError(t, err)
+			defer mockConn.Close(ctx)
+
+			tt.mockSetup(mockConn)
+
+			db := &EventDB{pool: mockConn}
+			facets, err := db.GetSearchFacets(ctx, tt.params)
+
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedFacets, facets)
+			}
+			assert.NoError(t, mockConn.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestDateBuckets(t *testing.T) {
+	t.Parallel()
+
+	// Thursday 02:30 in Moscow while it is still Wednesday in UTC.
+	buckets := newDateBuckets(time.Date(2025, time.January, 8, 23, 30, 0, 0, time.UTC))
+	occurrence := func(start time.Time) EventInfo {
+		return EventInfo{EventStart: start, EventFinish: start.Add(time.Hour)}
+	}
+
+	var dates models.DateFacets
+	// 01:00-02:00 on Thursday in Moscow.
+	buckets.add(&dates, occurrence(time.Date(2025, time.January, 8, 22, 0, 0, 0, time.UTC)))
+	// Over by Thursday midnight in Moscow.
+	buckets.add(&dates, occurrence(time.Date(2025, time.January, 8, 19, 0, 0, 0, time.UTC)))
+	// Saturday.
+	buckets.add(&dates, occurrence(time.Date(2025, time.January, 11, 10, 0, 0, 0, time.UTC)))
+	// Monday of the next week in Moscow, still Sunday in UTC.
+	buckets.add(&dates, occurrence(time.Date(2025, time.January, 12, 21, 30, 0, 0, time.UTC)))
+
+	assert.Equal(t, models.DateFacets{Today: 1, ThisWeekend: 1, NextWeek: 1}, dates)
+}
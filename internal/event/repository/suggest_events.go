@@ -0,0 +1,78 @@
+package eventRepository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"kudago/internal/models"
+)
+
+// Prefix matches go first, then the most favorited ones; the trigram
+// word similarity ($1 <% ...) catches typos and matches inside the title.
+const suggestEventTitlesQuery = `
+	SELECT event.id, event.title, COUNT(favorite_event.id) AS favorites
+	FROM event
+	LEFT JOIN favorite_event ON favorite_event.event_id = event.id
+	WHERE event.event_finish >= NOW() AND ` + listedCondition + `
+		AND (event.title ILIKE $2 OR $1::TEXT <% event.title)
+	GROUP BY event.id
+	ORDER BY (event.title ILIKE $2) DESC, favorites DESC, word_similarity($1::TEXT, event.title) DESC, event.id
+	LIMIT $3`
+
+const suggestTagsQuery = `
+	SELECT tag.name, COUNT(favorite_event.id) AS favorites
+	FROM tag
+	LEFT JOIN event_tag ON event_tag.tag_id = tag.id
+	LEFT JOIN favorite_event ON favorite_event.event_id = event_tag.event_id
+	WHERE tag.name ILIKE $2 OR $1::TEXT <% tag.name
+	GROUP BY tag.id, tag.name
+	ORDER BY (tag.name ILIKE $2) DESC, favorites DESC, tag.name
+	LIMIT $3`
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+func (db *EventDB) SuggestEvents(ctx context.Context, query string, limit int) (models.Suggestions, error) {
+	prefix := likeEscaper.Replace(query) + "%"
+
+	suggestions := models.Suggestions{
+		Events: make([]models.EventSuggestion, 0, limit),
+		Tags:   make([]models.TagSuggestion, 0, limit),
+	}
+
+	rows, err := db.pool.Query(ctx, suggestEventTitlesQuery, query, prefix, limit)
+	if err != nil {
+		return models.Suggestions{}, fmt.Errorf("%s: %w", models.LevelDB, err)
+	}
+	for rows.Next() {
+		var suggestion models.EventSuggestion
+		if err = rows.Scan(&suggestion.ID, &suggestion.Title, &suggestion.FavoritesCount); err != nil {
+			rows.Close()
+			return models.Suggestions{}, fmt.Errorf("%s: %w", models.LevelDB, err)
+		}
+		suggestions.Events = append(suggestions.Events, suggestion)
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return models.Suggestions{}, fmt.Errorf("%s: %w", models.LevelDB, err)
+	}
+
+	rows, err = db.pool.Query(ctx, suggestTagsQuery, query, prefix, limit)
+	if err != nil {
+		return models.Suggestions{}, fmt.Errorf("%s: %w", models.LevelDB, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var suggestion models.TagSuggestion
+		if err = rows.Scan(&suggestion.Name, &suggestion.FavoritesCount); err != nil {
+			return models.Suggestions{}, fmt.Errorf("%s: %w", models.LevelDB, err)
+		}
+		suggestions.Tags = append(suggestions.Tags, suggestion)
+	}
+	if err = rows.Err(); err != nil {
+		return models.Suggestions{}, fmt.Errorf("%s: %w", models.LevelDB, err)
+	}
+
+	return suggestions, nil
+}
@@ -0,0 +1,102 @@
+package eventRepository
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"kudago/internal/models"
+
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventRepository_SuggestEvents(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	tests := []struct {
+		name                string
+		query               string
+		mockSetup           func(m pgxmock.PgxConnIface)
+		expectedSuggestions models.Suggestions
+		expectErr           bool
+	}{
+		{
+			name:  "подсказки по названиям и тегам",
+			query: "джа",
+			mockSetup: func(m pgxmock.PgxConnIface) {
+				m.ExpectQuery(`(?s)FROM event\s+LEFT JOIN favorite_event.*event.title ILIKE \$2 OR \$1::TEXT <% event.title`).
+					WithArgs("джа", "джа%", 5).
+					WillReturnRows(pgxmock.NewRows([]string{"id", "title", "favorites"}).
+						AddRow(3, "Джаз в саду", 12).
+						AddRow(8, "Вечер джаза", 4))
+				m.ExpectQuery(`(?s)FROM tag.*tag.name ILIKE \$2 OR \$1::TEXT <% tag.name`).
+					WithArgs("джа", "джа%", 5).
+					WillReturnRows(pgxmock.NewRows([]string{"name", "favorites"}).
+						AddRow("джаз", 30))
+			},
+			expectedSuggestions: models.Suggestions{
+				Events: []models.EventSuggestion{
+					{ID: 3, Title: "Джаз в саду", FavoritesCount: 12},
+					{ID: 8, Title: "Вечер джаза", FavoritesCount: 4},
+				},
+				Tags: []models.TagSuggestion{{Name: "джаз", FavoritesCount: 30}},
+			},
+		},
+		{
+			name:  "спецсимволы LIKE экранируются",
+			query: "100%_",
+			mockSetup: func(m pgxmock.PgxConnIface) {
+				m.ExpectQuery(`(?s)FROM event\s+LEFT JOIN favorite_event`).
+					WithArgs("100%_", `100\%\_%`, 5).
+					WillReturnRows(pgxmock.NewRows([]string{"id", "title", "favorites"}))
+				m.ExpectQuery(`(?s)FROM tag`).
+					WithArgs("100%_", `100\%\_%`, 5).
+					WillReturnRows(pgxmock.NewRows([]string{"name", "favorites"}))
+			},
+			expectedSuggestions: models.Suggestions{
+				Events: []models.EventSuggestion{},
+				Tags:   []models.TagSuggestion{},
+			},
+		},
+		{
+			name:  "ошибка при поиске тегов",
+			query: "джа",
+			mockSetup: func(m pgxmock.PgxConnIface) {
+				m.ExpectQuery(`(?s)FROM event\s+LEFT JOIN favorite_event`).
+					WithArgs("джа", "джа%", 5).
+					WillReturnRows(pgxmock.NewRows([]string{"id", "title", "favorites"}))
+				m.ExpectQuery(`(?s)FROM tag`).
+					WithArgs("джа", "джа%", 5).
+					WillReturnError(errors.New("query error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockConn, err := pgxmock.NewConn()
+			require.NoError(t, err)
+			defer mockConn.Close(ctx)
+
+			tt.mockSetup(mockConn)
+
+			db := &EventDB{pool: mockConn}
+			suggestions, err := db.SuggestEvents(ctx, tt.query, 5)
+
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedSuggestions, suggestions)
+			}
+			assert.NoError(t, mockConn.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package eventRepository
+
+import (
+	"time"
+
+	"kudago/internal/models"
+)
+
+type TicketInfo struct {
+	ID            int        `db:"id"`
+	EventID       int        `db:"event_id"`
+	TicketTypeID  int        `db:"ticket_type_id"`
+	UserID        int        `db:"user_id"`
+	Type          string     `db:"type"`
+	Price         float64    `db:"price"`
+	Quantity      int        `db:"quantity"`
+	Status        string     `db:"status"`
+	BoughtAt      time.Time  `db:"ticket_buy_date"`
+	ReservedUntil *time.Time `db:"reserved_until"`
+}
+
+func toDomainTicket(ticketInfo TicketInfo) models.Ticket {
+	ticket := models.Ticket{
+		ID:           ticketInfo.ID,
+		EventID:      ticketInfo.EventID,
+		TicketTypeID: ticketInfo.TicketTypeID,
+		UserID:       ticketInfo.UserID,
+		Type:         ticketInfo.Type,
+		Price:        ticketInfo.Price,
+		Quantity:     ticketInfo.Quantity,
+		Status:       ticketInfo.Status,
+	}
+
+	if ticketInfo.Status == models.TicketStatusPaid {
+		ticket.BoughtAt = ticketInfo.BoughtAt
+	}
+	if ticketInfo.ReservedUntil != nil {
+		ticket.ReservedUntil = *ticketInfo.ReservedUntil
+	}
+	return ticket
+}
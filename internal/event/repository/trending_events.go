@@ -0,0 +1,74 @@
+package eventRepository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"kudago/internal/models"
+)
+
+// refreshTrendingScoresQuery sums favorites from the last $2 seconds, each
+// halving its weight every $1 seconds. Finished events are reset to zero so
+// they don't linger in the index with stale scores. Only rows whose score
+// changes are written.
+const refreshTrendingScoresQuery = `
+	WITH scores AS (
+		SELECT event.id, COALESCE((
+			SELECT SUM(EXP(-LN(2) * EXTRACT(EPOCH FROM LOCALTIMESTAMP - FAVORITE_EVENT.created_at) / $1::DOUBLE PRECISION))
+			FROM FAVORITE_EVENT
+			WHERE FAVORITE_EVENT.event_id = event.id
+				AND FAVORITE_EVENT.created_at >= LOCALTIMESTAMP - make_interval(secs => $2::DOUBLE PRECISION)
+		), 0) AS score
+		FROM event
+		WHERE ` + upcomingCondition + `
+			OR event.trending_score <> 0
+	)
+	UPDATE event
+	SET trending_score = scores.score
+	FROM scores
+	WHERE event.id = scores.id AND event.trending_score IS DISTINCT FROM scores.score`
+
+const selectTrendingEventsQuery = `
+	SELECT event.id, event.title, event.description, event.event_start, event.event_finish,
+		event.location, event.capacity, event.created_at, event.user_id, event.category_id, event.lat, event.lon,
+		COALESCE(array_agg(DISTINCT COALESCE(tag.name, '')), '{}') AS tags, media_url.url AS media_link,
+		(SELECT COUNT(*) FROM attendance WHERE attendance.event_id = event.id) AS attendees,
+		(SELECT COUNT(*) FROM FAVORITE_EVENT WHERE FAVORITE_EVENT.event_id = event.id) AS favorites_count,
+		event.status, event.publish_at,
+		event.recurrence_rule, event.recurrence_exdates
+	FROM event
+	LEFT JOIN event_tag ON event.id = event_tag.event_id
+	LEFT JOIN tag ON tag.id = event_tag.tag_id
+	LEFT JOIN media_url ON event.id = media_url.event_id AND media_url.is_cover
+	WHERE ` + upcomingCondition + ` AND ` + listedCondition + `
+		AND ($3::INT = 0 OR event.category_id = $3)
+	GROUP BY event.id, media_url.url
+	ORDER BY event.trending_score DESC, event.event_start ASC, event.id ASC
+	LIMIT $1 OFFSET $2`
+
+// RefreshTrendingScores recomputes the time-decayed popularity of upcoming
+// events from favorites added within window.
+func (db *EventDB) RefreshTrendingScores(ctx context.Context, halfLife, window time.Duration) error {
+	_, err := db.pool.Exec(ctx, refreshTrendingScoresQuery, halfLife.Seconds(), window.Seconds())
+	if err != nil {
+		return fmt.Errorf("%s: %w", models.LevelDB, err)
+	}
+
+	return nil
+}
+
+// GetTrendingEvents ranks upcoming events by their trending score. A zero
+// categoryID disables the category filter.
+func (db *EventDB) GetTrendingEvents(ctx context.Context, categoryID int, paginationParams models.PaginationParams) ([]models.Event, error) {
+	rows, err := db.pool.Query(ctx, selectTrendingEventsQuery, paginationParams.Limit, paginationParams.Offset, categoryID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", models.LevelDB, err)
+	}
+	infos, err := scanEventInfos(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	return db.toNextOccurrences(ctx, infos, time.Now(), paginationParams.Limit), nil
+}
@@ -0,0 +1,114 @@
+package eventRepository
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"kudago/internal/models"
+)
+
+func TestEventRepository_GetTrendingEvents(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	eventStart := time.Now().Add(24 * time.Hour).Truncate(time.Second)
+	eventEnd := eventStart.Add(2 * time.Hour)
+	columns := []string{
+		"id", "title", "description", "event_start", "event_finish", "location", "capacity", "created_at",
+		"user_id", "category_id", "lat", "lon", "tags", "media_link", "attendees", "favorites_count", "status", "publish_at", "recurrence_rule", "recurrence_exdates",
+	}
+	pattern := `(?s)AND event.status = 'published'\s+AND \(\$3::INT = 0 OR event.category_id = \$3\).*ORDER BY event.trending_score DESC, event.event_start ASC, event.id ASC\s+LIMIT \$1 OFFSET \$2`
+
+	tests := []struct {
+		name           string
+		categoryID     int
+		mockSetup      func(m pgxmock.PgxConnIface)
+		expectedEvents []models.Event
+		expectErr      bool
+	}{
+		{
+			name:       "популярные события категории",
+			categoryID: 3,
+			mockSetup: func(m pgxmock.PgxConnIface) {
+				m.ExpectQuery(pattern).
+					WithArgs(10, 0, 3).
+					WillReturnRows(pgxmock.NewRows(columns).
+						AddRow(5, "Джаз", "", eventStart, eventEnd, "", 0, eventStart, 2, 3, 0.0, 0.0, []string{}, nil, 1, 12, "published", nil, nil, []time.Time{}))
+			},
+			expectedEvents: []models.Event{
+				{
+					ID: 5, Title: "Джаз", AuthorID: 2, CategoryID: 3, Tag: []string{}, Attendees: 1, FavoritesCount: 12, Status: "published",
+					EventStart: eventStart.Format(time.RFC3339), EventEnd: eventEnd.Format(time.RFC3339), Start: eventStart,
+				},
+			},
+		},
+		{
+			name: "ошибка при чтении строк",
+			mockSetup: func(m pgxmock.PgxConnIface) {
+				m.ExpectQuery(pattern).
+					WithArgs(10, 0, 0).
+					WillReturnRows(pgxmock.NewRows(columns).
+						AddRow(5, "Джаз", "", eventStart, eventEnd, "", 0, eventStart, 2, 3, 0.0, 0.0, []string{}, nil, 1, 12, "published", nil, nil, []time.Time{}).
+						RowError(0, fmt.Errorf("connection reset")))
+			},
+			expectErr: true,
+		},
+		{
+			name: "ошибка базы данных",
+			mockSetup: func(m pgxmock.PgxConnIface) {
+				m.ExpectQuery(pattern).
+					WithArgs(10, 0, 0).
+					WillReturnError(fmt.Errorf("database error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockConn, err := pgxmock.NewConn()
+			require.NoError(t, err)
+			defer mockConn.Close(ctx)
+
+			tt.mockSetup(mockConn)
+
+			db := EventDB{pool: mockConn}
+			events, err := db.GetTrendingEvents(ctx, tt.categoryID, models.PaginationParams{Limit: 10})
+
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedEvents, events)
+			}
+			require.NoError(t, mockConn.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestEventRepository_RefreshTrendingScores(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	mockConn, err := pgxmock.NewConn()
+	require.NoError(t, err)
+	defer mockConn.Close(ctx)
+
+	mockConn.ExpectExec(`(?s)WITH scores AS \(.*EXP\(-LN\(2\).*OR event.trending_score <> 0.*UPDATE event\s+SET trending_score = scores.score.*event.trending_score IS DISTINCT FROM scores.score`).
+		WithArgs(float64(48*60*60), float64(7*24*60*60)).
+		WillReturnResult(pgxmock.NewResult("UPDATE", 3))
+
+	db := EventDB{pool: mockConn}
+	err = db.RefreshTrendingScores(ctx, 48*time.Hour, 7*24*time.Hour)
+
+	require.NoError(t, err)
+	require.NoError(t, mockConn.ExpectationsWereMet())
+}
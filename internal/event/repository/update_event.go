@@ -20,9 +20,16 @@ const updateEventQuery = `
 		category_id = COALESCE($8, category_id), 
 		updated_at = $9,
 		lat = COALESCE($10, lat),
-		lon = COALESCE($11, lon)
+		lon = COALESCE($11, lon),
+		recurrence_rule = $12,
+		recurrence_exdates = $13,
+		recurrence_until = $14,
+		publish_at = COALESCE($15, publish_at)
 	WHERE id = $1
-	RETURNING id, title, description, event_start, event_finish, location, capacity, category_id, user_id, lat, lon
+	RETURNING id, title, description, event_start, event_finish, location, capacity, category_id, user_id, lat, lon,
+		(SELECT COUNT(*) FROM attendance WHERE attendance.event_id = event.id),
+		(SELECT COUNT(*) FROM FAVORITE_EVENT WHERE FAVORITE_EVENT.event_id = event.id), status, publish_at,
+		recurrence_rule, recurrence_exdates
 `
 
 func (db *EventDB) UpdateEvent(ctx context.Context, updatedEvent models.Event) (models.Event, error) {
@@ -32,6 +39,13 @@ func (db *EventDB) UpdateEvent(ctx context.Context, updatedEvent models.Event) (
 	}
 	defer tx.Rollback(ctx)
 
+	// The recurrence is replaced as a whole: an update without a rule turns a
+	// series back into a single event.
+	rule, exdates, until, err := recurrenceArgs(updatedEvent)
+	if err != nil {
+		return models.Event{}, fmt.Errorf("%s: %w", models.LevelDB, err)
+	}
+
 	var eventInfo EventInfo
 	err = tx.QueryRow(ctx, updateEventQuery,
 		updatedEvent.ID,
@@ -45,6 +59,10 @@ func (db *EventDB) UpdateEvent(ctx context.Context, updatedEvent models.Event) (
 		time.Now(),
 		nilIfZeroFloat(updatedEvent.Latitude),
 		nilIfZeroFloat(updatedEvent.Longitude),
+		rule,
+		exdates,
+		until,
+		nilIfEmpty(updatedEvent.PublishAt),
 	).Scan(
 		&eventInfo.ID,
 		&eventInfo.Title,
@@ -57,6 +75,12 @@ func (db *EventDB) UpdateEvent(ctx context.Context, updatedEvent models.Event) (
 		&eventInfo.UserID,
 		&eventInfo.Latitude,
 		&eventInfo.Longitude,
+		&eventInfo.Attendees,
+		&eventInfo.FavoritesCount,
+		&eventInfo.Status,
+		&eventInfo.PublishAt,
+		&eventInfo.RecurrenceRule,
+		&eventInfo.RecurrenceExdates,
 	)
 	if err != nil {
 		return models.Event{}, fmt.Errorf("%s: %w", models.LevelDB, err)
@@ -69,8 +93,9 @@ func (db *EventDB) UpdateEvent(ctx context.Context, updatedEvent models.Event) (
 		}
 	}
 
+	var replacedImageURL string
 	if updatedEvent.ImageURL != "" {
-		err = db.updateMediaURL(ctx, tx, updatedEvent.ID, updatedEvent.ImageURL)
+		replacedImageURL, err = db.updateMediaURL(ctx, tx, updatedEvent.ID, updatedEvent.ImageURL)
 		if err != nil {
 			return models.Event{}, fmt.Errorf("%s: %w", models.LevelDB, err)
 		}
@@ -88,6 +113,7 @@ func (db *EventDB) UpdateEvent(ctx context.Context, updatedEvent models.Event) (
 
 	event.Tag = updatedEvent.Tag
 	event.ImageURL = updatedEvent.ImageURL
+	event.ReplacedImageURL = replacedImageURL
 
 	return event, nil
 }
@@ -94,3 +94,60 @@ type anyTime struct{}
 func (anyTime) Scan(src interface{}) error {
 	return nil
 }
+
+func TestEventRepository_UpdateMediaURL(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	tests := []struct {
+		name      string
+		mockSetup func(m pgxmock.PgxConnIface)
+		expected  string
+	}{
+		{
+			name: "Замена обложки",
+			mockSetup: func(m pgxmock.PgxConnIface) {
+				m.ExpectQuery(`UPDATE media_url SET url = \$2\s+FROM media_url AS replaced`).
+					WithArgs(1, "/images/new_full.jpg").
+					WillReturnRows(pgxmock.NewRows([]string{"url"}).AddRow("/images/old_full.jpg"))
+			},
+			expected: "/images/old_full.jpg",
+		},
+		{
+			name: "Событие без обложки",
+			mockSetup: func(m pgxmock.PgxConnIface) {
+				m.ExpectQuery(`UPDATE media_url SET url = \$2\s+FROM media_url AS replaced`).
+					WithArgs(1, "/images/new_full.jpg").
+					WillReturnRows(pgxmock.NewRows([]string{"url"}))
+				m.ExpectExec(`INSERT INTO media_url \(event_id, url, position, is_cover\)`).
+					WithArgs(1, "/images/new_full.jpg").
+					WillReturnResult(pgxmock.NewResult("INSERT", 1))
+			},
+			expected: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockConn, err := pgxmock.NewConn()
+			require.NoError(t, err)
+			defer mockConn.Close(ctx)
+
+			mockConn.ExpectBegin()
+			tt.mockSetup(mockConn)
+
+			db := NewDB(mockConn)
+			tx, err := mockConn.Begin(ctx)
+			require.NoError(t, err)
+
+			replaced, err := db.updateMediaURL(ctx, tx, 1, "/images/new_full.jpg")
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, replaced)
+			assert.NoError(t, mockConn.ExpectationsWereMet())
+		})
+	}
+}
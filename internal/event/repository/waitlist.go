@@ -0,0 +1,156 @@
+package eventRepository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"kudago/internal/models"
+
+	"github.com/jackc/pgx/v5"
+)
+
+const deleteExpiredClaimsQuery = `DELETE FROM waitlist WHERE event_id = $1 AND claim_until <= NOW()`
+
+// countTakenSpotsQuery is the single measure of the event capacity shared by
+// RSVPs and tickets: attendees, spots held by promoted users until their claim
+// window ends, and seats of paid tickets or reservations that have not expired.
+const countTakenSpotsQuery = `
+	SELECT (SELECT COUNT(*) FROM attendance WHERE event_id = $1) +
+	       (SELECT COUNT(*) FROM waitlist WHERE event_id = $1 AND claim_until > NOW()) +
+	       (SELECT COALESCE(SUM(quantity), 0) FROM ticket
+	        WHERE event_id = $1 AND (status = 'paid' OR reserved_until > NOW()))`
+
+// Expired reservations no longer hold seats, so they are dropped once the
+// waitlist has been given the chance to take them.
+const deleteExpiredReservationsQuery = `
+	DELETE FROM ticket WHERE event_id = $1 AND status = 'reserved' AND reserved_until <= NOW()`
+
+const promoteWaitlistQuery = `
+	UPDATE waitlist SET claim_until = $3
+	WHERE id IN (
+		SELECT id FROM waitlist
+		WHERE event_id = $1 AND claim_until IS NULL
+		ORDER BY id
+		LIMIT $2
+	)
+	RETURNING event_id, user_id, claim_until`
+
+// promoteFromWaitlist drops expired claims and hands every free spot to the
+// next users in the queue. The caller must hold the lock on the event row.
+func promoteFromWaitlist(ctx context.Context, tx pgx.Tx, eventID, capacity int, claimUntil time.Time) ([]models.WaitlistEntry, error) {
+	if capacity == 0 {
+		return nil, nil
+	}
+
+	_, err := tx.Exec(ctx, deleteExpiredClaimsQuery, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", models.LevelDB, err)
+	}
+
+	var taken int
+	err = tx.QueryRow(ctx, countTakenSpotsQuery, eventID).Scan(&taken)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", models.LevelDB, err)
+	}
+
+	free := capacity - taken
+	if free <= 0 {
+		return nil, nil
+	}
+
+	rows, err := tx.Query(ctx, promoteWaitlistQuery, eventID, free, claimUntil)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", models.LevelDB, err)
+	}
+	defer rows.Close()
+
+	var promoted []models.WaitlistEntry
+	for rows.Next() {
+		var entry models.WaitlistEntry
+		if err = rows.Scan(&entry.EventID, &entry.UserID, &entry.ClaimUntil); err != nil {
+			return nil, fmt.Errorf("%s: %w", models.LevelDB, err)
+		}
+		promoted = append(promoted, entry)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", models.LevelDB, err)
+	}
+
+	return promoted, nil
+}
+
+const selectExpiredClaimEventsQuery = `
+	SELECT event_id FROM waitlist WHERE claim_until <= NOW()
+	UNION
+	SELECT event_id FROM ticket WHERE status = 'reserved' AND reserved_until <= NOW()`
+
+// PromoteExpiredClaims hands the spots of expired claims and ticket
+// reservations to the next users in the waitlists, who are returned for
+// notification. Each event is handled in a transaction of its own, holding
+// the lock on its row.
+func (db *EventDB) PromoteExpiredClaims(ctx context.Context, claimUntil time.Time) ([]models.WaitlistEntry, error) {
+	rows, err := db.pool.Query(ctx, selectExpiredClaimEventsQuery)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", models.LevelDB, err)
+	}
+	defer rows.Close()
+
+	var eventIDs []int
+	for rows.Next() {
+		var eventID int
+		if err = rows.Scan(&eventID); err != nil {
+			return nil, fmt.Errorf("%s: %w", models.LevelDB, err)
+		}
+		eventIDs = append(eventIDs, eventID)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", models.LevelDB, err)
+	}
+
+	var promoted []models.WaitlistEntry
+	for _, eventID := range eventIDs {
+		entries, err := db.promoteEventWaitlist(ctx, eventID, claimUntil)
+		if err != nil {
+			return promoted, err
+		}
+		promoted = append(promoted, entries...)
+	}
+
+	return promoted, nil
+}
+
+func (db *EventDB) promoteEventWaitlist(ctx context.Context, eventID int, claimUntil time.Time) ([]models.WaitlistEntry, error) {
+	tx, err := db.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", models.LevelDB, err)
+	}
+	defer tx.Rollback(ctx)
+
+	var capacity int
+	err = tx.QueryRow(ctx, selectEventCapacityForUpdateQuery, eventID).Scan(&capacity)
+	if err != nil {
+		// The event was deleted in the meantime along with its waitlist.
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("%s: %w", models.LevelDB, err)
+	}
+
+	_, err = tx.Exec(ctx, deleteExpiredReservationsQuery, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", models.LevelDB, err)
+	}
+
+	promoted, err := promoteFromWaitlist(ctx, tx, eventID, capacity, claimUntil)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", models.LevelDB, err)
+	}
+	return promoted, nil
+}
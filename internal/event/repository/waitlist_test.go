@@ -0,0 +1,63 @@
+package eventRepository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"kudago/internal/models"
+)
+
+func TestEventRepository_PromoteExpiredClaims(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	claimUntil := time.Date(2024, 12, 1, 12, 0, 0, 0, time.UTC)
+
+	mockConn, err := pgxmock.NewConn()
+	require.NoError(t, err)
+	defer mockConn.Close(ctx)
+
+	mockConn.ExpectQuery(`SELECT event_id FROM waitlist WHERE claim_until <= NOW\(\)\s+UNION\s+SELECT event_id FROM ticket WHERE status = 'reserved' AND reserved_until <= NOW\(\)`).
+		WillReturnRows(pgxmock.NewRows([]string{"event_id"}).AddRow(1).AddRow(2))
+
+	mockConn.ExpectBegin()
+	mockConn.ExpectQuery(`SELECT COALESCE\(capacity, 0\) FROM event WHERE id = \$1 FOR UPDATE`).
+		WithArgs(1).
+		WillReturnRows(pgxmock.NewRows([]string{"capacity"}).AddRow(10))
+	// The seat of an expired reservation goes to the waitlist as well.
+	mockConn.ExpectExec(`DELETE FROM ticket WHERE event_id = \$1 AND status = 'reserved' AND reserved_until <= NOW\(\)`).
+		WithArgs(1).
+		WillReturnResult(pgxmock.NewResult("DELETE", 1))
+	mockConn.ExpectExec(`DELETE FROM waitlist WHERE event_id = \$1 AND claim_until <= NOW\(\)`).
+		WithArgs(1).
+		WillReturnResult(pgxmock.NewResult("DELETE", 1))
+	mockConn.ExpectQuery(`SELECT \(SELECT COUNT\(\*\) FROM attendance`).
+		WithArgs(1).
+		WillReturnRows(pgxmock.NewRows([]string{"count"}).AddRow(9))
+	mockConn.ExpectQuery(`UPDATE waitlist SET claim_until`).
+		WithArgs(1, 1, claimUntil).
+		WillReturnRows(pgxmock.NewRows([]string{"event_id", "user_id", "claim_until"}).
+			AddRow(1, 5, claimUntil))
+	mockConn.ExpectCommit()
+	mockConn.ExpectRollback()
+
+	// The second event has been deleted since.
+	mockConn.ExpectBegin()
+	mockConn.ExpectQuery(`SELECT COALESCE\(capacity, 0\) FROM event WHERE id = \$1 FOR UPDATE`).
+		WithArgs(2).
+		WillReturnError(pgx.ErrNoRows)
+	mockConn.ExpectRollback()
+
+	db := NewDB(mockConn)
+	promoted, err := db.PromoteExpiredClaims(ctx, claimUntil)
+
+	require.NoError(t, err)
+	assert.Equal(t, []models.WaitlistEntry{{EventID: 1, UserID: 5, ClaimUntil: claimUntil}}, promoted)
+	assert.NoError(t, mockConn.ExpectationsWereMet())
+}
@@ -0,0 +1,75 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"kudago/internal/models"
+)
+
+// waitlistClaimWindow is how long a user promoted from the waitlist keeps the
+// freed spot before it passes to the next one in the queue.
+const waitlistClaimWindow = 24 * time.Hour
+
+func (s *EventService) AttendEvent(ctx context.Context, eventID, userID int) (models.AttendanceResult, error) {
+	err := s.checkPublished(ctx, eventID)
+	if err != nil {
+		return models.AttendanceResult{}, err
+	}
+
+	return s.EventDB.AddAttendee(ctx, eventID, userID, time.Now().Add(waitlistClaimWindow))
+}
+
+func (s *EventService) CancelAttendance(ctx context.Context, eventID, userID int) ([]models.WaitlistEntry, error) {
+	return s.EventDB.RemoveAttendee(ctx, eventID, userID, time.Now().Add(waitlistClaimWindow))
+}
+
+// PromoteWaitlists passes the spots of expired claims and ticket reservations
+// to the next users in the waitlists and notifies them. It runs periodically, otherwise a spot
+// would stay held until someone else happens to RSVP or cancel.
+func (s *EventService) PromoteWaitlists(ctx context.Context) error {
+	promoted, err := s.EventDB.PromoteExpiredClaims(ctx, time.Now().Add(waitlistClaimWindow))
+
+	var errs []error
+	if err != nil {
+		errs = append(errs, err)
+	}
+	for _, entry := range promoted {
+		err := s.Notifier.CreateNotifications(ctx, []int{entry.UserID}, models.Notification{
+			EventID:  entry.EventID,
+			NotifyAt: time.Now(),
+			Message:  fmt.Sprintf(models.WaitlistPromotedMessage, entry.ClaimUntil.Format(models.ClaimDeadlineLayout)),
+		})
+		if err != nil {
+			errs = append(errs, fmt.Errorf("notify user %d of event %d: %w", entry.UserID, entry.EventID, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// CheckInAttendee marks that the user actually came. Only the organizer can do
+// it and only once the event has started.
+func (s *EventService) CheckInAttendee(ctx context.Context, eventID, userID, authorID int) error {
+	dbEvent, err := s.EventDB.GetEventByID(ctx, eventID)
+	if err != nil {
+		return err
+	}
+
+	if dbEvent.AuthorID != authorID {
+		return fmt.Errorf("%s: %w", models.LevelService, models.ErrAccessDenied)
+	}
+
+	eventStart, err := time.Parse(time.RFC3339, dbEvent.EventStart)
+	if err != nil {
+		return fmt.Errorf("%s: %w", models.LevelService, err)
+	}
+
+	if time.Now().Before(eventStart) {
+		return fmt.Errorf("%s: %w", models.LevelService, models.ErrEventNotStarted)
+	}
+
+	return s.EventDB.CheckInAttendee(ctx, eventID, userID)
+}
@@ -0,0 +1,160 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"kudago/internal/event/service/mocks"
+	"kudago/internal/models"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEventService_AttendEvent(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		setupMocks    func(mockEventDB *mocks.MockEventDB)
+		expected      models.AttendanceResult
+		expectedError error
+	}{
+		{
+			name: "success",
+			setupMocks: func(mockEventDB *mocks.MockEventDB) {
+				mockEventDB.EXPECT().GetEventByID(gomock.Any(), 1).
+					Return(models.Event{ID: 1, Status: models.EventStatusPublished}, nil)
+				mockEventDB.EXPECT().AddAttendee(gomock.Any(), 1, 2, gomock.Any()).
+					Return(models.AttendanceResult{Status: models.AttendanceStatusAttending}, nil)
+			},
+			expected: models.AttendanceResult{Status: models.AttendanceStatusAttending},
+		},
+		{
+			name: "cancelled event",
+			setupMocks: func(mockEventDB *mocks.MockEventDB) {
+				mockEventDB.EXPECT().GetEventByID(gomock.Any(), 1).
+					Return(models.Event{ID: 1, Status: models.EventStatusCancelled}, nil)
+			},
+			expectedError: models.ErrNotPublished,
+		},
+		{
+			name: "event not found",
+			setupMocks: func(mockEventDB *mocks.MockEventDB) {
+				mockEventDB.EXPECT().GetEventByID(gomock.Any(), 1).Return(models.Event{}, models.ErrEventNotFound)
+			},
+			expectedError: models.ErrEventNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockEventDB := mocks.NewMockEventDB(ctrl)
+			tc.setupMocks(mockEventDB)
+			service := NewService(mockEventDB, nil)
+
+			result, err := service.AttendEvent(context.Background(), 1, 2)
+			assert.ErrorIs(t, err, tc.expectedError)
+			assert.Equal(t, tc.expected, result)
+		})
+	}
+}
+
+func TestEventService_CheckInAttendee(t *testing.T) {
+	t.Parallel()
+
+	started := models.Event{ID: 1, AuthorID: 1, EventStart: time.Now().Add(-time.Hour).Format(time.RFC3339)}
+	upcoming := models.Event{ID: 1, AuthorID: 1, EventStart: time.Now().Add(time.Hour).Format(time.RFC3339)}
+
+	testCases := []struct {
+		name          string
+		authorID      int
+		setupMocks    func(mockEventDB *mocks.MockEventDB)
+		expectedError error
+	}{
+		{
+			name:     "success",
+			authorID: 1,
+			setupMocks: func(mockEventDB *mocks.MockEventDB) {
+				mockEventDB.EXPECT().GetEventByID(gomock.Any(), 1).Return(started, nil)
+				mockEventDB.EXPECT().CheckInAttendee(gomock.Any(), 1, 2).Return(nil)
+			},
+		},
+		{
+			name:     "not an organizer",
+			authorID: 3,
+			setupMocks: func(mockEventDB *mocks.MockEventDB) {
+				mockEventDB.EXPECT().GetEventByID(gomock.Any(), 1).Return(started, nil)
+			},
+			expectedError: models.ErrAccessDenied,
+		},
+		{
+			name:     "event has not started",
+			authorID: 1,
+			setupMocks: func(mockEventDB *mocks.MockEventDB) {
+				mockEventDB.EXPECT().GetEventByID(gomock.Any(), 1).Return(upcoming, nil)
+			},
+			expectedError: models.ErrEventNotStarted,
+		},
+		{
+			name:     "attendee not found",
+			authorID: 1,
+			setupMocks: func(mockEventDB *mocks.MockEventDB) {
+				mockEventDB.EXPECT().GetEventByID(gomock.Any(), 1).Return(started, nil)
+				mockEventDB.EXPECT().CheckInAttendee(gomock.Any(), 1, 2).Return(models.ErrNotFound)
+			},
+			expectedError: models.ErrNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockEventDB := mocks.NewMockEventDB(ctrl)
+			tc.setupMocks(mockEventDB)
+			service := NewService(mockEventDB, nil)
+
+			err := service.CheckInAttendee(context.Background(), 1, 2, tc.authorID)
+			assert.ErrorIs(t, err, tc.expectedError)
+		})
+	}
+}
+
+func TestEventService_PromoteWaitlists(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockEventDB := mocks.NewMockEventDB(ctrl)
+	mockNotifier := mocks.NewMockNotifier(ctrl)
+	service := NewService(mockEventDB, mockNotifier)
+
+	claimUntil := time.Date(2025, time.January, 10, 19, 0, 0, 0, time.UTC)
+	dbErr := errors.New("connection reset")
+	mockEventDB.EXPECT().PromoteExpiredClaims(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, until time.Time) ([]models.WaitlistEntry, error) {
+			assert.WithinDuration(t, time.Now().Add(waitlistClaimWindow), until, time.Minute)
+			return []models.WaitlistEntry{{EventID: 1, UserID: 5, ClaimUntil: claimUntil}}, dbErr
+		})
+	mockNotifier.EXPECT().CreateNotifications(gomock.Any(), []int{5}, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ []int, notification models.Notification) error {
+			assert.Equal(t, 1, notification.EventID)
+			assert.Contains(t, notification.Message, "10.01.2025 19:00 UTC")
+			return nil
+		})
+
+	err := service.PromoteWaitlists(context.Background())
+	assert.ErrorIs(t, err, dbErr, "users promoted before the failure are still notified")
+}
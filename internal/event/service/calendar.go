@@ -0,0 +1,37 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	"kudago/internal/models"
+)
+
+// CreateCalendarToken issues a token for the user's personal calendar feed.
+// Only its hash is stored, and issuing a new token revokes the previous one.
+func (s *EventService) CreateCalendarToken(ctx context.Context, userID int) (string, error) {
+	b := make([]byte, 32)
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", fmt.Errorf("%s: %w", models.LevelService, err)
+	}
+	token := hex.EncodeToString(b)
+
+	err = s.EventDB.SaveCalendarToken(ctx, userID, calendarTokenHash(token))
+	if err != nil {
+		return "", err
+	}
+	return token, nil
+}
+
+func (s *EventService) GetUserIDByCalendarToken(ctx context.Context, token string) (int, error) {
+	return s.EventDB.GetUserIDByCalendarToken(ctx, calendarTokenHash(token))
+}
+
+func calendarTokenHash(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,44 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"kudago/internal/event/service/mocks"
+	"kudago/internal/models"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventService_CalendarToken(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockEventDB := mocks.NewMockEventDB(ctrl)
+	service := NewService(mockEventDB, nil)
+
+	var savedHash string
+	mockEventDB.EXPECT().SaveCalendarToken(gomock.Any(), 1, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ int, tokenHash string) error {
+			savedHash = tokenHash
+			return nil
+		})
+
+	token, err := service.CreateCalendarToken(context.Background(), 1)
+	require.NoError(t, err)
+	assert.Len(t, token, 64)
+	assert.NotEqual(t, token, savedHash, "the token itself must not be stored")
+
+	mockEventDB.EXPECT().GetUserIDByCalendarToken(gomock.Any(), savedHash).Return(1, nil)
+	userID, err := service.GetUserIDByCalendarToken(context.Background(), token)
+	require.NoError(t, err)
+	assert.Equal(t, 1, userID)
+
+	mockEventDB.EXPECT().GetUserIDByCalendarToken(gomock.Any(), gomock.Any()).Return(0, models.ErrInvalidToken)
+	_, err = service.GetUserIDByCalendarToken(context.Background(), "unknown")
+	assert.ErrorIs(t, err, models.ErrInvalidToken)
+}
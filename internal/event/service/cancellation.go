@@ -0,0 +1,75 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"kudago/internal/models"
+)
+
+// CancelEvent cancels a published event of the author. The event stays
+// visible with its status, and favoriters are notified by the caller.
+func (s *EventService) CancelEvent(ctx context.Context, ID, authorID int, reason string) (models.Event, error) {
+	dbEvent, err := s.getPublishedEvent(ctx, ID, authorID)
+	if err != nil {
+		return models.Event{}, err
+	}
+
+	err = s.EventDB.CancelEvent(ctx, ID, reason)
+	if err != nil {
+		return models.Event{}, err
+	}
+
+	dbEvent.Status = models.EventStatusCancelled
+	dbEvent.CancelReason = reason
+	return dbEvent, nil
+}
+
+// RescheduleEvent moves a published event of the author to new start and
+// finish times. For a series they are the times of its first occurrence.
+func (s *EventService) RescheduleEvent(ctx context.Context, ID, authorID int, eventStart, eventEnd string) (models.Event, error) {
+	dbEvent, err := s.getPublishedEvent(ctx, ID, authorID)
+	if err != nil {
+		return models.Event{}, err
+	}
+
+	dbEvent.EventStart = eventStart
+	dbEvent.EventEnd = eventEnd
+	err = s.EventDB.RescheduleEvent(ctx, dbEvent)
+	if err != nil {
+		return models.Event{}, err
+	}
+
+	return dbEvent, nil
+}
+
+// checkPublished rejects events users cannot sign up for: cancelled and
+// archived ones, and drafts, which are not shown to users at all.
+func (s *EventService) checkPublished(ctx context.Context, ID int) error {
+	dbEvent, err := s.EventDB.GetEventByID(ctx, ID)
+	if err != nil {
+		return err
+	}
+
+	if dbEvent.Status == models.EventStatusDraft {
+		return fmt.Errorf("%s: %w", models.LevelService, models.ErrEventNotFound)
+	}
+	if dbEvent.Status != models.EventStatusPublished {
+		return fmt.Errorf("%s: %w", models.LevelService, models.ErrNotPublished)
+	}
+
+	return nil
+}
+
+func (s *EventService) getPublishedEvent(ctx context.Context, ID, authorID int) (models.Event, error) {
+	dbEvent, err := s.getAuthoredEvent(ctx, ID, authorID)
+	if err != nil {
+		return models.Event{}, err
+	}
+
+	if dbEvent.Status != models.EventStatusPublished {
+		return models.Event{}, fmt.Errorf("%s: %w", models.LevelService, models.ErrNotPublished)
+	}
+
+	return dbEvent, nil
+}
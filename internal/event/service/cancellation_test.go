@@ -0,0 +1,133 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"kudago/internal/event/service/mocks"
+	"kudago/internal/models"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEventService_CancelEvent(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name       string
+		authorID   int
+		setupMocks func(mockEventDB *mocks.MockEventDB)
+		expected   models.Event
+		wantErr    error
+	}{
+		{
+			name:     "cancel published event",
+			authorID: 1,
+			setupMocks: func(mockEventDB *mocks.MockEventDB) {
+				mockEventDB.EXPECT().GetEventByID(gomock.Any(), 5).Return(models.Event{ID: 5, AuthorID: 1, Status: models.EventStatusPublished}, nil)
+				mockEventDB.EXPECT().CancelEvent(gomock.Any(), 5, "Площадка закрыта").Return(nil)
+			},
+			expected: models.Event{ID: 5, AuthorID: 1, Status: models.EventStatusCancelled, CancelReason: "Площадка закрыта"},
+		},
+		{
+			name:     "not the author",
+			authorID: 2,
+			setupMocks: func(mockEventDB *mocks.MockEventDB) {
+				mockEventDB.EXPECT().GetEventByID(gomock.Any(), 5).Return(models.Event{ID: 5, AuthorID: 1, Status: models.EventStatusPublished}, nil)
+			},
+			wantErr: models.ErrAccessDenied,
+		},
+		{
+			name:     "already cancelled",
+			authorID: 1,
+			setupMocks: func(mockEventDB *mocks.MockEventDB) {
+				mockEventDB.EXPECT().GetEventByID(gomock.Any(), 5).Return(models.Event{ID: 5, AuthorID: 1, Status: models.EventStatusCancelled}, nil)
+			},
+			wantErr: models.ErrNotPublished,
+		},
+		{
+			name:     "event not found",
+			authorID: 1,
+			setupMocks: func(mockEventDB *mocks.MockEventDB) {
+				mockEventDB.EXPECT().GetEventByID(gomock.Any(), 5).Return(models.Event{}, models.ErrEventNotFound)
+			},
+			wantErr: models.ErrEventNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockEventDB := mocks.NewMockEventDB(ctrl)
+			tc.setupMocks(mockEventDB)
+			service := NewService(mockEventDB, nil)
+
+			event, err := service.CancelEvent(context.Background(), 5, tc.authorID, "Площадка закрыта")
+			assert.ErrorIs(t, err, tc.wantErr)
+			assert.Equal(t, tc.expected, event)
+		})
+	}
+}
+
+func TestEventService_RescheduleEvent(t *testing.T) {
+	t.Parallel()
+
+	const (
+		newStart = "2030-01-06T18:00:00Z"
+		newEnd   = "2030-01-06T20:00:00Z"
+	)
+
+	testCases := []struct {
+		name       string
+		setupMocks func(mockEventDB *mocks.MockEventDB)
+		expected   models.Event
+		wantErr    error
+	}{
+		{
+			name: "reschedule series",
+			setupMocks: func(mockEventDB *mocks.MockEventDB) {
+				mockEventDB.EXPECT().GetEventByID(gomock.Any(), 5).Return(models.Event{
+					ID: 5, AuthorID: 1, Status: models.EventStatusPublished,
+					EventStart: "2030-01-01T18:00:00Z", EventEnd: "2030-01-01T20:00:00Z", RecurrenceRule: "FREQ=WEEKLY",
+				}, nil)
+				mockEventDB.EXPECT().RescheduleEvent(gomock.Any(), models.Event{
+					ID: 5, AuthorID: 1, Status: models.EventStatusPublished,
+					EventStart: newStart, EventEnd: newEnd, RecurrenceRule: "FREQ=WEEKLY",
+				}).Return(nil)
+			},
+			expected: models.Event{
+				ID: 5, AuthorID: 1, Status: models.EventStatusPublished,
+				EventStart: newStart, EventEnd: newEnd, RecurrenceRule: "FREQ=WEEKLY",
+			},
+		},
+		{
+			name: "draft",
+			setupMocks: func(mockEventDB *mocks.MockEventDB) {
+				mockEventDB.EXPECT().GetEventByID(gomock.Any(), 5).Return(models.Event{ID: 5, AuthorID: 1, Status: models.EventStatusDraft}, nil)
+			},
+			wantErr: models.ErrNotPublished,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockEventDB := mocks.NewMockEventDB(ctrl)
+			tc.setupMocks(mockEventDB)
+			service := NewService(mockEventDB, nil)
+
+			event, err := service.RescheduleEvent(context.Background(), 5, 1, newStart, newEnd)
+			assert.ErrorIs(t, err, tc.wantErr)
+			assert.Equal(t, tc.expected, event)
+		})
+	}
+}
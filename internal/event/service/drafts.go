@@ -0,0 +1,74 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"kudago/internal/models"
+)
+
+func (s *EventService) GetDrafts(ctx context.Context, userID int, paginationParams models.PaginationParams) ([]models.Event, error) {
+	return s.EventDB.GetDrafts(ctx, userID, paginationParams)
+}
+
+// PublishEvent publishes the draft right away. Subscribers are notified by
+// the caller, the same way as for events created as published.
+func (s *EventService) PublishEvent(ctx context.Context, ID, authorID int) (models.Event, error) {
+	dbEvent, err := s.EventDB.GetEventByID(ctx, ID)
+	if err != nil {
+		return models.Event{}, err
+	}
+
+	if dbEvent.AuthorID != authorID {
+		return models.Event{}, fmt.Errorf("%s: %w", models.LevelService, models.ErrAccessDenied)
+	}
+
+	if dbEvent.Status != models.EventStatusDraft {
+		return models.Event{}, fmt.Errorf("%s: %w", models.LevelService, models.ErrNotDraft)
+	}
+
+	err = s.EventDB.PublishEvent(ctx, ID)
+	if err != nil {
+		return models.Event{}, err
+	}
+
+	dbEvent.Status = models.EventStatusPublished
+	return dbEvent, nil
+}
+
+// PublishScheduledEvents publishes the drafts whose publish_at has come and
+// notifies the subscribers of their authors. It is run periodically.
+func (s *EventService) PublishScheduledEvents(ctx context.Context) error {
+	events, err := s.EventDB.PublishDueEvents(ctx, time.Now())
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, event := range events {
+		if err := s.notifySubscribers(ctx, event); err != nil {
+			errs = append(errs, fmt.Errorf("notify subscribers of event %d: %w", event.ID, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (s *EventService) notifySubscribers(ctx context.Context, event models.Event) error {
+	subscriberIDs, err := s.EventDB.GetSubscribersIDs(ctx, event.AuthorID)
+	if err != nil {
+		return err
+	}
+
+	if len(subscriberIDs) == 0 {
+		return nil
+	}
+
+	return s.Notifier.CreateNotifications(ctx, subscriberIDs, models.Notification{
+		EventID:  event.ID,
+		NotifyAt: time.Now(),
+		Message:  models.CreatedEventMessage,
+	})
+}
@@ -0,0 +1,149 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"kudago/internal/event/service/mocks"
+	"kudago/internal/models"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventService_AddEventStatus(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		event    models.Event
+		expected models.Event
+		wantErr  error
+	}{
+		{
+			name:     "published by default",
+			event:    models.Event{Title: "Концерт", PublishAt: "2030-01-01T10:00:00Z"},
+			expected: models.Event{Title: "Концерт", Status: models.EventStatusPublished},
+		},
+		{
+			name:     "scheduled draft",
+			event:    models.Event{Title: "Концерт", Status: models.EventStatusDraft, PublishAt: "2030-01-01T10:00:00Z"},
+			expected: models.Event{Title: "Концерт", Status: models.EventStatusDraft, PublishAt: "2030-01-01T10:00:00Z"},
+		},
+		{
+			name:    "cancelled on creation",
+			event:   models.Event{Title: "Концерт", Status: models.EventStatusCancelled},
+			wantErr: models.ErrInvalidStatus,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockEventDB := mocks.NewMockEventDB(ctrl)
+			service := NewService(mockEventDB, nil)
+
+			if tc.wantErr == nil {
+				mockEventDB.EXPECT().CreateEvent(gomock.Any(), tc.expected).Return(tc.expected, nil)
+			}
+
+			_, err := service.AddEvent(context.Background(), tc.event)
+			assert.ErrorIs(t, err, tc.wantErr)
+		})
+	}
+}
+
+func TestEventService_PublishEvent(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name       string
+		authorID   int
+		setupMocks func(mockEventDB *mocks.MockEventDB)
+		wantErr    error
+	}{
+		{
+			name:     "publish draft",
+			authorID: 1,
+			setupMocks: func(mockEventDB *mocks.MockEventDB) {
+				mockEventDB.EXPECT().GetEventByID(gomock.Any(), 5).Return(models.Event{ID: 5, AuthorID: 1, Status: models.EventStatusDraft}, nil)
+				mockEventDB.EXPECT().PublishEvent(gomock.Any(), 5).Return(nil)
+			},
+		},
+		{
+			name:     "not the author",
+			authorID: 2,
+			setupMocks: func(mockEventDB *mocks.MockEventDB) {
+				mockEventDB.EXPECT().GetEventByID(gomock.Any(), 5).Return(models.Event{ID: 5, AuthorID: 1, Status: models.EventStatusDraft}, nil)
+			},
+			wantErr: models.ErrAccessDenied,
+		},
+		{
+			name:     "already published",
+			authorID: 1,
+			setupMocks: func(mockEventDB *mocks.MockEventDB) {
+				mockEventDB.EXPECT().GetEventByID(gomock.Any(), 5).Return(models.Event{ID: 5, AuthorID: 1, Status: models.EventStatusPublished}, nil)
+			},
+			wantErr: models.ErrNotDraft,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockEventDB := mocks.NewMockEventDB(ctrl)
+			tc.setupMocks(mockEventDB)
+			service := NewService(mockEventDB, nil)
+
+			event, err := service.PublishEvent(context.Background(), 5, tc.authorID)
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, models.EventStatusPublished, event.Status)
+		})
+	}
+}
+
+func TestEventService_PublishScheduledEvents(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockEventDB := mocks.NewMockEventDB(ctrl)
+	mockNotifier := mocks.NewMockNotifier(ctrl)
+	service := NewService(mockEventDB, mockNotifier)
+
+	mockEventDB.EXPECT().PublishDueEvents(gomock.Any(), gomock.Any()).Return([]models.Event{
+		{ID: 1, AuthorID: 7},
+		{ID: 2, AuthorID: 8},
+		{ID: 3, AuthorID: 9},
+	}, nil)
+	mockEventDB.EXPECT().GetSubscribersIDs(gomock.Any(), 7).Return([]int{10, 11}, nil)
+	mockEventDB.EXPECT().GetSubscribersIDs(gomock.Any(), 8).Return(nil, nil)
+	mockEventDB.EXPECT().GetSubscribersIDs(gomock.Any(), 9).Return([]int{12}, nil)
+
+	mockNotifier.EXPECT().CreateNotifications(gomock.Any(), []int{10, 11}, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ []int, notification models.Notification) error {
+			assert.Equal(t, 1, notification.EventID)
+			assert.Equal(t, models.CreatedEventMessage, notification.Message)
+			return nil
+		})
+	notifyErr := errors.New("notification service is down")
+	mockNotifier.EXPECT().CreateNotifications(gomock.Any(), []int{12}, gomock.Any()).Return(notifyErr)
+
+	err := service.PublishScheduledEvents(context.Background())
+	assert.ErrorIs(t, err, notifyErr, "a failed notification must not stop the others")
+}
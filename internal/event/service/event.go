@@ -6,12 +6,23 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
+	"unicode/utf8"
 
 	"kudago/internal/models"
 )
 
+const (
+	minSuggestQueryLength = 2
+	maxSuggestLimit       = 10
+	// suggestTimeout keeps type-ahead responsive: a slow suggestion is useless
+	// once the user has typed the next character.
+	suggestTimeout = 300 * time.Millisecond
+)
+
 type EventService struct {
-	EventDB EventDB
+	EventDB  EventDB
+	Notifier Notifier
 }
 
 type EventDB interface {
@@ -22,45 +33,125 @@ type EventDB interface {
 	GetEventsByUser(ctx context.Context, userID int, paginationParams models.PaginationParams) ([]models.Event, error)
 	GetEventByID(ctx context.Context, ID int) (models.Event, error)
 	CreateEvent(ctx context.Context, event models.Event) (models.Event, error)
-	DeleteEvent(ctx context.Context, ID int) error
+	DeleteEvent(ctx context.Context, ID int) ([]string, error)
+	PromoteExpiredClaims(ctx context.Context, claimUntil time.Time) ([]models.WaitlistEntry, error)
 	UpdateEvent(ctx context.Context, event models.Event) (models.Event, error)
 	SearchEvents(ctx context.Context, params models.SearchParams, paginationParams models.PaginationParams) ([]models.Event, error)
+	GetSearchFacets(ctx context.Context, params models.SearchParams) (models.SearchFacets, error)
+	SuggestEvents(ctx context.Context, query string, limit int) (models.Suggestions, error)
 	AddEventToFavorites(ctx context.Context, newFavorite models.FavoriteEvent) error
 	DeleteEventFromFavorites(ctx context.Context, favorite models.FavoriteEvent) error
+	CreateTicketType(ctx context.Context, ticketType models.TicketType) (models.TicketType, error)
+	ReserveTickets(ctx context.Context, ticket models.Ticket) (models.Ticket, error)
+	BuyTicket(ctx context.Context, ticketID, userID int) (models.Ticket, error)
+	AddAttendee(ctx context.Context, eventID, userID int, claimUntil time.Time) (models.AttendanceResult, error)
+	RemoveAttendee(ctx context.Context, eventID, userID int, claimUntil time.Time) ([]models.WaitlistEntry, error)
+	CheckInAttendee(ctx context.Context, eventID, userID int) error
+	SaveCalendarToken(ctx context.Context, userID int, tokenHash string) error
+	GetUserIDByCalendarToken(ctx context.Context, tokenHash string) (int, error)
+	HasRecommendationSignals(ctx context.Context, userID int) (bool, error)
+	GetRecommendedEvents(ctx context.Context, userID int, paginationParams models.PaginationParams) ([]models.Event, error)
+	GetTrendingEvents(ctx context.Context, categoryID int, paginationParams models.PaginationParams) ([]models.Event, error)
+	RefreshTrendingScores(ctx context.Context, halfLife, window time.Duration) error
+	GetSubscribersIDs(ctx context.Context, userID int) ([]int, error)
+	GetDrafts(ctx context.Context, userID int, paginationParams models.PaginationParams) ([]models.Event, error)
+	PublishEvent(ctx context.Context, ID int) error
+	PublishDueEvents(ctx context.Context, now time.Time) ([]models.Event, error)
+	CancelEvent(ctx context.Context, ID int, reason string) error
+	RescheduleEvent(ctx context.Context, event models.Event) error
+	AddEventImage(ctx context.Context, eventID int, url string, limit int) (models.EventImage, error)
+	DeleteEventImage(ctx context.Context, eventID, imageID int) (models.EventImage, error)
+	UpdateEventImages(ctx context.Context, eventID int, order []int, coverID int) ([]models.EventImage, error)
 }
 
-func NewService(eventDB EventDB) EventService {
-	return EventService{EventDB: eventDB}
+// Notifier delivers notifications to users through the notification service.
+type Notifier interface {
+	CreateNotifications(ctx context.Context, userIDs []int, notification models.Notification) error
+}
+
+func NewService(eventDB EventDB, notifier Notifier) EventService {
+	return EventService{EventDB: eventDB, Notifier: notifier}
 }
 
 func (s *EventService) AddEvent(ctx context.Context, event models.Event) (models.Event, error) {
+	switch event.Status {
+	case "":
+		event.Status = models.EventStatusPublished
+	case models.EventStatusDraft, models.EventStatusPublished:
+	default:
+		return models.Event{}, fmt.Errorf("%s: %w", models.LevelService, models.ErrInvalidStatus)
+	}
+
+	// Only drafts wait for publishing.
+	if event.Status != models.EventStatusDraft {
+		event.PublishAt = ""
+	}
+
 	return s.EventDB.CreateEvent(ctx, event)
 }
 
-func (s *EventService) DeleteEvent(ctx context.Context, ID, AuthorID int) error {
+// DeleteEvent deletes the event and returns the URLs of its images. Deleting
+// the files is left to the caller, which talks to the image service.
+func (s *EventService) DeleteEvent(ctx context.Context, ID, AuthorID int) ([]string, error) {
 	dbEvent, err := s.EventDB.GetEventByID(ctx, ID)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	if dbEvent.AuthorID != AuthorID {
-		return fmt.Errorf("%s: %w", models.LevelService, models.ErrAccessDenied)
+		return nil, fmt.Errorf("%s: %w", models.LevelService, models.ErrAccessDenied)
 	}
 
 	return s.EventDB.DeleteEvent(ctx, ID)
 }
 
-func (s *EventService) GetEventByID(ctx context.Context, ID int) (models.Event, error) {
-	return s.EventDB.GetEventByID(ctx, ID)
+// GetEventByID returns the event to the user with userID. Drafts are seen
+// only by their author, to anyone else they do not exist.
+func (s *EventService) GetEventByID(ctx context.Context, ID, userID int) (models.Event, error) {
+	dbEvent, err := s.EventDB.GetEventByID(ctx, ID)
+	if err != nil {
+		return models.Event{}, err
+	}
+
+	if dbEvent.Status == models.EventStatusDraft && dbEvent.AuthorID != userID {
+		return models.Event{}, fmt.Errorf("%s: %w", models.LevelService, models.ErrEventNotFound)
+	}
+
+	return dbEvent, nil
 }
 
 func (s *EventService) SearchEvents(ctx context.Context, params models.SearchParams, paginationParams models.PaginationParams) ([]models.Event, error) {
-	for i, tag := range params.Tags {
-		params.Tags[i] = strings.ToLower(tag)
-	}
+	lowerTags(params.Tags)
 	return s.EventDB.SearchEvents(ctx, params, paginationParams)
 }
 
+func (s *EventService) GetSearchFacets(ctx context.Context, params models.SearchParams) (models.SearchFacets, error) {
+	lowerTags(params.Tags)
+	return s.EventDB.GetSearchFacets(ctx, params)
+}
+
+func (s *EventService) SuggestEvents(ctx context.Context, query string, limit int) (models.Suggestions, error) {
+	query = strings.TrimSpace(query)
+	if utf8.RuneCountInString(query) < minSuggestQueryLength {
+		return models.Suggestions{Events: []models.EventSuggestion{}, Tags: []models.TagSuggestion{}}, nil
+	}
+
+	if limit <= 0 || limit > maxSuggestLimit {
+		limit = maxSuggestLimit
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, suggestTimeout)
+	defer cancel()
+
+	return s.EventDB.SuggestEvents(ctx, query, limit)
+}
+
+func lowerTags(tags []string) {
+	for i, tag := range tags {
+		tags[i] = strings.ToLower(tag)
+	}
+}
+
 func (s *EventService) UpdateEvent(ctx context.Context, event models.Event) (models.Event, error) {
 	dbEvent, err := s.EventDB.GetEventByID(ctx, event.ID)
 	if err != nil {
@@ -71,6 +162,17 @@ func (s *EventService) UpdateEvent(ctx context.Context, event models.Event) (mod
 		return models.Event{}, fmt.Errorf("%s: %w", models.LevelService, models.ErrAccessDenied)
 	}
 
+	// The series end is computed from the first occurrence, which a partial
+	// update may leave out.
+	if event.RecurrenceRule != "" {
+		if event.EventStart == "" {
+			event.EventStart = dbEvent.EventStart
+		}
+		if event.EventEnd == "" {
+			event.EventEnd = dbEvent.EventEnd
+		}
+	}
+
 	updatedEvent, err := s.EventDB.UpdateEvent(ctx, event)
 	if err != nil {
 		return models.Event{}, err
@@ -80,6 +182,11 @@ func (s *EventService) UpdateEvent(ctx context.Context, event models.Event) (mod
 }
 
 func (s *EventService) AddEventToFavorites(ctx context.Context, newFavorite models.FavoriteEvent) error {
+	err := s.checkPublished(ctx, newFavorite.EventID)
+	if err != nil {
+		return err
+	}
+
 	return s.EventDB.AddEventToFavorites(ctx, newFavorite)
 }
 
@@ -0,0 +1,76 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"kudago/internal/models"
+)
+
+const maxEventImages = 10
+
+func (s *EventService) AddEventImage(ctx context.Context, eventID, authorID int, url string) (models.EventImage, error) {
+	_, err := s.getAuthoredEvent(ctx, eventID, authorID)
+	if err != nil {
+		return models.EventImage{}, err
+	}
+
+	return s.EventDB.AddEventImage(ctx, eventID, url, maxEventImages)
+}
+
+// DeleteEventImage removes the image from the gallery. Deleting the file is
+// left to the caller, which owns the image service client.
+func (s *EventService) DeleteEventImage(ctx context.Context, eventID, authorID, imageID int) (models.EventImage, error) {
+	_, err := s.getAuthoredEvent(ctx, eventID, authorID)
+	if err != nil {
+		return models.EventImage{}, err
+	}
+
+	return s.EventDB.DeleteEventImage(ctx, eventID, imageID)
+}
+
+// UpdateEventImages reorders the gallery and picks its cover. A non-empty
+// order has to list every image of the event exactly once.
+func (s *EventService) UpdateEventImages(ctx context.Context, eventID, authorID int, order []int, coverID int) ([]models.EventImage, error) {
+	event, err := s.getAuthoredEvent(ctx, eventID, authorID)
+	if err != nil {
+		return nil, err
+	}
+
+	imageIDs := make(map[int]bool, len(event.Images))
+	for _, image := range event.Images {
+		imageIDs[image.ID] = false
+	}
+
+	if len(order) > 0 {
+		if len(order) != len(imageIDs) {
+			return nil, fmt.Errorf("%s: %w", models.LevelService, models.ErrInvalidImageOrder)
+		}
+		for _, id := range order {
+			listed, ok := imageIDs[id]
+			if !ok || listed {
+				return nil, fmt.Errorf("%s: %w", models.LevelService, models.ErrInvalidImageOrder)
+			}
+			imageIDs[id] = true
+		}
+	}
+
+	if _, ok := imageIDs[coverID]; coverID != 0 && !ok {
+		return nil, fmt.Errorf("%s: %w", models.LevelService, models.ErrImageNotFound)
+	}
+
+	return s.EventDB.UpdateEventImages(ctx, eventID, order, coverID)
+}
+
+func (s *EventService) getAuthoredEvent(ctx context.Context, eventID, authorID int) (models.Event, error) {
+	event, err := s.EventDB.GetEventByID(ctx, eventID)
+	if err != nil {
+		return models.Event{}, err
+	}
+
+	if event.AuthorID != authorID {
+		return models.Event{}, fmt.Errorf("%s: %w", models.LevelService, models.ErrAccessDenied)
+	}
+
+	return event, nil
+}
@@ -0,0 +1,149 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"kudago/internal/event/service/mocks"
+	"kudago/internal/models"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEventService_AddEventImage(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name       string
+		authorID   int
+		setupMocks func(mockEventDB *mocks.MockEventDB)
+		wantErr    error
+	}{
+		{
+			name:     "add image",
+			authorID: 1,
+			setupMocks: func(mockEventDB *mocks.MockEventDB) {
+				mockEventDB.EXPECT().GetEventByID(gomock.Any(), 5).Return(models.Event{ID: 5, AuthorID: 1}, nil)
+				mockEventDB.EXPECT().AddEventImage(gomock.Any(), 5, "/images/a.jpg", maxEventImages).
+					Return(models.EventImage{ID: 1, URL: "/images/a.jpg", IsCover: true}, nil)
+			},
+		},
+		{
+			name:     "not the author",
+			authorID: 2,
+			setupMocks: func(mockEventDB *mocks.MockEventDB) {
+				mockEventDB.EXPECT().GetEventByID(gomock.Any(), 5).Return(models.Event{ID: 5, AuthorID: 1}, nil)
+			},
+			wantErr: models.ErrAccessDenied,
+		},
+		{
+			name:     "gallery is full",
+			authorID: 1,
+			setupMocks: func(mockEventDB *mocks.MockEventDB) {
+				mockEventDB.EXPECT().GetEventByID(gomock.Any(), 5).Return(models.Event{ID: 5, AuthorID: 1}, nil)
+				mockEventDB.EXPECT().AddEventImage(gomock.Any(), 5, "/images/a.jpg", maxEventImages).
+					Return(models.EventImage{}, models.ErrTooManyImages)
+			},
+			wantErr: models.ErrTooManyImages,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockEventDB := mocks.NewMockEventDB(ctrl)
+			tc.setupMocks(mockEventDB)
+			service := NewService(mockEventDB, nil)
+
+			_, err := service.AddEventImage(context.Background(), 5, tc.authorID, "/images/a.jpg")
+			assert.ErrorIs(t, err, tc.wantErr)
+		})
+	}
+}
+
+func TestEventService_UpdateEventImages(t *testing.T) {
+	t.Parallel()
+
+	gallery := models.Event{ID: 5, AuthorID: 1, Images: []models.EventImage{
+		{ID: 10, Position: 0, IsCover: true},
+		{ID: 11, Position: 1},
+		{ID: 12, Position: 2},
+	}}
+
+	testCases := []struct {
+		name    string
+		order   []int
+		coverID int
+		wantErr error
+	}{
+		{
+			name:    "reorder and pick cover",
+			order:   []int{12, 10, 11},
+			coverID: 12,
+		},
+		{
+			name:    "pick cover only",
+			coverID: 11,
+		},
+		{
+			name:    "image left out of order",
+			order:   []int{12, 10},
+			wantErr: models.ErrInvalidImageOrder,
+		},
+		{
+			name:    "image listed twice",
+			order:   []int{12, 12, 10},
+			wantErr: models.ErrInvalidImageOrder,
+		},
+		{
+			name:    "foreign image in order",
+			order:   []int{12, 10, 99},
+			wantErr: models.ErrInvalidImageOrder,
+		},
+		{
+			name:    "foreign cover",
+			coverID: 99,
+			wantErr: models.ErrImageNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockEventDB := mocks.NewMockEventDB(ctrl)
+			mockEventDB.EXPECT().GetEventByID(gomock.Any(), 5).Return(gallery, nil)
+			if tc.wantErr == nil {
+				mockEventDB.EXPECT().UpdateEventImages(gomock.Any(), 5, tc.order, tc.coverID).Return(gallery.Images, nil)
+			}
+			service := NewService(mockEventDB, nil)
+
+			_, err := service.UpdateEventImages(context.Background(), 5, 1, tc.order, tc.coverID)
+			assert.ErrorIs(t, err, tc.wantErr)
+		})
+	}
+}
+
+func TestEventService_DeleteEventImage(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockEventDB := mocks.NewMockEventDB(ctrl)
+	mockEventDB.EXPECT().GetEventByID(gomock.Any(), 5).Return(models.Event{ID: 5, AuthorID: 1}, nil)
+	mockEventDB.EXPECT().DeleteEventImage(gomock.Any(), 5, 10).Return(models.EventImage{ID: 10, URL: "/images/a.jpg"}, nil)
+	service := NewService(mockEventDB, nil)
+
+	image, err := service.DeleteEventImage(context.Background(), 5, 1, 10)
+	assert.NoError(t, err)
+	assert.Equal(t, models.EventImage{ID: 10, URL: "/images/a.jpg"}, image)
+}
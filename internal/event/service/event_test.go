@@ -18,7 +18,7 @@ func TestEventService_DeleteEvent(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockEventDB := mocks.NewMockEventDB(ctrl)
-	service := NewService(mockEventDB)
+	service := NewService(mockEventDB, nil)
 
 	testCases := []struct {
 		name        string
@@ -33,7 +33,7 @@ func TestEventService_DeleteEvent(t *testing.T) {
 			AuthorID: 1,
 			setupMocks: func() {
 				mockEventDB.EXPECT().GetEventByID(gomock.Any(), 1).Return(models.Event{ID: 1, AuthorID: 1, ImageURL: "path/to/image.jpg"}, nil)
-				mockEventDB.EXPECT().DeleteEvent(gomock.Any(), 1).Return(nil)
+				mockEventDB.EXPECT().DeleteEvent(gomock.Any(), 1).Return([]string{"path/to/image.jpg"}, nil)
 			},
 			expectError: false,
 		},
@@ -54,7 +54,7 @@ func TestEventService_DeleteEvent(t *testing.T) {
 
 			tc.setupMocks()
 
-			err := service.DeleteEvent(context.Background(), tc.ID, tc.AuthorID)
+			_, err := service.DeleteEvent(context.Background(), tc.ID, tc.AuthorID)
 			if tc.expectError {
 				assert.Error(t, err)
 			} else {
@@ -64,6 +64,61 @@ func TestEventService_DeleteEvent(t *testing.T) {
 	}
 }
 
+func TestEventService_GetEventByID(t *testing.T) {
+	t.Parallel()
+
+	draft := models.Event{ID: 1, AuthorID: 1, Status: models.EventStatusDraft}
+	cancelled := models.Event{ID: 1, AuthorID: 1, Status: models.EventStatusCancelled}
+
+	testCases := []struct {
+		name          string
+		dbEvent       models.Event
+		userID        int
+		expected      models.Event
+		expectedError error
+	}{
+		{
+			name:     "draft is visible to its author",
+			dbEvent:  draft,
+			userID:   1,
+			expected: draft,
+		},
+		{
+			name:          "draft is hidden from other users",
+			dbEvent:       draft,
+			userID:        2,
+			expectedError: models.ErrEventNotFound,
+		},
+		{
+			name:          "draft is hidden from guests",
+			dbEvent:       draft,
+			expectedError: models.ErrEventNotFound,
+		},
+		{
+			name:     "cancelled event is visible to everyone",
+			dbEvent:  cancelled,
+			expected: cancelled,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockEventDB := mocks.NewMockEventDB(ctrl)
+			mockEventDB.EXPECT().GetEventByID(gomock.Any(), 1).Return(tc.dbEvent, nil)
+			service := NewService(mockEventDB, nil)
+
+			event, err := service.GetEventByID(context.Background(), 1, tc.userID)
+			assert.ErrorIs(t, err, tc.expectedError)
+			assert.Equal(t, tc.expected, event)
+		})
+	}
+}
+
 func TestEventService_SearchEvents(t *testing.T) {
 	t.Parallel()
 
@@ -71,7 +126,7 @@ func TestEventService_SearchEvents(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockEventDB := mocks.NewMockEventDB(ctrl)
-	service := NewService(mockEventDB)
+	service := NewService(mockEventDB, nil)
 
 	testCases := []struct {
 		name         string
@@ -114,7 +169,7 @@ func TestEventService_SearchEvents(t *testing.T) {
 // 	defer ctrl.Finish()
 
 // 	mockEventDB := mocks.NewMockEventDB(ctrl)
-// 	service := NewService(mockEventDB)
+// 	service := NewService(mockEventDB, nil)
 
 // 	event := models.Event{
 // 		ID:       1,
@@ -173,3 +228,51 @@ func TestEventService_SearchEvents(t *testing.T) {
 // 		})
 // 	}
 // }
+
+func TestEventService_SuggestEvents(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name       string
+		query      string
+		limit      int
+		setupMocks func(mockEventDB *mocks.MockEventDB)
+		expected   models.Suggestions
+	}{
+		{
+			name:  "short query skips database",
+			query: " д ",
+			limit: 5,
+			setupMocks: func(mockEventDB *mocks.MockEventDB) {
+			},
+			expected: models.Suggestions{Events: []models.EventSuggestion{}, Tags: []models.TagSuggestion{}},
+		},
+		{
+			name:  "query is trimmed and limit is capped",
+			query: "  джаз ",
+			limit: 100,
+			setupMocks: func(mockEventDB *mocks.MockEventDB) {
+				mockEventDB.EXPECT().SuggestEvents(gomock.Any(), "джаз", maxSuggestLimit).
+					Return(models.Suggestions{Tags: []models.TagSuggestion{{Name: "джаз"}}}, nil)
+			},
+			expected: models.Suggestions{Tags: []models.TagSuggestion{{Name: "джаз"}}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockEventDB := mocks.NewMockEventDB(ctrl)
+			tc.setupMocks(mockEventDB)
+			service := NewService(mockEventDB, nil)
+
+			suggestions, err := service.SuggestEvents(context.Background(), tc.query, tc.limit)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, suggestions)
+		})
+	}
+}
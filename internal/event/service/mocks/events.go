@@ -8,6 +8,7 @@ import (
 	context "context"
 	models "kudago/internal/models"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 )
@@ -35,6 +36,36 @@ func (m *MockEventDB) EXPECT() *MockEventDBMockRecorder {
 	return m.recorder
 }
 
+// AddAttendee mocks base method.
+func (m *MockEventDB) AddAttendee(ctx context.Context, eventID, userID int, claimUntil time.Time) (models.AttendanceResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddAttendee", ctx, eventID, userID, claimUntil)
+	ret0, _ := ret[0].(models.AttendanceResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddAttendee indicates an expected call of AddAttendee.
+func (mr *MockEventDBMockRecorder) AddAttendee(ctx, eventID, userID, claimUntil interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddAttendee", reflect.TypeOf((*MockEventDB)(nil).AddAttendee), ctx, eventID, userID, claimUntil)
+}
+
+// AddEventImage mocks base method.
+func (m *MockEventDB) AddEventImage(ctx context.Context, eventID int, url string, limit int) (models.EventImage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddEventImage", ctx, eventID, url, limit)
+	ret0, _ := ret[0].(models.EventImage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddEventImage indicates an expected call of AddEventImage.
+func (mr *MockEventDBMockRecorder) AddEventImage(ctx, eventID, url, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddEventImage", reflect.TypeOf((*MockEventDB)(nil).AddEventImage), ctx, eventID, url, limit)
+}
+
 // AddEventToFavorites mocks base method.
 func (m *MockEventDB) AddEventToFavorites(ctx context.Context, newFavorite models.FavoriteEvent) error {
 	m.ctrl.T.Helper()
@@ -49,6 +80,49 @@ func (mr *MockEventDBMockRecorder) AddEventToFavorites(ctx, newFavorite interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddEventToFavorites", reflect.TypeOf((*MockEventDB)(nil).AddEventToFavorites), ctx, newFavorite)
 }
 
+// BuyTicket mocks base method.
+func (m *MockEventDB) BuyTicket(ctx context.Context, ticketID, userID int) (models.Ticket, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BuyTicket", ctx, ticketID, userID)
+	ret0, _ := ret[0].(models.Ticket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BuyTicket indicates an expected call of BuyTicket.
+func (mr *MockEventDBMockRecorder) BuyTicket(ctx, ticketID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuyTicket", reflect.TypeOf((*MockEventDB)(nil).BuyTicket), ctx, ticketID, userID)
+}
+
+// CancelEvent mocks base method.
+func (m *MockEventDB) CancelEvent(ctx context.Context, ID int, reason string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelEvent", ctx, ID, reason)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CancelEvent indicates an expected call of CancelEvent.
+func (mr *MockEventDBMockRecorder) CancelEvent(ctx, ID, reason interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelEvent", reflect.TypeOf((*MockEventDB)(nil).CancelEvent), ctx, ID, reason)
+}
+
+// CheckInAttendee mocks base method.
+func (m *MockEventDB) CheckInAttendee(ctx context.Context, eventID, userID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckInAttendee", ctx, eventID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckInAttendee indicates an expected call of CheckInAttendee.
+func (mr *MockEventDBMockRecorder) CheckInAttendee(ctx, eventID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckInAttendee", reflect.TypeOf((*MockEventDB)(nil).CheckInAttendee), ctx, eventID, userID)
+}
+
 // CreateEvent mocks base method.
 func (m *MockEventDB) CreateEvent(ctx context.Context, event models.Event) (models.Event, error) {
 	m.ctrl.T.Helper()
@@ -64,12 +138,28 @@ func (mr *MockEventDBMockRecorder) CreateEvent(ctx, event interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEvent", reflect.TypeOf((*MockEventDB)(nil).CreateEvent), ctx, event)
 }
 
+// CreateTicketType mocks base method.
+func (m *MockEventDB) CreateTicketType(ctx context.Context, ticketType models.TicketType) (models.TicketType, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTicketType", ctx, ticketType)
+	ret0, _ := ret[0].(models.TicketType)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTicketType indicates an expected call of CreateTicketType.
+func (mr *MockEventDBMockRecorder) CreateTicketType(ctx, ticketType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTicketType", reflect.TypeOf((*MockEventDB)(nil).CreateTicketType), ctx, ticketType)
+}
+
 // DeleteEvent mocks base method.
-func (m *MockEventDB) DeleteEvent(ctx context.Context, ID int) error {
+func (m *MockEventDB) DeleteEvent(ctx context.Context, ID int) ([]string, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "DeleteEvent", ctx, ID)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // DeleteEvent indicates an expected call of DeleteEvent.
@@ -92,6 +182,21 @@ func (mr *MockEventDBMockRecorder) DeleteEventFromFavorites(ctx, favorite interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEventFromFavorites", reflect.TypeOf((*MockEventDB)(nil).DeleteEventFromFavorites), ctx, favorite)
 }
 
+// DeleteEventImage mocks base method.
+func (m *MockEventDB) DeleteEventImage(ctx context.Context, eventID, imageID int) (models.EventImage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEventImage", ctx, eventID, imageID)
+	ret0, _ := ret[0].(models.EventImage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteEventImage indicates an expected call of DeleteEventImage.
+func (mr *MockEventDBMockRecorder) DeleteEventImage(ctx, eventID, imageID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEventImage", reflect.TypeOf((*MockEventDB)(nil).DeleteEventImage), ctx, eventID, imageID)
+}
+
 // GetCategories mocks base method.
 func (m *MockEventDB) GetCategories(ctx context.Context) ([]models.Category, error) {
 	m.ctrl.T.Helper()
@@ -107,6 +212,21 @@ func (mr *MockEventDBMockRecorder) GetCategories(ctx interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategories", reflect.TypeOf((*MockEventDB)(nil).GetCategories), ctx)
 }
 
+// GetDrafts mocks base method.
+func (m *MockEventDB) GetDrafts(ctx context.Context, userID int, paginationParams models.PaginationParams) ([]models.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDrafts", ctx, userID, paginationParams)
+	ret0, _ := ret[0].([]models.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDrafts indicates an expected call of GetDrafts.
+func (mr *MockEventDBMockRecorder) GetDrafts(ctx, userID, paginationParams interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDrafts", reflect.TypeOf((*MockEventDB)(nil).GetDrafts), ctx, userID, paginationParams)
+}
+
 // GetEventByID mocks base method.
 func (m *MockEventDB) GetEventByID(ctx context.Context, ID int) (models.Event, error) {
 	m.ctrl.T.Helper()
@@ -167,6 +287,66 @@ func (mr *MockEventDBMockRecorder) GetPastEvents(ctx, paginationParams interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPastEvents", reflect.TypeOf((*MockEventDB)(nil).GetPastEvents), ctx, paginationParams)
 }
 
+// GetRecommendedEvents mocks base method.
+func (m *MockEventDB) GetRecommendedEvents(ctx context.Context, userID int, paginationParams models.PaginationParams) ([]models.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRecommendedEvents", ctx, userID, paginationParams)
+	ret0, _ := ret[0].([]models.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRecommendedEvents indicates an expected call of GetRecommendedEvents.
+func (mr *MockEventDBMockRecorder) GetRecommendedEvents(ctx, userID, paginationParams interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecommendedEvents", reflect.TypeOf((*MockEventDB)(nil).GetRecommendedEvents), ctx, userID, paginationParams)
+}
+
+// GetSearchFacets mocks base method.
+func (m *MockEventDB) GetSearchFacets(ctx context.Context, params models.SearchParams) (models.SearchFacets, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSearchFacets", ctx, params)
+	ret0, _ := ret[0].(models.SearchFacets)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSearchFacets indicates an expected call of GetSearchFacets.
+func (mr *MockEventDBMockRecorder) GetSearchFacets(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSearchFacets", reflect.TypeOf((*MockEventDB)(nil).GetSearchFacets), ctx, params)
+}
+
+// GetSubscribersIDs mocks base method.
+func (m *MockEventDB) GetSubscribersIDs(ctx context.Context, userID int) ([]int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSubscribersIDs", ctx, userID)
+	ret0, _ := ret[0].([]int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSubscribersIDs indicates an expected call of GetSubscribersIDs.
+func (mr *MockEventDBMockRecorder) GetSubscribersIDs(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubscribersIDs", reflect.TypeOf((*MockEventDB)(nil).GetSubscribersIDs), ctx, userID)
+}
+
+// GetTrendingEvents mocks base method.
+func (m *MockEventDB) GetTrendingEvents(ctx context.Context, categoryID int, paginationParams models.PaginationParams) ([]models.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTrendingEvents", ctx, categoryID, paginationParams)
+	ret0, _ := ret[0].([]models.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTrendingEvents indicates an expected call of GetTrendingEvents.
+func (mr *MockEventDBMockRecorder) GetTrendingEvents(ctx, categoryID, paginationParams interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrendingEvents", reflect.TypeOf((*MockEventDB)(nil).GetTrendingEvents), ctx, categoryID, paginationParams)
+}
+
 // GetUpcomingEvents mocks base method.
 func (m *MockEventDB) GetUpcomingEvents(ctx context.Context, paginationParams models.PaginationParams) ([]models.Event, error) {
 	m.ctrl.T.Helper()
@@ -182,6 +362,152 @@ func (mr *MockEventDBMockRecorder) GetUpcomingEvents(ctx, paginationParams inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUpcomingEvents", reflect.TypeOf((*MockEventDB)(nil).GetUpcomingEvents), ctx, paginationParams)
 }
 
+// GetUserIDByCalendarToken mocks base method.
+func (m *MockEventDB) GetUserIDByCalendarToken(ctx context.Context, tokenHash string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserIDByCalendarToken", ctx, tokenHash)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserIDByCalendarToken indicates an expected call of GetUserIDByCalendarToken.
+func (mr *MockEventDBMockRecorder) GetUserIDByCalendarToken(ctx, tokenHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserIDByCalendarToken", reflect.TypeOf((*MockEventDB)(nil).GetUserIDByCalendarToken), ctx, tokenHash)
+}
+
+// HasRecommendationSignals mocks base method.
+func (m *MockEventDB) HasRecommendationSignals(ctx context.Context, userID int) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasRecommendationSignals", ctx, userID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HasRecommendationSignals indicates an expected call of HasRecommendationSignals.
+func (mr *MockEventDBMockRecorder) HasRecommendationSignals(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasRecommendationSignals", reflect.TypeOf((*MockEventDB)(nil).HasRecommendationSignals), ctx, userID)
+}
+
+// PromoteExpiredClaims mocks base method.
+func (m *MockEventDB) PromoteExpiredClaims(ctx context.Context, claimUntil time.Time) ([]models.WaitlistEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PromoteExpiredClaims", ctx, claimUntil)
+	ret0, _ := ret[0].([]models.WaitlistEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PromoteExpiredClaims indicates an expected call of PromoteExpiredClaims.
+func (mr *MockEventDBMockRecorder) PromoteExpiredClaims(ctx, claimUntil interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PromoteExpiredClaims", reflect.TypeOf((*MockEventDB)(nil).PromoteExpiredClaims), ctx, claimUntil)
+}
+
+// PublishDueEvents mocks base method.
+func (m *MockEventDB) PublishDueEvents(ctx context.Context, now time.Time) ([]models.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishDueEvents", ctx, now)
+	ret0, _ := ret[0].([]models.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PublishDueEvents indicates an expected call of PublishDueEvents.
+func (mr *MockEventDBMockRecorder) PublishDueEvents(ctx, now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishDueEvents", reflect.TypeOf((*MockEventDB)(nil).PublishDueEvents), ctx, now)
+}
+
+// PublishEvent mocks base method.
+func (m *MockEventDB) PublishEvent(ctx context.Context, ID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishEvent", ctx, ID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PublishEvent indicates an expected call of PublishEvent.
+func (mr *MockEventDBMockRecorder) PublishEvent(ctx, ID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishEvent", reflect.TypeOf((*MockEventDB)(nil).PublishEvent), ctx, ID)
+}
+
+// RefreshTrendingScores mocks base method.
+func (m *MockEventDB) RefreshTrendingScores(ctx context.Context, halfLife, window time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefreshTrendingScores", ctx, halfLife, window)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RefreshTrendingScores indicates an expected call of RefreshTrendingScores.
+func (mr *MockEventDBMockRecorder) RefreshTrendingScores(ctx, halfLife, window interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshTrendingScores", reflect.TypeOf((*MockEventDB)(nil).RefreshTrendingScores), ctx, halfLife, window)
+}
+
+// RemoveAttendee mocks base method.
+func (m *MockEventDB) RemoveAttendee(ctx context.Context, eventID, userID int, claimUntil time.Time) ([]models.WaitlistEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveAttendee", ctx, eventID, userID, claimUntil)
+	ret0, _ := ret[0].([]models.WaitlistEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveAttendee indicates an expected call of RemoveAttendee.
+func (mr *MockEventDBMockRecorder) RemoveAttendee(ctx, eventID, userID, claimUntil interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveAttendee", reflect.TypeOf((*MockEventDB)(nil).RemoveAttendee), ctx, eventID, userID, claimUntil)
+}
+
+// RescheduleEvent mocks base method.
+func (m *MockEventDB) RescheduleEvent(ctx context.Context, event models.Event) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RescheduleEvent", ctx, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RescheduleEvent indicates an expected call of RescheduleEvent.
+func (mr *MockEventDBMockRecorder) RescheduleEvent(ctx, event interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RescheduleEvent", reflect.TypeOf((*MockEventDB)(nil).RescheduleEvent), ctx, event)
+}
+
+// ReserveTickets mocks base method.
+func (m *MockEventDB) ReserveTickets(ctx context.Context, ticket models.Ticket) (models.Ticket, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReserveTickets", ctx, ticket)
+	ret0, _ := ret[0].(models.Ticket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReserveTickets indicates an expected call of ReserveTickets.
+func (mr *MockEventDBMockRecorder) ReserveTickets(ctx, ticket interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReserveTickets", reflect.TypeOf((*MockEventDB)(nil).ReserveTickets), ctx, ticket)
+}
+
+// SaveCalendarToken mocks base method.
+func (m *MockEventDB) SaveCalendarToken(ctx context.Context, userID int, tokenHash string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveCalendarToken", ctx, userID, tokenHash)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveCalendarToken indicates an expected call of SaveCalendarToken.
+func (mr *MockEventDBMockRecorder) SaveCalendarToken(ctx, userID, tokenHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveCalendarToken", reflect.TypeOf((*MockEventDB)(nil).SaveCalendarToken), ctx, userID, tokenHash)
+}
+
 // SearchEvents mocks base method.
 func (m *MockEventDB) SearchEvents(ctx context.Context, params models.SearchParams, paginationParams models.PaginationParams) ([]models.Event, error) {
 	m.ctrl.T.Helper()
@@ -197,6 +523,21 @@ func (mr *MockEventDBMockRecorder) SearchEvents(ctx, params, paginationParams in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchEvents", reflect.TypeOf((*MockEventDB)(nil).SearchEvents), ctx, params, paginationParams)
 }
 
+// SuggestEvents mocks base method.
+func (m *MockEventDB) SuggestEvents(ctx context.Context, query string, limit int) (models.Suggestions, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SuggestEvents", ctx, query, limit)
+	ret0, _ := ret[0].(models.Suggestions)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SuggestEvents indicates an expected call of SuggestEvents.
+func (mr *MockEventDBMockRecorder) SuggestEvents(ctx, query, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SuggestEvents", reflect.TypeOf((*MockEventDB)(nil).SuggestEvents), ctx, query, limit)
+}
+
 // UpdateEvent mocks base method.
 func (m *MockEventDB) UpdateEvent(ctx context.Context, event models.Event) (models.Event, error) {
 	m.ctrl.T.Helper()
@@ -211,3 +552,55 @@ func (mr *MockEventDBMockRecorder) UpdateEvent(ctx, event interface{}) *gomock.C
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEvent", reflect.TypeOf((*MockEventDB)(nil).UpdateEvent), ctx, event)
 }
+
+// UpdateEventImages mocks base method.
+func (m *MockEventDB) UpdateEventImages(ctx context.Context, eventID int, order []int, coverID int) ([]models.EventImage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateEventImages", ctx, eventID, order, coverID)
+	ret0, _ := ret[0].([]models.EventImage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateEventImages indicates an expected call of UpdateEventImages.
+func (mr *MockEventDBMockRecorder) UpdateEventImages(ctx, eventID, order, coverID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEventImages", reflect.TypeOf((*MockEventDB)(nil).UpdateEventImages), ctx, eventID, order, coverID)
+}
+
+// MockNotifier is a mock of Notifier interface.
+type MockNotifier struct {
+	ctrl     *gomock.Controller
+	recorder *MockNotifierMockRecorder
+}
+
+// MockNotifierMockRecorder is the mock recorder for MockNotifier.
+type MockNotifierMockRecorder struct {
+	mock *MockNotifier
+}
+
+// NewMockNotifier creates a new mock instance.
+func NewMockNotifier(ctrl *gomock.Controller) *MockNotifier {
+	mock := &MockNotifier{ctrl: ctrl}
+	mock.recorder = &MockNotifierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNotifier) EXPECT() *MockNotifierMockRecorder {
+	return m.recorder
+}
+
+// CreateNotifications mocks base method.
+func (m *MockNotifier) CreateNotifications(ctx context.Context, userIDs []int, notification models.Notification) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateNotifications", ctx, userIDs, notification)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateNotifications indicates an expected call of CreateNotifications.
+func (mr *MockNotifierMockRecorder) CreateNotifications(ctx, userIDs, notification interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNotifications", reflect.TypeOf((*MockNotifier)(nil).CreateNotifications), ctx, userIDs, notification)
+}
@@ -0,0 +1,25 @@
+package service
+
+import (
+	"context"
+
+	"kudago/internal/models"
+)
+
+// GetRecommendedEvents ranks upcoming events for the user. Users without
+// favorites or subscriptions, including anonymous ones, get trending events.
+func (s *EventService) GetRecommendedEvents(ctx context.Context, userID int, paginationParams models.PaginationParams) ([]models.Event, error) {
+	if userID == 0 {
+		return s.EventDB.GetTrendingEvents(ctx, 0, paginationParams)
+	}
+
+	hasSignals, err := s.EventDB.HasRecommendationSignals(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !hasSignals {
+		return s.EventDB.GetTrendingEvents(ctx, 0, paginationParams)
+	}
+
+	return s.EventDB.GetRecommendedEvents(ctx, userID, paginationParams)
+}
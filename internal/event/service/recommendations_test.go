@@ -0,0 +1,70 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"kudago/internal/event/service/mocks"
+	"kudago/internal/models"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEventService_GetRecommendedEvents(t *testing.T) {
+	t.Parallel()
+
+	params := models.PaginationParams{Limit: 10}
+	recommended := []models.Event{{ID: 1}}
+	trending := []models.Event{{ID: 2}}
+
+	testCases := []struct {
+		name       string
+		userID     int
+		setupMocks func(mockEventDB *mocks.MockEventDB)
+		expected   []models.Event
+	}{
+		{
+			name:   "user with favorites",
+			userID: 1,
+			setupMocks: func(mockEventDB *mocks.MockEventDB) {
+				mockEventDB.EXPECT().HasRecommendationSignals(gomock.Any(), 1).Return(true, nil)
+				mockEventDB.EXPECT().GetRecommendedEvents(gomock.Any(), 1, params).Return(recommended, nil)
+			},
+			expected: recommended,
+		},
+		{
+			name:   "cold start user",
+			userID: 1,
+			setupMocks: func(mockEventDB *mocks.MockEventDB) {
+				mockEventDB.EXPECT().HasRecommendationSignals(gomock.Any(), 1).Return(false, nil)
+				mockEventDB.EXPECT().GetTrendingEvents(gomock.Any(), 0, params).Return(trending, nil)
+			},
+			expected: trending,
+		},
+		{
+			name: "anonymous user",
+			setupMocks: func(mockEventDB *mocks.MockEventDB) {
+				mockEventDB.EXPECT().GetTrendingEvents(gomock.Any(), 0, params).Return(trending, nil)
+			},
+			expected: trending,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockEventDB := mocks.NewMockEventDB(ctrl)
+			tc.setupMocks(mockEventDB)
+			service := NewService(mockEventDB, nil)
+
+			events, err := service.GetRecommendedEvents(context.Background(), tc.userID, params)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, events)
+		})
+	}
+}
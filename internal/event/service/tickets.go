@@ -0,0 +1,42 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"kudago/internal/models"
+)
+
+// reservationTTL is how long reserved tickets hold their seats before they
+// have to be bought.
+const reservationTTL = 15 * time.Minute
+
+func (s *EventService) AddTicketType(ctx context.Context, ticketType models.TicketType, authorID int) (models.TicketType, error) {
+	dbEvent, err := s.EventDB.GetEventByID(ctx, ticketType.EventID)
+	if err != nil {
+		return models.TicketType{}, err
+	}
+
+	if dbEvent.AuthorID != authorID {
+		return models.TicketType{}, fmt.Errorf("%s: %w", models.LevelService, models.ErrAccessDenied)
+	}
+
+	return s.EventDB.CreateTicketType(ctx, ticketType)
+}
+
+func (s *EventService) ReserveTickets(ctx context.Context, ticket models.Ticket) (models.Ticket, error) {
+	err := s.checkPublished(ctx, ticket.EventID)
+	if err != nil {
+		return models.Ticket{}, err
+	}
+
+	ticket.ReservedUntil = time.Now().Add(reservationTTL)
+	return s.EventDB.ReserveTickets(ctx, ticket)
+}
+
+// BuyTicket pays for a reservation. The repository refuses it once the event
+// is no longer published, as the reservation may predate the cancellation.
+func (s *EventService) BuyTicket(ctx context.Context, ticketID, userID int) (models.Ticket, error) {
+	return s.EventDB.BuyTicket(ctx, ticketID, userID)
+}
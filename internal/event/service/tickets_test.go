@@ -0,0 +1,111 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"kudago/internal/event/service/mocks"
+	"kudago/internal/models"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEventService_AddTicketType(t *testing.T) {
+	t.Parallel()
+
+	ticketType := models.TicketType{EventID: 1, Name: "VIP", Price: 1500}
+
+	testCases := []struct {
+		name          string
+		authorID      int
+		setupMocks    func(mockEventDB *mocks.MockEventDB)
+		expected      models.TicketType
+		expectedError error
+	}{
+		{
+			name:     "success",
+			authorID: 1,
+			setupMocks: func(mockEventDB *mocks.MockEventDB) {
+				mockEventDB.EXPECT().GetEventByID(gomock.Any(), 1).Return(models.Event{ID: 1, AuthorID: 1}, nil)
+				mockEventDB.EXPECT().CreateTicketType(gomock.Any(), ticketType).Return(models.TicketType{ID: 5, EventID: 1, Name: "VIP", Price: 1500}, nil)
+			},
+			expected: models.TicketType{ID: 5, EventID: 1, Name: "VIP", Price: 1500},
+		},
+		{
+			name:     "not an organizer",
+			authorID: 2,
+			setupMocks: func(mockEventDB *mocks.MockEventDB) {
+				mockEventDB.EXPECT().GetEventByID(gomock.Any(), 1).Return(models.Event{ID: 1, AuthorID: 1}, nil)
+			},
+			expectedError: models.ErrAccessDenied,
+		},
+		{
+			name:     "event not found",
+			authorID: 1,
+			setupMocks: func(mockEventDB *mocks.MockEventDB) {
+				mockEventDB.EXPECT().GetEventByID(gomock.Any(), 1).Return(models.Event{}, models.ErrEventNotFound)
+			},
+			expectedError: models.ErrEventNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockEventDB := mocks.NewMockEventDB(ctrl)
+			tc.setupMocks(mockEventDB)
+			service := NewService(mockEventDB, nil)
+
+			result, err := service.AddTicketType(context.Background(), ticketType, tc.authorID)
+			assert.ErrorIs(t, err, tc.expectedError)
+			assert.Equal(t, tc.expected, result)
+		})
+	}
+}
+
+func TestEventService_ReserveTickets(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockEventDB := mocks.NewMockEventDB(ctrl)
+	service := NewService(mockEventDB, nil)
+
+	request := models.Ticket{EventID: 1, TicketTypeID: 2, UserID: 3, Quantity: 2}
+
+	mockEventDB.EXPECT().GetEventByID(gomock.Any(), 1).
+		Return(models.Event{ID: 1, Status: models.EventStatusPublished}, nil)
+	mockEventDB.EXPECT().ReserveTickets(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, ticket models.Ticket) (models.Ticket, error) {
+			assert.WithinDuration(t, time.Now().Add(reservationTTL), ticket.ReservedUntil, time.Minute)
+			ticket.ID = 7
+			return ticket, nil
+		})
+
+	ticket, err := service.ReserveTickets(context.Background(), request)
+	assert.NoError(t, err)
+	assert.Equal(t, 7, ticket.ID)
+}
+
+func TestEventService_ReserveTicketsCancelledEvent(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockEventDB := mocks.NewMockEventDB(ctrl)
+	service := NewService(mockEventDB, nil)
+
+	mockEventDB.EXPECT().GetEventByID(gomock.Any(), 1).
+		Return(models.Event{ID: 1, Status: models.EventStatusCancelled}, nil)
+
+	_, err := service.ReserveTickets(context.Background(), models.Ticket{EventID: 1, TicketTypeID: 2, UserID: 3, Quantity: 2})
+	assert.ErrorIs(t, err, models.ErrNotPublished)
+}
@@ -0,0 +1,27 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"kudago/internal/models"
+)
+
+const (
+	// trendingHalfLife is how long it takes a favorite to lose half of its
+	// weight in the trending score.
+	trendingHalfLife = 48 * time.Hour
+	// trendingWindow bounds the favorites considered; older ones weigh
+	// less than 1/8 anyway.
+	trendingWindow = 7 * 24 * time.Hour
+)
+
+func (s *EventService) GetTrendingEvents(ctx context.Context, categoryID int, paginationParams models.PaginationParams) ([]models.Event, error) {
+	return s.EventDB.GetTrendingEvents(ctx, categoryID, paginationParams)
+}
+
+// RefreshTrendingScores is run periodically so trending reads stay a plain
+// index scan.
+func (s *EventService) RefreshTrendingScores(ctx context.Context) error {
+	return s.EventDB.RefreshTrendingScores(ctx, trendingHalfLife, trendingWindow)
+}
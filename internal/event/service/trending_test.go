@@ -0,0 +1,48 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"kudago/internal/event/service/mocks"
+	"kudago/internal/models"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEventService_GetTrendingEvents(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockEventDB := mocks.NewMockEventDB(ctrl)
+	service := NewService(mockEventDB, nil)
+
+	params := models.PaginationParams{Limit: 10}
+	trending := []models.Event{{ID: 2, FavoritesCount: 5}}
+	mockEventDB.EXPECT().GetTrendingEvents(gomock.Any(), 3, params).Return(trending, nil)
+
+	events, err := service.GetTrendingEvents(context.Background(), 3, params)
+	assert.NoError(t, err)
+	assert.Equal(t, trending, events)
+}
+
+func TestEventService_RefreshTrendingScores(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockEventDB := mocks.NewMockEventDB(ctrl)
+	service := NewService(mockEventDB, nil)
+
+	mockEventDB.EXPECT().RefreshTrendingScores(gomock.Any(), trendingHalfLife, trendingWindow).Return(nil)
+	assert.NoError(t, service.RefreshTrendingScores(context.Background()))
+
+	dbErr := errors.New("db error")
+	mockEventDB.EXPECT().RefreshTrendingScores(gomock.Any(), trendingHalfLife, trendingWindow).Return(dbErr)
+	assert.ErrorIs(t, service.RefreshTrendingScores(context.Background()), dbErr)
+}
@@ -5,6 +5,7 @@ package handlers
 
 import (
 	"context"
+	"math"
 	"net/http"
 	"regexp"
 	"time"
@@ -18,22 +19,30 @@ import (
 	"kudago/internal/models"
 
 	"github.com/asaskevich/govalidator"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
+	grpcCodes "google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
+	grpcStatus "google.golang.org/grpc/status"
 )
 
 type AuthHandlers struct {
 	AuthService  pb.AuthServiceClient
-	ImageService pbImage.ImageServiceClient
+	ImageService utils.ImageServiceClient
 	logger       *logger.Logger
 }
 
+const defaultRetryAfter = 60
+
 var validPasswordRegex = regexp.MustCompile(`^[a-zA-Z0-9+\-*/.;=\]\[\}\{\?]+$`)
 
 func init() {
 	govalidator.TagMap["password"] = govalidator.Validator(func(str string) bool {
 		return validPasswordRegex.MatchString(str)
 	})
+	govalidator.TagMap["login"] = govalidator.Validator(func(str string) bool {
+		return govalidator.IsAlphanumeric(str) || govalidator.IsEmail(str)
+	})
 }
 
 func NewHandlers(authServiceAddr string, imageServiceAddr string, logger *logger.Logger) (*AuthHandlers, error) {
@@ -65,11 +74,27 @@ type UserResponse struct {
 	Username string `json:"username"`
 	Email    string `json:"email"`
 	ImageURL string `json:"image"`
+	Verified bool   `json:"verified"`
+}
+
+//easyjson:json
+type SessionResponse struct {
+	ID         string `json:"id"`
+	UserAgent  string `json:"user_agent"`
+	IP         string `json:"ip"`
+	CreatedAt  string `json:"created_at"`
+	LastUsedAt string `json:"last_used_at"`
+	Current    bool   `json:"current"`
+}
+
+//easyjson:json
+type SessionsResponse struct {
+	Sessions []SessionResponse `json:"sessions"`
 }
 
 //easyjson:json
 type LoginRequest struct {
-	Username string `json:"username" valid:"required,alphanum,length(3|50)"`
+	Login    string `json:"username" valid:"login,required,length(3|100)"`
 	Password string `json:"password" valid:"password,required,length(3|50)"`
 }
 
@@ -80,6 +105,28 @@ type RegisterRequest struct {
 	Password string `json:"password" valid:"password,required,length(3|50)"`
 }
 
+//easyjson:json
+type ChangePasswordRequest struct {
+	OldPassword string `json:"old_password" valid:"required"`
+	NewPassword string `json:"new_password" valid:"password,required,length(3|50)"`
+}
+
+//easyjson:json
+type PasswordResetRequest struct {
+	Email string `json:"email" valid:"email,required"`
+}
+
+//easyjson:json
+type ConfirmPasswordResetRequest struct {
+	Token       string `json:"token" valid:"required"`
+	NewPassword string `json:"new_password" valid:"password,required,length(3|50)"`
+}
+
+//easyjson:json
+type VerifyEmailRequest struct {
+	Token string `json:"token" valid:"required"`
+}
+
 func userToUserResponse(user *pb.User) AuthResponse {
 	resp := AuthResponse{
 		User: UserResponse{
@@ -87,13 +134,35 @@ func userToUserResponse(user *pb.User) AuthResponse {
 			Username: user.Username,
 			Email:    user.Email,
 			ImageURL: user.AvatarUrl,
+			Verified: user.Verified,
 		},
 	}
 	return resp
 }
 
+func sessionsToSessionsResponse(sessions *pb.Sessions) SessionsResponse {
+	resp := SessionsResponse{
+		Sessions: make([]SessionResponse, 0, len(sessions.Sessions)),
+	}
+	for _, session := range sessions.Sessions {
+		resp.Sessions = append(resp.Sessions, SessionResponse{
+			ID:         session.ID,
+			UserAgent:  session.UserAgent,
+			IP:         session.Ip,
+			CreatedAt:  session.CreatedAt,
+			LastUsedAt: session.LastUsedAt,
+			Current:    session.Current,
+		})
+	}
+	return resp
+}
+
 func (h *AuthHandlers) setSessionCookie(w http.ResponseWriter, r *http.Request, ID int) error {
-	req := &pb.CreateSessionRequest{ID: int32(ID)}
+	req := &pb.CreateSessionRequest{
+		ID:        int32(ID),
+		UserAgent: r.UserAgent(),
+		Ip:        utils.GetClientIP(r),
+	}
 
 	session, err := h.AuthService.CreateSession(r.Context(), req)
 	if err != nil {
@@ -105,15 +174,20 @@ func (h *AuthHandlers) setSessionCookie(w http.ResponseWriter, r *http.Request,
 		return models.ErrInternal
 	}
 
-	http.SetCookie(w, &http.Cookie{
-		Name:     models.SessionToken,
-		Value:    session.Token,
-		Expires:  expires,
-		HttpOnly: true,
-	})
+	utils.SetSessionCookie(w, session.Token, expires)
 	return nil
 }
 
+// retryAfter returns the lockout delay passed by the auth service in seconds.
+func retryAfter(st *grpcStatus.Status) int {
+	for _, detail := range st.Details() {
+		if info, ok := detail.(*errdetails.RetryInfo); ok {
+			return int(math.Ceil(info.RetryDelay.AsDuration().Seconds()))
+		}
+	}
+	return defaultRetryAfter
+}
+
 func (h *AuthHandlers) deleteImage(ctx context.Context, url string) {
 	if url != "" {
 		req := &pbImage.DeleteRequest{
@@ -123,14 +197,16 @@ func (h *AuthHandlers) deleteImage(ctx context.Context, url string) {
 	}
 }
 
-func (h *AuthHandlers) uploadImage(ctx context.Context, media *pbImage.UploadRequest, w http.ResponseWriter) (string, error) {
+func (h *AuthHandlers) uploadImage(ctx context.Context, media *utils.ImageUpload, w http.ResponseWriter) (string, error) {
 	if media != nil {
-		url, err := h.ImageService.UploadImage(ctx, media)
+		url, err := utils.UploadImage(ctx, h.ImageService, media)
 		if err != nil {
-			switch err {
-			case models.ErrInvalidImage:
+			switch {
+			case grpcStatus.Code(err) == grpcCodes.InvalidArgument, err == models.ErrInvalidImage:
 				utils.WriteResponse(w, http.StatusBadRequest, httpErrors.ErrInvalidImage)
-			case models.ErrInvalidImageFormat:
+			case grpcStatus.Code(err) == grpcCodes.ResourceExhausted, err == models.ErrImageTooLarge:
+				utils.WriteResponse(w, http.StatusRequestEntityTooLarge, httpErrors.ErrImageTooLarge)
+			case err == models.ErrInvalidImageFormat:
 				utils.WriteResponse(w, http.StatusBadRequest, httpErrors.ErrInvalidImageFormat)
 			default:
 				h.logger.Error(ctx, "upload image", err)
@@ -17,7 +17,73 @@ var (
 	_ easyjson.Marshaler
 )
 
-func easyjson4a0f95aaDecodeKudagoInternalGatewayAuth(in *jlexer.Lexer, out *UserResponse) {
+func easyjson4a0f95aaDecodeKudagoInternalGatewayAuth(in *jlexer.Lexer, out *VerifyEmailRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "token":
+			out.Token = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson4a0f95aaEncodeKudagoInternalGatewayAuth(out *jwriter.Writer, in VerifyEmailRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"token\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Token))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v VerifyEmailRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson4a0f95aaEncodeKudagoInternalGatewayAuth(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v VerifyEmailRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson4a0f95aaEncodeKudagoInternalGatewayAuth(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *VerifyEmailRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson4a0f95aaDecodeKudagoInternalGatewayAuth(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *VerifyEmailRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson4a0f95aaDecodeKudagoInternalGatewayAuth(l, v)
+}
+func easyjson4a0f95aaDecodeKudagoInternalGatewayAuth1(in *jlexer.Lexer, out *UserResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -44,6 +110,8 @@ func easyjson4a0f95aaDecodeKudagoInternalGatewayAuth(in *jlexer.Lexer, out *User
 			out.Email = string(in.String())
 		case "image":
 			out.ImageURL = string(in.String())
+		case "verified":
+			out.Verified = bool(in.Bool())
 		default:
 			in.SkipRecursive()
 		}
@@ -54,7 +122,7 @@ func easyjson4a0f95aaDecodeKudagoInternalGatewayAuth(in *jlexer.Lexer, out *User
 		in.Consumed()
 	}
 }
-func easyjson4a0f95aaEncodeKudagoInternalGatewayAuth(out *jwriter.Writer, in UserResponse) {
+func easyjson4a0f95aaEncodeKudagoInternalGatewayAuth1(out *jwriter.Writer, in UserResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -78,33 +146,237 @@ func easyjson4a0f95aaEncodeKudagoInternalGatewayAuth(out *jwriter.Writer, in Use
 		out.RawString(prefix)
 		out.String(string(in.ImageURL))
 	}
+	{
+		const prefix string = ",\"verified\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Verified))
+	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
 func (v UserResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson4a0f95aaEncodeKudagoInternalGatewayAuth(&w, v)
+	easyjson4a0f95aaEncodeKudagoInternalGatewayAuth1(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v UserResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson4a0f95aaEncodeKudagoInternalGatewayAuth(w, v)
+	easyjson4a0f95aaEncodeKudagoInternalGatewayAuth1(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *UserResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson4a0f95aaDecodeKudagoInternalGatewayAuth(&r, v)
+	easyjson4a0f95aaDecodeKudagoInternalGatewayAuth1(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *UserResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson4a0f95aaDecodeKudagoInternalGatewayAuth(l, v)
+	easyjson4a0f95aaDecodeKudagoInternalGatewayAuth1(l, v)
 }
-func easyjson4a0f95aaDecodeKudagoInternalGatewayAuth1(in *jlexer.Lexer, out *RegisterRequest) {
+func easyjson4a0f95aaDecodeKudagoInternalGatewayAuth2(in *jlexer.Lexer, out *SessionsResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "sessions":
+			if in.IsNull() {
+				in.Skip()
+				out.Sessions = nil
+			} else {
+				in.Delim('[')
+				if out.Sessions == nil {
+					if !in.IsDelim(']') {
+						out.Sessions = make([]SessionResponse, 0, 0)
+					} else {
+						out.Sessions = []SessionResponse{}
+					}
+				} else {
+					out.Sessions = (out.Sessions)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 SessionResponse
+					(v1).UnmarshalEasyJSON(in)
+					out.Sessions = append(out.Sessions, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson4a0f95aaEncodeKudagoInternalGatewayAuth2(out *jwriter.Writer, in SessionsResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"sessions\":"
+		out.RawString(prefix[1:])
+		if in.Sessions == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v2, v3 := range in.Sessions {
+				if v2 > 0 {
+					out.RawByte(',')
+				}
+				(v3).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v SessionsResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson4a0f95aaEncodeKudagoInternalGatewayAuth2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v SessionsResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson4a0f95aaEncodeKudagoInternalGatewayAuth2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *SessionsResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson4a0f95aaDecodeKudagoInternalGatewayAuth2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *SessionsResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson4a0f95aaDecodeKudagoInternalGatewayAuth2(l, v)
+}
+func easyjson4a0f95aaDecodeKudagoInternalGatewayAuth3(in *jlexer.Lexer, out *SessionResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = string(in.String())
+		case "user_agent":
+			out.UserAgent = string(in.String())
+		case "ip":
+			out.IP = string(in.String())
+		case "created_at":
+			out.CreatedAt = string(in.String())
+		case "last_used_at":
+			out.LastUsedAt = string(in.String())
+		case "current":
+			out.Current = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson4a0f95aaEncodeKudagoInternalGatewayAuth3(out *jwriter.Writer, in SessionResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.ID))
+	}
+	{
+		const prefix string = ",\"user_agent\":"
+		out.RawString(prefix)
+		out.String(string(in.UserAgent))
+	}
+	{
+		const prefix string = ",\"ip\":"
+		out.RawString(prefix)
+		out.String(string(in.IP))
+	}
+	{
+		const prefix string = ",\"created_at\":"
+		out.RawString(prefix)
+		out.String(string(in.CreatedAt))
+	}
+	{
+		const prefix string = ",\"last_used_at\":"
+		out.RawString(prefix)
+		out.String(string(in.LastUsedAt))
+	}
+	{
+		const prefix string = ",\"current\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Current))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v SessionResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson4a0f95aaEncodeKudagoInternalGatewayAuth3(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v SessionResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson4a0f95aaEncodeKudagoInternalGatewayAuth3(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *SessionResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson4a0f95aaDecodeKudagoInternalGatewayAuth3(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *SessionResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson4a0f95aaDecodeKudagoInternalGatewayAuth3(l, v)
+}
+func easyjson4a0f95aaDecodeKudagoInternalGatewayAuth4(in *jlexer.Lexer, out *RegisterRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -139,7 +411,7 @@ func easyjson4a0f95aaDecodeKudagoInternalGatewayAuth1(in *jlexer.Lexer, out *Reg
 		in.Consumed()
 	}
 }
-func easyjson4a0f95aaEncodeKudagoInternalGatewayAuth1(out *jwriter.Writer, in RegisterRequest) {
+func easyjson4a0f95aaEncodeKudagoInternalGatewayAuth4(out *jwriter.Writer, in RegisterRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -164,27 +436,93 @@ func easyjson4a0f95aaEncodeKudagoInternalGatewayAuth1(out *jwriter.Writer, in Re
 // MarshalJSON supports json.Marshaler interface
 func (v RegisterRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson4a0f95aaEncodeKudagoInternalGatewayAuth1(&w, v)
+	easyjson4a0f95aaEncodeKudagoInternalGatewayAuth4(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v RegisterRequest) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson4a0f95aaEncodeKudagoInternalGatewayAuth1(w, v)
+	easyjson4a0f95aaEncodeKudagoInternalGatewayAuth4(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *RegisterRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson4a0f95aaDecodeKudagoInternalGatewayAuth1(&r, v)
+	easyjson4a0f95aaDecodeKudagoInternalGatewayAuth4(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *RegisterRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson4a0f95aaDecodeKudagoInternalGatewayAuth1(l, v)
+	easyjson4a0f95aaDecodeKudagoInternalGatewayAuth4(l, v)
+}
+func easyjson4a0f95aaDecodeKudagoInternalGatewayAuth5(in *jlexer.Lexer, out *PasswordResetRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "email":
+			out.Email = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson4a0f95aaEncodeKudagoInternalGatewayAuth5(out *jwriter.Writer, in PasswordResetRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"email\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Email))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PasswordResetRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson4a0f95aaEncodeKudagoInternalGatewayAuth5(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PasswordResetRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson4a0f95aaEncodeKudagoInternalGatewayAuth5(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PasswordResetRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson4a0f95aaDecodeKudagoInternalGatewayAuth5(&r, v)
+	return r.Error()
 }
-func easyjson4a0f95aaDecodeKudagoInternalGatewayAuth2(in *jlexer.Lexer, out *LoginRequest) {
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PasswordResetRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson4a0f95aaDecodeKudagoInternalGatewayAuth5(l, v)
+}
+func easyjson4a0f95aaDecodeKudagoInternalGatewayAuth6(in *jlexer.Lexer, out *LoginRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -204,7 +542,7 @@ func easyjson4a0f95aaDecodeKudagoInternalGatewayAuth2(in *jlexer.Lexer, out *Log
 		}
 		switch key {
 		case "username":
-			out.Username = string(in.String())
+			out.Login = string(in.String())
 		case "password":
 			out.Password = string(in.String())
 		default:
@@ -217,14 +555,14 @@ func easyjson4a0f95aaDecodeKudagoInternalGatewayAuth2(in *jlexer.Lexer, out *Log
 		in.Consumed()
 	}
 }
-func easyjson4a0f95aaEncodeKudagoInternalGatewayAuth2(out *jwriter.Writer, in LoginRequest) {
+func easyjson4a0f95aaEncodeKudagoInternalGatewayAuth6(out *jwriter.Writer, in LoginRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
 		const prefix string = ",\"username\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Username))
+		out.String(string(in.Login))
 	}
 	{
 		const prefix string = ",\"password\":"
@@ -237,27 +575,173 @@ func easyjson4a0f95aaEncodeKudagoInternalGatewayAuth2(out *jwriter.Writer, in Lo
 // MarshalJSON supports json.Marshaler interface
 func (v LoginRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson4a0f95aaEncodeKudagoInternalGatewayAuth2(&w, v)
+	easyjson4a0f95aaEncodeKudagoInternalGatewayAuth6(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v LoginRequest) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson4a0f95aaEncodeKudagoInternalGatewayAuth2(w, v)
+	easyjson4a0f95aaEncodeKudagoInternalGatewayAuth6(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *LoginRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson4a0f95aaDecodeKudagoInternalGatewayAuth2(&r, v)
+	easyjson4a0f95aaDecodeKudagoInternalGatewayAuth6(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *LoginRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson4a0f95aaDecodeKudagoInternalGatewayAuth2(l, v)
+	easyjson4a0f95aaDecodeKudagoInternalGatewayAuth6(l, v)
+}
+func easyjson4a0f95aaDecodeKudagoInternalGatewayAuth7(in *jlexer.Lexer, out *ConfirmPasswordResetRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "token":
+			out.Token = string(in.String())
+		case "new_password":
+			out.NewPassword = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson4a0f95aaEncodeKudagoInternalGatewayAuth7(out *jwriter.Writer, in ConfirmPasswordResetRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"token\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Token))
+	}
+	{
+		const prefix string = ",\"new_password\":"
+		out.RawString(prefix)
+		out.String(string(in.NewPassword))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ConfirmPasswordResetRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson4a0f95aaEncodeKudagoInternalGatewayAuth7(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ConfirmPasswordResetRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson4a0f95aaEncodeKudagoInternalGatewayAuth7(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ConfirmPasswordResetRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson4a0f95aaDecodeKudagoInternalGatewayAuth7(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ConfirmPasswordResetRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson4a0f95aaDecodeKudagoInternalGatewayAuth7(l, v)
+}
+func easyjson4a0f95aaDecodeKudagoInternalGatewayAuth8(in *jlexer.Lexer, out *ChangePasswordRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "old_password":
+			out.OldPassword = string(in.String())
+		case "new_password":
+			out.NewPassword = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
 }
-func easyjson4a0f95aaDecodeKudagoInternalGatewayAuth3(in *jlexer.Lexer, out *AuthResponse) {
+func easyjson4a0f95aaEncodeKudagoInternalGatewayAuth8(out *jwriter.Writer, in ChangePasswordRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"old_password\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.OldPassword))
+	}
+	{
+		const prefix string = ",\"new_password\":"
+		out.RawString(prefix)
+		out.String(string(in.NewPassword))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ChangePasswordRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson4a0f95aaEncodeKudagoInternalGatewayAuth8(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ChangePasswordRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson4a0f95aaEncodeKudagoInternalGatewayAuth8(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ChangePasswordRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson4a0f95aaDecodeKudagoInternalGatewayAuth8(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ChangePasswordRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson4a0f95aaDecodeKudagoInternalGatewayAuth8(l, v)
+}
+func easyjson4a0f95aaDecodeKudagoInternalGatewayAuth9(in *jlexer.Lexer, out *AuthResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -288,7 +772,7 @@ func easyjson4a0f95aaDecodeKudagoInternalGatewayAuth3(in *jlexer.Lexer, out *Aut
 		in.Consumed()
 	}
 }
-func easyjson4a0f95aaEncodeKudagoInternalGatewayAuth3(out *jwriter.Writer, in AuthResponse) {
+func easyjson4a0f95aaEncodeKudagoInternalGatewayAuth9(out *jwriter.Writer, in AuthResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -303,23 +787,23 @@ func easyjson4a0f95aaEncodeKudagoInternalGatewayAuth3(out *jwriter.Writer, in Au
 // MarshalJSON supports json.Marshaler interface
 func (v AuthResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson4a0f95aaEncodeKudagoInternalGatewayAuth3(&w, v)
+	easyjson4a0f95aaEncodeKudagoInternalGatewayAuth9(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v AuthResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson4a0f95aaEncodeKudagoInternalGatewayAuth3(w, v)
+	easyjson4a0f95aaEncodeKudagoInternalGatewayAuth9(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *AuthResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson4a0f95aaDecodeKudagoInternalGatewayAuth3(&r, v)
+	easyjson4a0f95aaDecodeKudagoInternalGatewayAuth9(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *AuthResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson4a0f95aaDecodeKudagoInternalGatewayAuth3(l, v)
+	easyjson4a0f95aaDecodeKudagoInternalGatewayAuth9(l, v)
 }
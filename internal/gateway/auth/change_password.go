@@ -0,0 +1,62 @@
+package handlers
+
+import (
+	"net/http"
+
+	pb "kudago/internal/auth/api"
+	httpErrors "kudago/internal/gateway/errors"
+	"kudago/internal/gateway/utils"
+
+	"github.com/asaskevich/govalidator"
+	easyjson "github.com/mailru/easyjson"
+	grpcCodes "google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+func (h *AuthHandlers) ChangePassword(w http.ResponseWriter, r *http.Request) {
+	session, ok := utils.GetSessionFromContext(r.Context())
+	if !ok {
+		utils.WriteResponse(w, http.StatusForbidden, httpErrors.ErrUnauthorized)
+		return
+	}
+
+	var req ChangePasswordRequest
+	err := easyjson.UnmarshalFromReader(r.Body, &req)
+	if err != nil {
+		utils.WriteResponse(w, http.StatusBadRequest, httpErrors.ErrInvalidData)
+		return
+	}
+
+	_, err = govalidator.ValidateStruct(&req)
+	if err != nil {
+		utils.ProcessValidationErrors(w, err)
+		return
+	}
+
+	changePasswordRequest := &pb.ChangePasswordRequest{
+		UserID:      int32(session.UserID),
+		OldPassword: req.OldPassword,
+		NewPassword: req.NewPassword,
+		Token:       session.Token,
+	}
+
+	_, err = h.AuthService.ChangePassword(r.Context(), changePasswordRequest)
+	if err != nil {
+		st, ok := grpcStatus.FromError(err)
+		if ok {
+			switch st.Code() {
+			case grpcCodes.PermissionDenied:
+				utils.WriteResponse(w, http.StatusForbidden, httpErrors.ErrWrongPassword)
+				return
+			case grpcCodes.NotFound:
+				utils.WriteResponse(w, http.StatusNotFound, httpErrors.ErrUserNotFound)
+				return
+			}
+		}
+		h.logger.Error(r.Context(), "change password", err)
+		utils.WriteResponse(w, http.StatusInternalServerError, httpErrors.ErrInternal)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
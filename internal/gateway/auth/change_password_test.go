@@ -0,0 +1,131 @@
+package handlers
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	pb "kudago/internal/auth/api"
+	auth "kudago/internal/auth/grpc"
+	"kudago/internal/gateway/auth/mocks"
+	"kudago/internal/gateway/utils"
+	"kudago/internal/logger"
+	"kudago/internal/models"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestAuthHandler_ChangePassword(t *testing.T) {
+	t.Parallel()
+	logger, _ := logger.NewLogger()
+
+	withSession := func(body string) *http.Request {
+		req := httptest.NewRequest(http.MethodPut, "/profile/password", bytes.NewBufferString(body))
+		session := models.Session{UserID: 1, Token: "valid_token"}
+		return req.WithContext(utils.SetSessionInContext(req.Context(), session))
+	}
+	validBody := `{"old_password": "password", "new_password": "newpassword"}`
+
+	tests := []struct {
+		name      string
+		req       *http.Request
+		w         *httptest.ResponseRecorder
+		setupFunc func(ctrl *gomock.Controller) *AuthHandlers
+		wantCode  int
+	}{
+		{
+			name: "Успешная смена пароля",
+			req:  withSession(validBody),
+			w:    httptest.NewRecorder(),
+			setupFunc: func(ctrl *gomock.Controller) *AuthHandlers {
+				serviceMock := mocks.NewMockAuthServiceClient(ctrl)
+
+				serviceMock.EXPECT().ChangePassword(gomock.Any(), &pb.ChangePasswordRequest{
+					UserID:      1,
+					OldPassword: "password",
+					NewPassword: "newpassword",
+					Token:       "valid_token",
+				}).Return(&pb.Empty{}, nil)
+
+				return &AuthHandlers{
+					AuthService: serviceMock,
+					logger:      logger,
+				}
+			},
+			wantCode: http.StatusOK,
+		},
+		{
+			name: "Нет активной сессии",
+			req:  httptest.NewRequest(http.MethodPut, "/profile/password", bytes.NewBufferString(validBody)),
+			w:    httptest.NewRecorder(),
+			setupFunc: func(ctrl *gomock.Controller) *AuthHandlers {
+				return &AuthHandlers{
+					AuthService: mocks.NewMockAuthServiceClient(ctrl),
+				}
+			},
+			wantCode: http.StatusForbidden,
+		},
+		{
+			name: "Невалидный новый пароль",
+			req:  withSession(`{"old_password": "password", "new_password": "новый пароль"}`),
+			w:    httptest.NewRecorder(),
+			setupFunc: func(ctrl *gomock.Controller) *AuthHandlers {
+				return &AuthHandlers{
+					AuthService: mocks.NewMockAuthServiceClient(ctrl),
+				}
+			},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name: "Неверный старый пароль",
+			req:  withSession(validBody),
+			w:    httptest.NewRecorder(),
+			setupFunc: func(ctrl *gomock.Controller) *AuthHandlers {
+				serviceMock := mocks.NewMockAuthServiceClient(ctrl)
+
+				serviceMock.EXPECT().ChangePassword(gomock.Any(), gomock.Any()).
+					Return(nil, status.Error(codes.PermissionDenied, auth.ErrWrongPassword))
+
+				return &AuthHandlers{
+					AuthService: serviceMock,
+					logger:      logger,
+				}
+			},
+			wantCode: http.StatusForbidden,
+		},
+		{
+			name: "Ошибка сервиса",
+			req:  withSession(validBody),
+			w:    httptest.NewRecorder(),
+			setupFunc: func(ctrl *gomock.Controller) *AuthHandlers {
+				serviceMock := mocks.NewMockAuthServiceClient(ctrl)
+
+				serviceMock.EXPECT().ChangePassword(gomock.Any(), gomock.Any()).
+					Return(nil, status.Error(codes.Internal, auth.ErrInternal))
+
+				return &AuthHandlers{
+					AuthService: serviceMock,
+					logger:      logger,
+				}
+			},
+			wantCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			tt.setupFunc(ctrl).ChangePassword(tt.w, tt.req)
+
+			assert.Equal(t, tt.wantCode, tt.w.Code)
+		})
+	}
+}
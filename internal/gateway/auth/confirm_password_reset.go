@@ -0,0 +1,60 @@
+package handlers
+
+import (
+	"net/http"
+
+	pb "kudago/internal/auth/api"
+	httpErrors "kudago/internal/gateway/errors"
+	"kudago/internal/gateway/utils"
+	"kudago/internal/models"
+
+	"github.com/asaskevich/govalidator"
+	easyjson "github.com/mailru/easyjson"
+	grpcCodes "google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+func (h *AuthHandlers) ConfirmPasswordReset(w http.ResponseWriter, r *http.Request) {
+	var req ConfirmPasswordResetRequest
+	err := easyjson.UnmarshalFromReader(r.Body, &req)
+	if err != nil {
+		utils.WriteResponse(w, http.StatusBadRequest, httpErrors.ErrInvalidData)
+		return
+	}
+
+	_, err = govalidator.ValidateStruct(&req)
+	if err != nil {
+		utils.ProcessValidationErrors(w, err)
+		return
+	}
+
+	confirmRequest := &pb.ConfirmPasswordResetRequest{
+		Token:       req.Token,
+		NewPassword: req.NewPassword,
+	}
+
+	_, err = h.AuthService.ConfirmPasswordReset(r.Context(), confirmRequest)
+	if err != nil {
+		st, ok := grpcStatus.FromError(err)
+		if ok {
+			switch st.Code() {
+			case grpcCodes.InvalidArgument:
+				utils.WriteResponse(w, http.StatusBadRequest, httpErrors.ErrInvalidToken)
+				return
+			case grpcCodes.NotFound:
+				utils.WriteResponse(w, http.StatusNotFound, httpErrors.ErrUserNotFound)
+				return
+			}
+		}
+		h.logger.Error(r.Context(), "confirm password reset", err)
+		utils.WriteResponse(w, http.StatusInternalServerError, httpErrors.ErrInternal)
+		return
+	}
+
+	http.SetCookie(w, &http.Cookie{
+		Name:   models.SessionToken,
+		MaxAge: -1,
+	})
+
+	w.WriteHeader(http.StatusOK)
+}
@@ -0,0 +1,111 @@
+package handlers
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	pb "kudago/internal/auth/api"
+	auth "kudago/internal/auth/grpc"
+	"kudago/internal/gateway/auth/mocks"
+	"kudago/internal/logger"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestAuthHandler_ConfirmPasswordReset(t *testing.T) {
+	t.Parallel()
+	logger, _ := logger.NewLogger()
+
+	validBody := `{"token": "reset_token", "new_password": "newpassword"}`
+
+	tests := []struct {
+		name      string
+		req       *http.Request
+		w         *httptest.ResponseRecorder
+		setupFunc func(ctrl *gomock.Controller) *AuthHandlers
+		wantCode  int
+	}{
+		{
+			name: "Пароль восстановлен",
+			req:  httptest.NewRequest(http.MethodPost, "/password/reset/confirm", bytes.NewBufferString(validBody)),
+			w:    httptest.NewRecorder(),
+			setupFunc: func(ctrl *gomock.Controller) *AuthHandlers {
+				serviceMock := mocks.NewMockAuthServiceClient(ctrl)
+
+				serviceMock.EXPECT().ConfirmPasswordReset(gomock.Any(), &pb.ConfirmPasswordResetRequest{
+					Token:       "reset_token",
+					NewPassword: "newpassword",
+				}).Return(&pb.Empty{}, nil)
+
+				return &AuthHandlers{
+					AuthService: serviceMock,
+					logger:      logger,
+				}
+			},
+			wantCode: http.StatusOK,
+		},
+		{
+			name: "Нет токена",
+			req:  httptest.NewRequest(http.MethodPost, "/password/reset/confirm", bytes.NewBufferString(`{"new_password": "newpassword"}`)),
+			w:    httptest.NewRecorder(),
+			setupFunc: func(ctrl *gomock.Controller) *AuthHandlers {
+				return &AuthHandlers{
+					AuthService: mocks.NewMockAuthServiceClient(ctrl),
+				}
+			},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name: "Токен недействителен",
+			req:  httptest.NewRequest(http.MethodPost, "/password/reset/confirm", bytes.NewBufferString(validBody)),
+			w:    httptest.NewRecorder(),
+			setupFunc: func(ctrl *gomock.Controller) *AuthHandlers {
+				serviceMock := mocks.NewMockAuthServiceClient(ctrl)
+
+				serviceMock.EXPECT().ConfirmPasswordReset(gomock.Any(), gomock.Any()).
+					Return(nil, status.Error(codes.InvalidArgument, auth.ErrInvalidToken))
+
+				return &AuthHandlers{
+					AuthService: serviceMock,
+					logger:      logger,
+				}
+			},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name: "Ошибка сервиса",
+			req:  httptest.NewRequest(http.MethodPost, "/password/reset/confirm", bytes.NewBufferString(validBody)),
+			w:    httptest.NewRecorder(),
+			setupFunc: func(ctrl *gomock.Controller) *AuthHandlers {
+				serviceMock := mocks.NewMockAuthServiceClient(ctrl)
+
+				serviceMock.EXPECT().ConfirmPasswordReset(gomock.Any(), gomock.Any()).
+					Return(nil, status.Error(codes.Internal, auth.ErrInternal))
+
+				return &AuthHandlers{
+					AuthService: serviceMock,
+					logger:      logger,
+				}
+			},
+			wantCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			tt.setupFunc(ctrl).ConfirmPasswordReset(tt.w, tt.req)
+
+			assert.Equal(t, tt.wantCode, tt.w.Code)
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package handlers
+
+import (
+	"net/http"
+
+	pb "kudago/internal/auth/api"
+	httpErrors "kudago/internal/gateway/errors"
+	"kudago/internal/gateway/utils"
+)
+
+func (h *AuthHandlers) ListSessions(w http.ResponseWriter, r *http.Request) {
+	session, ok := utils.GetSessionFromContext(r.Context())
+	if !ok {
+		utils.WriteResponse(w, http.StatusForbidden, httpErrors.ErrUnauthorized)
+		return
+	}
+
+	req := &pb.ListSessionsRequest{
+		UserID: int32(session.UserID),
+		Token:  session.Token,
+	}
+
+	sessions, err := h.AuthService.ListSessions(r.Context(), req)
+	if err != nil {
+		h.logger.Error(r.Context(), "list sessions", err)
+		utils.WriteResponse(w, http.StatusInternalServerError, httpErrors.ErrInternal)
+		return
+	}
+
+	utils.WriteResponse(w, http.StatusOK, sessionsToSessionsResponse(sessions))
+}
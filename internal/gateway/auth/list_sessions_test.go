@@ -0,0 +1,114 @@
+package handlers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	pb "kudago/internal/auth/api"
+	auth "kudago/internal/auth/grpc"
+	"kudago/internal/gateway/auth/mocks"
+	"kudago/internal/gateway/utils"
+	"kudago/internal/logger"
+	"kudago/internal/models"
+
+	"github.com/golang/mock/gomock"
+	"github.com/mailru/easyjson"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestAuthHandler_ListSessions(t *testing.T) {
+	t.Parallel()
+	logger, _ := logger.NewLogger()
+
+	withSession := func() *http.Request {
+		req := httptest.NewRequest(http.MethodGet, "/sessions", nil)
+		session := models.Session{UserID: 1, Token: "valid_token"}
+		return req.WithContext(utils.SetSessionInContext(req.Context(), session))
+	}
+
+	tests := []struct {
+		name      string
+		req       *http.Request
+		w         *httptest.ResponseRecorder
+		setupFunc func(ctrl *gomock.Controller) *AuthHandlers
+		wantCode  int
+		wantResp  SessionsResponse
+	}{
+		{
+			name: "Успешное получение сессий",
+			req:  withSession(),
+			w:    httptest.NewRecorder(),
+			setupFunc: func(ctrl *gomock.Controller) *AuthHandlers {
+				serviceMock := mocks.NewMockAuthServiceClient(ctrl)
+
+				serviceMock.EXPECT().ListSessions(gomock.Any(), &pb.ListSessionsRequest{UserID: 1, Token: "valid_token"}).
+					Return(&pb.Sessions{
+						Sessions: []*pb.SessionInfo{
+							{ID: "a1b2c3d4e5f60718", UserAgent: "Mozilla/5.0", Ip: "192.0.2.1", Current: true},
+						},
+					}, nil)
+
+				return &AuthHandlers{
+					AuthService: serviceMock,
+					logger:      logger,
+				}
+			},
+			wantCode: http.StatusOK,
+			wantResp: SessionsResponse{
+				Sessions: []SessionResponse{
+					{ID: "a1b2c3d4e5f60718", UserAgent: "Mozilla/5.0", IP: "192.0.2.1", Current: true},
+				},
+			},
+		},
+		{
+			name: "Нет активной сессии",
+			req:  httptest.NewRequest(http.MethodGet, "/sessions", nil),
+			w:    httptest.NewRecorder(),
+			setupFunc: func(ctrl *gomock.Controller) *AuthHandlers {
+				return &AuthHandlers{
+					AuthService: mocks.NewMockAuthServiceClient(ctrl),
+				}
+			},
+			wantCode: http.StatusForbidden,
+		},
+		{
+			name: "Ошибка сервиса",
+			req:  withSession(),
+			w:    httptest.NewRecorder(),
+			setupFunc: func(ctrl *gomock.Controller) *AuthHandlers {
+				serviceMock := mocks.NewMockAuthServiceClient(ctrl)
+
+				serviceMock.EXPECT().ListSessions(gomock.Any(), gomock.Any()).
+					Return(nil, status.Error(codes.Internal, auth.ErrInternal))
+
+				return &AuthHandlers{
+					AuthService: serviceMock,
+					logger:      logger,
+				}
+			},
+			wantCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			tt.setupFunc(ctrl).ListSessions(tt.w, tt.req)
+
+			assert.Equal(t, tt.wantCode, tt.w.Code)
+			if tt.wantCode == http.StatusOK {
+				var resp SessionsResponse
+				err := easyjson.Unmarshal(tt.w.Body.Bytes(), &resp)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.wantResp, resp)
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"net/http"
+	"strconv"
 
 	pb "kudago/internal/auth/api"
 	httpErrors "kudago/internal/gateway/errors"
@@ -34,8 +35,9 @@ func (h *AuthHandlers) Login(w http.ResponseWriter, r *http.Request) {
 	}
 
 	creds := &pb.LoginRequest{
-		Username: req.Username,
+		Login:    req.Login,
 		Password: req.Password,
+		Ip:       utils.GetClientIP(r),
 	}
 
 	user, err := h.AuthService.Login(r.Context(), creds)
@@ -46,6 +48,10 @@ func (h *AuthHandlers) Login(w http.ResponseWriter, r *http.Request) {
 			case grpcCodes.NotFound:
 				utils.WriteResponse(w, http.StatusForbidden, httpErrors.ErrWrongCredentials)
 				return
+			case grpcCodes.ResourceExhausted:
+				w.Header().Set("Retry-After", strconv.Itoa(retryAfter(st)))
+				utils.WriteResponse(w, http.StatusTooManyRequests, httpErrors.ErrTooManyLoginAttempts)
+				return
 			}
 		}
 		h.logger.Error(r.Context(), "login", err)
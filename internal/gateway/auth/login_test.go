@@ -20,18 +20,21 @@ import (
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
 )
 
 func TestAuthHandler_Login(t *testing.T) {
 	t.Parallel()
 
 	type want struct {
-		code     int
-		username string
-		email    string
-		user_id  int
+		code       int
+		username   string
+		email      string
+		user_id    int
+		retryAfter string
 	}
 
 	ctx := context.Background()
@@ -41,11 +44,11 @@ func TestAuthHandler_Login(t *testing.T) {
 	randTime := time.Now().Add(time.Hour).Format(time.RFC3339)
 
 	creds := &pb.LoginRequest{
-		Username: "user1",
+		Login:    "user1",
 		Password: "password",
 	}
 
-	body, err := json.Marshal(creds)
+	body, err := json.Marshal(LoginRequest{Login: creds.Login, Password: creds.Password})
 	require.NoError(t, err)
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodPost, "/login", bytes.NewBuffer(body))
@@ -98,7 +101,7 @@ func TestAuthHandler_Login(t *testing.T) {
 			setupFunc: func(ctrl *gomock.Controller) *AuthHandlers {
 				serviceMock := mocks.NewMockAuthServiceClient(ctrl)
 
-				serviceMock.EXPECT().Login(gomock.Any(), creds).
+				serviceMock.EXPECT().Login(gomock.Any(), &pb.LoginRequest{Login: "user1", Password: "password", Ip: "192.0.2.1"}).
 					Return(&pb.User{}, status.Error(codes.NotFound, auth.ErrUserNotFound))
 
 				return &AuthHandlers{
@@ -110,6 +113,55 @@ func TestAuthHandler_Login(t *testing.T) {
 				code: http.StatusForbidden,
 			},
 		},
+		{
+			name: "Вход по email",
+			req: func() *http.Request {
+				req := httptest.NewRequest(http.MethodPost, "/login", bytes.NewBuffer([]byte(`{"username": "user1@mail.ru", "password": "password"}`)))
+				req.Header.Set("User-Agent", "Mozilla/5.0")
+				return req.WithContext(utils.SetClientIPInContext(req.Context(), "10.0.0.1"))
+			}(),
+			w: httptest.NewRecorder(),
+			setupFunc: func(ctrl *gomock.Controller) *AuthHandlers {
+				serviceMock := mocks.NewMockAuthServiceClient(ctrl)
+
+				serviceMock.EXPECT().Login(gomock.Any(), &pb.LoginRequest{Login: "user1@mail.ru", Password: "password", Ip: "10.0.0.1"}).
+					Return(&pb.User{ID: 1, Username: "user1", Email: "user1@mail.ru"}, nil)
+
+				serviceMock.EXPECT().CreateSession(gomock.Any(), &pb.CreateSessionRequest{ID: 1, UserAgent: "Mozilla/5.0", Ip: "10.0.0.1"}).
+					Return(&pb.Session{UserID: 1, Token: "token", Expires: randTime}, nil)
+
+				return &AuthHandlers{
+					AuthService: serviceMock,
+					logger:      logger,
+				}
+			},
+			want: want{
+				code: http.StatusOK,
+			},
+		},
+		{
+			name: "Слишком много попыток",
+			req:  httptest.NewRequest(http.MethodPost, "/login", bytes.NewBuffer([]byte(`{"username": "user1", "password": "password"}`))),
+			w:    httptest.NewRecorder(),
+			setupFunc: func(ctrl *gomock.Controller) *AuthHandlers {
+				serviceMock := mocks.NewMockAuthServiceClient(ctrl)
+
+				st, _ := status.New(codes.ResourceExhausted, auth.ErrTooManyAttempts).WithDetails(&errdetails.RetryInfo{
+					RetryDelay: durationpb.New(90 * time.Second),
+				})
+				serviceMock.EXPECT().Login(gomock.Any(), gomock.Any()).
+					Return(nil, st.Err())
+
+				return &AuthHandlers{
+					AuthService: serviceMock,
+					logger:      logger,
+				}
+			},
+			want: want{
+				code:       http.StatusTooManyRequests,
+				retryAfter: "90",
+			},
+		},
 		{
 			name: "Уже авторизован",
 			req: func() *http.Request {
@@ -157,6 +209,7 @@ func TestAuthHandler_Login(t *testing.T) {
 			tt.setupFunc(ctrl).Login(tt.w, tt.req)
 
 			assert.Equal(t, tt.want.code, tt.w.Code)
+			assert.Equal(t, tt.want.retryAfter, tt.w.Header().Get("Retry-After"))
 		})
 	}
 }
@@ -6,7 +6,6 @@ import (
 	pb "kudago/internal/auth/api"
 	httpErrors "kudago/internal/gateway/errors"
 	"kudago/internal/gateway/utils"
-	pbImage "kudago/internal/image/api"
 	"kudago/internal/models"
 
 	grpcCodes "google.golang.org/grpc/codes"
@@ -22,7 +21,7 @@ func (h *AuthHandlers) Register(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	req, media, reqErr := parseRegisterData(r)
+	req, media, reqErr := parseRegisterData(w, r)
 	if reqErr != nil {
 		utils.WriteResponse(w, http.StatusBadRequest, reqErr)
 		return
@@ -83,17 +82,16 @@ func (h *AuthHandlers) Register(w http.ResponseWriter, r *http.Request) {
 	return
 }
 
-func parseRegisterData(r *http.Request) (models.User, *pbImage.UploadRequest, *httpErrors.HttpError) {
+func parseRegisterData(w http.ResponseWriter, r *http.Request) (models.User, *utils.ImageUpload, *httpErrors.HttpError) {
 	var req models.User
-	jsonData := r.FormValue("json")
-	err := req.UnmarshalJSON([]byte(jsonData))
+	jsonData, media, err := utils.ReadImageForm(w, r)
 	if err != nil {
-		return req, nil, httpErrors.ErrInvalidData
+		return req, nil, httpErrors.ErrInvalidImage
 	}
 
-	media, err := utils.HandleImageUpload(r)
+	err = req.UnmarshalJSON(jsonData)
 	if err != nil {
-		return req, nil, httpErrors.ErrInvalidImage
+		return req, nil, httpErrors.ErrInvalidData
 	}
 
 	return req, media, nil
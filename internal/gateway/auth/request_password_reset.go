@@ -0,0 +1,37 @@
+package handlers
+
+import (
+	"net/http"
+
+	pb "kudago/internal/auth/api"
+	httpErrors "kudago/internal/gateway/errors"
+	"kudago/internal/gateway/utils"
+
+	"github.com/asaskevich/govalidator"
+	easyjson "github.com/mailru/easyjson"
+)
+
+func (h *AuthHandlers) RequestPasswordReset(w http.ResponseWriter, r *http.Request) {
+	var req PasswordResetRequest
+	err := easyjson.UnmarshalFromReader(r.Body, &req)
+	if err != nil {
+		utils.WriteResponse(w, http.StatusBadRequest, httpErrors.ErrInvalidData)
+		return
+	}
+
+	_, err = govalidator.ValidateStruct(&req)
+	if err != nil {
+		utils.ProcessValidationErrors(w, err)
+		return
+	}
+
+	_, err = h.AuthService.RequestPasswordReset(r.Context(), &pb.RequestPasswordResetRequest{Email: req.Email})
+	if err != nil {
+		h.logger.Error(r.Context(), "request password reset", err)
+		utils.WriteResponse(w, http.StatusInternalServerError, httpErrors.ErrInternal)
+		return
+	}
+
+	// the response is the same whether the email is registered or not
+	w.WriteHeader(http.StatusOK)
+}
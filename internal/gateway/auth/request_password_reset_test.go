@@ -0,0 +1,90 @@
+package handlers
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	pb "kudago/internal/auth/api"
+	auth "kudago/internal/auth/grpc"
+	"kudago/internal/gateway/auth/mocks"
+	"kudago/internal/logger"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestAuthHandler_RequestPasswordReset(t *testing.T) {
+	t.Parallel()
+	logger, _ := logger.NewLogger()
+
+	tests := []struct {
+		name      string
+		req       *http.Request
+		w         *httptest.ResponseRecorder
+		setupFunc func(ctrl *gomock.Controller) *AuthHandlers
+		wantCode  int
+	}{
+		{
+			name: "Письмо отправлено",
+			req:  httptest.NewRequest(http.MethodPost, "/password/reset", bytes.NewBufferString(`{"email": "user1@mail.ru"}`)),
+			w:    httptest.NewRecorder(),
+			setupFunc: func(ctrl *gomock.Controller) *AuthHandlers {
+				serviceMock := mocks.NewMockAuthServiceClient(ctrl)
+
+				serviceMock.EXPECT().RequestPasswordReset(gomock.Any(), &pb.RequestPasswordResetRequest{Email: "user1@mail.ru"}).
+					Return(&pb.Empty{}, nil)
+
+				return &AuthHandlers{
+					AuthService: serviceMock,
+					logger:      logger,
+				}
+			},
+			wantCode: http.StatusOK,
+		},
+		{
+			name: "Невалидный email",
+			req:  httptest.NewRequest(http.MethodPost, "/password/reset", bytes.NewBufferString(`{"email": "user1"}`)),
+			w:    httptest.NewRecorder(),
+			setupFunc: func(ctrl *gomock.Controller) *AuthHandlers {
+				return &AuthHandlers{
+					AuthService: mocks.NewMockAuthServiceClient(ctrl),
+				}
+			},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name: "Ошибка сервиса",
+			req:  httptest.NewRequest(http.MethodPost, "/password/reset", bytes.NewBufferString(`{"email": "user1@mail.ru"}`)),
+			w:    httptest.NewRecorder(),
+			setupFunc: func(ctrl *gomock.Controller) *AuthHandlers {
+				serviceMock := mocks.NewMockAuthServiceClient(ctrl)
+
+				serviceMock.EXPECT().RequestPasswordReset(gomock.Any(), gomock.Any()).
+					Return(nil, status.Error(codes.Internal, auth.ErrInternal))
+
+				return &AuthHandlers{
+					AuthService: serviceMock,
+					logger:      logger,
+				}
+			},
+			wantCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			tt.setupFunc(ctrl).RequestPasswordReset(tt.w, tt.req)
+
+			assert.Equal(t, tt.wantCode, tt.w.Code)
+		})
+	}
+}
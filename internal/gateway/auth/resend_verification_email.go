@@ -0,0 +1,36 @@
+package handlers
+
+import (
+	"net/http"
+
+	pb "kudago/internal/auth/api"
+	httpErrors "kudago/internal/gateway/errors"
+	"kudago/internal/gateway/utils"
+
+	grpcCodes "google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+func (h *AuthHandlers) ResendVerificationEmail(w http.ResponseWriter, r *http.Request) {
+	session, ok := utils.GetSessionFromContext(r.Context())
+	if !ok {
+		utils.WriteResponse(w, http.StatusForbidden, httpErrors.ErrUnauthorized)
+		return
+	}
+
+	req := &pb.ResendVerificationEmailRequest{UserID: int32(session.UserID)}
+
+	_, err := h.AuthService.ResendVerificationEmail(r.Context(), req)
+	if err != nil {
+		st, ok := grpcStatus.FromError(err)
+		if ok && st.Code() == grpcCodes.NotFound {
+			utils.WriteResponse(w, http.StatusNotFound, httpErrors.ErrUserNotFound)
+			return
+		}
+		h.logger.Error(r.Context(), "resend verification email", err)
+		utils.WriteResponse(w, http.StatusInternalServerError, httpErrors.ErrInternal)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
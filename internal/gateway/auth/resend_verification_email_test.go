@@ -0,0 +1,114 @@
+package handlers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	pb "kudago/internal/auth/api"
+	auth "kudago/internal/auth/grpc"
+	"kudago/internal/gateway/auth/mocks"
+	"kudago/internal/gateway/utils"
+	"kudago/internal/logger"
+	"kudago/internal/models"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestAuthHandler_ResendVerificationEmail(t *testing.T) {
+	t.Parallel()
+	logger, _ := logger.NewLogger()
+
+	withSession := func() *http.Request {
+		req := httptest.NewRequest(http.MethodPost, "/verify-email/resend", nil)
+		session := models.Session{UserID: 1, Token: "valid_token"}
+		return req.WithContext(utils.SetSessionInContext(req.Context(), session))
+	}
+
+	tests := []struct {
+		name      string
+		req       *http.Request
+		w         *httptest.ResponseRecorder
+		setupFunc func(ctrl *gomock.Controller) *AuthHandlers
+		wantCode  int
+	}{
+		{
+			name: "Письмо отправлено",
+			req:  withSession(),
+			w:    httptest.NewRecorder(),
+			setupFunc: func(ctrl *gomock.Controller) *AuthHandlers {
+				serviceMock := mocks.NewMockAuthServiceClient(ctrl)
+
+				serviceMock.EXPECT().ResendVerificationEmail(gomock.Any(), &pb.ResendVerificationEmailRequest{UserID: 1}).
+					Return(&pb.Empty{}, nil)
+
+				return &AuthHandlers{
+					AuthService: serviceMock,
+					logger:      logger,
+				}
+			},
+			wantCode: http.StatusOK,
+		},
+		{
+			name: "Нет активной сессии",
+			req:  httptest.NewRequest(http.MethodPost, "/verify-email/resend", nil),
+			w:    httptest.NewRecorder(),
+			setupFunc: func(ctrl *gomock.Controller) *AuthHandlers {
+				return &AuthHandlers{
+					AuthService: mocks.NewMockAuthServiceClient(ctrl),
+				}
+			},
+			wantCode: http.StatusForbidden,
+		},
+		{
+			name: "Пользователь не найден",
+			req:  withSession(),
+			w:    httptest.NewRecorder(),
+			setupFunc: func(ctrl *gomock.Controller) *AuthHandlers {
+				serviceMock := mocks.NewMockAuthServiceClient(ctrl)
+
+				serviceMock.EXPECT().ResendVerificationEmail(gomock.Any(), gomock.Any()).
+					Return(nil, status.Error(codes.NotFound, auth.ErrUserNotFound))
+
+				return &AuthHandlers{
+					AuthService: serviceMock,
+					logger:      logger,
+				}
+			},
+			wantCode: http.StatusNotFound,
+		},
+		{
+			name: "Внутренняя ошибка",
+			req:  withSession(),
+			w:    httptest.NewRecorder(),
+			setupFunc: func(ctrl *gomock.Controller) *AuthHandlers {
+				serviceMock := mocks.NewMockAuthServiceClient(ctrl)
+
+				serviceMock.EXPECT().ResendVerificationEmail(gomock.Any(), gomock.Any()).
+					Return(nil, status.Error(codes.Internal, auth.ErrInternal))
+
+				return &AuthHandlers{
+					AuthService: serviceMock,
+					logger:      logger,
+				}
+			},
+			wantCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			tt.setupFunc(ctrl).ResendVerificationEmail(tt.w, tt.req)
+
+			assert.Equal(t, tt.wantCode, tt.w.Code)
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package handlers
+
+import (
+	"net/http"
+
+	pb "kudago/internal/auth/api"
+	httpErrors "kudago/internal/gateway/errors"
+	"kudago/internal/gateway/utils"
+)
+
+func (h *AuthHandlers) RevokeAllSessions(w http.ResponseWriter, r *http.Request) {
+	session, ok := utils.GetSessionFromContext(r.Context())
+	if !ok {
+		utils.WriteResponse(w, http.StatusForbidden, httpErrors.ErrUnauthorized)
+		return
+	}
+
+	req := &pb.RevokeAllSessionsRequest{
+		UserID:      int32(session.UserID),
+		ExceptToken: session.Token,
+	}
+
+	_, err := h.AuthService.RevokeAllSessions(r.Context(), req)
+	if err != nil {
+		h.logger.Error(r.Context(), "revoke all sessions", err)
+		utils.WriteResponse(w, http.StatusInternalServerError, httpErrors.ErrInternal)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
@@ -0,0 +1,40 @@
+package handlers
+
+import (
+	"net/http"
+
+	pb "kudago/internal/auth/api"
+	httpErrors "kudago/internal/gateway/errors"
+	"kudago/internal/gateway/utils"
+
+	"github.com/gorilla/mux"
+	grpcCodes "google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+func (h *AuthHandlers) RevokeSession(w http.ResponseWriter, r *http.Request) {
+	session, ok := utils.GetSessionFromContext(r.Context())
+	if !ok {
+		utils.WriteResponse(w, http.StatusForbidden, httpErrors.ErrUnauthorized)
+		return
+	}
+
+	req := &pb.RevokeSessionRequest{
+		UserID:    int32(session.UserID),
+		SessionID: mux.Vars(r)["id"],
+	}
+
+	_, err := h.AuthService.RevokeSession(r.Context(), req)
+	if err != nil {
+		st, ok := grpcStatus.FromError(err)
+		if ok && st.Code() == grpcCodes.NotFound {
+			utils.WriteResponse(w, http.StatusNotFound, httpErrors.ErrSessionNotFound)
+			return
+		}
+		h.logger.Error(r.Context(), "revoke session", err)
+		utils.WriteResponse(w, http.StatusInternalServerError, httpErrors.ErrInternal)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
@@ -0,0 +1,43 @@
+package handlers
+
+import (
+	"net/http"
+
+	pb "kudago/internal/auth/api"
+	httpErrors "kudago/internal/gateway/errors"
+	"kudago/internal/gateway/utils"
+
+	"github.com/asaskevich/govalidator"
+	easyjson "github.com/mailru/easyjson"
+	grpcCodes "google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+func (h *AuthHandlers) VerifyEmail(w http.ResponseWriter, r *http.Request) {
+	var req VerifyEmailRequest
+	err := easyjson.UnmarshalFromReader(r.Body, &req)
+	if err != nil {
+		utils.WriteResponse(w, http.StatusBadRequest, httpErrors.ErrInvalidData)
+		return
+	}
+
+	_, err = govalidator.ValidateStruct(&req)
+	if err != nil {
+		utils.ProcessValidationErrors(w, err)
+		return
+	}
+
+	_, err = h.AuthService.VerifyEmail(r.Context(), &pb.VerifyEmailRequest{Token: req.Token})
+	if err != nil {
+		st, ok := grpcStatus.FromError(err)
+		if ok && st.Code() == grpcCodes.InvalidArgument {
+			utils.WriteResponse(w, http.StatusBadRequest, httpErrors.ErrInvalidToken)
+			return
+		}
+		h.logger.Error(r.Context(), "verify email", err)
+		utils.WriteResponse(w, http.StatusInternalServerError, httpErrors.ErrInternal)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
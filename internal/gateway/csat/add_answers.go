@@ -0,0 +1,110 @@
+package csat
+
+import (
+	"net/http"
+	"strconv"
+
+	pb "kudago/internal/csat/api"
+	httpErrors "kudago/internal/gateway/errors"
+	"kudago/internal/gateway/utils"
+	"kudago/internal/models"
+
+	"github.com/gorilla/mux"
+	"github.com/mailru/easyjson"
+	grpcCodes "google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+// @Summary Ответы на опрос
+// @Description Сохраняет оценки пользователя от 1 до 10. На каждый вопрос можно ответить только один раз
+// @Tags csat
+// @Accept  json
+// @Produce  json
+// @Param id path int true "ID опроса"
+// @Param answers body AddAnswersRequest true "Ответы на вопросы"
+// @Success 201
+// @Failure 400 {object} httpErrors.HttpError "Invalid Answers"
+// @Failure 401 {object} httpErrors.HttpError "Unauthorized"
+// @Failure 404 {object} httpErrors.HttpError "Test Not Found"
+// @Failure 409 {object} httpErrors.HttpError "Already Answered"
+// @Failure 500 {object} httpErrors.HttpError "Internal Server Error"
+// @Router /csat/tests/{id}/answers [post]
+func (h *CSATHandlers) AddAnswers(w http.ResponseWriter, r *http.Request) {
+	session, ok := utils.GetSessionFromContext(r.Context())
+	if !ok {
+		utils.WriteResponse(w, http.StatusUnauthorized, httpErrors.ErrUnauthorized)
+		return
+	}
+
+	vars := mux.Vars(r)
+	id, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		utils.WriteResponse(w, http.StatusBadRequest, httpErrors.ErrInvalidID)
+		return
+	}
+
+	var req AddAnswersRequest
+	err = easyjson.UnmarshalFromReader(r.Body, &req)
+	if err != nil {
+		utils.WriteResponse(w, http.StatusBadRequest, httpErrors.ErrInvalidData)
+		return
+	}
+
+	if !validAnswers(req.Answers) {
+		utils.WriteResponse(w, http.StatusBadRequest, httpErrors.ErrInvalidAnswers)
+		return
+	}
+
+	answers := make([]*pb.Answer, 0, len(req.Answers))
+	for _, answer := range req.Answers {
+		answers = append(answers, &pb.Answer{
+			QuestionID: int32(answer.QuestionID),
+			Value:      int32(answer.Value),
+		})
+	}
+
+	_, err = h.CSATService.AddAnswers(r.Context(), &pb.AddAnswersRequest{
+		TestID:  int32(id),
+		UserID:  int32(session.UserID),
+		Answers: answers,
+	})
+	if err != nil {
+		st, ok := grpcStatus.FromError(err)
+		if ok {
+			switch st.Code() {
+			case grpcCodes.NotFound:
+				utils.WriteResponse(w, http.StatusNotFound, httpErrors.ErrTestNotFound)
+				return
+			case grpcCodes.AlreadyExists:
+				utils.WriteResponse(w, http.StatusConflict, httpErrors.ErrUserAlreadyDidTest)
+				return
+			case grpcCodes.InvalidArgument:
+				utils.WriteResponse(w, http.StatusBadRequest, httpErrors.ErrInvalidAnswers)
+				return
+			}
+		}
+
+		h.logger.Error(r.Context(), "add answers", err)
+		utils.WriteResponse(w, http.StatusInternalServerError, httpErrors.ErrInternal)
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+}
+
+// validAnswers checks that every question is answered once with a value in
+// the allowed range.
+func validAnswers(answers []AnswerRequest) bool {
+	if len(answers) == 0 {
+		return false
+	}
+
+	answered := make(map[int]bool, len(answers))
+	for _, answer := range answers {
+		if answer.Value < models.MinAnswer || answer.Value > models.MaxAnswer || answered[answer.QuestionID] {
+			return false
+		}
+		answered[answer.QuestionID] = true
+	}
+	return true
+}
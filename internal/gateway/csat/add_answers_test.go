@@ -0,0 +1,124 @@
+package csat
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	pb "kudago/internal/csat/api"
+	"kudago/internal/gateway/csat/mocks"
+	"kudago/internal/gateway/utils"
+	"kudago/internal/logger"
+	"kudago/internal/models"
+
+	"github.com/golang/mock/gomock"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCSATHandlers_AddAnswers(t *testing.T) {
+	t.Parallel()
+
+	logger, _ := logger.NewLogger()
+
+	newRequest := func(body string, withSession bool) *http.Request {
+		req := httptest.NewRequest(http.MethodPost, "/csat/tests/1/answers", bytes.NewBufferString(body))
+		req = mux.SetURLVars(req, map[string]string{"id": "1"})
+		if !withSession {
+			return req
+		}
+		session := models.Session{UserID: 3, Token: "valid_token"}
+		return req.WithContext(utils.SetSessionInContext(req.Context(), session))
+	}
+
+	validBody := `{"answers": [{"question_id": 1, "value": 8}, {"question_id": 2, "value": 10}]}`
+	addRequest := &pb.AddAnswersRequest{
+		TestID:  1,
+		UserID:  3,
+		Answers: []*pb.Answer{{QuestionID: 1, Value: 8}, {QuestionID: 2, Value: 10}},
+	}
+
+	tests := []struct {
+		name      string
+		req       *http.Request
+		setupFunc func(ctrl *gomock.Controller) *CSATHandlers
+		wantCode  int
+	}{
+		{
+			name: "Успешная отправка ответов",
+			req:  newRequest(validBody, true),
+			setupFunc: func(ctrl *gomock.Controller) *CSATHandlers {
+				serviceMock := mocks.NewMockCSATServiceClient(ctrl)
+				serviceMock.EXPECT().AddAnswers(gomock.Any(), addRequest).Return(&pb.Empty{}, nil)
+
+				return &CSATHandlers{CSATService: serviceMock, logger: logger}
+			},
+			wantCode: http.StatusCreated,
+		},
+		{
+			name: "Нет сессии",
+			req:  newRequest(validBody, false),
+			setupFunc: func(ctrl *gomock.Controller) *CSATHandlers {
+				return &CSATHandlers{CSATService: mocks.NewMockCSATServiceClient(ctrl), logger: logger}
+			},
+			wantCode: http.StatusUnauthorized,
+		},
+		{
+			name: "Оценка вне диапазона",
+			req:  newRequest(`{"answers": [{"question_id": 1, "value": 0}]}`, true),
+			setupFunc: func(ctrl *gomock.Controller) *CSATHandlers {
+				return &CSATHandlers{CSATService: mocks.NewMockCSATServiceClient(ctrl), logger: logger}
+			},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name: "Два ответа на один вопрос",
+			req:  newRequest(`{"answers": [{"question_id": 1, "value": 5}, {"question_id": 1, "value": 7}]}`, true),
+			setupFunc: func(ctrl *gomock.Controller) *CSATHandlers {
+				return &CSATHandlers{CSATService: mocks.NewMockCSATServiceClient(ctrl), logger: logger}
+			},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name: "Пользователь уже отвечал",
+			req:  newRequest(validBody, true),
+			setupFunc: func(ctrl *gomock.Controller) *CSATHandlers {
+				serviceMock := mocks.NewMockCSATServiceClient(ctrl)
+				serviceMock.EXPECT().AddAnswers(gomock.Any(), addRequest).
+					Return(nil, status.Error(codes.AlreadyExists, "user already answered the question"))
+
+				return &CSATHandlers{CSATService: serviceMock, logger: logger}
+			},
+			wantCode: http.StatusConflict,
+		},
+		{
+			name: "Опрос не найден",
+			req:  newRequest(validBody, true),
+			setupFunc: func(ctrl *gomock.Controller) *CSATHandlers {
+				serviceMock := mocks.NewMockCSATServiceClient(ctrl)
+				serviceMock.EXPECT().AddAnswers(gomock.Any(), addRequest).
+					Return(nil, status.Error(codes.NotFound, "test not found"))
+
+				return &CSATHandlers{CSATService: serviceMock, logger: logger}
+			},
+			wantCode: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			recorder := httptest.NewRecorder()
+			tt.setupFunc(ctrl).AddAnswers(recorder, tt.req)
+
+			assert.Equal(t, tt.wantCode, recorder.Code)
+		})
+	}
+}
@@ -0,0 +1,115 @@
+//go:generate mockgen -source=../../csat/api/csat_grpc.pb.go -destination=mocks/csat.go -package=mocks
+
+//go:generate easyjson csat.go
+package csat
+
+import (
+	pb "kudago/internal/csat/api"
+	"kudago/internal/logger"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+type CSATHandlers struct {
+	CSATService pb.CSATServiceClient
+	logger      *logger.Logger
+}
+
+func NewHandlers(csatServiceAddr string, logger *logger.Logger) (*CSATHandlers, error) {
+	csatConn, err := grpc.NewClient(csatServiceAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, err
+	}
+
+	return &CSATHandlers{
+		CSATService: pb.NewCSATServiceClient(csatConn),
+		logger:      logger,
+	}, nil
+}
+
+//easyjson:json
+type QuestionResponse struct {
+	ID   int    `json:"id"`
+	Text string `json:"text"`
+}
+
+//easyjson:json
+type TestResponse struct {
+	ID        int                `json:"id"`
+	Title     string             `json:"title"`
+	Questions []QuestionResponse `json:"questions"`
+}
+
+//easyjson:json
+type TestsResponse struct {
+	Tests []TestResponse `json:"tests"`
+}
+
+//easyjson:json
+type AnswerRequest struct {
+	QuestionID int `json:"question_id"`
+	Value      int `json:"value"`
+}
+
+//easyjson:json
+type AddAnswersRequest struct {
+	Answers []AnswerRequest `json:"answers"`
+}
+
+//easyjson:json
+type QuestionStatisticsResponse struct {
+	QuestionID   int     `json:"question_id"`
+	Question     string  `json:"question"`
+	Average      float64 `json:"average"`
+	Total        int     `json:"total"`
+	Distribution []int   `json:"distribution"`
+}
+
+//easyjson:json
+type StatisticsResponse struct {
+	TestID    int                          `json:"test_id"`
+	Questions []QuestionStatisticsResponse `json:"questions"`
+}
+
+func testsToTestsResponse(tests *pb.Tests) TestsResponse {
+	resp := TestsResponse{
+		Tests: make([]TestResponse, 0, len(tests.Tests)),
+	}
+	for _, test := range tests.Tests {
+		testResp := TestResponse{
+			ID:        int(test.ID),
+			Title:     test.Title,
+			Questions: make([]QuestionResponse, 0, len(test.Questions)),
+		}
+		for _, question := range test.Questions {
+			testResp.Questions = append(testResp.Questions, QuestionResponse{
+				ID:   int(question.ID),
+				Text: question.Text,
+			})
+		}
+		resp.Tests = append(resp.Tests, testResp)
+	}
+	return resp
+}
+
+func statisticsToStatisticsResponse(stats *pb.Statistics) StatisticsResponse {
+	resp := StatisticsResponse{
+		TestID:    int(stats.TestID),
+		Questions: make([]QuestionStatisticsResponse, 0, len(stats.Questions)),
+	}
+	for _, question := range stats.Questions {
+		distribution := make([]int, 0, len(question.Distribution))
+		for _, count := range question.Distribution {
+			distribution = append(distribution, int(count))
+		}
+		resp.Questions = append(resp.Questions, QuestionStatisticsResponse{
+			QuestionID:   int(question.QuestionID),
+			Question:     question.Question,
+			Average:      question.Average,
+			Total:        int(question.Total),
+			Distribution: distribution,
+		})
+	}
+	return resp
+}
@@ -0,0 +1,704 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package csat
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjsonD7ffe469DecodeKudagoInternalGatewayCsat(in *jlexer.Lexer, out *TestsResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "tests":
+			if in.IsNull() {
+				in.Skip()
+				out.Tests = nil
+			} else {
+				in.Delim('[')
+				if out.Tests == nil {
+					if !in.IsDelim(']') {
+						out.Tests = make([]TestResponse, 0, 1)
+					} else {
+						out.Tests = []TestResponse{}
+					}
+				} else {
+					out.Tests = (out.Tests)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 TestResponse
+					(v1).UnmarshalEasyJSON(in)
+					out.Tests = append(out.Tests, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD7ffe469EncodeKudagoInternalGatewayCsat(out *jwriter.Writer, in TestsResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"tests\":"
+		out.RawString(prefix[1:])
+		if in.Tests == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v2, v3 := range in.Tests {
+				if v2 > 0 {
+					out.RawByte(',')
+				}
+				(v3).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v TestsResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD7ffe469EncodeKudagoInternalGatewayCsat(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v TestsResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD7ffe469EncodeKudagoInternalGatewayCsat(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *TestsResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD7ffe469DecodeKudagoInternalGatewayCsat(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *TestsResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD7ffe469DecodeKudagoInternalGatewayCsat(l, v)
+}
+func easyjsonD7ffe469DecodeKudagoInternalGatewayCsat1(in *jlexer.Lexer, out *TestResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = int(in.Int())
+		case "title":
+			out.Title = string(in.String())
+		case "questions":
+			if in.IsNull() {
+				in.Skip()
+				out.Questions = nil
+			} else {
+				in.Delim('[')
+				if out.Questions == nil {
+					if !in.IsDelim(']') {
+						out.Questions = make([]QuestionResponse, 0, 2)
+					} else {
+						out.Questions = []QuestionResponse{}
+					}
+				} else {
+					out.Questions = (out.Questions)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v4 QuestionResponse
+					(v4).UnmarshalEasyJSON(in)
+					out.Questions = append(out.Questions, v4)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD7ffe469EncodeKudagoInternalGatewayCsat1(out *jwriter.Writer, in TestResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"title\":"
+		out.RawString(prefix)
+		out.String(string(in.Title))
+	}
+	{
+		const prefix string = ",\"questions\":"
+		out.RawString(prefix)
+		if in.Questions == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v5, v6 := range in.Questions {
+				if v5 > 0 {
+					out.RawByte(',')
+				}
+				(v6).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v TestResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD7ffe469EncodeKudagoInternalGatewayCsat1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v TestResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD7ffe469EncodeKudagoInternalGatewayCsat1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *TestResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD7ffe469DecodeKudagoInternalGatewayCsat1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *TestResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD7ffe469DecodeKudagoInternalGatewayCsat1(l, v)
+}
+func easyjsonD7ffe469DecodeKudagoInternalGatewayCsat2(in *jlexer.Lexer, out *StatisticsResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "test_id":
+			out.TestID = int(in.Int())
+		case "questions":
+			if in.IsNull() {
+				in.Skip()
+				out.Questions = nil
+			} else {
+				in.Delim('[')
+				if out.Questions == nil {
+					if !in.IsDelim(']') {
+						out.Questions = make([]QuestionStatisticsResponse, 0, 1)
+					} else {
+						out.Questions = []QuestionStatisticsResponse{}
+					}
+				} else {
+					out.Questions = (out.Questions)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v7 QuestionStatisticsResponse
+					(v7).UnmarshalEasyJSON(in)
+					out.Questions = append(out.Questions, v7)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD7ffe469EncodeKudagoInternalGatewayCsat2(out *jwriter.Writer, in StatisticsResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"test_id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.TestID))
+	}
+	{
+		const prefix string = ",\"questions\":"
+		out.RawString(prefix)
+		if in.Questions == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v8, v9 := range in.Questions {
+				if v8 > 0 {
+					out.RawByte(',')
+				}
+				(v9).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v StatisticsResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD7ffe469EncodeKudagoInternalGatewayCsat2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v StatisticsResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD7ffe469EncodeKudagoInternalGatewayCsat2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *StatisticsResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD7ffe469DecodeKudagoInternalGatewayCsat2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *StatisticsResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD7ffe469DecodeKudagoInternalGatewayCsat2(l, v)
+}
+func easyjsonD7ffe469DecodeKudagoInternalGatewayCsat3(in *jlexer.Lexer, out *QuestionStatisticsResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "question_id":
+			out.QuestionID = int(in.Int())
+		case "question":
+			out.Question = string(in.String())
+		case "average":
+			out.Average = float64(in.Float64())
+		case "total":
+			out.Total = int(in.Int())
+		case "distribution":
+			if in.IsNull() {
+				in.Skip()
+				out.Distribution = nil
+			} else {
+				in.Delim('[')
+				if out.Distribution == nil {
+					if !in.IsDelim(']') {
+						out.Distribution = make([]int, 0, 8)
+					} else {
+						out.Distribution = []int{}
+					}
+				} else {
+					out.Distribution = (out.Distribution)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v10 int
+					v10 = int(in.Int())
+					out.Distribution = append(out.Distribution, v10)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD7ffe469EncodeKudagoInternalGatewayCsat3(out *jwriter.Writer, in QuestionStatisticsResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"question_id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.QuestionID))
+	}
+	{
+		const prefix string = ",\"question\":"
+		out.RawString(prefix)
+		out.String(string(in.Question))
+	}
+	{
+		const prefix string = ",\"average\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Average))
+	}
+	{
+		const prefix string = ",\"total\":"
+		out.RawString(prefix)
+		out.Int(int(in.Total))
+	}
+	{
+		const prefix string = ",\"distribution\":"
+		out.RawString(prefix)
+		if in.Distribution == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v11, v12 := range in.Distribution {
+				if v11 > 0 {
+					out.RawByte(',')
+				}
+				out.Int(int(v12))
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v QuestionStatisticsResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD7ffe469EncodeKudagoInternalGatewayCsat3(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v QuestionStatisticsResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD7ffe469EncodeKudagoInternalGatewayCsat3(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *QuestionStatisticsResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD7ffe469DecodeKudagoInternalGatewayCsat3(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *QuestionStatisticsResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD7ffe469DecodeKudagoInternalGatewayCsat3(l, v)
+}
+func easyjsonD7ffe469DecodeKudagoInternalGatewayCsat4(in *jlexer.Lexer, out *QuestionResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = int(in.Int())
+		case "text":
+			out.Text = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD7ffe469EncodeKudagoInternalGatewayCsat4(out *jwriter.Writer, in QuestionResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"text\":"
+		out.RawString(prefix)
+		out.String(string(in.Text))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v QuestionResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD7ffe469EncodeKudagoInternalGatewayCsat4(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v QuestionResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD7ffe469EncodeKudagoInternalGatewayCsat4(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *QuestionResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD7ffe469DecodeKudagoInternalGatewayCsat4(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *QuestionResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD7ffe469DecodeKudagoInternalGatewayCsat4(l, v)
+}
+func easyjsonD7ffe469DecodeKudagoInternalGatewayCsat5(in *jlexer.Lexer, out *AnswerRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "question_id":
+			out.QuestionID = int(in.Int())
+		case "value":
+			out.Value = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD7ffe469EncodeKudagoInternalGatewayCsat5(out *jwriter.Writer, in AnswerRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"question_id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.QuestionID))
+	}
+	{
+		const prefix string = ",\"value\":"
+		out.RawString(prefix)
+		out.Int(int(in.Value))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v AnswerRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD7ffe469EncodeKudagoInternalGatewayCsat5(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v AnswerRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD7ffe469EncodeKudagoInternalGatewayCsat5(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *AnswerRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD7ffe469DecodeKudagoInternalGatewayCsat5(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *AnswerRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD7ffe469DecodeKudagoInternalGatewayCsat5(l, v)
+}
+func easyjsonD7ffe469DecodeKudagoInternalGatewayCsat6(in *jlexer.Lexer, out *AddAnswersRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "answers":
+			if in.IsNull() {
+				in.Skip()
+				out.Answers = nil
+			} else {
+				in.Delim('[')
+				if out.Answers == nil {
+					if !in.IsDelim(']') {
+						out.Answers = make([]AnswerRequest, 0, 4)
+					} else {
+						out.Answers = []AnswerRequest{}
+					}
+				} else {
+					out.Answers = (out.Answers)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v13 AnswerRequest
+					(v13).UnmarshalEasyJSON(in)
+					out.Answers = append(out.Answers, v13)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD7ffe469EncodeKudagoInternalGatewayCsat6(out *jwriter.Writer, in AddAnswersRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"answers\":"
+		out.RawString(prefix[1:])
+		if in.Answers == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v14, v15 := range in.Answers {
+				if v14 > 0 {
+					out.RawByte(',')
+				}
+				(v15).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v AddAnswersRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD7ffe469EncodeKudagoInternalGatewayCsat6(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v AddAnswersRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD7ffe469EncodeKudagoInternalGatewayCsat6(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *AddAnswersRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD7ffe469DecodeKudagoInternalGatewayCsat6(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *AddAnswersRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD7ffe469DecodeKudagoInternalGatewayCsat6(l, v)
+}
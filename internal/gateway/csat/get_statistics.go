@@ -0,0 +1,54 @@
+package csat
+
+import (
+	"net/http"
+	"strconv"
+
+	pb "kudago/internal/csat/api"
+	httpErrors "kudago/internal/gateway/errors"
+	"kudago/internal/gateway/utils"
+
+	"github.com/gorilla/mux"
+	grpcCodes "google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+// @Summary Статистика опроса
+// @Description Возвращает среднюю оценку и распределение оценок от 1 до 10 по каждому вопросу
+// @Tags csat
+// @Produce  json
+// @Param id path int true "ID опроса"
+// @Success 200 {object} StatisticsResponse
+// @Failure 401 {object} httpErrors.HttpError "Unauthorized"
+// @Failure 404 {object} httpErrors.HttpError "Test Not Found"
+// @Failure 500 {object} httpErrors.HttpError "Internal Server Error"
+// @Router /csat/tests/{id}/statistics [get]
+func (h *CSATHandlers) GetStatistics(w http.ResponseWriter, r *http.Request) {
+	_, ok := utils.GetSessionFromContext(r.Context())
+	if !ok {
+		utils.WriteResponse(w, http.StatusUnauthorized, httpErrors.ErrUnauthorized)
+		return
+	}
+
+	vars := mux.Vars(r)
+	id, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		utils.WriteResponse(w, http.StatusBadRequest, httpErrors.ErrInvalidID)
+		return
+	}
+
+	stats, err := h.CSATService.GetStatistics(r.Context(), &pb.GetStatisticsRequest{TestID: int32(id)})
+	if err != nil {
+		st, ok := grpcStatus.FromError(err)
+		if ok && st.Code() == grpcCodes.NotFound {
+			utils.WriteResponse(w, http.StatusNotFound, httpErrors.ErrTestNotFound)
+			return
+		}
+
+		h.logger.Error(r.Context(), "get statistics", err)
+		utils.WriteResponse(w, http.StatusInternalServerError, httpErrors.ErrInternal)
+		return
+	}
+
+	utils.WriteResponse(w, http.StatusOK, statisticsToStatisticsResponse(stats))
+}
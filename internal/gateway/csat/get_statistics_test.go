@@ -0,0 +1,89 @@
+package csat
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	pb "kudago/internal/csat/api"
+	"kudago/internal/gateway/csat/mocks"
+	"kudago/internal/gateway/utils"
+	"kudago/internal/logger"
+	"kudago/internal/models"
+
+	"github.com/golang/mock/gomock"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCSATHandlers_GetStatistics(t *testing.T) {
+	t.Parallel()
+
+	logger, _ := logger.NewLogger()
+
+	newRequest := func() *http.Request {
+		req := httptest.NewRequest(http.MethodGet, "/csat/tests/1/statistics", nil)
+		req = mux.SetURLVars(req, map[string]string{"id": "1"})
+		session := models.Session{UserID: 3, Token: "valid_token"}
+		return req.WithContext(utils.SetSessionInContext(req.Context(), session))
+	}
+
+	tests := []struct {
+		name      string
+		setupFunc func(ctrl *gomock.Controller) *CSATHandlers
+		wantCode  int
+		wantBody  string
+	}{
+		{
+			name: "Успешное получение статистики",
+			setupFunc: func(ctrl *gomock.Controller) *CSATHandlers {
+				serviceMock := mocks.NewMockCSATServiceClient(ctrl)
+				serviceMock.EXPECT().GetStatistics(gomock.Any(), &pb.GetStatisticsRequest{TestID: 1}).
+					Return(&pb.Statistics{
+						TestID: 1,
+						Questions: []*pb.QuestionStatistics{{
+							QuestionID:   1,
+							Question:     "Q",
+							Average:      9,
+							Total:        2,
+							Distribution: []int32{0, 0, 0, 0, 0, 0, 0, 1, 0, 1},
+						}},
+					}, nil)
+
+				return &CSATHandlers{CSATService: serviceMock, logger: logger}
+			},
+			wantCode: http.StatusOK,
+			wantBody: `{"test_id":1,"questions":[{"question_id":1,"question":"Q","average":9,"total":2,"distribution":[0,0,0,0,0,0,0,1,0,1]}]}`,
+		},
+		{
+			name: "Опрос не найден",
+			setupFunc: func(ctrl *gomock.Controller) *CSATHandlers {
+				serviceMock := mocks.NewMockCSATServiceClient(ctrl)
+				serviceMock.EXPECT().GetStatistics(gomock.Any(), &pb.GetStatisticsRequest{TestID: 1}).
+					Return(nil, status.Error(codes.NotFound, "test not found"))
+
+				return &CSATHandlers{CSATService: serviceMock, logger: logger}
+			},
+			wantCode: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			recorder := httptest.NewRecorder()
+			tt.setupFunc(ctrl).GetStatistics(recorder, newRequest())
+
+			assert.Equal(t, tt.wantCode, recorder.Code)
+			if tt.wantBody != "" {
+				assert.JSONEq(t, tt.wantBody, recorder.Body.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,34 @@
+package csat
+
+import (
+	"net/http"
+
+	pb "kudago/internal/csat/api"
+	httpErrors "kudago/internal/gateway/errors"
+	"kudago/internal/gateway/utils"
+)
+
+// @Summary Получение опросов
+// @Description Возвращает активные CSAT-опросы с вопросами
+// @Tags csat
+// @Produce  json
+// @Success 200 {object} TestsResponse
+// @Failure 401 {object} httpErrors.HttpError "Unauthorized"
+// @Failure 500 {object} httpErrors.HttpError "Internal Server Error"
+// @Router /csat/tests [get]
+func (h *CSATHandlers) GetTests(w http.ResponseWriter, r *http.Request) {
+	_, ok := utils.GetSessionFromContext(r.Context())
+	if !ok {
+		utils.WriteResponse(w, http.StatusUnauthorized, httpErrors.ErrUnauthorized)
+		return
+	}
+
+	tests, err := h.CSATService.GetTests(r.Context(), &pb.Empty{})
+	if err != nil {
+		h.logger.Error(r.Context(), "get tests", err)
+		utils.WriteResponse(w, http.StatusInternalServerError, httpErrors.ErrInternal)
+		return
+	}
+
+	utils.WriteResponse(w, http.StatusOK, testsToTestsResponse(tests))
+}
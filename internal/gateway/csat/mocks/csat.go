@@ -0,0 +1,212 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ../../csat/api/csat_grpc.pb.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	csat "kudago/internal/csat/api"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	grpc "google.golang.org/grpc"
+)
+
+// MockCSATServiceClient is a mock of CSATServiceClient interface.
+type MockCSATServiceClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockCSATServiceClientMockRecorder
+}
+
+// MockCSATServiceClientMockRecorder is the mock recorder for MockCSATServiceClient.
+type MockCSATServiceClientMockRecorder struct {
+	mock *MockCSATServiceClient
+}
+
+// NewMockCSATServiceClient creates a new mock instance.
+func NewMockCSATServiceClient(ctrl *gomock.Controller) *MockCSATServiceClient {
+	mock := &MockCSATServiceClient{ctrl: ctrl}
+	mock.recorder = &MockCSATServiceClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCSATServiceClient) EXPECT() *MockCSATServiceClientMockRecorder {
+	return m.recorder
+}
+
+// AddAnswers mocks base method.
+func (m *MockCSATServiceClient) AddAnswers(ctx context.Context, in *csat.AddAnswersRequest, opts ...grpc.CallOption) (*csat.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddAnswers", varargs...)
+	ret0, _ := ret[0].(*csat.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddAnswers indicates an expected call of AddAnswers.
+func (mr *MockCSATServiceClientMockRecorder) AddAnswers(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddAnswers", reflect.TypeOf((*MockCSATServiceClient)(nil).AddAnswers), varargs...)
+}
+
+// GetStatistics mocks base method.
+func (m *MockCSATServiceClient) GetStatistics(ctx context.Context, in *csat.GetStatisticsRequest, opts ...grpc.CallOption) (*csat.Statistics, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetStatistics", varargs...)
+	ret0, _ := ret[0].(*csat.Statistics)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStatistics indicates an expected call of GetStatistics.
+func (mr *MockCSATServiceClientMockRecorder) GetStatistics(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStatistics", reflect.TypeOf((*MockCSATServiceClient)(nil).GetStatistics), varargs...)
+}
+
+// GetTests mocks base method.
+func (m *MockCSATServiceClient) GetTests(ctx context.Context, in *csat.Empty, opts ...grpc.CallOption) (*csat.Tests, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetTests", varargs...)
+	ret0, _ := ret[0].(*csat.Tests)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTests indicates an expected call of GetTests.
+func (mr *MockCSATServiceClientMockRecorder) GetTests(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTests", reflect.TypeOf((*MockCSATServiceClient)(nil).GetTests), varargs...)
+}
+
+// MockCSATServiceServer is a mock of CSATServiceServer interface.
+type MockCSATServiceServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockCSATServiceServerMockRecorder
+}
+
+// MockCSATServiceServerMockRecorder is the mock recorder for MockCSATServiceServer.
+type MockCSATServiceServerMockRecorder struct {
+	mock *MockCSATServiceServer
+}
+
+// NewMockCSATServiceServer creates a new mock instance.
+func NewMockCSATServiceServer(ctrl *gomock.Controller) *MockCSATServiceServer {
+	mock := &MockCSATServiceServer{ctrl: ctrl}
+	mock.recorder = &MockCSATServiceServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCSATServiceServer) EXPECT() *MockCSATServiceServerMockRecorder {
+	return m.recorder
+}
+
+// AddAnswers mocks base method.
+func (m *MockCSATServiceServer) AddAnswers(arg0 context.Context, arg1 *csat.AddAnswersRequest) (*csat.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddAnswers", arg0, arg1)
+	ret0, _ := ret[0].(*csat.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddAnswers indicates an expected call of AddAnswers.
+func (mr *MockCSATServiceServerMockRecorder) AddAnswers(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddAnswers", reflect.TypeOf((*MockCSATServiceServer)(nil).AddAnswers), arg0, arg1)
+}
+
+// GetStatistics mocks base method.
+func (m *MockCSATServiceServer) GetStatistics(arg0 context.Context, arg1 *csat.GetStatisticsRequest) (*csat.Statistics, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStatistics", arg0, arg1)
+	ret0, _ := ret[0].(*csat.Statistics)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStatistics indicates an expected call of GetStatistics.
+func (mr *MockCSATServiceServerMockRecorder) GetStatistics(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStatistics", reflect.TypeOf((*MockCSATServiceServer)(nil).GetStatistics), arg0, arg1)
+}
+
+// GetTests mocks base method.
+func (m *MockCSATServiceServer) GetTests(arg0 context.Context, arg1 *csat.Empty) (*csat.Tests, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTests", arg0, arg1)
+	ret0, _ := ret[0].(*csat.Tests)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTests indicates an expected call of GetTests.
+func (mr *MockCSATServiceServerMockRecorder) GetTests(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTests", reflect.TypeOf((*MockCSATServiceServer)(nil).GetTests), arg0, arg1)
+}
+
+// mustEmbedUnimplementedCSATServiceServer mocks base method.
+func (m *MockCSATServiceServer) mustEmbedUnimplementedCSATServiceServer() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "mustEmbedUnimplementedCSATServiceServer")
+}
+
+// mustEmbedUnimplementedCSATServiceServer indicates an expected call of mustEmbedUnimplementedCSATServiceServer.
+func (mr *MockCSATServiceServerMockRecorder) mustEmbedUnimplementedCSATServiceServer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mustEmbedUnimplementedCSATServiceServer", reflect.TypeOf((*MockCSATServiceServer)(nil).mustEmbedUnimplementedCSATServiceServer))
+}
+
+// MockUnsafeCSATServiceServer is a mock of UnsafeCSATServiceServer interface.
+type MockUnsafeCSATServiceServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockUnsafeCSATServiceServerMockRecorder
+}
+
+// MockUnsafeCSATServiceServerMockRecorder is the mock recorder for MockUnsafeCSATServiceServer.
+type MockUnsafeCSATServiceServerMockRecorder struct {
+	mock *MockUnsafeCSATServiceServer
+}
+
+// NewMockUnsafeCSATServiceServer creates a new mock instance.
+func NewMockUnsafeCSATServiceServer(ctrl *gomock.Controller) *MockUnsafeCSATServiceServer {
+	mock := &MockUnsafeCSATServiceServer{ctrl: ctrl}
+	mock.recorder = &MockUnsafeCSATServiceServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUnsafeCSATServiceServer) EXPECT() *MockUnsafeCSATServiceServerMockRecorder {
+	return m.recorder
+}
+
+// mustEmbedUnimplementedCSATServiceServer mocks base method.
+func (m *MockUnsafeCSATServiceServer) mustEmbedUnimplementedCSATServiceServer() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "mustEmbedUnimplementedCSATServiceServer")
+}
+
+// mustEmbedUnimplementedCSATServiceServer indicates an expected call of mustEmbedUnimplementedCSATServiceServer.
+func (mr *MockUnsafeCSATServiceServerMockRecorder) mustEmbedUnimplementedCSATServiceServer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mustEmbedUnimplementedCSATServiceServer", reflect.TypeOf((*MockUnsafeCSATServiceServer)(nil).mustEmbedUnimplementedCSATServiceServer))
+}
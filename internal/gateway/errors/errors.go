@@ -28,6 +28,16 @@ var (
 		Code:    "already_logged",
 	}
 
+	ErrTooManyLoginAttempts = &HttpError{
+		Message: "Too many login attempts, try again later",
+		Code:    "too_many_attempts",
+	}
+
+	ErrEmailNotVerified = &HttpError{
+		Message: "Email is not verified",
+		Code:    "email_not_verified",
+	}
+
 	ErrUnauthorized = &HttpError{
 		Message: "Unauthorized",
 		Code:    "forbidden",
@@ -38,6 +48,16 @@ var (
 		Code:    "wrong_credentials",
 	}
 
+	ErrWrongPassword = &HttpError{
+		Message: "Wrong password",
+		Code:    "wrong_password",
+	}
+
+	ErrInvalidToken = &HttpError{
+		Message: "Invalid or expired token",
+		Code:    "invalid_token",
+	}
+
 	ErrInvalidData = &HttpError{
 		Message: "Can't decode JSON",
 		Code:    "invalid_data",
@@ -48,6 +68,46 @@ var (
 		Code:    "invalid_time",
 	}
 
+	ErrInvalidLocation = &HttpError{
+		Message: "Latitude, longitude and radius must be valid and set together",
+		Code:    "invalid_location",
+	}
+
+	ErrInvalidCursor = &HttpError{
+		Message: "Invalid or malformed cursor",
+		Code:    "invalid_cursor",
+	}
+
+	ErrInvalidRecurrence = &HttpError{
+		Message: "Invalid recurrence rule or exceptions",
+		Code:    "invalid_recurrence",
+	}
+
+	ErrInvalidStatus = &HttpError{
+		Message: "Event can be created as a draft or published, publish time is for drafts only",
+		Code:    "invalid_status",
+	}
+
+	ErrNotDraft = &HttpError{
+		Message: "Event is already published",
+		Code:    "not_draft",
+	}
+
+	ErrNotPublished = &HttpError{
+		Message: "Only published events can be cancelled or rescheduled",
+		Code:    "not_published",
+	}
+
+	ErrEventClosed = &HttpError{
+		Message: "Event is cancelled or not published",
+		Code:    "event_closed",
+	}
+
+	ErrInvalidCalendarToken = &HttpError{
+		Message: "Calendar link is invalid or was revoked",
+		Code:    "invalid_token",
+	}
+
 	ErrInvalidCategory = &HttpError{
 		Message: "Wrong or empty category",
 		Code:    "invalid_category",
@@ -63,6 +123,26 @@ var (
 		Code:    "invalid_image",
 	}
 
+	ErrImageTooLarge = &HttpError{
+		Message: "Image is larger than 10Mb",
+		Code:    "image_too_large",
+	}
+
+	ErrImageNotFound = &HttpError{
+		Message: "Image not found",
+		Code:    "image_not_found",
+	}
+
+	ErrTooManyImages = &HttpError{
+		Message: "Event gallery is full",
+		Code:    "too_many_images",
+	}
+
+	ErrInvalidImageOrder = &HttpError{
+		Message: "Order must list every image of the event once",
+		Code:    "invalid_image_order",
+	}
+
 	ErrInvalidCapacity = &HttpError{
 		Message: "Wrong or empty capacity",
 		Code:    "invalid_capacity",
@@ -88,6 +168,11 @@ var (
 		Code:    "internal_Error",
 	}
 
+	ErrSuggestTimeout = &HttpError{
+		Message: "Suggestions took too long, try again",
+		Code:    "timeout",
+	}
+
 	ErrEventNotFound = &HttpError{
 		Message: "Event not found",
 		Code:    "not_found",
@@ -98,6 +183,46 @@ var (
 		Code:    "not_found",
 	}
 
+	ErrSessionNotFound = &HttpError{
+		Message: "Session not found",
+		Code:    "not_found",
+	}
+
+	ErrTicketTypeNotFound = &HttpError{
+		Message: "Ticket type not found",
+		Code:    "not_found",
+	}
+
+	ErrTicketNotFound = &HttpError{
+		Message: "Ticket reservation not found or expired",
+		Code:    "not_found",
+	}
+
+	ErrTicketTypeAlreadyExists = &HttpError{
+		Message: "Ticket type with this name already exists",
+		Code:    "already_exists",
+	}
+
+	ErrNoTicketsLeft = &HttpError{
+		Message: "Not enough tickets left",
+		Code:    "sold_out",
+	}
+
+	ErrNotAttending = &HttpError{
+		Message: "User is not attending this event",
+		Code:    "not_found",
+	}
+
+	ErrEventNotStarted = &HttpError{
+		Message: "Check-in is available once the event starts",
+		Code:    "event_not_started",
+	}
+
+	ErrInvalidAnswers = &HttpError{
+		Message: "Each question must be answered once with a value from 1 to 10",
+		Code:    "invalid_answers",
+	}
+
 	ErrTestNotFound = &HttpError{
 		Message: "Test not found",
 		Code:    "not_found",
@@ -8,6 +8,7 @@ import (
 	pbEvent "kudago/internal/event/api"
 	httpErrors "kudago/internal/gateway/errors"
 	"kudago/internal/gateway/utils"
+	"kudago/internal/models"
 	pbNtf "kudago/internal/notification/api"
 
 	"github.com/asaskevich/govalidator"
@@ -22,9 +23,11 @@ import (
 // @Accept  json
 // @Produce  json
 // @Param json body NewEventRequest true "Данные для создания события"
+// @Param image formData file false "Изображение события, передаётся после части json"
 // @Success 201 {object} NewEventResponse "Событие успешно создано"
 // @Failure 400 {object} httpErrors.HttpError "Неверные данные"
 // @Failure 401 {object} httpErrors.HttpError "Неавторизован"
+// @Failure 403 {object} httpErrors.HttpError "Почта не подтверждена"
 // @Failure 500 {object} httpErrors.HttpError "Внутренняя ошибка сервера"
 // @Router /events [post]
 func (h EventHandler) AddEvent(w http.ResponseWriter, r *http.Request) {
@@ -34,7 +37,12 @@ func (h EventHandler) AddEvent(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	req, media, reqErr := parseEventData(r)
+	if !session.Verified {
+		utils.WriteResponse(w, http.StatusForbidden, httpErrors.ErrEmailNotVerified)
+		return
+	}
+
+	req, media, reqErr := parseEventData(w, r)
 	if reqErr != nil {
 		utils.WriteResponse(w, http.StatusBadRequest, reqErr)
 		return
@@ -82,9 +90,12 @@ func (h EventHandler) AddEvent(w http.ResponseWriter, r *http.Request) {
 		Event: eventResp,
 	}
 
-	err = h.sendCreatedNotifications(r.Context(), int(event.ID), session.UserID)
-	if err != nil {
-		h.logger.Error(r.Context(), "send create notifications", err)
+	// Subscribers of a draft are notified once it is published.
+	if event.Status == models.EventStatusPublished {
+		err = h.sendCreatedNotifications(r.Context(), int(event.ID), session.UserID)
+		if err != nil {
+			h.logger.Error(r.Context(), "send create notifications", err)
+		}
 	}
 
 	utils.WriteResponse(w, http.StatusOK, resp)
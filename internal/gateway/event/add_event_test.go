@@ -4,7 +4,9 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
+	httpErrors "kudago/internal/gateway/errors"
 	"kudago/internal/gateway/event/mocks"
 	"kudago/internal/gateway/utils"
 	"kudago/internal/logger"
@@ -45,7 +47,7 @@ func TestEventHandler_AddEvent(t *testing.T) {
 			name: "Bad request",
 			req: func() *http.Request {
 				req := httptest.NewRequest(http.MethodDelete, "/events/1", nil)
-				session := models.Session{UserID: 1, Token: "valid_token"}
+				session := models.Session{UserID: 1, Token: "valid_token", Verified: true}
 				ctx := utils.SetSessionInContext(req.Context(), session)
 				return req.WithContext(ctx)
 			}(),
@@ -59,6 +61,24 @@ func TestEventHandler_AddEvent(t *testing.T) {
 			},
 			wantCode: http.StatusBadRequest,
 		},
+		{
+			name: "Email not verified",
+			req: func() *http.Request {
+				req := httptest.NewRequest(http.MethodPost, "/events", nil)
+				session := models.Session{UserID: 1, Token: "valid_token"}
+				ctx := utils.SetSessionInContext(req.Context(), session)
+				return req.WithContext(ctx)
+			}(),
+			setupFunc: func(ctrl *gomock.Controller) *EventHandler {
+				serviceMock := mocks.NewMockEventServiceClient(ctrl)
+
+				return &EventHandler{
+					EventService: serviceMock,
+					logger:       logger,
+				}
+			},
+			wantCode: http.StatusForbidden,
+		},
 	}
 
 	for _, tt := range tests {
@@ -75,3 +95,110 @@ func TestEventHandler_AddEvent(t *testing.T) {
 		})
 	}
 }
+
+func TestCheckNewEventRequest_Recurrence(t *testing.T) {
+	t.Parallel()
+
+	eventStart := time.Now().Add(24 * time.Hour).UTC()
+	base := NewEventRequest{
+		EventStart: eventStart.Format(time.RFC3339),
+		EventEnd:   eventStart.Add(2 * time.Hour).Format(time.RFC3339),
+	}
+
+	tests := []struct {
+		name       string
+		rule       string
+		exceptions []string
+		wantErr    *httpErrors.HttpError
+	}{
+		{
+			name:       "корректная серия",
+			rule:       "FREQ=WEEKLY;BYDAY=MO,TH;COUNT=10",
+			exceptions: []string{eventStart.Add(7 * 24 * time.Hour).Format(time.RFC3339)},
+		},
+		{
+			name:    "неподдерживаемое правило",
+			rule:    "FREQ=HOURLY",
+			wantErr: httpErrors.ErrInvalidRecurrence,
+		},
+		{
+			name:       "неверная дата исключения",
+			rule:       "FREQ=DAILY",
+			exceptions: []string{"2025-01-13"},
+			wantErr:    httpErrors.ErrInvalidRecurrence,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := base
+			req.RecurrenceRule = tt.rule
+			req.RecurrenceExceptions = tt.exceptions
+
+			assert.Equal(t, tt.wantErr, checkNewEventRequest(req))
+		})
+	}
+}
+
+func TestCheckNewEventRequest_Status(t *testing.T) {
+	t.Parallel()
+
+	eventStart := time.Now().Add(24 * time.Hour).UTC()
+	base := NewEventRequest{
+		EventStart: eventStart.Format(time.RFC3339),
+		EventEnd:   eventStart.Add(2 * time.Hour).Format(time.RFC3339),
+	}
+
+	tests := []struct {
+		name      string
+		status    string
+		publishAt string
+		wantErr   *httpErrors.HttpError
+	}{
+		{
+			name: "опубликованное по умолчанию",
+		},
+		{
+			name:      "запланированный черновик",
+			status:    models.EventStatusDraft,
+			publishAt: eventStart.Add(-time.Hour).Format(time.RFC3339),
+		},
+		{
+			name:      "время публикации без черновика",
+			status:    models.EventStatusPublished,
+			publishAt: eventStart.Add(-time.Hour).Format(time.RFC3339),
+			wantErr:   httpErrors.ErrInvalidStatus,
+		},
+		{
+			name:    "создание отменённого события",
+			status:  models.EventStatusCancelled,
+			wantErr: httpErrors.ErrInvalidStatus,
+		},
+		{
+			name:      "публикация после начала",
+			status:    models.EventStatusDraft,
+			publishAt: eventStart.Add(time.Hour).Format(time.RFC3339),
+			wantErr:   httpErrors.ErrBadEventTiming,
+		},
+		{
+			name:      "неверное время публикации",
+			status:    models.EventStatusDraft,
+			publishAt: "завтра",
+			wantErr:   httpErrors.ErrInvalidTime,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := base
+			req.Status = tt.status
+			req.PublishAt = tt.publishAt
+
+			assert.Equal(t, tt.wantErr, checkNewEventRequest(req))
+		})
+	}
+}
@@ -17,8 +17,12 @@ import (
 // @Description Добавить событие в избранное
 // @Tags events
 // @Produce  json
+// @Param id path int true "ID события"
+// @Param occurrence query string false "Начало повторения серии в формате RFC3339; без него — всё событие"
 // @Success 200
+// @Failure 400 {object} httpErrors.HttpError "Bad Request"
 // @Failure 401 {object} httpErrors.HttpError "Unauthorized"
+// @Failure 409 {object} httpErrors.HttpError "Already In Favorites Or Event Cancelled"
 // @Failure 500 {object} httpErrors.HttpError "Internal Server Error"
 // @Router /events/favorites/{id} [post]
 func (h EventHandler) AddEventToFavorites(w http.ResponseWriter, r *http.Request) {
@@ -35,9 +39,16 @@ func (h EventHandler) AddEventToFavorites(w http.ResponseWriter, r *http.Request
 		return
 	}
 
+	occurrence, reqErr := getOccurrenceParam(r)
+	if reqErr != nil {
+		utils.WriteResponse(w, http.StatusBadRequest, reqErr)
+		return
+	}
+
 	newFavorite := &pb.FavoriteEvent{
-		UserID:  int32(session.UserID),
-		EventID: int32(id),
+		UserID:          int32(session.UserID),
+		EventID:         int32(id),
+		OccurrenceStart: occurrence,
 	}
 
 	_, err = h.EventService.AddEventToFavorites(r.Context(), newFavorite)
@@ -51,6 +62,9 @@ func (h EventHandler) AddEventToFavorites(w http.ResponseWriter, r *http.Request
 			case grpcCodes.AlreadyExists:
 				utils.WriteResponse(w, http.StatusConflict, httpErrors.ErrEventAlreadyAddedToFavorites)
 				return
+			case grpcCodes.FailedPrecondition:
+				utils.WriteResponse(w, http.StatusConflict, httpErrors.ErrEventClosed)
+				return
 			}
 		}
 
@@ -56,6 +56,50 @@ func TestEventHandler_AddEventToFavorites(t *testing.T) {
 			},
 			wantCode: http.StatusOK,
 		},
+		{
+			name: "Добавление одного повторения серии",
+			req: func() *http.Request {
+				req := httptest.NewRequest(http.MethodPost, "/events/favorites/1?occurrence=2025-01-13T19:00:00Z", nil)
+				req = mux.SetURLVars(req, map[string]string{"id": "1"})
+				session := models.Session{UserID: 1, Token: "valid_token"}
+				ctx := utils.SetSessionInContext(req.Context(), session)
+				return req.WithContext(ctx)
+			}(),
+			setupFunc: func(ctrl *gomock.Controller) *EventHandler {
+				serviceMock := mocks.NewMockEventServiceClient(ctrl)
+
+				serviceMock.EXPECT().AddEventToFavorites(gomock.Any(), &pb.FavoriteEvent{
+					EventID:         1,
+					UserID:          1,
+					OccurrenceStart: "2025-01-13T19:00:00Z",
+				}).Return(nil, nil)
+
+				return &EventHandler{
+					EventService: serviceMock,
+					logger:       logger,
+				}
+			},
+			wantCode: http.StatusOK,
+		},
+		{
+			name: "Неверное время повторения",
+			req: func() *http.Request {
+				req := httptest.NewRequest(http.MethodPost, "/events/favorites/1?occurrence=tomorrow", nil)
+				req = mux.SetURLVars(req, map[string]string{"id": "1"})
+				session := models.Session{UserID: 1, Token: "valid_token"}
+				ctx := utils.SetSessionInContext(req.Context(), session)
+				return req.WithContext(ctx)
+			}(),
+			setupFunc: func(ctrl *gomock.Controller) *EventHandler {
+				serviceMock := mocks.NewMockEventServiceClient(ctrl)
+
+				return &EventHandler{
+					EventService: serviceMock,
+					logger:       logger,
+				}
+			},
+			wantCode: http.StatusBadRequest,
+		},
 		{
 			name: "Not found",
 			req: func() *http.Request {
@@ -0,0 +1,93 @@
+package events
+
+import (
+	"net/http"
+	"strconv"
+
+	pb "kudago/internal/event/api"
+	httpErrors "kudago/internal/gateway/errors"
+	"kudago/internal/gateway/utils"
+
+	"github.com/asaskevich/govalidator"
+	"github.com/gorilla/mux"
+	easyjson "github.com/mailru/easyjson"
+	grpcCodes "google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+// @Summary Добавление типа билета
+// @Description Организатор добавляет тип билета к своему событию
+// @Tags tickets
+// @Accept  json
+// @Produce  json
+// @Param id path int true "ID события"
+// @Param ticketType body NewTicketTypeRequest true "Тип билета"
+// @Success 201 {object} TicketTypeResponse
+// @Failure 400 {object} httpErrors.HttpError "Bad Request"
+// @Failure 401 {object} httpErrors.HttpError "Unauthorized"
+// @Failure 403 {object} httpErrors.HttpError "Access Denied"
+// @Failure 404 {object} httpErrors.HttpError "Event Not Found"
+// @Failure 409 {object} httpErrors.HttpError "Ticket Type Already Exists"
+// @Failure 500 {object} httpErrors.HttpError "Internal Server Error"
+// @Router /events/{id}/ticket-types [post]
+func (h EventHandler) AddTicketType(w http.ResponseWriter, r *http.Request) {
+	session, ok := utils.GetSessionFromContext(r.Context())
+	if !ok {
+		utils.WriteResponse(w, http.StatusForbidden, httpErrors.ErrUnauthorized)
+		return
+	}
+
+	vars := mux.Vars(r)
+	id, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		utils.WriteResponse(w, http.StatusBadRequest, httpErrors.ErrInvalidID)
+		return
+	}
+
+	var req NewTicketTypeRequest
+	err = easyjson.UnmarshalFromReader(r.Body, &req)
+	if err != nil {
+		utils.WriteResponse(w, http.StatusBadRequest, httpErrors.ErrInvalidData)
+		return
+	}
+
+	_, err = govalidator.ValidateStruct(&req)
+	if err != nil {
+		utils.ProcessValidationErrors(w, err)
+		return
+	}
+
+	ticketType, err := h.EventService.AddTicketType(r.Context(), &pb.AddTicketTypeRequest{
+		Type: &pb.TicketType{
+			EventID: int32(id),
+			Name:    req.Name,
+			Price:   req.Price,
+		},
+		AuthorID: int32(session.UserID),
+	})
+	if err != nil {
+		st, ok := grpcStatus.FromError(err)
+		if ok {
+			switch st.Code() {
+			case grpcCodes.NotFound:
+				utils.WriteResponse(w, http.StatusNotFound, httpErrors.ErrEventNotFound)
+				return
+			case grpcCodes.PermissionDenied:
+				utils.WriteResponse(w, http.StatusForbidden, httpErrors.ErrAccessDenied)
+				return
+			case grpcCodes.AlreadyExists:
+				utils.WriteResponse(w, http.StatusConflict, httpErrors.ErrTicketTypeAlreadyExists)
+				return
+			case grpcCodes.InvalidArgument:
+				utils.WriteResponse(w, http.StatusBadRequest, httpErrors.ErrInvalidData)
+				return
+			}
+		}
+
+		h.logger.Error(r.Context(), "add ticket type", err)
+		utils.WriteResponse(w, http.StatusInternalServerError, httpErrors.ErrInternal)
+		return
+	}
+
+	utils.WriteResponse(w, http.StatusCreated, ticketTypeToTicketTypeResponse(ticketType))
+}
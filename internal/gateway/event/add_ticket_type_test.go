@@ -0,0 +1,183 @@
+package events
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	pb "kudago/internal/event/api"
+	"kudago/internal/event/grpc"
+	"kudago/internal/gateway/event/mocks"
+	"kudago/internal/gateway/utils"
+	"kudago/internal/logger"
+	"kudago/internal/models"
+
+	"github.com/golang/mock/gomock"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestEventHandler_AddTicketType(t *testing.T) {
+	t.Parallel()
+
+	logger, _ := logger.NewLogger()
+
+	newRequest := func(body string, withSession bool) *http.Request {
+		req := httptest.NewRequest(http.MethodPost, "/events/1/ticket-types", bytes.NewBufferString(body))
+		req = mux.SetURLVars(req, map[string]string{"id": "1"})
+		if !withSession {
+			return req
+		}
+		session := models.Session{UserID: 3, Token: "valid_token"}
+		return req.WithContext(utils.SetSessionInContext(req.Context(), session))
+	}
+
+	addRequest := &pb.AddTicketTypeRequest{
+		Type:     &pb.TicketType{EventID: 1, Name: "VIP", Price: 1500},
+		AuthorID: 3,
+	}
+	validBody := `{"name": "VIP", "price": 1500}`
+
+	tests := []struct {
+		name      string
+		req       *http.Request
+		setupFunc func(ctrl *gomock.Controller) *EventHandler
+		wantCode  int
+		wantBody  string
+	}{
+		{
+			name: "Успешное добавление",
+			req:  newRequest(validBody, true),
+			setupFunc: func(ctrl *gomock.Controller) *EventHandler {
+				serviceMock := mocks.NewMockEventServiceClient(ctrl)
+
+				serviceMock.EXPECT().AddTicketType(gomock.Any(), addRequest).
+					Return(&pb.TicketType{ID: 2, EventID: 1, Name: "VIP", Price: 1500}, nil)
+
+				return &EventHandler{
+					EventService: serviceMock,
+					logger:       logger,
+				}
+			},
+			wantCode: http.StatusCreated,
+			wantBody: `{"id":2,"event_id":1,"name":"VIP","price":1500}`,
+		},
+		{
+			name: "Нет сессии",
+			req:  newRequest(validBody, false),
+			setupFunc: func(ctrl *gomock.Controller) *EventHandler {
+				return &EventHandler{
+					EventService: mocks.NewMockEventServiceClient(ctrl),
+					logger:       logger,
+				}
+			},
+			wantCode: http.StatusForbidden,
+		},
+		{
+			name: "Некорректный JSON",
+			req:  newRequest(`{"name":`, true),
+			setupFunc: func(ctrl *gomock.Controller) *EventHandler {
+				return &EventHandler{
+					EventService: mocks.NewMockEventServiceClient(ctrl),
+					logger:       logger,
+				}
+			},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name: "Пустое название",
+			req:  newRequest(`{"name": "", "price": 1500}`, true),
+			setupFunc: func(ctrl *gomock.Controller) *EventHandler {
+				return &EventHandler{
+					EventService: mocks.NewMockEventServiceClient(ctrl),
+					logger:       logger,
+				}
+			},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name: "Событие не найдено",
+			req:  newRequest(validBody, true),
+			setupFunc: func(ctrl *gomock.Controller) *EventHandler {
+				serviceMock := mocks.NewMockEventServiceClient(ctrl)
+
+				serviceMock.EXPECT().AddTicketType(gomock.Any(), addRequest).
+					Return(nil, status.Error(codes.NotFound, grpc.ErrEventNotFound))
+
+				return &EventHandler{
+					EventService: serviceMock,
+					logger:       logger,
+				}
+			},
+			wantCode: http.StatusNotFound,
+		},
+		{
+			name: "Чужое событие",
+			req:  newRequest(validBody, true),
+			setupFunc: func(ctrl *gomock.Controller) *EventHandler {
+				serviceMock := mocks.NewMockEventServiceClient(ctrl)
+
+				serviceMock.EXPECT().AddTicketType(gomock.Any(), addRequest).
+					Return(nil, status.Error(codes.PermissionDenied, grpc.ErrPermissionDenied))
+
+				return &EventHandler{
+					EventService: serviceMock,
+					logger:       logger,
+				}
+			},
+			wantCode: http.StatusForbidden,
+		},
+		{
+			name: "Тип билета уже существует",
+			req:  newRequest(validBody, true),
+			setupFunc: func(ctrl *gomock.Controller) *EventHandler {
+				serviceMock := mocks.NewMockEventServiceClient(ctrl)
+
+				serviceMock.EXPECT().AddTicketType(gomock.Any(), addRequest).
+					Return(nil, status.Error(codes.AlreadyExists, grpc.ErrTicketTypeExists))
+
+				return &EventHandler{
+					EventService: serviceMock,
+					logger:       logger,
+				}
+			},
+			wantCode: http.StatusConflict,
+		},
+		{
+			name: "Внутренняя ошибка",
+			req:  newRequest(validBody, true),
+			setupFunc: func(ctrl *gomock.Controller) *EventHandler {
+				serviceMock := mocks.NewMockEventServiceClient(ctrl)
+
+				serviceMock.EXPECT().AddTicketType(gomock.Any(), addRequest).
+					Return(nil, status.Error(codes.Internal, grpc.ErrInternal))
+
+				return &EventHandler{
+					EventService: serviceMock,
+					logger:       logger,
+				}
+			},
+			wantCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			recorder := httptest.NewRecorder()
+			tt.setupFunc(ctrl).AddTicketType(recorder, tt.req)
+
+			assert.Equal(t, tt.wantCode, recorder.Code)
+			if tt.wantBody != "" {
+				assert.JSONEq(t, tt.wantBody, recorder.Body.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,111 @@
+package events
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	pb "kudago/internal/event/api"
+	httpErrors "kudago/internal/gateway/errors"
+	"kudago/internal/gateway/utils"
+	"kudago/internal/models"
+	pbNtf "kudago/internal/notification/api"
+
+	"github.com/gorilla/mux"
+	grpcCodes "google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+const claimDeadlineLayout = models.ClaimDeadlineLayout
+
+// @Summary Запись на событие
+// @Description Отмечает, что пользователь пойдёт на событие. Если мест нет, пользователь попадает в лист ожидания и получит уведомление, когда место освободится
+// @Tags attendance
+// @Produce  json
+// @Param id path int true "ID события"
+// @Success 200 {object} AttendanceResponse "Пользователь записан"
+// @Success 202 {object} AttendanceResponse "Пользователь в листе ожидания"
+// @Failure 401 {object} httpErrors.HttpError "Unauthorized"
+// @Failure 404 {object} httpErrors.HttpError "Event Not Found"
+// @Failure 409 {object} httpErrors.HttpError "Event Cancelled Or Not Published"
+// @Failure 500 {object} httpErrors.HttpError "Internal Server Error"
+// @Router /events/{id}/attendees [post]
+func (h EventHandler) AttendEvent(w http.ResponseWriter, r *http.Request) {
+	session, ok := utils.GetSessionFromContext(r.Context())
+	if !ok {
+		utils.WriteResponse(w, http.StatusForbidden, httpErrors.ErrUnauthorized)
+		return
+	}
+
+	vars := mux.Vars(r)
+	id, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		utils.WriteResponse(w, http.StatusBadRequest, httpErrors.ErrInvalidID)
+		return
+	}
+
+	req := &pb.AttendanceRequest{
+		UserID:  int32(session.UserID),
+		EventID: int32(id),
+	}
+
+	attendance, err := h.EventService.AttendEvent(r.Context(), req)
+	if err != nil {
+		st, ok := grpcStatus.FromError(err)
+		if ok {
+			switch st.Code() {
+			case grpcCodes.NotFound:
+				utils.WriteResponse(w, http.StatusNotFound, httpErrors.ErrEventNotFound)
+				return
+			case grpcCodes.FailedPrecondition:
+				utils.WriteResponse(w, http.StatusConflict, httpErrors.ErrEventClosed)
+				return
+			}
+		}
+
+		h.logger.Error(r.Context(), "attend event", err)
+		utils.WriteResponse(w, http.StatusInternalServerError, httpErrors.ErrInternal)
+		return
+	}
+
+	err = h.sendWaitlistNotifications(r.Context(), attendance.Promoted)
+	if err != nil {
+		h.logger.Error(r.Context(), "send waitlist notifications", err)
+	}
+
+	resp := AttendanceResponse{
+		Status:   attendance.Status,
+		Position: int(attendance.Position),
+	}
+	if attendance.Status == models.AttendanceStatusWaitlisted {
+		utils.WriteResponse(w, http.StatusAccepted, resp)
+		return
+	}
+	utils.WriteResponse(w, http.StatusOK, resp)
+}
+
+func (h EventHandler) sendWaitlistNotifications(ctx context.Context, promoted []*pb.WaitlistEntry) error {
+	for _, entry := range promoted {
+		claimUntil, err := time.Parse(time.RFC3339, entry.ClaimUntil)
+		if err != nil {
+			return err
+		}
+
+		req := &pbNtf.CreateNotificationsRequest{
+			UserIDs: []int32{entry.UserID},
+			Notification: &pbNtf.Notification{
+				Message:  fmt.Sprintf(WaitlistPromotedMsg, claimUntil.Format(claimDeadlineLayout)),
+				NotifyAt: time.Now().String(),
+				EventID:  entry.EventID,
+			},
+		}
+
+		_, err = h.NotificationService.CreateNotifications(ctx, req)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,168 @@
+package events
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	pb "kudago/internal/event/api"
+	"kudago/internal/event/grpc"
+	"kudago/internal/gateway/event/mocks"
+	"kudago/internal/gateway/utils"
+	"kudago/internal/logger"
+	"kudago/internal/models"
+	pbNtf "kudago/internal/notification/api"
+
+	"github.com/golang/mock/gomock"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	grpcLib "google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestEventHandler_AttendEvent(t *testing.T) {
+	t.Parallel()
+
+	logger, _ := logger.NewLogger()
+
+	newRequest := func(withSession bool) *http.Request {
+		req := httptest.NewRequest(http.MethodPost, "/events/1/attendees", nil)
+		req = mux.SetURLVars(req, map[string]string{"id": "1"})
+		if !withSession {
+			return req
+		}
+		session := models.Session{UserID: 3, Token: "valid_token"}
+		return req.WithContext(utils.SetSessionInContext(req.Context(), session))
+	}
+
+	attendRequest := &pb.AttendanceRequest{UserID: 3, EventID: 1}
+
+	tests := []struct {
+		name      string
+		req       *http.Request
+		setupFunc func(ctrl *gomock.Controller) *EventHandler
+		wantCode  int
+	}{
+		{
+			name: "Успешная запись",
+			req:  newRequest(true),
+			setupFunc: func(ctrl *gomock.Controller) *EventHandler {
+				serviceMock := mocks.NewMockEventServiceClient(ctrl)
+
+				serviceMock.EXPECT().AttendEvent(gomock.Any(), attendRequest).
+					Return(&pb.AttendanceStatus{Status: models.AttendanceStatusAttending}, nil)
+
+				return &EventHandler{
+					EventService: serviceMock,
+					logger:       logger,
+				}
+			},
+			wantCode: http.StatusOK,
+		},
+		{
+			name: "Нет сессии",
+			req:  newRequest(false),
+			setupFunc: func(ctrl *gomock.Controller) *EventHandler {
+				return &EventHandler{
+					EventService: mocks.NewMockEventServiceClient(ctrl),
+					logger:       logger,
+				}
+			},
+			wantCode: http.StatusForbidden,
+		},
+		{
+			name: "Нет свободных мест, запись в лист ожидания",
+			req:  newRequest(true),
+			setupFunc: func(ctrl *gomock.Controller) *EventHandler {
+				serviceMock := mocks.NewMockEventServiceClient(ctrl)
+
+				serviceMock.EXPECT().AttendEvent(gomock.Any(), attendRequest).
+					Return(&pb.AttendanceStatus{Status: models.AttendanceStatusWaitlisted, Position: 2}, nil)
+
+				return &EventHandler{
+					EventService: serviceMock,
+					logger:       logger,
+				}
+			},
+			wantCode: http.StatusAccepted,
+		},
+		{
+			name: "Уведомление продвинутому из листа ожидания",
+			req:  newRequest(true),
+			setupFunc: func(ctrl *gomock.Controller) *EventHandler {
+				serviceMock := mocks.NewMockEventServiceClient(ctrl)
+				notificationMock := mocks.NewMockNotificationServiceClient(ctrl)
+
+				serviceMock.EXPECT().AttendEvent(gomock.Any(), attendRequest).
+					Return(&pb.AttendanceStatus{
+						Status: models.AttendanceStatusWaitlisted,
+						Promoted: []*pb.WaitlistEntry{
+							{UserID: 5, EventID: 1, ClaimUntil: "2024-12-01T12:00:00Z"},
+						},
+					}, nil)
+				notificationMock.EXPECT().CreateNotifications(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, req *pbNtf.CreateNotificationsRequest, _ ...grpcLib.CallOption) (*pbNtf.Empty, error) {
+						assert.Equal(t, []int32{5}, req.UserIDs)
+						assert.Equal(t, int32(1), req.Notification.EventID)
+						assert.Contains(t, req.Notification.Message, "01.12.2024 12:00")
+						return &pbNtf.Empty{}, nil
+					})
+
+				return &EventHandler{
+					EventService:        serviceMock,
+					NotificationService: notificationMock,
+					logger:              logger,
+				}
+			},
+			wantCode: http.StatusAccepted,
+		},
+		{
+			name: "Событие не найдено",
+			req:  newRequest(true),
+			setupFunc: func(ctrl *gomock.Controller) *EventHandler {
+				serviceMock := mocks.NewMockEventServiceClient(ctrl)
+
+				serviceMock.EXPECT().AttendEvent(gomock.Any(), attendRequest).
+					Return(nil, status.Error(codes.NotFound, grpc.ErrEventNotFound))
+
+				return &EventHandler{
+					EventService: serviceMock,
+					logger:       logger,
+				}
+			},
+			wantCode: http.StatusNotFound,
+		},
+		{
+			name: "Событие отменено",
+			req:  newRequest(true),
+			setupFunc: func(ctrl *gomock.Controller) *EventHandler {
+				serviceMock := mocks.NewMockEventServiceClient(ctrl)
+
+				serviceMock.EXPECT().AttendEvent(gomock.Any(), attendRequest).
+					Return(nil, status.Error(codes.FailedPrecondition, grpc.ErrNotPublished))
+
+				return &EventHandler{
+					EventService: serviceMock,
+					logger:       logger,
+				}
+			},
+			wantCode: http.StatusConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			recorder := httptest.NewRecorder()
+			tt.setupFunc(ctrl).AttendEvent(recorder, tt.req)
+
+			assert.Equal(t, tt.wantCode, recorder.Code)
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package events
+
+import (
+	"net/http"
+	"strconv"
+
+	pb "kudago/internal/event/api"
+	httpErrors "kudago/internal/gateway/errors"
+	"kudago/internal/gateway/utils"
+
+	"github.com/gorilla/mux"
+	grpcCodes "google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+// @Summary Покупка билета
+// @Description Подтверждает покупку ранее забронированного билета
+// @Tags tickets
+// @Produce  json
+// @Param id path int true "ID билета"
+// @Success 200 {object} TicketResponse
+// @Failure 401 {object} httpErrors.HttpError "Unauthorized"
+// @Failure 404 {object} httpErrors.HttpError "Reservation Not Found Or Expired"
+// @Failure 409 {object} httpErrors.HttpError "Event Cancelled Or Not Published"
+// @Failure 500 {object} httpErrors.HttpError "Internal Server Error"
+// @Router /tickets/{id}/buy [post]
+func (h EventHandler) BuyTicket(w http.ResponseWriter, r *http.Request) {
+	session, ok := utils.GetSessionFromContext(r.Context())
+	if !ok {
+		utils.WriteResponse(w, http.StatusForbidden, httpErrors.ErrUnauthorized)
+		return
+	}
+
+	vars := mux.Vars(r)
+	id, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		utils.WriteResponse(w, http.StatusBadRequest, httpErrors.ErrInvalidID)
+		return
+	}
+
+	ticket, err := h.EventService.BuyTicket(r.Context(), &pb.BuyTicketRequest{
+		UserID:   int32(session.UserID),
+		TicketID: int32(id),
+	})
+	if err != nil {
+		st, ok := grpcStatus.FromError(err)
+		if ok {
+			switch st.Code() {
+			case grpcCodes.NotFound:
+				utils.WriteResponse(w, http.StatusNotFound, httpErrors.ErrTicketNotFound)
+				return
+			case grpcCodes.FailedPrecondition:
+				utils.WriteResponse(w, http.StatusConflict, httpErrors.ErrEventClosed)
+				return
+			}
+		}
+
+		h.logger.Error(r.Context(), "buy ticket", err)
+		utils.WriteResponse(w, http.StatusInternalServerError, httpErrors.ErrInternal)
+		return
+	}
+
+	utils.WriteResponse(w, http.StatusOK, ticketToTicketResponse(ticket))
+}
@@ -0,0 +1,162 @@
+package events
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	pb "kudago/internal/event/api"
+	"kudago/internal/event/grpc"
+	"kudago/internal/gateway/event/mocks"
+	"kudago/internal/gateway/utils"
+	"kudago/internal/logger"
+	"kudago/internal/models"
+
+	"github.com/golang/mock/gomock"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestEventHandler_BuyTicket(t *testing.T) {
+	t.Parallel()
+
+	logger, _ := logger.NewLogger()
+
+	newRequest := func(id string, withSession bool) *http.Request {
+		req := httptest.NewRequest(http.MethodPost, "/tickets/"+id+"/buy", nil)
+		req = mux.SetURLVars(req, map[string]string{"id": id})
+		if !withSession {
+			return req
+		}
+		session := models.Session{UserID: 3, Token: "valid_token"}
+		return req.WithContext(utils.SetSessionInContext(req.Context(), session))
+	}
+
+	buyRequest := &pb.BuyTicketRequest{UserID: 3, TicketID: 7}
+
+	tests := []struct {
+		name      string
+		req       *http.Request
+		setupFunc func(ctrl *gomock.Controller) *EventHandler
+		wantCode  int
+		wantBody  string
+	}{
+		{
+			name: "Успешная покупка",
+			req:  newRequest("7", true),
+			setupFunc: func(ctrl *gomock.Controller) *EventHandler {
+				serviceMock := mocks.NewMockEventServiceClient(ctrl)
+
+				serviceMock.EXPECT().BuyTicket(gomock.Any(), buyRequest).
+					Return(&pb.Ticket{
+						ID:           7,
+						EventID:      1,
+						TicketTypeID: 2,
+						UserID:       3,
+						Type:         "VIP",
+						Price:        1500,
+						Quantity:     2,
+						Status:       models.TicketStatusPaid,
+						BoughtAt:     "2030-01-01T12:00:00Z",
+					}, nil)
+
+				return &EventHandler{
+					EventService: serviceMock,
+					logger:       logger,
+				}
+			},
+			wantCode: http.StatusOK,
+			wantBody: `"status":"paid"`,
+		},
+		{
+			name: "Нет сессии",
+			req:  newRequest("7", false),
+			setupFunc: func(ctrl *gomock.Controller) *EventHandler {
+				return &EventHandler{
+					EventService: mocks.NewMockEventServiceClient(ctrl),
+					logger:       logger,
+				}
+			},
+			wantCode: http.StatusForbidden,
+		},
+		{
+			name: "Некорректный ID",
+			req:  newRequest("abc", true),
+			setupFunc: func(ctrl *gomock.Controller) *EventHandler {
+				return &EventHandler{
+					EventService: mocks.NewMockEventServiceClient(ctrl),
+					logger:       logger,
+				}
+			},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name: "Бронь не найдена или истекла",
+			req:  newRequest("7", true),
+			setupFunc: func(ctrl *gomock.Controller) *EventHandler {
+				serviceMock := mocks.NewMockEventServiceClient(ctrl)
+
+				serviceMock.EXPECT().BuyTicket(gomock.Any(), buyRequest).
+					Return(nil, status.Error(codes.NotFound, grpc.ErrTicketNotFound))
+
+				return &EventHandler{
+					EventService: serviceMock,
+					logger:       logger,
+				}
+			},
+			wantCode: http.StatusNotFound,
+			wantBody: "Ticket reservation not found or expired",
+		},
+		{
+			name: "Событие отменено",
+			req:  newRequest("7", true),
+			setupFunc: func(ctrl *gomock.Controller) *EventHandler {
+				serviceMock := mocks.NewMockEventServiceClient(ctrl)
+
+				serviceMock.EXPECT().BuyTicket(gomock.Any(), buyRequest).
+					Return(nil, status.Error(codes.FailedPrecondition, grpc.ErrNotPublished))
+
+				return &EventHandler{
+					EventService: serviceMock,
+					logger:       logger,
+				}
+			},
+			wantCode: http.StatusConflict,
+		},
+		{
+			name: "Внутренняя ошибка",
+			req:  newRequest("7", true),
+			setupFunc: func(ctrl *gomock.Controller) *EventHandler {
+				serviceMock := mocks.NewMockEventServiceClient(ctrl)
+
+				serviceMock.EXPECT().BuyTicket(gomock.Any(), buyRequest).
+					Return(nil, status.Error(codes.Internal, grpc.ErrInternal))
+
+				return &EventHandler{
+					EventService: serviceMock,
+					logger:       logger,
+				}
+			},
+			wantCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			recorder := httptest.NewRecorder()
+			tt.setupFunc(ctrl).BuyTicket(recorder, tt.req)
+
+			assert.Equal(t, tt.wantCode, recorder.Code)
+			if tt.wantBody != "" {
+				assert.Contains(t, recorder.Body.String(), tt.wantBody)
+			}
+		})
+	}
+}
@@ -0,0 +1,187 @@
+package events
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	pb "kudago/internal/event/api"
+	"kudago/internal/event/recurrence"
+	httpErrors "kudago/internal/gateway/errors"
+	"kudago/internal/gateway/utils"
+	"kudago/internal/ical"
+	"kudago/internal/models"
+
+	"github.com/gorilla/mux"
+	grpcCodes "google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+const (
+	// calendarTimezone is the zone .ics times are written in: events are in
+	// Moscow, so calendar apps show them as local times. Series are expanded
+	// in the same zone by the event service.
+	calendarTimezone = recurrence.Timezone
+	// calendarFeedLimit bounds each source of the personal feed.
+	calendarFeedLimit = 500
+	calendarFeedName  = "Выходной"
+)
+
+var calendarLocation = sync.OnceValues(func() (*time.Location, error) {
+	return time.LoadLocation(calendarTimezone)
+})
+
+// @Summary Экспорт события в календарь
+// @Description Возвращает событие в формате iCalendar (.ics)
+// @Tags events
+// @Produce  text/calendar
+// @Param id path int true "ID события"
+// @Success 200 {string} string "Файл .ics"
+// @Failure 404 {object} httpErrors.HttpError "Event Not Found"
+// @Failure 500 {object} httpErrors.HttpError "Internal Server Error"
+// @Router /events/{id}.ics [get]
+func (h EventHandler) ExportEventICS(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		utils.WriteResponse(w, http.StatusBadRequest, httpErrors.ErrInvalidID)
+		return
+	}
+
+	event, err := h.EventService.GetEventByID(r.Context(), eventByIDRequest(r, id))
+	if err != nil {
+		st, ok := grpcStatus.FromError(err)
+		if ok && st.Code() == grpcCodes.NotFound {
+			utils.WriteResponse(w, http.StatusNotFound, httpErrors.ErrEventNotFound)
+			return
+		}
+
+		h.logger.Error(r.Context(), "export event ics", err)
+		utils.WriteResponse(w, http.StatusInternalServerError, httpErrors.ErrInternal)
+		return
+	}
+
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="event-%d.ics"`, id))
+	h.writeCalendar(w, r, event.Title, []models.Event{toEvent(event)})
+}
+
+// @Summary Ссылка на персональный календарь
+// @Description Выпускает новую ссылку на календарь с избранными событиями и событиями подписок. Прежняя ссылка перестаёт работать.
+// @Tags events
+// @Produce  json
+// @Success 200 {object} CalendarTokenResponse
+// @Failure 401 {object} httpErrors.HttpError "Unauthorized"
+// @Failure 500 {object} httpErrors.HttpError "Internal Server Error"
+// @Router /calendar/token [post]
+func (h EventHandler) CreateCalendarToken(w http.ResponseWriter, r *http.Request) {
+	session, ok := utils.GetSessionFromContext(r.Context())
+	if !ok {
+		utils.WriteResponse(w, http.StatusForbidden, httpErrors.ErrUnauthorized)
+		return
+	}
+
+	token, err := h.EventService.CreateCalendarToken(r.Context(), &pb.CreateCalendarTokenRequest{UserID: int32(session.UserID)})
+	if err != nil {
+		h.logger.Error(r.Context(), "create calendar token", err)
+		utils.WriteResponse(w, http.StatusInternalServerError, httpErrors.ErrInternal)
+		return
+	}
+
+	resp := CalendarTokenResponse{
+		Token: token.Token,
+		URL:   "/calendar/" + token.Token + ".ics",
+	}
+	utils.WriteResponse(w, http.StatusOK, resp)
+}
+
+// @Summary Персональный календарь
+// @Description Возвращает избранные события и события подписок в формате iCalendar. Доступ по токену из ссылки, без сессии, чтобы календарь мог обновляться сам.
+// @Tags events
+// @Produce  text/calendar
+// @Param token path string true "Токен календаря"
+// @Success 200 {string} string "Файл .ics"
+// @Failure 404 {object} httpErrors.HttpError "Invalid Token"
+// @Failure 500 {object} httpErrors.HttpError "Internal Server Error"
+// @Router /calendar/{token}.ics [get]
+func (h EventHandler) GetCalendarFeed(w http.ResponseWriter, r *http.Request) {
+	token := mux.Vars(r)["token"]
+
+	owner, err := h.EventService.GetUserIDByCalendarToken(r.Context(), &pb.CalendarToken{Token: token})
+	if err != nil {
+		st, ok := grpcStatus.FromError(err)
+		if ok && st.Code() == grpcCodes.NotFound {
+			utils.WriteResponse(w, http.StatusNotFound, httpErrors.ErrInvalidCalendarToken)
+			return
+		}
+
+		h.logger.Error(r.Context(), "get calendar token owner", err)
+		utils.WriteResponse(w, http.StatusInternalServerError, httpErrors.ErrInternal)
+		return
+	}
+
+	params := &pb.PaginationParams{Limit: calendarFeedLimit}
+	favorites, err := h.EventService.GetFavorites(r.Context(), &pb.GetFavoritesRequest{UserID: owner.UserID, Params: params})
+	if err != nil {
+		h.logger.Error(r.Context(), "calendar favorites", err)
+		utils.WriteResponse(w, http.StatusInternalServerError, httpErrors.ErrInternal)
+		return
+	}
+
+	subscriptions, err := h.EventService.GetSubscriptionsEvents(r.Context(), &pb.GetSubscriptionsRequest{ID: owner.UserID, Params: params})
+	if err != nil {
+		h.logger.Error(r.Context(), "calendar subscription events", err)
+		utils.WriteResponse(w, http.StatusInternalServerError, httpErrors.ErrInternal)
+		return
+	}
+
+	// An event may be both a favorite and from a subscription; a duplicate
+	// entry would make calendar apps drop or merge entries unpredictably.
+	// Occurrences saved on their own are entries of their own.
+	type feedKey struct {
+		id         int32
+		occurrence string
+	}
+	seen := make(map[feedKey]bool)
+	events := make([]models.Event, 0, len(favorites.Events)+len(subscriptions.Events))
+	for _, event := range append(favorites.Events, subscriptions.Events...) {
+		key := feedKey{id: event.ID}
+		if event.SavedOccurrence {
+			key.occurrence = event.EventStart
+		}
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		events = append(events, toEvent(event))
+	}
+
+	h.writeCalendar(w, r, calendarFeedName, events)
+}
+
+func (h EventHandler) writeCalendar(w http.ResponseWriter, r *http.Request, name string, events []models.Event) {
+	loc, err := calendarLocation()
+	if err != nil {
+		h.logger.Error(r.Context(), "load calendar timezone", err)
+		utils.WriteResponse(w, http.StatusInternalServerError, httpErrors.ErrInternal)
+		return
+	}
+
+	cal := ical.Calendar{
+		Name:     name,
+		Location: loc,
+		Events:   events,
+		Stamp:    time.Now(),
+	}
+	data, err := cal.Encode()
+	if err != nil {
+		h.logger.Error(r.Context(), "encode calendar", err)
+		utils.WriteResponse(w, http.StatusInternalServerError, httpErrors.ErrInternal)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+}
@@ -0,0 +1,160 @@
+package events
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	pb "kudago/internal/event/api"
+	"kudago/internal/event/grpc"
+	"kudago/internal/gateway/event/mocks"
+	"kudago/internal/logger"
+
+	"github.com/golang/mock/gomock"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestEventHandler_ExportEventICS(t *testing.T) {
+	t.Parallel()
+
+	logger, _ := logger.NewLogger()
+
+	tests := []struct {
+		name         string
+		setupFunc    func(serviceMock *mocks.MockEventServiceClient)
+		wantCode     int
+		wantContains []string
+	}{
+		{
+			name: "Успешный экспорт",
+			setupFunc: func(serviceMock *mocks.MockEventServiceClient) {
+				serviceMock.EXPECT().GetEventByID(gomock.Any(), &pb.GetEventByIDRequest{ID: 1}).Return(&pb.Event{
+					ID:         1,
+					Title:      "Концерт",
+					EventStart: "2025-01-06T16:00:00Z",
+					EventEnd:   "2025-01-06T18:00:00Z",
+				}, nil)
+			},
+			wantCode: http.StatusOK,
+			wantContains: []string{
+				"UID:event-1@vyhodnoy.online",
+				"DTSTART;TZID=Europe/Moscow:20250106T190000",
+				"SUMMARY:Концерт",
+			},
+		},
+		{
+			name: "Событие не найдено",
+			setupFunc: func(serviceMock *mocks.MockEventServiceClient) {
+				serviceMock.EXPECT().GetEventByID(gomock.Any(), &pb.GetEventByIDRequest{ID: 1}).Return(nil, status.Error(codes.NotFound, grpc.ErrEventNotFound))
+			},
+			wantCode: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			serviceMock := mocks.NewMockEventServiceClient(ctrl)
+			tt.setupFunc(serviceMock)
+			handler := &EventHandler{EventService: serviceMock, logger: logger}
+
+			req := httptest.NewRequest(http.MethodGet, "/events/1.ics", nil)
+			req = mux.SetURLVars(req, map[string]string{"id": "1"})
+			recorder := httptest.NewRecorder()
+			handler.ExportEventICS(recorder, req)
+
+			assert.Equal(t, tt.wantCode, recorder.Code)
+			for _, line := range tt.wantContains {
+				assert.Contains(t, recorder.Body.String(), line+"\r\n")
+			}
+			if tt.wantCode == http.StatusOK {
+				assert.Equal(t, "text/calendar; charset=utf-8", recorder.Header().Get("Content-Type"))
+			}
+		})
+	}
+}
+
+func TestEventHandler_GetCalendarFeed(t *testing.T) {
+	t.Parallel()
+
+	logger, _ := logger.NewLogger()
+	params := &pb.PaginationParams{Limit: calendarFeedLimit}
+	event := func(id int32) *pb.Event {
+		return &pb.Event{ID: id, Title: "Событие", EventStart: "2025-01-06T16:00:00Z", EventEnd: "2025-01-06T18:00:00Z"}
+	}
+
+	tests := []struct {
+		name       string
+		setupFunc  func(serviceMock *mocks.MockEventServiceClient)
+		wantCode   int
+		wantEvents int
+	}{
+		{
+			name: "Избранное и подписки без повторов",
+			setupFunc: func(serviceMock *mocks.MockEventServiceClient) {
+				serviceMock.EXPECT().GetUserIDByCalendarToken(gomock.Any(), &pb.CalendarToken{Token: "abc"}).Return(&pb.CalendarTokenOwner{UserID: 7}, nil)
+				serviceMock.EXPECT().GetFavorites(gomock.Any(), &pb.GetFavoritesRequest{UserID: 7, Params: params}).
+					Return(&pb.Events{Events: []*pb.Event{event(1), event(2)}}, nil)
+				serviceMock.EXPECT().GetSubscriptionsEvents(gomock.Any(), &pb.GetSubscriptionsRequest{ID: 7, Params: params}).
+					Return(&pb.Events{Events: []*pb.Event{event(2), event(3)}}, nil)
+			},
+			wantCode:   http.StatusOK,
+			wantEvents: 3,
+		},
+		{
+			name: "Сохранённые повторения серии остаются отдельными записями",
+			setupFunc: func(serviceMock *mocks.MockEventServiceClient) {
+				series := event(4)
+				series.RecurrenceRule = "FREQ=WEEKLY"
+				series.SeriesStart = "2024-12-30T16:00:00Z"
+				occurrence := func(start string) *pb.Event {
+					return &pb.Event{ID: 4, Title: "Событие", EventStart: start, EventEnd: start, RecurrenceRule: "FREQ=WEEKLY", SavedOccurrence: true}
+				}
+
+				serviceMock.EXPECT().GetUserIDByCalendarToken(gomock.Any(), &pb.CalendarToken{Token: "abc"}).Return(&pb.CalendarTokenOwner{UserID: 7}, nil)
+				serviceMock.EXPECT().GetFavorites(gomock.Any(), &pb.GetFavoritesRequest{UserID: 7, Params: params}).
+					Return(&pb.Events{Events: []*pb.Event{occurrence("2025-01-13T16:00:00Z"), occurrence("2025-01-20T16:00:00Z")}}, nil)
+				serviceMock.EXPECT().GetSubscriptionsEvents(gomock.Any(), &pb.GetSubscriptionsRequest{ID: 7, Params: params}).
+					Return(&pb.Events{Events: []*pb.Event{series, series}}, nil)
+			},
+			wantCode:   http.StatusOK,
+			wantEvents: 3,
+		},
+		{
+			name: "Отозванный токен",
+			setupFunc: func(serviceMock *mocks.MockEventServiceClient) {
+				serviceMock.EXPECT().GetUserIDByCalendarToken(gomock.Any(), &pb.CalendarToken{Token: "abc"}).Return(nil, status.Error(codes.NotFound, grpc.ErrInvalidToken))
+			},
+			wantCode: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			serviceMock := mocks.NewMockEventServiceClient(ctrl)
+			tt.setupFunc(serviceMock)
+			handler := &EventHandler{EventService: serviceMock, logger: logger}
+
+			req := httptest.NewRequest(http.MethodGet, "/calendar/abc.ics", nil)
+			req = mux.SetURLVars(req, map[string]string{"token": "abc"})
+			recorder := httptest.NewRecorder()
+			handler.GetCalendarFeed(recorder, req)
+
+			assert.Equal(t, tt.wantCode, recorder.Code)
+			assert.Equal(t, tt.wantEvents, strings.Count(recorder.Body.String(), "BEGIN:VEVENT"))
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package events
+
+import (
+	"net/http"
+	"strconv"
+
+	pb "kudago/internal/event/api"
+	httpErrors "kudago/internal/gateway/errors"
+	"kudago/internal/gateway/utils"
+
+	"github.com/gorilla/mux"
+	grpcCodes "google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+// @Summary Отмена записи на событие
+// @Description Отмечает, что пользователь не пойдёт на событие, или убирает его из листа ожидания. Освободившееся место предлагается следующему в очереди
+// @Tags attendance
+// @Produce  json
+// @Param id path int true "ID события"
+// @Success 200
+// @Failure 401 {object} httpErrors.HttpError "Unauthorized"
+// @Failure 404 {object} httpErrors.HttpError "Not Attending"
+// @Failure 500 {object} httpErrors.HttpError "Internal Server Error"
+// @Router /events/{id}/attendees [delete]
+func (h EventHandler) CancelAttendance(w http.ResponseWriter, r *http.Request) {
+	session, ok := utils.GetSessionFromContext(r.Context())
+	if !ok {
+		utils.WriteResponse(w, http.StatusForbidden, httpErrors.ErrUnauthorized)
+		return
+	}
+
+	vars := mux.Vars(r)
+	id, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		utils.WriteResponse(w, http.StatusBadRequest, httpErrors.ErrInvalidID)
+		return
+	}
+
+	req := &pb.AttendanceRequest{
+		UserID:  int32(session.UserID),
+		EventID: int32(id),
+	}
+
+	promotions, err := h.EventService.CancelAttendance(r.Context(), req)
+	if err != nil {
+		st, ok := grpcStatus.FromError(err)
+		if ok && st.Code() == grpcCodes.NotFound {
+			utils.WriteResponse(w, http.StatusNotFound, httpErrors.ErrNotAttending)
+			return
+		}
+
+		h.logger.Error(r.Context(), "cancel attendance", err)
+		utils.WriteResponse(w, http.StatusInternalServerError, httpErrors.ErrInternal)
+		return
+	}
+
+	err = h.sendWaitlistNotifications(r.Context(), promotions.Promoted)
+	if err != nil {
+		h.logger.Error(r.Context(), "send waitlist notifications", err)
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
@@ -0,0 +1,167 @@
+package events
+
+import (
+	"net/http"
+	"strconv"
+
+	pb "kudago/internal/event/api"
+	httpErrors "kudago/internal/gateway/errors"
+	"kudago/internal/gateway/utils"
+
+	"github.com/asaskevich/govalidator"
+	"github.com/gorilla/mux"
+	easyjson "github.com/mailru/easyjson"
+	grpcCodes "google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+// @Summary Отмена события
+// @Description Отменяет опубликованное событие. Событие пропадает из подборок и поиска, но остаётся доступным по ID и в избранном со статусом cancelled и причиной отмены. Добавившие его в избранное получают уведомление.
+// @Tags events
+// @Accept  json
+// @Produce  json
+// @Param id path int true "Идентификатор события"
+// @Param json body CancelEventRequest false "Причина отмены"
+// @Success 200 {object} NewEventResponse
+// @Failure 400 {object} httpErrors.HttpError "Неверные данные"
+// @Failure 401 {object} httpErrors.HttpError "Неавторизован"
+// @Failure 403 {object} httpErrors.HttpError "Доступ запрещен"
+// @Failure 404 {object} httpErrors.HttpError "Событие не найдено"
+// @Failure 409 {object} httpErrors.HttpError "Событие не опубликовано"
+// @Failure 500 {object} httpErrors.HttpError "Внутренняя ошибка сервера"
+// @Router /events/{id}/cancel [post]
+func (h EventHandler) CancelEvent(w http.ResponseWriter, r *http.Request) {
+	session, ok := utils.GetSessionFromContext(r.Context())
+	if !ok {
+		utils.WriteResponse(w, http.StatusForbidden, httpErrors.ErrUnauthorized)
+		return
+	}
+
+	vars := mux.Vars(r)
+	id, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		utils.WriteResponse(w, http.StatusBadRequest, httpErrors.ErrInvalidID)
+		return
+	}
+
+	var req CancelEventRequest
+	if r.ContentLength != 0 {
+		err = easyjson.UnmarshalFromReader(r.Body, &req)
+		if err != nil {
+			utils.WriteResponse(w, http.StatusBadRequest, httpErrors.ErrInvalidData)
+			return
+		}
+	}
+
+	_, err = govalidator.ValidateStruct(&req)
+	if err != nil {
+		utils.ProcessValidationErrors(w, err)
+		return
+	}
+
+	event, err := h.EventService.CancelEvent(r.Context(), &pb.CancelEventRequest{
+		EventID:  int32(id),
+		AuthorID: int32(session.UserID),
+		Reason:   req.Reason,
+	})
+	if err != nil {
+		h.writeStatusChangeError(w, r, "cancel event", err)
+		return
+	}
+
+	err = h.sendFavoritesNotifications(r.Context(), int(event.ID), CancelledEventMsg)
+	if err != nil {
+		h.logger.Error(r.Context(), "send cancel notifications", err)
+	}
+
+	utils.WriteResponse(w, http.StatusOK, NewEventResponse{Event: eventToEventResponse(event)})
+}
+
+// @Summary Перенос события
+// @Description Переносит опубликованное событие на новое время. Для повторяющегося события передаётся время первого повторения. Добавившие событие в избранное получают уведомление.
+// @Tags events
+// @Accept  json
+// @Produce  json
+// @Param id path int true "Идентификатор события"
+// @Param json body RescheduleEventRequest true "Новое время начала и окончания"
+// @Success 200 {object} NewEventResponse
+// @Failure 400 {object} httpErrors.HttpError "Неверные данные"
+// @Failure 401 {object} httpErrors.HttpError "Неавторизован"
+// @Failure 403 {object} httpErrors.HttpError "Доступ запрещен"
+// @Failure 404 {object} httpErrors.HttpError "Событие не найдено"
+// @Failure 409 {object} httpErrors.HttpError "Событие не опубликовано"
+// @Failure 500 {object} httpErrors.HttpError "Внутренняя ошибка сервера"
+// @Router /events/{id}/reschedule [post]
+func (h EventHandler) RescheduleEvent(w http.ResponseWriter, r *http.Request) {
+	session, ok := utils.GetSessionFromContext(r.Context())
+	if !ok {
+		utils.WriteResponse(w, http.StatusForbidden, httpErrors.ErrUnauthorized)
+		return
+	}
+
+	vars := mux.Vars(r)
+	id, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		utils.WriteResponse(w, http.StatusBadRequest, httpErrors.ErrInvalidID)
+		return
+	}
+
+	var req RescheduleEventRequest
+	err = easyjson.UnmarshalFromReader(r.Body, &req)
+	if err != nil {
+		utils.WriteResponse(w, http.StatusBadRequest, httpErrors.ErrInvalidData)
+		return
+	}
+
+	_, err = govalidator.ValidateStruct(&req)
+	if err != nil {
+		utils.ProcessValidationErrors(w, err)
+		return
+	}
+
+	if _, reqErr := checkEventTiming(req.EventStart, req.EventEnd); reqErr != nil {
+		utils.WriteResponse(w, http.StatusBadRequest, reqErr)
+		return
+	}
+
+	event, err := h.EventService.RescheduleEvent(r.Context(), &pb.RescheduleEventRequest{
+		EventID:    int32(id),
+		AuthorID:   int32(session.UserID),
+		EventStart: req.EventStart,
+		EventEnd:   req.EventEnd,
+	})
+	if err != nil {
+		h.writeStatusChangeError(w, r, "reschedule event", err)
+		return
+	}
+
+	err = h.sendFavoritesNotifications(r.Context(), int(event.ID), RescheduledEventMsg)
+	if err != nil {
+		h.logger.Error(r.Context(), "send reschedule notifications", err)
+	}
+
+	utils.WriteResponse(w, http.StatusOK, NewEventResponse{Event: eventToEventResponse(event)})
+}
+
+func (h EventHandler) writeStatusChangeError(w http.ResponseWriter, r *http.Request, method string, err error) {
+	st, ok := grpcStatus.FromError(err)
+	if ok {
+		switch st.Code() {
+		case grpcCodes.NotFound:
+			utils.WriteResponse(w, http.StatusNotFound, httpErrors.ErrEventNotFound)
+			return
+		case grpcCodes.PermissionDenied:
+			utils.WriteResponse(w, http.StatusForbidden, httpErrors.ErrAccessDenied)
+			return
+		case grpcCodes.FailedPrecondition:
+			utils.WriteResponse(w, http.StatusConflict, httpErrors.ErrNotPublished)
+			return
+		case grpcCodes.InvalidArgument:
+			utils.WriteResponse(w, http.StatusBadRequest, httpErrors.ErrInvalidRecurrence)
+			return
+		}
+	}
+
+	h.logger.Error(r.Context(), method, err)
+	utils.WriteResponse(w, http.StatusInternalServerError, httpErrors.ErrInternal)
+}
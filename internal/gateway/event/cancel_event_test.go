@@ -0,0 +1,198 @@
+package events
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	pb "kudago/internal/event/api"
+	"kudago/internal/event/grpc"
+	"kudago/internal/gateway/event/mocks"
+	"kudago/internal/gateway/utils"
+	"kudago/internal/logger"
+	"kudago/internal/models"
+	pbNtf "kudago/internal/notification/api"
+
+	"github.com/golang/mock/gomock"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestEventHandler_CancelEvent(t *testing.T) {
+	t.Parallel()
+
+	logger, _ := logger.NewLogger()
+	cancelReq := &pb.CancelEventRequest{EventID: 1, AuthorID: 2, Reason: "Площадка закрыта"}
+
+	tests := []struct {
+		name      string
+		body      string
+		session   bool
+		setupFunc func(serviceMock *mocks.MockEventServiceClient, notificationMock *mocks.MockNotificationServiceClient)
+		wantCode  int
+	}{
+		{
+			name:    "Отмена и уведомление добавивших в избранное",
+			body:    `{"reason": "Площадка закрыта"}`,
+			session: true,
+			setupFunc: func(serviceMock *mocks.MockEventServiceClient, notificationMock *mocks.MockNotificationServiceClient) {
+				serviceMock.EXPECT().CancelEvent(gomock.Any(), cancelReq).
+					Return(&pb.Event{ID: 1, AuthorID: 2, Status: models.EventStatusCancelled, CancelReason: "Площадка закрыта"}, nil)
+				serviceMock.EXPECT().GetUserIDsByFavoriteEvent(gomock.Any(), &pb.GetUserIDsByFavoriteEventRequest{ID: 1}).
+					Return(&pb.GetUserIDsResponse{IDs: []int32{3, 4}}, nil)
+				notificationMock.EXPECT().CreateNotifications(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ any, req *pbNtf.CreateNotificationsRequest, _ ...any) (*pbNtf.Empty, error) {
+						assert.Equal(t, []int32{3, 4}, req.UserIDs)
+						assert.Equal(t, CancelledEventMsg, req.Notification.Message)
+						return nil, nil
+					})
+			},
+			wantCode: http.StatusOK,
+		},
+		{
+			name:    "Отмена без причины и без избранного",
+			session: true,
+			setupFunc: func(serviceMock *mocks.MockEventServiceClient, _ *mocks.MockNotificationServiceClient) {
+				serviceMock.EXPECT().CancelEvent(gomock.Any(), &pb.CancelEventRequest{EventID: 1, AuthorID: 2}).
+					Return(&pb.Event{ID: 1, AuthorID: 2, Status: models.EventStatusCancelled}, nil)
+				serviceMock.EXPECT().GetUserIDsByFavoriteEvent(gomock.Any(), &pb.GetUserIDsByFavoriteEventRequest{ID: 1}).
+					Return(&pb.GetUserIDsResponse{}, nil)
+			},
+			wantCode: http.StatusOK,
+		},
+		{
+			name:      "Без сессии",
+			body:      `{"reason": "Площадка закрыта"}`,
+			setupFunc: func(*mocks.MockEventServiceClient, *mocks.MockNotificationServiceClient) {},
+			wantCode:  http.StatusForbidden,
+		},
+		{
+			name:      "Слишком длинная причина",
+			body:      `{"reason": "` + strings.Repeat("а", 501) + `"}`,
+			session:   true,
+			setupFunc: func(*mocks.MockEventServiceClient, *mocks.MockNotificationServiceClient) {},
+			wantCode:  http.StatusBadRequest,
+		},
+		{
+			name:    "Событие уже отменено",
+			body:    `{"reason": "Площадка закрыта"}`,
+			session: true,
+			setupFunc: func(serviceMock *mocks.MockEventServiceClient, _ *mocks.MockNotificationServiceClient) {
+				serviceMock.EXPECT().CancelEvent(gomock.Any(), cancelReq).
+					Return(nil, status.Error(codes.FailedPrecondition, grpc.ErrNotPublished))
+			},
+			wantCode: http.StatusConflict,
+		},
+		{
+			name:    "Событие не найдено",
+			body:    `{"reason": "Площадка закрыта"}`,
+			session: true,
+			setupFunc: func(serviceMock *mocks.MockEventServiceClient, _ *mocks.MockNotificationServiceClient) {
+				serviceMock.EXPECT().CancelEvent(gomock.Any(), cancelReq).
+					Return(nil, status.Error(codes.NotFound, grpc.ErrEventNotFound))
+			},
+			wantCode: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			serviceMock := mocks.NewMockEventServiceClient(ctrl)
+			notificationMock := mocks.NewMockNotificationServiceClient(ctrl)
+			tt.setupFunc(serviceMock, notificationMock)
+			handler := &EventHandler{EventService: serviceMock, NotificationService: notificationMock, logger: logger}
+
+			req := httptest.NewRequest(http.MethodPost, "/events/1/cancel", strings.NewReader(tt.body))
+			req = mux.SetURLVars(req, map[string]string{"id": "1"})
+			if tt.session {
+				req = req.WithContext(utils.SetSessionInContext(req.Context(), models.Session{UserID: 2, Token: "valid_token"}))
+			}
+			recorder := httptest.NewRecorder()
+			handler.CancelEvent(recorder, req)
+
+			assert.Equal(t, tt.wantCode, recorder.Code)
+		})
+	}
+}
+
+func TestEventHandler_RescheduleEvent(t *testing.T) {
+	t.Parallel()
+
+	logger, _ := logger.NewLogger()
+	eventStart := time.Now().Add(48 * time.Hour).UTC().Truncate(time.Second)
+	newStart := eventStart.Format(time.RFC3339)
+	newEnd := eventStart.Add(2 * time.Hour).Format(time.RFC3339)
+	body := `{"event_start": "` + newStart + `", "event_end": "` + newEnd + `"}`
+
+	tests := []struct {
+		name      string
+		body      string
+		setupFunc func(serviceMock *mocks.MockEventServiceClient, notificationMock *mocks.MockNotificationServiceClient)
+		wantCode  int
+	}{
+		{
+			name: "Перенос и уведомление добавивших в избранное",
+			body: body,
+			setupFunc: func(serviceMock *mocks.MockEventServiceClient, notificationMock *mocks.MockNotificationServiceClient) {
+				serviceMock.EXPECT().RescheduleEvent(gomock.Any(), &pb.RescheduleEventRequest{EventID: 1, AuthorID: 2, EventStart: newStart, EventEnd: newEnd}).
+					Return(&pb.Event{ID: 1, AuthorID: 2, EventStart: newStart, EventEnd: newEnd, Status: models.EventStatusPublished}, nil)
+				serviceMock.EXPECT().GetUserIDsByFavoriteEvent(gomock.Any(), &pb.GetUserIDsByFavoriteEventRequest{ID: 1}).
+					Return(&pb.GetUserIDsResponse{IDs: []int32{3}}, nil)
+				notificationMock.EXPECT().CreateNotifications(gomock.Any(), gomock.Any()).Return(nil, nil)
+			},
+			wantCode: http.StatusOK,
+		},
+		{
+			name:      "Окончание раньше начала",
+			body:      `{"event_start": "` + newEnd + `", "event_end": "` + newStart + `"}`,
+			setupFunc: func(*mocks.MockEventServiceClient, *mocks.MockNotificationServiceClient) {},
+			wantCode:  http.StatusBadRequest,
+		},
+		{
+			name:      "Без нового времени",
+			body:      `{}`,
+			setupFunc: func(*mocks.MockEventServiceClient, *mocks.MockNotificationServiceClient) {},
+			wantCode:  http.StatusBadRequest,
+		},
+		{
+			name: "Чужое событие",
+			body: body,
+			setupFunc: func(serviceMock *mocks.MockEventServiceClient, _ *mocks.MockNotificationServiceClient) {
+				serviceMock.EXPECT().RescheduleEvent(gomock.Any(), gomock.Any()).
+					Return(nil, status.Error(codes.PermissionDenied, grpc.ErrPermissionDenied))
+			},
+			wantCode: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			serviceMock := mocks.NewMockEventServiceClient(ctrl)
+			notificationMock := mocks.NewMockNotificationServiceClient(ctrl)
+			tt.setupFunc(serviceMock, notificationMock)
+			handler := &EventHandler{EventService: serviceMock, NotificationService: notificationMock, logger: logger}
+
+			req := httptest.NewRequest(http.MethodPost, "/events/1/reschedule", strings.NewReader(tt.body))
+			req = mux.SetURLVars(req, map[string]string{"id": "1"})
+			req = req.WithContext(utils.SetSessionInContext(req.Context(), models.Session{UserID: 2, Token: "valid_token"}))
+			recorder := httptest.NewRecorder()
+			handler.RescheduleEvent(recorder, req)
+
+			assert.Equal(t, tt.wantCode, recorder.Code)
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package events
+
+import (
+	"net/http"
+	"strconv"
+
+	pb "kudago/internal/event/api"
+	httpErrors "kudago/internal/gateway/errors"
+	"kudago/internal/gateway/utils"
+
+	"github.com/gorilla/mux"
+	grpcCodes "google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+// @Summary Отметка о приходе участника
+// @Description Организатор отмечает, что участник пришёл на событие. Доступно после начала события
+// @Tags attendance
+// @Produce  json
+// @Param id path int true "ID события"
+// @Param user_id path int true "ID участника"
+// @Success 200
+// @Failure 401 {object} httpErrors.HttpError "Unauthorized"
+// @Failure 403 {object} httpErrors.HttpError "Access Denied"
+// @Failure 404 {object} httpErrors.HttpError "Not Attending"
+// @Failure 409 {object} httpErrors.HttpError "Event Not Started"
+// @Failure 500 {object} httpErrors.HttpError "Internal Server Error"
+// @Router /events/{id}/attendees/{user_id}/check-in [post]
+func (h EventHandler) CheckInAttendee(w http.ResponseWriter, r *http.Request) {
+	session, ok := utils.GetSessionFromContext(r.Context())
+	if !ok {
+		utils.WriteResponse(w, http.StatusForbidden, httpErrors.ErrUnauthorized)
+		return
+	}
+
+	vars := mux.Vars(r)
+	id, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		utils.WriteResponse(w, http.StatusBadRequest, httpErrors.ErrInvalidID)
+		return
+	}
+
+	userID, err := strconv.Atoi(vars["user_id"])
+	if err != nil {
+		utils.WriteResponse(w, http.StatusBadRequest, httpErrors.ErrInvalidID)
+		return
+	}
+
+	req := &pb.CheckInAttendeeRequest{
+		EventID:  int32(id),
+		UserID:   int32(userID),
+		AuthorID: int32(session.UserID),
+	}
+
+	_, err = h.EventService.CheckInAttendee(r.Context(), req)
+	if err != nil {
+		st, ok := grpcStatus.FromError(err)
+		if ok {
+			switch st.Code() {
+			case grpcCodes.NotFound:
+				utils.WriteResponse(w, http.StatusNotFound, httpErrors.ErrNotAttending)
+				return
+			case grpcCodes.PermissionDenied:
+				utils.WriteResponse(w, http.StatusForbidden, httpErrors.ErrAccessDenied)
+				return
+			case grpcCodes.FailedPrecondition:
+				utils.WriteResponse(w, http.StatusConflict, httpErrors.ErrEventNotStarted)
+				return
+			}
+		}
+
+		h.logger.Error(r.Context(), "check in attendee", err)
+		utils.WriteResponse(w, http.StatusInternalServerError, httpErrors.ErrInternal)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
@@ -0,0 +1,87 @@
+package events
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	pb "kudago/internal/event/api"
+	"kudago/internal/event/grpc"
+	"kudago/internal/gateway/event/mocks"
+	"kudago/internal/gateway/utils"
+	"kudago/internal/logger"
+	"kudago/internal/models"
+
+	"github.com/golang/mock/gomock"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestEventHandler_CheckInAttendee(t *testing.T) {
+	t.Parallel()
+
+	logger, _ := logger.NewLogger()
+
+	newRequest := func() *http.Request {
+		req := httptest.NewRequest(http.MethodPost, "/events/1/attendees/5/check-in", nil)
+		req = mux.SetURLVars(req, map[string]string{"id": "1", "user_id": "5"})
+		session := models.Session{UserID: 3, Token: "valid_token"}
+		return req.WithContext(utils.SetSessionInContext(req.Context(), session))
+	}
+
+	checkInRequest := &pb.CheckInAttendeeRequest{EventID: 1, UserID: 5, AuthorID: 3}
+
+	tests := []struct {
+		name     string
+		err      error
+		wantCode int
+	}{
+		{
+			name:     "Успешная отметка",
+			wantCode: http.StatusOK,
+		},
+		{
+			name:     "Не организатор",
+			err:      status.Error(codes.PermissionDenied, grpc.ErrPermissionDenied),
+			wantCode: http.StatusForbidden,
+		},
+		{
+			name:     "Событие ещё не началось",
+			err:      status.Error(codes.FailedPrecondition, grpc.ErrEventNotStarted),
+			wantCode: http.StatusConflict,
+		},
+		{
+			name:     "Пользователь не записан",
+			err:      status.Error(codes.NotFound, grpc.ErrNotAttending),
+			wantCode: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			serviceMock := mocks.NewMockEventServiceClient(ctrl)
+			if tt.err != nil {
+				serviceMock.EXPECT().CheckInAttendee(gomock.Any(), checkInRequest).Return(nil, tt.err)
+			} else {
+				serviceMock.EXPECT().CheckInAttendee(gomock.Any(), checkInRequest).Return(&pb.Empty{}, nil)
+			}
+
+			handler := &EventHandler{
+				EventService: serviceMock,
+				logger:       logger,
+			}
+
+			recorder := httptest.NewRecorder()
+			handler.CheckInAttendee(recorder, newRequest())
+
+			assert.Equal(t, tt.wantCode, recorder.Code)
+		})
+	}
+}
@@ -18,15 +18,21 @@ import (
 // @Accept  json
 // @Param json body CreateNotificationRequest true "Данные для создания уведомления"
 // @Success 200 {object} string "Notification created successfully"
+// @Failure 403 {object} httpErrors.HttpError "Email is not verified"
 // @Failure 500 {object} httpErrors.HttpError "Internal Server Error"
 // @Router /notifications [post]
 func (h EventHandler) CreateInvitationNotification(w http.ResponseWriter, r *http.Request) {
-	_, ok := utils.GetSessionFromContext(r.Context())
+	session, ok := utils.GetSessionFromContext(r.Context())
 	if !ok {
 		utils.WriteResponse(w, http.StatusForbidden, httpErrors.ErrUnauthorized)
 		return
 	}
 
+	if !session.Verified {
+		utils.WriteResponse(w, http.StatusForbidden, httpErrors.ErrEmailNotVerified)
+		return
+	}
+
 	req := InviteNotificationRequest{}
 	err := easyjson.UnmarshalFromReader(r.Body, &req)
 	if err != nil {
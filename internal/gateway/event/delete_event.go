@@ -43,7 +43,7 @@ func (h EventHandler) DeleteEvent(w http.ResponseWriter, r *http.Request) {
 		AuthorID: int32(authorID),
 		EventID:  int32(id),
 	}
-	_, err = h.EventService.DeleteEvent(r.Context(), req)
+	resp, err := h.EventService.DeleteEvent(r.Context(), req)
 	if err != nil {
 		st, ok := grpcStatus.FromError(err)
 		if ok {
@@ -61,4 +61,8 @@ func (h EventHandler) DeleteEvent(w http.ResponseWriter, r *http.Request) {
 		utils.WriteResponse(w, http.StatusInternalServerError, httpErrors.ErrInternal)
 		return
 	}
+
+	for _, url := range resp.GetImages() {
+		h.deleteImage(r.Context(), url)
+	}
 }
@@ -18,7 +18,10 @@ import (
 // @Description Удаляет событие из списка избранного
 // @Tags events
 // @Produce  json
+// @Param id path int true "ID события"
+// @Param occurrence query string false "Начало повторения серии в формате RFC3339; без него — всё событие"
 // @Success 200
+// @Failure 400 {object} httpErrors.HttpError "Bad Request"
 // @Failure 401 {object} httpErrors.HttpError "Unauthorized"
 // @Failure 500 {object} httpErrors.HttpError "Internal Server Error"
 // @Router /events/favorites/{id} [delete]
@@ -36,9 +39,16 @@ func (h EventHandler) DeleteEventFromFavorites(w http.ResponseWriter, r *http.Re
 		return
 	}
 
+	occurrence, reqErr := getOccurrenceParam(r)
+	if reqErr != nil {
+		utils.WriteResponse(w, http.StatusBadRequest, reqErr)
+		return
+	}
+
 	newFavorite := &pb.FavoriteEvent{
-		UserID:  int32(session.UserID),
-		EventID: int32(id),
+		UserID:          int32(session.UserID),
+		EventID:         int32(id),
+		OccurrenceStart: occurrence,
 	}
 
 	_, err = h.EventService.DeleteEventFromFavorites(r.Context(), newFavorite)
@@ -9,6 +9,7 @@ import (
 	"kudago/internal/event/grpc"
 	"kudago/internal/gateway/event/mocks"
 	"kudago/internal/gateway/utils"
+	pbImage "kudago/internal/image/api"
 	"kudago/internal/logger"
 	"kudago/internal/models"
 
@@ -46,11 +47,16 @@ func TestEventHandler_DeleteEvent(t *testing.T) {
 			}(),
 			setupFunc: func(ctrl *gomock.Controller) *EventHandler {
 				serviceMock := mocks.NewMockEventServiceClient(ctrl)
+				imageMock := mocks.NewMockImageServiceClient(ctrl)
 
-				serviceMock.EXPECT().DeleteEvent(gomock.Any(), deleteEventRequest).Return(nil, nil)
+				serviceMock.EXPECT().DeleteEvent(gomock.Any(), deleteEventRequest).
+					Return(&pb.DeleteEventResponse{Images: []string{"/images/a_full.jpg", "/images/b_full.jpg"}}, nil)
+				imageMock.EXPECT().DeleteImage(gomock.Any(), &pbImage.DeleteRequest{FileUrl: "/images/a_full.jpg"}).Return(nil, nil)
+				imageMock.EXPECT().DeleteImage(gomock.Any(), &pbImage.DeleteRequest{FileUrl: "/images/b_full.jpg"}).Return(nil, nil)
 
 				return &EventHandler{
 					EventService: serviceMock,
+					ImageService: imageMock,
 					logger:       logger,
 				}
 			},
@@ -0,0 +1,105 @@
+package events
+
+import (
+	"net/http"
+	"strconv"
+
+	pb "kudago/internal/event/api"
+	httpErrors "kudago/internal/gateway/errors"
+	"kudago/internal/gateway/utils"
+
+	"github.com/gorilla/mux"
+	grpcCodes "google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+// @Summary Публикация черновика
+// @Description Публикует черновик сразу, не дожидаясь времени публикации. Подписчики автора получают уведомление.
+// @Tags events
+// @Produce  json
+// @Param id path int true "Идентификатор события"
+// @Success 200 {object} NewEventResponse
+// @Failure 401 {object} httpErrors.HttpError "Неавторизован"
+// @Failure 403 {object} httpErrors.HttpError "Доступ запрещен"
+// @Failure 404 {object} httpErrors.HttpError "Событие не найдено"
+// @Failure 409 {object} httpErrors.HttpError "Событие уже опубликовано"
+// @Failure 500 {object} httpErrors.HttpError "Внутренняя ошибка сервера"
+// @Router /events/{id}/publish [post]
+func (h EventHandler) PublishEvent(w http.ResponseWriter, r *http.Request) {
+	session, ok := utils.GetSessionFromContext(r.Context())
+	if !ok {
+		utils.WriteResponse(w, http.StatusForbidden, httpErrors.ErrUnauthorized)
+		return
+	}
+
+	vars := mux.Vars(r)
+	id, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		utils.WriteResponse(w, http.StatusBadRequest, httpErrors.ErrInvalidID)
+		return
+	}
+
+	event, err := h.EventService.PublishEvent(r.Context(), &pb.PublishEventRequest{
+		EventID:  int32(id),
+		AuthorID: int32(session.UserID),
+	})
+	if err != nil {
+		st, ok := grpcStatus.FromError(err)
+		if ok {
+			switch st.Code() {
+			case grpcCodes.NotFound:
+				utils.WriteResponse(w, http.StatusNotFound, httpErrors.ErrEventNotFound)
+				return
+			case grpcCodes.PermissionDenied:
+				utils.WriteResponse(w, http.StatusForbidden, httpErrors.ErrAccessDenied)
+				return
+			case grpcCodes.FailedPrecondition:
+				utils.WriteResponse(w, http.StatusConflict, httpErrors.ErrNotDraft)
+				return
+			}
+		}
+
+		h.logger.Error(r.Context(), "publish event", err)
+		utils.WriteResponse(w, http.StatusInternalServerError, httpErrors.ErrInternal)
+		return
+	}
+
+	err = h.sendCreatedNotifications(r.Context(), int(event.ID), session.UserID)
+	if err != nil {
+		h.logger.Error(r.Context(), "send create notifications", err)
+	}
+
+	utils.WriteResponse(w, http.StatusOK, NewEventResponse{Event: eventToEventResponse(event)})
+}
+
+// @Summary Черновики
+// @Description Возвращает неопубликованные события текущего пользователя, сначала запланированные
+// @Tags events
+// @Produce  json
+// @Param page query int false "Номер страницы (по умолчанию 1)"
+// @Param limit query int false "Количество событий на странице (по умолчанию 30)"
+// @Success 200 {object} GetEventsResponse
+// @Failure 401 {object} httpErrors.HttpError "Неавторизован"
+// @Failure 500 {object} httpErrors.HttpError "Внутренняя ошибка сервера"
+// @Router /events/drafts [get]
+func (h EventHandler) GetDrafts(w http.ResponseWriter, r *http.Request) {
+	session, ok := utils.GetSessionFromContext(r.Context())
+	if !ok {
+		utils.WriteResponse(w, http.StatusForbidden, httpErrors.ErrUnauthorized)
+		return
+	}
+
+	paginationParams := GetPaginationParams(r)
+
+	events, err := h.EventService.GetDrafts(r.Context(), &pb.GetEventsByUserRequest{
+		UserID: int32(session.UserID),
+		Params: paginationParams,
+	})
+	if err != nil {
+		h.logger.Error(r.Context(), "get drafts", err)
+		utils.WriteResponse(w, http.StatusInternalServerError, httpErrors.ErrInternal)
+		return
+	}
+
+	utils.WriteResponse(w, http.StatusOK, writeEventsResponse(events.Events, int(paginationParams.Limit)))
+}
@@ -0,0 +1,118 @@
+package events
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	pb "kudago/internal/event/api"
+	"kudago/internal/event/grpc"
+	"kudago/internal/gateway/event/mocks"
+	"kudago/internal/gateway/utils"
+	"kudago/internal/logger"
+	"kudago/internal/models"
+
+	"github.com/golang/mock/gomock"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestEventHandler_PublishEvent(t *testing.T) {
+	t.Parallel()
+
+	logger, _ := logger.NewLogger()
+	publishReq := &pb.PublishEventRequest{EventID: 1, AuthorID: 2}
+
+	tests := []struct {
+		name      string
+		session   bool
+		setupFunc func(serviceMock *mocks.MockEventServiceClient, notificationMock *mocks.MockNotificationServiceClient)
+		wantCode  int
+	}{
+		{
+			name:    "Публикация и уведомление подписчиков",
+			session: true,
+			setupFunc: func(serviceMock *mocks.MockEventServiceClient, notificationMock *mocks.MockNotificationServiceClient) {
+				serviceMock.EXPECT().PublishEvent(gomock.Any(), publishReq).
+					Return(&pb.Event{ID: 1, AuthorID: 2, Status: models.EventStatusPublished}, nil)
+				serviceMock.EXPECT().GetSubscribersIDs(gomock.Any(), &pb.GetSubscribersIDsRequest{UserID: 2}).
+					Return(&pb.GetUserIDsResponse{IDs: []int32{3}}, nil)
+				notificationMock.EXPECT().CreateNotifications(gomock.Any(), gomock.Any()).Return(nil, nil)
+			},
+			wantCode: http.StatusOK,
+		},
+		{
+			name:      "Без сессии",
+			setupFunc: func(*mocks.MockEventServiceClient, *mocks.MockNotificationServiceClient) {},
+			wantCode:  http.StatusForbidden,
+		},
+		{
+			name:    "Событие уже опубликовано",
+			session: true,
+			setupFunc: func(serviceMock *mocks.MockEventServiceClient, _ *mocks.MockNotificationServiceClient) {
+				serviceMock.EXPECT().PublishEvent(gomock.Any(), publishReq).
+					Return(nil, status.Error(codes.FailedPrecondition, grpc.ErrNotDraft))
+			},
+			wantCode: http.StatusConflict,
+		},
+		{
+			name:    "Чужое событие",
+			session: true,
+			setupFunc: func(serviceMock *mocks.MockEventServiceClient, _ *mocks.MockNotificationServiceClient) {
+				serviceMock.EXPECT().PublishEvent(gomock.Any(), publishReq).
+					Return(nil, status.Error(codes.PermissionDenied, grpc.ErrPermissionDenied))
+			},
+			wantCode: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			serviceMock := mocks.NewMockEventServiceClient(ctrl)
+			notificationMock := mocks.NewMockNotificationServiceClient(ctrl)
+			tt.setupFunc(serviceMock, notificationMock)
+			handler := &EventHandler{EventService: serviceMock, NotificationService: notificationMock, logger: logger}
+
+			req := httptest.NewRequest(http.MethodPost, "/events/1/publish", nil)
+			req = mux.SetURLVars(req, map[string]string{"id": "1"})
+			if tt.session {
+				req = req.WithContext(utils.SetSessionInContext(req.Context(), models.Session{UserID: 2, Token: "valid_token"}))
+			}
+			recorder := httptest.NewRecorder()
+			handler.PublishEvent(recorder, req)
+
+			assert.Equal(t, tt.wantCode, recorder.Code)
+		})
+	}
+}
+
+func TestEventHandler_GetDrafts(t *testing.T) {
+	t.Parallel()
+
+	logger, _ := logger.NewLogger()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	serviceMock := mocks.NewMockEventServiceClient(ctrl)
+	serviceMock.EXPECT().GetDrafts(gomock.Any(), &pb.GetEventsByUserRequest{UserID: 2, Params: &pb.PaginationParams{Limit: 30}}).
+		Return(&pb.Events{Events: []*pb.Event{{ID: 1, Status: models.EventStatusDraft}}}, nil)
+	handler := &EventHandler{EventService: serviceMock, logger: logger}
+
+	req := httptest.NewRequest(http.MethodGet, "/events/drafts", nil)
+	req = req.WithContext(utils.SetSessionInContext(req.Context(), models.Session{UserID: 2, Token: "valid_token"}))
+	recorder := httptest.NewRecorder()
+	handler.GetDrafts(recorder, req)
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	var resp GetEventsResponse
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp))
+	assert.Equal(t, GetEventsResponse{Events: []EventResponse{{ID: 1, Status: models.EventStatusDraft}}}, resp)
+}
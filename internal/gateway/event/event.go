@@ -1,5 +1,6 @@
 //go:generate mockgen -source=../../event/api/event_grpc.pb.go -destination=mocks/event.go -package=mocks
 //go:generate mockgen -source=../../notification/api/notification_grpc.pb.go -destination=mocks/notification.go -package=mocks
+//go:generate mockgen -source=../utils/image.go -destination=mocks/image.go -package=mocks
 
 //go:generate easyjson event.go
 package events
@@ -11,6 +12,7 @@ import (
 	"time"
 
 	pbEvent "kudago/internal/event/api"
+	"kudago/internal/event/recurrence"
 	httpErrors "kudago/internal/gateway/errors"
 	"kudago/internal/gateway/utils"
 	pbImage "kudago/internal/image/api"
@@ -20,21 +22,27 @@ import (
 	"kudago/internal/models"
 
 	"google.golang.org/grpc"
+	grpcCodes "google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
+	grpcStatus "google.golang.org/grpc/status"
 )
 
 const (
 	defaultPage  = 0
 	defaultLimit = 30
 
-	UpdatedEventMsg = "Информация о событии обновилась. Посмотреть тут:"
-	CreatedEventMsg = "У вас новое мероприятие в подписках! . Посмотреть тут:"
-	InvitationMsg   = "Вас пригласили на новое мероприятие: "
+	UpdatedEventMsg     = "Информация о событии обновилась. Посмотреть тут:"
+	CreatedEventMsg     = models.CreatedEventMessage
+	InvitationMsg       = "Вас пригласили на новое мероприятие: "
+	CancelledEventMsg   = "Мероприятие отменено. Подробнее тут:"
+	RescheduledEventMsg = "Мероприятие перенесено на другое время. Посмотреть тут:"
+	// WaitlistPromotedMsg is formatted with the end of the claim window.
+	WaitlistPromotedMsg = models.WaitlistPromotedMessage
 )
 
 type EventHandler struct {
 	EventService        pbEvent.EventServiceClient
-	ImageService        pbImage.ImageServiceClient
+	ImageService        utils.ImageServiceClient
 	NotificationService pbNotification.NotificationServiceClient
 	logger              *logger.Logger
 }
@@ -80,6 +88,39 @@ type EventResponse struct {
 	ImageURL    string   `json:"image"`
 	Latitude    float64  `json:"Latitude"`
 	Longitude   float64  `json:"Longitude"`
+	Attendees   int      `json:"attendees"`
+
+	ImageWebP            string               `json:"image_webp,omitempty"`
+	FavoritesCount       int                  `json:"favorites_count"`
+	Status               string               `json:"status"`
+	PublishAt            string               `json:"publish_at,omitempty"`
+	CancelReason         string               `json:"cancel_reason,omitempty"`
+	Images               []EventImageResponse `json:"images,omitempty"`
+	TitleHighlight       string               `json:"title_highlight,omitempty"`
+	DescriptionHighlight string               `json:"description_highlight,omitempty"`
+	Distance             *float64             `json:"distance,omitempty"`
+	RecurrenceRule       string               `json:"recurrence_rule,omitempty"`
+	RecurrenceExceptions []string             `json:"recurrence_exceptions,omitempty"`
+}
+
+//easyjson:json
+type EventImageResponse struct {
+	ID      int    `json:"id"`
+	URL     string `json:"url"`
+	Thumb   string `json:"thumb"`
+	IsCover bool   `json:"is_cover"`
+}
+
+//easyjson:json
+type EventImagesResponse struct {
+	Images []EventImageResponse `json:"images"`
+}
+
+//easyjson:json
+type UpdateEventImagesRequest struct {
+	// Order lists every image ID of the event in display order.
+	Order   []int `json:"order"`
+	CoverID int   `json:"cover_id"`
 }
 
 //easyjson:json
@@ -90,7 +131,48 @@ type InviteNotificationRequest struct {
 
 //easyjson:json
 type GetEventsResponse struct {
-	Events []EventResponse `json:"events"`
+	Events     []EventResponse       `json:"events"`
+	NextCursor string                `json:"next_cursor,omitempty"`
+	Facets     *SearchFacetsResponse `json:"facets,omitempty"`
+}
+
+type SearchFacetsResponse struct {
+	Categories []CategoryFacetResponse `json:"categories"`
+	Tags       []TagFacetResponse      `json:"tags"`
+	Dates      DateFacetsResponse      `json:"dates"`
+}
+
+type CategoryFacetResponse struct {
+	CategoryID int `json:"category_id"`
+	Count      int `json:"count"`
+}
+
+type TagFacetResponse struct {
+	Tag   string `json:"tag"`
+	Count int    `json:"count"`
+}
+
+type DateFacetsResponse struct {
+	Today       int `json:"today"`
+	ThisWeekend int `json:"this_weekend"`
+	NextWeek    int `json:"next_week"`
+}
+
+//easyjson:json
+type SuggestionsResponse struct {
+	Events []EventSuggestionResponse `json:"events"`
+	Tags   []TagSuggestionResponse   `json:"tags"`
+}
+
+type EventSuggestionResponse struct {
+	ID             int    `json:"id"`
+	Title          string `json:"title"`
+	FavoritesCount int    `json:"favorites_count"`
+}
+
+type TagSuggestionResponse struct {
+	Name           string `json:"name"`
+	FavoritesCount int    `json:"favorites_count"`
 }
 
 //easyjson:json
@@ -105,6 +187,25 @@ type NewEventRequest struct {
 	EventEnd    string   `json:"event_end" valid:"rfc3339,required"`
 	Latitude    float64  `json:"Latitude"`
 	Longitude   float64  `json:"Longitude"`
+
+	// RecurrenceRule is an RFC 5545 RRULE such as "FREQ=WEEKLY;BYDAY=MO,TH;COUNT=10".
+	RecurrenceRule string `json:"recurrence_rule"`
+	// RecurrenceExceptions are RFC 3339 starts of skipped occurrences.
+	RecurrenceExceptions []string `json:"recurrence_exceptions"`
+
+	// Status is "draft" or "published", the default. Updates keep the current
+	// status; drafts are published with PublishEvent.
+	Status string `json:"status"`
+	// PublishAt is the RFC 3339 time a draft gets published automatically,
+	// accepted only together with the draft status.
+	PublishAt string `json:"publish_at"`
+}
+
+//easyjson:json
+type CalendarTokenResponse struct {
+	Token string `json:"token"`
+	// URL is the feed path to subscribe to in a calendar app.
+	URL string `json:"url"`
 }
 
 //easyjson:json
@@ -123,11 +224,110 @@ type NotificationWithEvent struct {
 	Event        models.Event        `json:"event"`
 }
 
+//easyjson:json
+type NewTicketTypeRequest struct {
+	Name  string  `json:"name" valid:"required,length(1|50)"`
+	Price float64 `json:"price" valid:"range(0|1000000)"`
+}
+
+//easyjson:json
+type TicketTypeResponse struct {
+	ID      int     `json:"id"`
+	EventID int     `json:"event_id"`
+	Name    string  `json:"name"`
+	Price   float64 `json:"price"`
+}
+
+//easyjson:json
+type TicketTypesResponse struct {
+	Types     []TicketTypeResponse `json:"types"`
+	Remaining *int                 `json:"remaining"`
+}
+
+//easyjson:json
+type CancelEventRequest struct {
+	Reason string `json:"reason" valid:"length(0|500)"`
+}
+
+//easyjson:json
+type RescheduleEventRequest struct {
+	EventStart string `json:"event_start" valid:"required"`
+	EventEnd   string `json:"event_end" valid:"required"`
+}
+
+//easyjson:json
+type ReserveTicketsRequest struct {
+	TicketTypeID int `json:"type_id" valid:"required,range(1|1000000)"`
+	Quantity     int `json:"quantity" valid:"required,range(1|10)"`
+}
+
+//easyjson:json
+type TicketResponse struct {
+	ID            int     `json:"id"`
+	EventID       int     `json:"event_id"`
+	TicketTypeID  int     `json:"type_id"`
+	Type          string  `json:"type"`
+	Price         float64 `json:"price"`
+	Quantity      int     `json:"quantity"`
+	Status        string  `json:"status"`
+	ReservedUntil string  `json:"reserved_until,omitempty"`
+	BoughtAt      string  `json:"bought_at,omitempty"`
+}
+
+//easyjson:json
+type TicketsResponse struct {
+	Tickets []TicketResponse `json:"tickets"`
+}
+
+//easyjson:json
+type AttendanceResponse struct {
+	Status   string `json:"status"`
+	Position int    `json:"position,omitempty"`
+}
+
+//easyjson:json
+type AttendeeResponse struct {
+	UserID      int    `json:"user_id"`
+	Username    string `json:"username"`
+	ImageURL    string `json:"image"`
+	RSVPAt      string `json:"rsvp_at"`
+	CheckedInAt string `json:"checked_in_at,omitempty"`
+}
+
+//easyjson:json
+type AttendeesResponse struct {
+	Attendees []AttendeeResponse `json:"attendees"`
+}
+
 //easyjson:json
 type GetCategoriesResponse struct {
 	Categories []models.Category `json:"categories"`
 }
 
+// checkEventTiming validates the start and finish of an event and returns
+// the parsed start.
+func checkEventTiming(start, end string) (time.Time, *httpErrors.HttpError) {
+	eventStart, err := time.Parse(time.RFC3339, start)
+	if err != nil {
+		return time.Time{}, httpErrors.ErrInvalidTime
+	}
+
+	eventEnd, err := time.Parse(time.RFC3339, end)
+	if err != nil {
+		return time.Time{}, httpErrors.ErrInvalidTime
+	}
+
+	if !eventEnd.After(eventStart) {
+		return time.Time{}, httpErrors.ErrEventStartAfterEventEnd
+	}
+
+	if eventStart.Before(time.Now()) || eventEnd.After(maxDate) {
+		return time.Time{}, httpErrors.ErrBadEventTiming
+	}
+
+	return eventStart, nil
+}
+
 func checkNewEventRequest(req NewEventRequest) *httpErrors.HttpError {
 	if len(req.Tag) > 3 {
 		return httpErrors.ErrTooManyTags
@@ -139,38 +339,80 @@ func checkNewEventRequest(req NewEventRequest) *httpErrors.HttpError {
 		}
 	}
 
-	eventStart, err := time.Parse(time.RFC3339, req.EventStart)
-	if err != nil {
-		return httpErrors.ErrInvalidTime
+	eventStart, reqErr := checkEventTiming(req.EventStart, req.EventEnd)
+	if reqErr != nil {
+		return reqErr
 	}
 
-	eventEnd, err := time.Parse(time.RFC3339, req.EventEnd)
-	if err != nil {
-		return httpErrors.ErrInvalidTime
+	if req.RecurrenceRule != "" {
+		if _, err := recurrence.Parse(req.RecurrenceRule); err != nil {
+			return httpErrors.ErrInvalidRecurrence
+		}
 	}
 
-	if !eventEnd.After(eventStart) {
-		return httpErrors.ErrEventStartAfterEventEnd
+	for _, exception := range req.RecurrenceExceptions {
+		if _, err := time.Parse(time.RFC3339, exception); err != nil {
+			return httpErrors.ErrInvalidRecurrence
+		}
 	}
 
-	if eventStart.Before(time.Now()) || eventEnd.After(maxDate) {
-		return httpErrors.ErrBadEventTiming
+	switch req.Status {
+	case "", models.EventStatusPublished:
+		if req.PublishAt != "" {
+			return httpErrors.ErrInvalidStatus
+		}
+	case models.EventStatusDraft:
+	default:
+		return httpErrors.ErrInvalidStatus
+	}
+
+	if req.PublishAt != "" {
+		publishAt, err := time.Parse(time.RFC3339, req.PublishAt)
+		if err != nil {
+			return httpErrors.ErrInvalidTime
+		}
+		if !publishAt.Before(eventStart) {
+			return httpErrors.ErrBadEventTiming
+		}
 	}
 
 	return nil
 }
 
-func parseEventData(r *http.Request) (NewEventRequest, *pbImage.UploadRequest, *httpErrors.HttpError) {
+// eventByIDRequest asks for the event on behalf of the requester, so that
+// the event service shows drafts to their author only.
+func eventByIDRequest(r *http.Request, id int) *pbEvent.GetEventByIDRequest {
+	req := &pbEvent.GetEventByIDRequest{ID: int32(id)}
+	if session, ok := utils.GetSessionFromContext(r.Context()); ok {
+		req.UserID = int32(session.UserID)
+	}
+	return req
+}
+
+// getOccurrenceParam reads the start of a single occurrence of a series,
+// empty when the request is about the whole event.
+func getOccurrenceParam(r *http.Request) (string, *httpErrors.HttpError) {
+	occurrence := r.URL.Query().Get("occurrence")
+	if occurrence == "" {
+		return "", nil
+	}
+
+	if _, err := time.Parse(time.RFC3339, occurrence); err != nil {
+		return "", httpErrors.ErrInvalidTime
+	}
+	return occurrence, nil
+}
+
+func parseEventData(w http.ResponseWriter, r *http.Request) (NewEventRequest, *utils.ImageUpload, *httpErrors.HttpError) {
 	var req NewEventRequest
-	jsonData := r.FormValue("json")
-	err := req.UnmarshalJSON([]byte(jsonData))
+	jsonData, media, err := utils.ReadImageForm(w, r)
 	if err != nil {
-		return req, nil, httpErrors.ErrInvalidData
+		return req, nil, httpErrors.ErrInvalidImage
 	}
 
-	media, err := utils.HandleImageUpload(r)
+	err = req.UnmarshalJSON(jsonData)
 	if err != nil {
-		return req, nil, httpErrors.ErrInvalidImage
+		return req, nil, httpErrors.ErrInvalidData
 	}
 
 	return req, media, nil
@@ -203,11 +445,17 @@ func toPBEvent(req NewEventRequest, authorID int) *pbEvent.Event {
 		Tag:         req.Tag,
 		Latitude:    float64(req.Latitude),
 		Longitude:   float64(req.Longitude),
+		Status:      req.Status,
+		PublishAt:   req.PublishAt,
+
+		RecurrenceRule:       req.RecurrenceRule,
+		RecurrenceExceptions: req.RecurrenceExceptions,
 	}
 }
 
 func toEvent(event *pbEvent.Event) models.Event {
 	return models.Event{
+		ID:          int(event.ID),
 		Title:       event.Title,
 		Description: event.Description,
 		Location:    event.Location,
@@ -217,6 +465,14 @@ func toEvent(event *pbEvent.Event) models.Event {
 		CategoryID:  int(event.CategoryID),
 		Capacity:    int(event.Capacity),
 		Tag:         event.Tag,
+		Latitude:    event.Latitude,
+		Longitude:   event.Longitude,
+		Status:      event.Status,
+
+		RecurrenceRule:       event.RecurrenceRule,
+		RecurrenceExceptions: event.RecurrenceExceptions,
+		SeriesStart:          event.SeriesStart,
+		SavedOccurrence:      event.SavedOccurrence,
 	}
 }
 
@@ -230,23 +486,68 @@ func eventToEventResponse(event *pbEvent.Event) EventResponse {
 		Tag:         event.Tag,
 		AuthorID:    int(event.AuthorID),
 		Category:    int(event.CategoryID),
-		ImageURL:    event.Image,
+		ImageURL:    models.ImageVariantURL(event.Image, models.ImageFull, models.ImageFormatJPEG),
+		ImageWebP:   models.ImageVariantURL(event.Image, models.ImageFull, models.ImageFormatWebP),
 		Capacity:    int(event.Capacity),
 		Longitude:   float64(event.Longitude),
 		Latitude:    float64(event.Latitude),
+		Attendees:   int(event.Attendees),
+
+		FavoritesCount:       int(event.FavoritesCount),
+		Status:               event.Status,
+		PublishAt:            event.PublishAt,
+		CancelReason:         event.CancelReason,
+		Images:               eventImagesToResponse(event.Images),
+		TitleHighlight:       event.TitleHighlight,
+		DescriptionHighlight: event.DescriptionHighlight,
+		Distance:             event.Distance,
+		RecurrenceRule:       event.RecurrenceRule,
+		RecurrenceExceptions: event.RecurrenceExceptions,
 	}
 }
 
 func writeEventsResponse(events []*pbEvent.Event, limit int) GetEventsResponse {
-	resp := GetEventsResponse{make([]EventResponse, 0, limit)}
+	resp := GetEventsResponse{Events: make([]EventResponse, 0, limit)}
 
 	for _, event := range events {
+		// Lists show events as cards, so they get the card sized image.
 		eventResp := eventToEventResponse(event)
+		eventResp.ImageURL = models.ImageVariantURL(event.Image, models.ImageCard, models.ImageFormatJPEG)
+		eventResp.ImageWebP = models.ImageVariantURL(event.Image, models.ImageCard, models.ImageFormatWebP)
 		resp.Events = append(resp.Events, eventResp)
 	}
 	return resp
 }
 
+func writeCursorEventsResponse(events *pbEvent.Events, limit int) GetEventsResponse {
+	resp := writeEventsResponse(events.Events, limit)
+	resp.NextCursor = events.NextCursor
+	return resp
+}
+
+func ticketTypeToTicketTypeResponse(ticketType *pbEvent.TicketType) TicketTypeResponse {
+	return TicketTypeResponse{
+		ID:      int(ticketType.ID),
+		EventID: int(ticketType.EventID),
+		Name:    ticketType.Name,
+		Price:   ticketType.Price,
+	}
+}
+
+func ticketToTicketResponse(ticket *pbEvent.Ticket) TicketResponse {
+	return TicketResponse{
+		ID:            int(ticket.ID),
+		EventID:       int(ticket.EventID),
+		TicketTypeID:  int(ticket.TicketTypeID),
+		Type:          ticket.Type,
+		Price:         ticket.Price,
+		Quantity:      int(ticket.Quantity),
+		Status:        ticket.Status,
+		ReservedUntil: ticket.ReservedUntil,
+		BoughtAt:      ticket.BoughtAt,
+	}
+}
+
 func GetQueryParamInt(r *http.Request, key string, defaultValue int) int {
 	valueStr := r.URL.Query().Get(key)
 	value, err := strconv.Atoi(valueStr)
@@ -264,6 +565,7 @@ func GetPaginationParams(r *http.Request) *pbEvent.PaginationParams {
 	return &pbEvent.PaginationParams{
 		Offset: int32(offset),
 		Limit:  int32(limit),
+		Cursor: r.URL.Query().Get("cursor"),
 	}
 }
 
@@ -276,14 +578,16 @@ func (h *EventHandler) deleteImage(ctx context.Context, url string) {
 	}
 }
 
-func (h *EventHandler) uploadImage(ctx context.Context, media *pbImage.UploadRequest, w http.ResponseWriter) (string, error) {
+func (h *EventHandler) uploadImage(ctx context.Context, media *utils.ImageUpload, w http.ResponseWriter) (string, error) {
 	if media != nil {
-		url, err := h.ImageService.UploadImage(ctx, media)
+		url, err := utils.UploadImage(ctx, h.ImageService, media)
 		if err != nil {
-			switch err {
-			case models.ErrInvalidImage:
+			switch {
+			case grpcStatus.Code(err) == grpcCodes.InvalidArgument, err == models.ErrInvalidImage:
 				utils.WriteResponse(w, http.StatusBadRequest, httpErrors.ErrInvalidImage)
-			case models.ErrInvalidImageFormat:
+			case grpcStatus.Code(err) == grpcCodes.ResourceExhausted, err == models.ErrImageTooLarge:
+				utils.WriteResponse(w, http.StatusRequestEntityTooLarge, httpErrors.ErrImageTooLarge)
+			case err == models.ErrInvalidImageFormat:
 				utils.WriteResponse(w, http.StatusBadRequest, httpErrors.ErrInvalidImageFormat)
 			default:
 				h.logger.Error(ctx, "upload image", err)
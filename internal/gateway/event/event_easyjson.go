@@ -18,7 +18,924 @@ var (
 	_ easyjson.Marshaler
 )
 
-func easyjsonF642ad3eDecodeKudagoInternalGatewayEvent(in *jlexer.Lexer, out *NotificationWithEvent) {
+func easyjsonF642ad3eDecodeKudagoInternalGatewayEvent(in *jlexer.Lexer, out *UpdateEventImagesRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "order":
+			if in.IsNull() {
+				in.Skip()
+				out.Order = nil
+			} else {
+				in.Delim('[')
+				if out.Order == nil {
+					if !in.IsDelim(']') {
+						out.Order = make([]int, 0, 8)
+					} else {
+						out.Order = []int{}
+					}
+				} else {
+					out.Order = (out.Order)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 int
+					v1 = int(in.Int())
+					out.Order = append(out.Order, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "cover_id":
+			out.CoverID = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonF642ad3eEncodeKudagoInternalGatewayEvent(out *jwriter.Writer, in UpdateEventImagesRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"order\":"
+		out.RawString(prefix[1:])
+		if in.Order == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v2, v3 := range in.Order {
+				if v2 > 0 {
+					out.RawByte(',')
+				}
+				out.Int(int(v3))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"cover_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.CoverID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v UpdateEventImagesRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonF642ad3eEncodeKudagoInternalGatewayEvent(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v UpdateEventImagesRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonF642ad3eEncodeKudagoInternalGatewayEvent(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *UpdateEventImagesRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonF642ad3eDecodeKudagoInternalGatewayEvent(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *UpdateEventImagesRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonF642ad3eDecodeKudagoInternalGatewayEvent(l, v)
+}
+func easyjsonF642ad3eDecodeKudagoInternalGatewayEvent1(in *jlexer.Lexer, out *TicketsResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "tickets":
+			if in.IsNull() {
+				in.Skip()
+				out.Tickets = nil
+			} else {
+				in.Delim('[')
+				if out.Tickets == nil {
+					if !in.IsDelim(']') {
+						out.Tickets = make([]TicketResponse, 0, 0)
+					} else {
+						out.Tickets = []TicketResponse{}
+					}
+				} else {
+					out.Tickets = (out.Tickets)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v4 TicketResponse
+					(v4).UnmarshalEasyJSON(in)
+					out.Tickets = append(out.Tickets, v4)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonF642ad3eEncodeKudagoInternalGatewayEvent1(out *jwriter.Writer, in TicketsResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"tickets\":"
+		out.RawString(prefix[1:])
+		if in.Tickets == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v5, v6 := range in.Tickets {
+				if v5 > 0 {
+					out.RawByte(',')
+				}
+				(v6).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v TicketsResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonF642ad3eEncodeKudagoInternalGatewayEvent1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v TicketsResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonF642ad3eEncodeKudagoInternalGatewayEvent1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *TicketsResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonF642ad3eDecodeKudagoInternalGatewayEvent1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *TicketsResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonF642ad3eDecodeKudagoInternalGatewayEvent1(l, v)
+}
+func easyjsonF642ad3eDecodeKudagoInternalGatewayEvent2(in *jlexer.Lexer, out *TicketTypesResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "types":
+			if in.IsNull() {
+				in.Skip()
+				out.Types = nil
+			} else {
+				in.Delim('[')
+				if out.Types == nil {
+					if !in.IsDelim(']') {
+						out.Types = make([]TicketTypeResponse, 0, 1)
+					} else {
+						out.Types = []TicketTypeResponse{}
+					}
+				} else {
+					out.Types = (out.Types)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v7 TicketTypeResponse
+					(v7).UnmarshalEasyJSON(in)
+					out.Types = append(out.Types, v7)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "remaining":
+			if in.IsNull() {
+				in.Skip()
+				out.Remaining = nil
+			} else {
+				if out.Remaining == nil {
+					out.Remaining = new(int)
+				}
+				*out.Remaining = int(in.Int())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonF642ad3eEncodeKudagoInternalGatewayEvent2(out *jwriter.Writer, in TicketTypesResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"types\":"
+		out.RawString(prefix[1:])
+		if in.Types == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v8, v9 := range in.Types {
+				if v8 > 0 {
+					out.RawByte(',')
+				}
+				(v9).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"remaining\":"
+		out.RawString(prefix)
+		if in.Remaining == nil {
+			out.RawString("null")
+		} else {
+			out.Int(int(*in.Remaining))
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v TicketTypesResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonF642ad3eEncodeKudagoInternalGatewayEvent2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v TicketTypesResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonF642ad3eEncodeKudagoInternalGatewayEvent2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *TicketTypesResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonF642ad3eDecodeKudagoInternalGatewayEvent2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *TicketTypesResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonF642ad3eDecodeKudagoInternalGatewayEvent2(l, v)
+}
+func easyjsonF642ad3eDecodeKudagoInternalGatewayEvent3(in *jlexer.Lexer, out *TicketTypeResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = int(in.Int())
+		case "event_id":
+			out.EventID = int(in.Int())
+		case "name":
+			out.Name = string(in.String())
+		case "price":
+			out.Price = float64(in.Float64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonF642ad3eEncodeKudagoInternalGatewayEvent3(out *jwriter.Writer, in TicketTypeResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"event_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.EventID))
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"price\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Price))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v TicketTypeResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonF642ad3eEncodeKudagoInternalGatewayEvent3(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v TicketTypeResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonF642ad3eEncodeKudagoInternalGatewayEvent3(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *TicketTypeResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonF642ad3eDecodeKudagoInternalGatewayEvent3(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *TicketTypeResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonF642ad3eDecodeKudagoInternalGatewayEvent3(l, v)
+}
+func easyjsonF642ad3eDecodeKudagoInternalGatewayEvent4(in *jlexer.Lexer, out *TicketResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = int(in.Int())
+		case "event_id":
+			out.EventID = int(in.Int())
+		case "type_id":
+			out.TicketTypeID = int(in.Int())
+		case "type":
+			out.Type = string(in.String())
+		case "price":
+			out.Price = float64(in.Float64())
+		case "quantity":
+			out.Quantity = int(in.Int())
+		case "status":
+			out.Status = string(in.String())
+		case "reserved_until":
+			out.ReservedUntil = string(in.String())
+		case "bought_at":
+			out.BoughtAt = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonF642ad3eEncodeKudagoInternalGatewayEvent4(out *jwriter.Writer, in TicketResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"event_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.EventID))
+	}
+	{
+		const prefix string = ",\"type_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.TicketTypeID))
+	}
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix)
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"price\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Price))
+	}
+	{
+		const prefix string = ",\"quantity\":"
+		out.RawString(prefix)
+		out.Int(int(in.Quantity))
+	}
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix)
+		out.String(string(in.Status))
+	}
+	if in.ReservedUntil != "" {
+		const prefix string = ",\"reserved_until\":"
+		out.RawString(prefix)
+		out.String(string(in.ReservedUntil))
+	}
+	if in.BoughtAt != "" {
+		const prefix string = ",\"bought_at\":"
+		out.RawString(prefix)
+		out.String(string(in.BoughtAt))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v TicketResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonF642ad3eEncodeKudagoInternalGatewayEvent4(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v TicketResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonF642ad3eEncodeKudagoInternalGatewayEvent4(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *TicketResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonF642ad3eDecodeKudagoInternalGatewayEvent4(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *TicketResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonF642ad3eDecodeKudagoInternalGatewayEvent4(l, v)
+}
+func easyjsonF642ad3eDecodeKudagoInternalGatewayEvent5(in *jlexer.Lexer, out *SuggestionsResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "events":
+			if in.IsNull() {
+				in.Skip()
+				out.Events = nil
+			} else {
+				in.Delim('[')
+				if out.Events == nil {
+					if !in.IsDelim(']') {
+						out.Events = make([]EventSuggestionResponse, 0, 2)
+					} else {
+						out.Events = []EventSuggestionResponse{}
+					}
+				} else {
+					out.Events = (out.Events)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v10 EventSuggestionResponse
+					easyjsonF642ad3eDecodeKudagoInternalGatewayEvent6(in, &v10)
+					out.Events = append(out.Events, v10)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "tags":
+			if in.IsNull() {
+				in.Skip()
+				out.Tags = nil
+			} else {
+				in.Delim('[')
+				if out.Tags == nil {
+					if !in.IsDelim(']') {
+						out.Tags = make([]TagSuggestionResponse, 0, 2)
+					} else {
+						out.Tags = []TagSuggestionResponse{}
+					}
+				} else {
+					out.Tags = (out.Tags)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v11 TagSuggestionResponse
+					easyjsonF642ad3eDecodeKudagoInternalGatewayEvent7(in, &v11)
+					out.Tags = append(out.Tags, v11)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonF642ad3eEncodeKudagoInternalGatewayEvent5(out *jwriter.Writer, in SuggestionsResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"events\":"
+		out.RawString(prefix[1:])
+		if in.Events == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v12, v13 := range in.Events {
+				if v12 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonF642ad3eEncodeKudagoInternalGatewayEvent6(out, v13)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"tags\":"
+		out.RawString(prefix)
+		if in.Tags == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v14, v15 := range in.Tags {
+				if v14 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonF642ad3eEncodeKudagoInternalGatewayEvent7(out, v15)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v SuggestionsResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonF642ad3eEncodeKudagoInternalGatewayEvent5(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v SuggestionsResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonF642ad3eEncodeKudagoInternalGatewayEvent5(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *SuggestionsResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonF642ad3eDecodeKudagoInternalGatewayEvent5(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *SuggestionsResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonF642ad3eDecodeKudagoInternalGatewayEvent5(l, v)
+}
+func easyjsonF642ad3eDecodeKudagoInternalGatewayEvent7(in *jlexer.Lexer, out *TagSuggestionResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "name":
+			out.Name = string(in.String())
+		case "favorites_count":
+			out.FavoritesCount = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonF642ad3eEncodeKudagoInternalGatewayEvent7(out *jwriter.Writer, in TagSuggestionResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"favorites_count\":"
+		out.RawString(prefix)
+		out.Int(int(in.FavoritesCount))
+	}
+	out.RawByte('}')
+}
+func easyjsonF642ad3eDecodeKudagoInternalGatewayEvent6(in *jlexer.Lexer, out *EventSuggestionResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = int(in.Int())
+		case "title":
+			out.Title = string(in.String())
+		case "favorites_count":
+			out.FavoritesCount = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonF642ad3eEncodeKudagoInternalGatewayEvent6(out *jwriter.Writer, in EventSuggestionResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"title\":"
+		out.RawString(prefix)
+		out.String(string(in.Title))
+	}
+	{
+		const prefix string = ",\"favorites_count\":"
+		out.RawString(prefix)
+		out.Int(int(in.FavoritesCount))
+	}
+	out.RawByte('}')
+}
+func easyjsonF642ad3eDecodeKudagoInternalGatewayEvent8(in *jlexer.Lexer, out *ReserveTicketsRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "type_id":
+			out.TicketTypeID = int(in.Int())
+		case "quantity":
+			out.Quantity = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonF642ad3eEncodeKudagoInternalGatewayEvent8(out *jwriter.Writer, in ReserveTicketsRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"type_id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.TicketTypeID))
+	}
+	{
+		const prefix string = ",\"quantity\":"
+		out.RawString(prefix)
+		out.Int(int(in.Quantity))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ReserveTicketsRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonF642ad3eEncodeKudagoInternalGatewayEvent8(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ReserveTicketsRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonF642ad3eEncodeKudagoInternalGatewayEvent8(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ReserveTicketsRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonF642ad3eDecodeKudagoInternalGatewayEvent8(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ReserveTicketsRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonF642ad3eDecodeKudagoInternalGatewayEvent8(l, v)
+}
+func easyjsonF642ad3eDecodeKudagoInternalGatewayEvent9(in *jlexer.Lexer, out *RescheduleEventRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "event_start":
+			out.EventStart = string(in.String())
+		case "event_end":
+			out.EventEnd = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonF642ad3eEncodeKudagoInternalGatewayEvent9(out *jwriter.Writer, in RescheduleEventRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"event_start\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.EventStart))
+	}
+	{
+		const prefix string = ",\"event_end\":"
+		out.RawString(prefix)
+		out.String(string(in.EventEnd))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v RescheduleEventRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonF642ad3eEncodeKudagoInternalGatewayEvent9(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v RescheduleEventRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonF642ad3eEncodeKudagoInternalGatewayEvent9(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *RescheduleEventRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonF642ad3eDecodeKudagoInternalGatewayEvent9(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *RescheduleEventRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonF642ad3eDecodeKudagoInternalGatewayEvent9(l, v)
+}
+func easyjsonF642ad3eDecodeKudagoInternalGatewayEvent10(in *jlexer.Lexer, out *NotificationWithEvent) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -40,7 +957,1228 @@ func easyjsonF642ad3eDecodeKudagoInternalGatewayEvent(in *jlexer.Lexer, out *Not
 		case "notification":
 			(out.Notification).UnmarshalEasyJSON(in)
 		case "event":
-			easyjsonF642ad3eDecodeKudagoInternalModels(in, &out.Event)
+			easyjsonF642ad3eDecodeKudagoInternalModels(in, &out.Event)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonF642ad3eEncodeKudagoInternalGatewayEvent10(out *jwriter.Writer, in NotificationWithEvent) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"notification\":"
+		out.RawString(prefix[1:])
+		(in.Notification).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"event\":"
+		out.RawString(prefix)
+		easyjsonF642ad3eEncodeKudagoInternalModels(out, in.Event)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v NotificationWithEvent) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonF642ad3eEncodeKudagoInternalGatewayEvent10(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v NotificationWithEvent) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonF642ad3eEncodeKudagoInternalGatewayEvent10(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *NotificationWithEvent) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonF642ad3eDecodeKudagoInternalGatewayEvent10(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *NotificationWithEvent) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonF642ad3eDecodeKudagoInternalGatewayEvent10(l, v)
+}
+func easyjsonF642ad3eDecodeKudagoInternalModels(in *jlexer.Lexer, out *models.Event) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = int(in.Int())
+		case "title":
+			out.Title = string(in.String())
+		case "description":
+			out.Description = string(in.String())
+		case "event_start":
+			out.EventStart = string(in.String())
+		case "event_finish":
+			out.EventEnd = string(in.String())
+		case "location":
+			out.Location = string(in.String())
+		case "capacity":
+			out.Capacity = int(in.Int())
+		case "created_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		case "category_id":
+			out.CategoryID = int(in.Int())
+		case "author":
+			out.AuthorID = int(in.Int())
+		case "Latitude":
+			out.Latitude = float64(in.Float64())
+		case "Longitude":
+			out.Longitude = float64(in.Float64())
+		case "tag":
+			if in.IsNull() {
+				in.Skip()
+				out.Tag = nil
+			} else {
+				in.Delim('[')
+				if out.Tag == nil {
+					if !in.IsDelim(']') {
+						out.Tag = make([]string, 0, 4)
+					} else {
+						out.Tag = []string{}
+					}
+				} else {
+					out.Tag = (out.Tag)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v16 string
+					v16 = string(in.String())
+					out.Tag = append(out.Tag, v16)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "image":
+			out.ImageURL = string(in.String())
+		case "attendees":
+			out.Attendees = int(in.Int())
+		case "favorites_count":
+			out.FavoritesCount = int(in.Int())
+		case "status":
+			out.Status = string(in.String())
+		case "publish_at":
+			out.PublishAt = string(in.String())
+		case "cancel_reason":
+			out.CancelReason = string(in.String())
+		case "images":
+			if in.IsNull() {
+				in.Skip()
+				out.Images = nil
+			} else {
+				in.Delim('[')
+				if out.Images == nil {
+					if !in.IsDelim(']') {
+						out.Images = make([]models.EventImage, 0, 1)
+					} else {
+						out.Images = []models.EventImage{}
+					}
+				} else {
+					out.Images = (out.Images)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v17 models.EventImage
+					easyjsonF642ad3eDecodeKudagoInternalModels1(in, &v17)
+					out.Images = append(out.Images, v17)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "title_highlight":
+			out.TitleHighlight = string(in.String())
+		case "description_highlight":
+			out.DescriptionHighlight = string(in.String())
+		case "distance":
+			if in.IsNull() {
+				in.Skip()
+				out.Distance = nil
+			} else {
+				if out.Distance == nil {
+					out.Distance = new(float64)
+				}
+				*out.Distance = float64(in.Float64())
+			}
+		case "recurrence_rule":
+			out.RecurrenceRule = string(in.String())
+		case "recurrence_exceptions":
+			if in.IsNull() {
+				in.Skip()
+				out.RecurrenceExceptions = nil
+			} else {
+				in.Delim('[')
+				if out.RecurrenceExceptions == nil {
+					if !in.IsDelim(']') {
+						out.RecurrenceExceptions = make([]string, 0, 4)
+					} else {
+						out.RecurrenceExceptions = []string{}
+					}
+				} else {
+					out.RecurrenceExceptions = (out.RecurrenceExceptions)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v18 string
+					v18 = string(in.String())
+					out.RecurrenceExceptions = append(out.RecurrenceExceptions, v18)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonF642ad3eEncodeKudagoInternalModels(out *jwriter.Writer, in models.Event) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"title\":"
+		out.RawString(prefix)
+		out.String(string(in.Title))
+	}
+	{
+		const prefix string = ",\"description\":"
+		out.RawString(prefix)
+		out.String(string(in.Description))
+	}
+	{
+		const prefix string = ",\"event_start\":"
+		out.RawString(prefix)
+		out.String(string(in.EventStart))
+	}
+	{
+		const prefix string = ",\"event_finish\":"
+		out.RawString(prefix)
+		out.String(string(in.EventEnd))
+	}
+	{
+		const prefix string = ",\"location\":"
+		out.RawString(prefix)
+		out.String(string(in.Location))
+	}
+	{
+		const prefix string = ",\"capacity\":"
+		out.RawString(prefix)
+		out.Int(int(in.Capacity))
+	}
+	{
+		const prefix string = ",\"created_at\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"category_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.CategoryID))
+	}
+	{
+		const prefix string = ",\"author\":"
+		out.RawString(prefix)
+		out.Int(int(in.AuthorID))
+	}
+	{
+		const prefix string = ",\"Latitude\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Latitude))
+	}
+	{
+		const prefix string = ",\"Longitude\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Longitude))
+	}
+	{
+		const prefix string = ",\"tag\":"
+		out.RawString(prefix)
+		if in.Tag == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v19, v20 := range in.Tag {
+				if v19 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v20))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"image\":"
+		out.RawString(prefix)
+		out.String(string(in.ImageURL))
+	}
+	{
+		const prefix string = ",\"attendees\":"
+		out.RawString(prefix)
+		out.Int(int(in.Attendees))
+	}
+	{
+		const prefix string = ",\"favorites_count\":"
+		out.RawString(prefix)
+		out.Int(int(in.FavoritesCount))
+	}
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix)
+		out.String(string(in.Status))
+	}
+	if in.PublishAt != "" {
+		const prefix string = ",\"publish_at\":"
+		out.RawString(prefix)
+		out.String(string(in.PublishAt))
+	}
+	if in.CancelReason != "" {
+		const prefix string = ",\"cancel_reason\":"
+		out.RawString(prefix)
+		out.String(string(in.CancelReason))
+	}
+	if len(in.Images) != 0 {
+		const prefix string = ",\"images\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v21, v22 := range in.Images {
+				if v21 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonF642ad3eEncodeKudagoInternalModels1(out, v22)
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.TitleHighlight != "" {
+		const prefix string = ",\"title_highlight\":"
+		out.RawString(prefix)
+		out.String(string(in.TitleHighlight))
+	}
+	if in.DescriptionHighlight != "" {
+		const prefix string = ",\"description_highlight\":"
+		out.RawString(prefix)
+		out.String(string(in.DescriptionHighlight))
+	}
+	if in.Distance != nil {
+		const prefix string = ",\"distance\":"
+		out.RawString(prefix)
+		out.Float64(float64(*in.Distance))
+	}
+	if in.RecurrenceRule != "" {
+		const prefix string = ",\"recurrence_rule\":"
+		out.RawString(prefix)
+		out.String(string(in.RecurrenceRule))
+	}
+	if len(in.RecurrenceExceptions) != 0 {
+		const prefix string = ",\"recurrence_exceptions\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v23, v24 := range in.RecurrenceExceptions {
+				if v23 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v24))
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+func easyjsonF642ad3eDecodeKudagoInternalModels1(in *jlexer.Lexer, out *models.EventImage) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = int(in.Int())
+		case "url":
+			out.URL = string(in.String())
+		case "position":
+			out.Position = int(in.Int())
+		case "is_cover":
+			out.IsCover = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonF642ad3eEncodeKudagoInternalModels1(out *jwriter.Writer, in models.EventImage) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"url\":"
+		out.RawString(prefix)
+		out.String(string(in.URL))
+	}
+	{
+		const prefix string = ",\"position\":"
+		out.RawString(prefix)
+		out.Int(int(in.Position))
+	}
+	{
+		const prefix string = ",\"is_cover\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsCover))
+	}
+	out.RawByte('}')
+}
+func easyjsonF642ad3eDecodeKudagoInternalGatewayEvent11(in *jlexer.Lexer, out *NewTicketTypeRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "name":
+			out.Name = string(in.String())
+		case "price":
+			out.Price = float64(in.Float64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonF642ad3eEncodeKudagoInternalGatewayEvent11(out *jwriter.Writer, in NewTicketTypeRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"price\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Price))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v NewTicketTypeRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonF642ad3eEncodeKudagoInternalGatewayEvent11(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v NewTicketTypeRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonF642ad3eEncodeKudagoInternalGatewayEvent11(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *NewTicketTypeRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonF642ad3eDecodeKudagoInternalGatewayEvent11(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *NewTicketTypeRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonF642ad3eDecodeKudagoInternalGatewayEvent11(l, v)
+}
+func easyjsonF642ad3eDecodeKudagoInternalGatewayEvent12(in *jlexer.Lexer, out *NewEventResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "event":
+			(out.Event).UnmarshalEasyJSON(in)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonF642ad3eEncodeKudagoInternalGatewayEvent12(out *jwriter.Writer, in NewEventResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"event\":"
+		out.RawString(prefix[1:])
+		(in.Event).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v NewEventResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonF642ad3eEncodeKudagoInternalGatewayEvent12(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v NewEventResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonF642ad3eEncodeKudagoInternalGatewayEvent12(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *NewEventResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonF642ad3eDecodeKudagoInternalGatewayEvent12(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *NewEventResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonF642ad3eDecodeKudagoInternalGatewayEvent12(l, v)
+}
+func easyjsonF642ad3eDecodeKudagoInternalGatewayEvent13(in *jlexer.Lexer, out *NewEventRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "title":
+			out.Title = string(in.String())
+		case "description":
+			out.Description = string(in.String())
+		case "location":
+			out.Location = string(in.String())
+		case "category_id":
+			out.Category = int(in.Int())
+		case "capacity":
+			out.Capacity = int(in.Int())
+		case "tag":
+			if in.IsNull() {
+				in.Skip()
+				out.Tag = nil
+			} else {
+				in.Delim('[')
+				if out.Tag == nil {
+					if !in.IsDelim(']') {
+						out.Tag = make([]string, 0, 4)
+					} else {
+						out.Tag = []string{}
+					}
+				} else {
+					out.Tag = (out.Tag)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v25 string
+					v25 = string(in.String())
+					out.Tag = append(out.Tag, v25)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "event_start":
+			out.EventStart = string(in.String())
+		case "event_end":
+			out.EventEnd = string(in.String())
+		case "Latitude":
+			out.Latitude = float64(in.Float64())
+		case "Longitude":
+			out.Longitude = float64(in.Float64())
+		case "recurrence_rule":
+			out.RecurrenceRule = string(in.String())
+		case "recurrence_exceptions":
+			if in.IsNull() {
+				in.Skip()
+				out.RecurrenceExceptions = nil
+			} else {
+				in.Delim('[')
+				if out.RecurrenceExceptions == nil {
+					if !in.IsDelim(']') {
+						out.RecurrenceExceptions = make([]string, 0, 4)
+					} else {
+						out.RecurrenceExceptions = []string{}
+					}
+				} else {
+					out.RecurrenceExceptions = (out.RecurrenceExceptions)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v26 string
+					v26 = string(in.String())
+					out.RecurrenceExceptions = append(out.RecurrenceExceptions, v26)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "status":
+			out.Status = string(in.String())
+		case "publish_at":
+			out.PublishAt = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonF642ad3eEncodeKudagoInternalGatewayEvent13(out *jwriter.Writer, in NewEventRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"title\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Title))
+	}
+	{
+		const prefix string = ",\"description\":"
+		out.RawString(prefix)
+		out.String(string(in.Description))
+	}
+	{
+		const prefix string = ",\"location\":"
+		out.RawString(prefix)
+		out.String(string(in.Location))
+	}
+	{
+		const prefix string = ",\"category_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.Category))
+	}
+	{
+		const prefix string = ",\"capacity\":"
+		out.RawString(prefix)
+		out.Int(int(in.Capacity))
+	}
+	{
+		const prefix string = ",\"tag\":"
+		out.RawString(prefix)
+		if in.Tag == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v27, v28 := range in.Tag {
+				if v27 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v28))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"event_start\":"
+		out.RawString(prefix)
+		out.String(string(in.EventStart))
+	}
+	{
+		const prefix string = ",\"event_end\":"
+		out.RawString(prefix)
+		out.String(string(in.EventEnd))
+	}
+	{
+		const prefix string = ",\"Latitude\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Latitude))
+	}
+	{
+		const prefix string = ",\"Longitude\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Longitude))
+	}
+	{
+		const prefix string = ",\"recurrence_rule\":"
+		out.RawString(prefix)
+		out.String(string(in.RecurrenceRule))
+	}
+	{
+		const prefix string = ",\"recurrence_exceptions\":"
+		out.RawString(prefix)
+		if in.RecurrenceExceptions == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v29, v30 := range in.RecurrenceExceptions {
+				if v29 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v30))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix)
+		out.String(string(in.Status))
+	}
+	{
+		const prefix string = ",\"publish_at\":"
+		out.RawString(prefix)
+		out.String(string(in.PublishAt))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v NewEventRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonF642ad3eEncodeKudagoInternalGatewayEvent13(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v NewEventRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonF642ad3eEncodeKudagoInternalGatewayEvent13(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *NewEventRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonF642ad3eDecodeKudagoInternalGatewayEvent13(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *NewEventRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonF642ad3eDecodeKudagoInternalGatewayEvent13(l, v)
+}
+func easyjsonF642ad3eDecodeKudagoInternalGatewayEvent14(in *jlexer.Lexer, out *InviteNotificationRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "user_id":
+			out.UserID = int(in.Int())
+		case "event_id":
+			out.EventID = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonF642ad3eEncodeKudagoInternalGatewayEvent14(out *jwriter.Writer, in InviteNotificationRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.UserID))
+	}
+	{
+		const prefix string = ",\"event_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.EventID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v InviteNotificationRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonF642ad3eEncodeKudagoInternalGatewayEvent14(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v InviteNotificationRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonF642ad3eEncodeKudagoInternalGatewayEvent14(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *InviteNotificationRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonF642ad3eDecodeKudagoInternalGatewayEvent14(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *InviteNotificationRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonF642ad3eDecodeKudagoInternalGatewayEvent14(l, v)
+}
+func easyjsonF642ad3eDecodeKudagoInternalGatewayEvent15(in *jlexer.Lexer, out *GetNotificationsResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "notifications":
+			if in.IsNull() {
+				in.Skip()
+				out.Notifications = nil
+			} else {
+				in.Delim('[')
+				if out.Notifications == nil {
+					if !in.IsDelim(']') {
+						out.Notifications = make([]NotificationWithEvent, 0, 0)
+					} else {
+						out.Notifications = []NotificationWithEvent{}
+					}
+				} else {
+					out.Notifications = (out.Notifications)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v31 NotificationWithEvent
+					(v31).UnmarshalEasyJSON(in)
+					out.Notifications = append(out.Notifications, v31)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonF642ad3eEncodeKudagoInternalGatewayEvent15(out *jwriter.Writer, in GetNotificationsResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"notifications\":"
+		out.RawString(prefix[1:])
+		if in.Notifications == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v32, v33 := range in.Notifications {
+				if v32 > 0 {
+					out.RawByte(',')
+				}
+				(v33).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v GetNotificationsResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonF642ad3eEncodeKudagoInternalGatewayEvent15(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v GetNotificationsResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonF642ad3eEncodeKudagoInternalGatewayEvent15(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *GetNotificationsResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonF642ad3eDecodeKudagoInternalGatewayEvent15(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *GetNotificationsResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonF642ad3eDecodeKudagoInternalGatewayEvent15(l, v)
+}
+func easyjsonF642ad3eDecodeKudagoInternalGatewayEvent16(in *jlexer.Lexer, out *GetEventsResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "events":
+			if in.IsNull() {
+				in.Skip()
+				out.Events = nil
+			} else {
+				in.Delim('[')
+				if out.Events == nil {
+					if !in.IsDelim(']') {
+						out.Events = make([]EventResponse, 0, 0)
+					} else {
+						out.Events = []EventResponse{}
+					}
+				} else {
+					out.Events = (out.Events)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v34 EventResponse
+					(v34).UnmarshalEasyJSON(in)
+					out.Events = append(out.Events, v34)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "next_cursor":
+			out.NextCursor = string(in.String())
+		case "facets":
+			if in.IsNull() {
+				in.Skip()
+				out.Facets = nil
+			} else {
+				if out.Facets == nil {
+					out.Facets = new(SearchFacetsResponse)
+				}
+				easyjsonF642ad3eDecodeKudagoInternalGatewayEvent17(in, out.Facets)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonF642ad3eEncodeKudagoInternalGatewayEvent16(out *jwriter.Writer, in GetEventsResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"events\":"
+		out.RawString(prefix[1:])
+		if in.Events == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v35, v36 := range in.Events {
+				if v35 > 0 {
+					out.RawByte(',')
+				}
+				(v36).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.NextCursor != "" {
+		const prefix string = ",\"next_cursor\":"
+		out.RawString(prefix)
+		out.String(string(in.NextCursor))
+	}
+	if in.Facets != nil {
+		const prefix string = ",\"facets\":"
+		out.RawString(prefix)
+		easyjsonF642ad3eEncodeKudagoInternalGatewayEvent17(out, *in.Facets)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v GetEventsResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonF642ad3eEncodeKudagoInternalGatewayEvent16(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v GetEventsResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonF642ad3eEncodeKudagoInternalGatewayEvent16(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *GetEventsResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonF642ad3eDecodeKudagoInternalGatewayEvent16(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *GetEventsResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonF642ad3eDecodeKudagoInternalGatewayEvent16(l, v)
+}
+func easyjsonF642ad3eDecodeKudagoInternalGatewayEvent17(in *jlexer.Lexer, out *SearchFacetsResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "categories":
+			if in.IsNull() {
+				in.Skip()
+				out.Categories = nil
+			} else {
+				in.Delim('[')
+				if out.Categories == nil {
+					if !in.IsDelim(']') {
+						out.Categories = make([]CategoryFacetResponse, 0, 4)
+					} else {
+						out.Categories = []CategoryFacetResponse{}
+					}
+				} else {
+					out.Categories = (out.Categories)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v37 CategoryFacetResponse
+					easyjsonF642ad3eDecodeKudagoInternalGatewayEvent18(in, &v37)
+					out.Categories = append(out.Categories, v37)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "tags":
+			if in.IsNull() {
+				in.Skip()
+				out.Tags = nil
+			} else {
+				in.Delim('[')
+				if out.Tags == nil {
+					if !in.IsDelim(']') {
+						out.Tags = make([]TagFacetResponse, 0, 2)
+					} else {
+						out.Tags = []TagFacetResponse{}
+					}
+				} else {
+					out.Tags = (out.Tags)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v38 TagFacetResponse
+					easyjsonF642ad3eDecodeKudagoInternalGatewayEvent19(in, &v38)
+					out.Tags = append(out.Tags, v38)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "dates":
+			easyjsonF642ad3eDecodeKudagoInternalGatewayEvent20(in, &out.Dates)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonF642ad3eEncodeKudagoInternalGatewayEvent17(out *jwriter.Writer, in SearchFacetsResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"categories\":"
+		out.RawString(prefix[1:])
+		if in.Categories == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v39, v40 := range in.Categories {
+				if v39 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonF642ad3eEncodeKudagoInternalGatewayEvent18(out, v40)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"tags\":"
+		out.RawString(prefix)
+		if in.Tags == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v41, v42 := range in.Tags {
+				if v41 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonF642ad3eEncodeKudagoInternalGatewayEvent19(out, v42)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"dates\":"
+		out.RawString(prefix)
+		easyjsonF642ad3eEncodeKudagoInternalGatewayEvent20(out, in.Dates)
+	}
+	out.RawByte('}')
+}
+func easyjsonF642ad3eDecodeKudagoInternalGatewayEvent20(in *jlexer.Lexer, out *DateFacetsResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "today":
+			out.Today = int(in.Int())
+		case "this_weekend":
+			out.ThisWeekend = int(in.Int())
+		case "next_week":
+			out.NextWeek = int(in.Int())
 		default:
 			in.SkipRecursive()
 		}
@@ -51,47 +2189,273 @@ func easyjsonF642ad3eDecodeKudagoInternalGatewayEvent(in *jlexer.Lexer, out *Not
 		in.Consumed()
 	}
 }
-func easyjsonF642ad3eEncodeKudagoInternalGatewayEvent(out *jwriter.Writer, in NotificationWithEvent) {
+func easyjsonF642ad3eEncodeKudagoInternalGatewayEvent20(out *jwriter.Writer, in DateFacetsResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"notification\":"
+		const prefix string = ",\"today\":"
 		out.RawString(prefix[1:])
-		(in.Notification).MarshalEasyJSON(out)
+		out.Int(int(in.Today))
 	}
 	{
-		const prefix string = ",\"event\":"
+		const prefix string = ",\"this_weekend\":"
 		out.RawString(prefix)
-		easyjsonF642ad3eEncodeKudagoInternalModels(out, in.Event)
+		out.Int(int(in.ThisWeekend))
+	}
+	{
+		const prefix string = ",\"next_week\":"
+		out.RawString(prefix)
+		out.Int(int(in.NextWeek))
+	}
+	out.RawByte('}')
+}
+func easyjsonF642ad3eDecodeKudagoInternalGatewayEvent19(in *jlexer.Lexer, out *TagFacetResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "tag":
+			out.Tag = string(in.String())
+		case "count":
+			out.Count = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonF642ad3eEncodeKudagoInternalGatewayEvent19(out *jwriter.Writer, in TagFacetResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"tag\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Tag))
+	}
+	{
+		const prefix string = ",\"count\":"
+		out.RawString(prefix)
+		out.Int(int(in.Count))
+	}
+	out.RawByte('}')
+}
+func easyjsonF642ad3eDecodeKudagoInternalGatewayEvent18(in *jlexer.Lexer, out *CategoryFacetResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "category_id":
+			out.CategoryID = int(in.Int())
+		case "count":
+			out.Count = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonF642ad3eEncodeKudagoInternalGatewayEvent18(out *jwriter.Writer, in CategoryFacetResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"category_id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.CategoryID))
+	}
+	{
+		const prefix string = ",\"count\":"
+		out.RawString(prefix)
+		out.Int(int(in.Count))
+	}
+	out.RawByte('}')
+}
+func easyjsonF642ad3eDecodeKudagoInternalGatewayEvent21(in *jlexer.Lexer, out *GetCategoriesResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "categories":
+			if in.IsNull() {
+				in.Skip()
+				out.Categories = nil
+			} else {
+				in.Delim('[')
+				if out.Categories == nil {
+					if !in.IsDelim(']') {
+						out.Categories = make([]models.Category, 0, 2)
+					} else {
+						out.Categories = []models.Category{}
+					}
+				} else {
+					out.Categories = (out.Categories)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v43 models.Category
+					easyjsonF642ad3eDecodeKudagoInternalModels2(in, &v43)
+					out.Categories = append(out.Categories, v43)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonF642ad3eEncodeKudagoInternalGatewayEvent21(out *jwriter.Writer, in GetCategoriesResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"categories\":"
+		out.RawString(prefix[1:])
+		if in.Categories == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v44, v45 := range in.Categories {
+				if v44 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonF642ad3eEncodeKudagoInternalModels2(out, v45)
+			}
+			out.RawByte(']')
+		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v NotificationWithEvent) MarshalJSON() ([]byte, error) {
+func (v GetCategoriesResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonF642ad3eEncodeKudagoInternalGatewayEvent(&w, v)
+	easyjsonF642ad3eEncodeKudagoInternalGatewayEvent21(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v NotificationWithEvent) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonF642ad3eEncodeKudagoInternalGatewayEvent(w, v)
+func (v GetCategoriesResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonF642ad3eEncodeKudagoInternalGatewayEvent21(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *NotificationWithEvent) UnmarshalJSON(data []byte) error {
+func (v *GetCategoriesResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonF642ad3eDecodeKudagoInternalGatewayEvent(&r, v)
+	easyjsonF642ad3eDecodeKudagoInternalGatewayEvent21(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *NotificationWithEvent) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonF642ad3eDecodeKudagoInternalGatewayEvent(l, v)
+func (v *GetCategoriesResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonF642ad3eDecodeKudagoInternalGatewayEvent21(l, v)
 }
-func easyjsonF642ad3eDecodeKudagoInternalModels(in *jlexer.Lexer, out *models.Event) {
+func easyjsonF642ad3eDecodeKudagoInternalModels2(in *jlexer.Lexer, out *models.Category) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = int(in.Int())
+		case "name":
+			out.Name = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonF642ad3eEncodeKudagoInternalModels2(out *jwriter.Writer, in models.Category) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	out.RawByte('}')
+}
+func easyjsonF642ad3eDecodeKudagoInternalGatewayEvent22(in *jlexer.Lexer, out *EventResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -116,51 +2480,121 @@ func easyjsonF642ad3eDecodeKudagoInternalModels(in *jlexer.Lexer, out *models.Ev
 			out.Title = string(in.String())
 		case "description":
 			out.Description = string(in.String())
-		case "event_start":
-			out.EventStart = string(in.String())
-		case "event_finish":
-			out.EventEnd = string(in.String())
 		case "location":
 			out.Location = string(in.String())
+		case "category_id":
+			out.Category = int(in.Int())
 		case "capacity":
 			out.Capacity = int(in.Int())
-		case "created_at":
-			if data := in.Raw(); in.Ok() {
-				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+		case "tag":
+			if in.IsNull() {
+				in.Skip()
+				out.Tag = nil
+			} else {
+				in.Delim('[')
+				if out.Tag == nil {
+					if !in.IsDelim(']') {
+						out.Tag = make([]string, 0, 4)
+					} else {
+						out.Tag = []string{}
+					}
+				} else {
+					out.Tag = (out.Tag)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v46 string
+					v46 = string(in.String())
+					out.Tag = append(out.Tag, v46)
+					in.WantComma()
+				}
+				in.Delim(']')
 			}
-		case "category_id":
-			out.CategoryID = int(in.Int())
 		case "author":
 			out.AuthorID = int(in.Int())
+		case "event_start":
+			out.EventStart = string(in.String())
+		case "event_end":
+			out.EventEnd = string(in.String())
+		case "image":
+			out.ImageURL = string(in.String())
 		case "Latitude":
 			out.Latitude = float64(in.Float64())
 		case "Longitude":
 			out.Longitude = float64(in.Float64())
-		case "tag":
+		case "attendees":
+			out.Attendees = int(in.Int())
+		case "image_webp":
+			out.ImageWebP = string(in.String())
+		case "favorites_count":
+			out.FavoritesCount = int(in.Int())
+		case "status":
+			out.Status = string(in.String())
+		case "publish_at":
+			out.PublishAt = string(in.String())
+		case "cancel_reason":
+			out.CancelReason = string(in.String())
+		case "images":
 			if in.IsNull() {
 				in.Skip()
-				out.Tag = nil
+				out.Images = nil
 			} else {
 				in.Delim('[')
-				if out.Tag == nil {
+				if out.Images == nil {
 					if !in.IsDelim(']') {
-						out.Tag = make([]string, 0, 4)
+						out.Images = make([]EventImageResponse, 0, 1)
 					} else {
-						out.Tag = []string{}
+						out.Images = []EventImageResponse{}
 					}
 				} else {
-					out.Tag = (out.Tag)[:0]
+					out.Images = (out.Images)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v1 string
-					v1 = string(in.String())
-					out.Tag = append(out.Tag, v1)
+					var v47 EventImageResponse
+					(v47).UnmarshalEasyJSON(in)
+					out.Images = append(out.Images, v47)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "title_highlight":
+			out.TitleHighlight = string(in.String())
+		case "description_highlight":
+			out.DescriptionHighlight = string(in.String())
+		case "distance":
+			if in.IsNull() {
+				in.Skip()
+				out.Distance = nil
+			} else {
+				if out.Distance == nil {
+					out.Distance = new(float64)
+				}
+				*out.Distance = float64(in.Float64())
+			}
+		case "recurrence_rule":
+			out.RecurrenceRule = string(in.String())
+		case "recurrence_exceptions":
+			if in.IsNull() {
+				in.Skip()
+				out.RecurrenceExceptions = nil
+			} else {
+				in.Delim('[')
+				if out.RecurrenceExceptions == nil {
+					if !in.IsDelim(']') {
+						out.RecurrenceExceptions = make([]string, 0, 4)
+					} else {
+						out.RecurrenceExceptions = []string{}
+					}
+				} else {
+					out.RecurrenceExceptions = (out.RecurrenceExceptions)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v48 string
+					v48 = string(in.String())
+					out.RecurrenceExceptions = append(out.RecurrenceExceptions, v48)
 					in.WantComma()
 				}
 				in.Delim(']')
 			}
-		case "image":
-			out.ImageURL = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -171,7 +2605,7 @@ func easyjsonF642ad3eDecodeKudagoInternalModels(in *jlexer.Lexer, out *models.Ev
 		in.Consumed()
 	}
 }
-func easyjsonF642ad3eEncodeKudagoInternalModels(out *jwriter.Writer, in models.Event) {
+func easyjsonF642ad3eEncodeKudagoInternalGatewayEvent22(out *jwriter.Writer, in EventResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -191,39 +2625,55 @@ func easyjsonF642ad3eEncodeKudagoInternalModels(out *jwriter.Writer, in models.E
 		out.String(string(in.Description))
 	}
 	{
-		const prefix string = ",\"event_start\":"
+		const prefix string = ",\"location\":"
 		out.RawString(prefix)
-		out.String(string(in.EventStart))
+		out.String(string(in.Location))
 	}
 	{
-		const prefix string = ",\"event_finish\":"
+		const prefix string = ",\"category_id\":"
 		out.RawString(prefix)
-		out.String(string(in.EventEnd))
+		out.Int(int(in.Category))
 	}
 	{
-		const prefix string = ",\"location\":"
+		const prefix string = ",\"capacity\":"
 		out.RawString(prefix)
-		out.String(string(in.Location))
+		out.Int(int(in.Capacity))
 	}
 	{
-		const prefix string = ",\"capacity\":"
+		const prefix string = ",\"tag\":"
 		out.RawString(prefix)
-		out.Int(int(in.Capacity))
+		if in.Tag == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v49, v50 := range in.Tag {
+				if v49 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v50))
+			}
+			out.RawByte(']')
+		}
 	}
 	{
-		const prefix string = ",\"created_at\":"
+		const prefix string = ",\"author\":"
 		out.RawString(prefix)
-		out.Raw((in.CreatedAt).MarshalJSON())
+		out.Int(int(in.AuthorID))
 	}
 	{
-		const prefix string = ",\"category_id\":"
+		const prefix string = ",\"event_start\":"
 		out.RawString(prefix)
-		out.Int(int(in.CategoryID))
+		out.String(string(in.EventStart))
 	}
 	{
-		const prefix string = ",\"author\":"
+		const prefix string = ",\"event_end\":"
 		out.RawString(prefix)
-		out.Int(int(in.AuthorID))
+		out.String(string(in.EventEnd))
+	}
+	{
+		const prefix string = ",\"image\":"
+		out.RawString(prefix)
+		out.String(string(in.ImageURL))
 	}
 	{
 		const prefix string = ",\"Latitude\":"
@@ -236,95 +2686,110 @@ func easyjsonF642ad3eEncodeKudagoInternalModels(out *jwriter.Writer, in models.E
 		out.Float64(float64(in.Longitude))
 	}
 	{
-		const prefix string = ",\"tag\":"
+		const prefix string = ",\"attendees\":"
 		out.RawString(prefix)
-		if in.Tag == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
+		out.Int(int(in.Attendees))
+	}
+	if in.ImageWebP != "" {
+		const prefix string = ",\"image_webp\":"
+		out.RawString(prefix)
+		out.String(string(in.ImageWebP))
+	}
+	{
+		const prefix string = ",\"favorites_count\":"
+		out.RawString(prefix)
+		out.Int(int(in.FavoritesCount))
+	}
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix)
+		out.String(string(in.Status))
+	}
+	if in.PublishAt != "" {
+		const prefix string = ",\"publish_at\":"
+		out.RawString(prefix)
+		out.String(string(in.PublishAt))
+	}
+	if in.CancelReason != "" {
+		const prefix string = ",\"cancel_reason\":"
+		out.RawString(prefix)
+		out.String(string(in.CancelReason))
+	}
+	if len(in.Images) != 0 {
+		const prefix string = ",\"images\":"
+		out.RawString(prefix)
+		{
 			out.RawByte('[')
-			for v2, v3 := range in.Tag {
-				if v2 > 0 {
+			for v51, v52 := range in.Images {
+				if v51 > 0 {
 					out.RawByte(',')
 				}
-				out.String(string(v3))
+				(v52).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
 	}
-	{
-		const prefix string = ",\"image\":"
+	if in.TitleHighlight != "" {
+		const prefix string = ",\"title_highlight\":"
 		out.RawString(prefix)
-		out.String(string(in.ImageURL))
+		out.String(string(in.TitleHighlight))
 	}
-	out.RawByte('}')
-}
-func easyjsonF642ad3eDecodeKudagoInternalGatewayEvent1(in *jlexer.Lexer, out *NewEventResponse) {
-	isTopLevel := in.IsStart()
-	if in.IsNull() {
-		if isTopLevel {
-			in.Consumed()
-		}
-		in.Skip()
-		return
+	if in.DescriptionHighlight != "" {
+		const prefix string = ",\"description_highlight\":"
+		out.RawString(prefix)
+		out.String(string(in.DescriptionHighlight))
 	}
-	in.Delim('{')
-	for !in.IsDelim('}') {
-		key := in.UnsafeFieldName(false)
-		in.WantColon()
-		if in.IsNull() {
-			in.Skip()
-			in.WantComma()
-			continue
-		}
-		switch key {
-		case "event":
-			(out.Event).UnmarshalEasyJSON(in)
-		default:
-			in.SkipRecursive()
-		}
-		in.WantComma()
+	if in.Distance != nil {
+		const prefix string = ",\"distance\":"
+		out.RawString(prefix)
+		out.Float64(float64(*in.Distance))
 	}
-	in.Delim('}')
-	if isTopLevel {
-		in.Consumed()
+	if in.RecurrenceRule != "" {
+		const prefix string = ",\"recurrence_rule\":"
+		out.RawString(prefix)
+		out.String(string(in.RecurrenceRule))
 	}
-}
-func easyjsonF642ad3eEncodeKudagoInternalGatewayEvent1(out *jwriter.Writer, in NewEventResponse) {
-	out.RawByte('{')
-	first := true
-	_ = first
-	{
-		const prefix string = ",\"event\":"
-		out.RawString(prefix[1:])
-		(in.Event).MarshalEasyJSON(out)
+	if len(in.RecurrenceExceptions) != 0 {
+		const prefix string = ",\"recurrence_exceptions\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v53, v54 := range in.RecurrenceExceptions {
+				if v53 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v54))
+			}
+			out.RawByte(']')
+		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v NewEventResponse) MarshalJSON() ([]byte, error) {
+func (v EventResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonF642ad3eEncodeKudagoInternalGatewayEvent1(&w, v)
+	easyjsonF642ad3eEncodeKudagoInternalGatewayEvent22(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v NewEventResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonF642ad3eEncodeKudagoInternalGatewayEvent1(w, v)
+func (v EventResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonF642ad3eEncodeKudagoInternalGatewayEvent22(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *NewEventResponse) UnmarshalJSON(data []byte) error {
+func (v *EventResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonF642ad3eDecodeKudagoInternalGatewayEvent1(&r, v)
+	easyjsonF642ad3eDecodeKudagoInternalGatewayEvent22(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *NewEventResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonF642ad3eDecodeKudagoInternalGatewayEvent1(l, v)
+func (v *EventResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonF642ad3eDecodeKudagoInternalGatewayEvent22(l, v)
 }
-func easyjsonF642ad3eDecodeKudagoInternalGatewayEvent2(in *jlexer.Lexer, out *NewEventRequest) {
+func easyjsonF642ad3eDecodeKudagoInternalGatewayEvent23(in *jlexer.Lexer, out *EventImagesResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -343,47 +2808,29 @@ func easyjsonF642ad3eDecodeKudagoInternalGatewayEvent2(in *jlexer.Lexer, out *Ne
 			continue
 		}
 		switch key {
-		case "title":
-			out.Title = string(in.String())
-		case "description":
-			out.Description = string(in.String())
-		case "location":
-			out.Location = string(in.String())
-		case "category_id":
-			out.Category = int(in.Int())
-		case "capacity":
-			out.Capacity = int(in.Int())
-		case "tag":
+		case "images":
 			if in.IsNull() {
 				in.Skip()
-				out.Tag = nil
+				out.Images = nil
 			} else {
 				in.Delim('[')
-				if out.Tag == nil {
+				if out.Images == nil {
 					if !in.IsDelim(']') {
-						out.Tag = make([]string, 0, 4)
+						out.Images = make([]EventImageResponse, 0, 1)
 					} else {
-						out.Tag = []string{}
+						out.Images = []EventImageResponse{}
 					}
 				} else {
-					out.Tag = (out.Tag)[:0]
+					out.Images = (out.Images)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v4 string
-					v4 = string(in.String())
-					out.Tag = append(out.Tag, v4)
+					var v55 EventImageResponse
+					(v55).UnmarshalEasyJSON(in)
+					out.Images = append(out.Images, v55)
 					in.WantComma()
 				}
 				in.Delim(']')
 			}
-		case "event_start":
-			out.EventStart = string(in.String())
-		case "event_end":
-			out.EventEnd = string(in.String())
-		case "Latitude":
-			out.Latitude = float64(in.Float64())
-		case "Longitude":
-			out.Longitude = float64(in.Float64())
 		default:
 			in.SkipRecursive()
 		}
@@ -394,98 +2841,53 @@ func easyjsonF642ad3eDecodeKudagoInternalGatewayEvent2(in *jlexer.Lexer, out *Ne
 		in.Consumed()
 	}
 }
-func easyjsonF642ad3eEncodeKudagoInternalGatewayEvent2(out *jwriter.Writer, in NewEventRequest) {
+func easyjsonF642ad3eEncodeKudagoInternalGatewayEvent23(out *jwriter.Writer, in EventImagesResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"title\":"
+		const prefix string = ",\"images\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Title))
-	}
-	{
-		const prefix string = ",\"description\":"
-		out.RawString(prefix)
-		out.String(string(in.Description))
-	}
-	{
-		const prefix string = ",\"location\":"
-		out.RawString(prefix)
-		out.String(string(in.Location))
-	}
-	{
-		const prefix string = ",\"category_id\":"
-		out.RawString(prefix)
-		out.Int(int(in.Category))
-	}
-	{
-		const prefix string = ",\"capacity\":"
-		out.RawString(prefix)
-		out.Int(int(in.Capacity))
-	}
-	{
-		const prefix string = ",\"tag\":"
-		out.RawString(prefix)
-		if in.Tag == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		if in.Images == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v5, v6 := range in.Tag {
-				if v5 > 0 {
+			for v56, v57 := range in.Images {
+				if v56 > 0 {
 					out.RawByte(',')
 				}
-				out.String(string(v6))
+				(v57).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
 	}
-	{
-		const prefix string = ",\"event_start\":"
-		out.RawString(prefix)
-		out.String(string(in.EventStart))
-	}
-	{
-		const prefix string = ",\"event_end\":"
-		out.RawString(prefix)
-		out.String(string(in.EventEnd))
-	}
-	{
-		const prefix string = ",\"Latitude\":"
-		out.RawString(prefix)
-		out.Float64(float64(in.Latitude))
-	}
-	{
-		const prefix string = ",\"Longitude\":"
-		out.RawString(prefix)
-		out.Float64(float64(in.Longitude))
-	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v NewEventRequest) MarshalJSON() ([]byte, error) {
+func (v EventImagesResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonF642ad3eEncodeKudagoInternalGatewayEvent2(&w, v)
+	easyjsonF642ad3eEncodeKudagoInternalGatewayEvent23(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v NewEventRequest) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonF642ad3eEncodeKudagoInternalGatewayEvent2(w, v)
+func (v EventImagesResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonF642ad3eEncodeKudagoInternalGatewayEvent23(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *NewEventRequest) UnmarshalJSON(data []byte) error {
+func (v *EventImagesResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonF642ad3eDecodeKudagoInternalGatewayEvent2(&r, v)
+	easyjsonF642ad3eDecodeKudagoInternalGatewayEvent23(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *NewEventRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonF642ad3eDecodeKudagoInternalGatewayEvent2(l, v)
+func (v *EventImagesResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonF642ad3eDecodeKudagoInternalGatewayEvent23(l, v)
 }
-func easyjsonF642ad3eDecodeKudagoInternalGatewayEvent3(in *jlexer.Lexer, out *InviteNotificationRequest) {
+func easyjsonF642ad3eDecodeKudagoInternalGatewayEvent24(in *jlexer.Lexer, out *EventImageResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -504,10 +2906,14 @@ func easyjsonF642ad3eDecodeKudagoInternalGatewayEvent3(in *jlexer.Lexer, out *In
 			continue
 		}
 		switch key {
-		case "user_id":
-			out.UserID = int(in.Int())
-		case "event_id":
-			out.EventID = int(in.Int())
+		case "id":
+			out.ID = int(in.Int())
+		case "url":
+			out.URL = string(in.String())
+		case "thumb":
+			out.Thumb = string(in.String())
+		case "is_cover":
+			out.IsCover = bool(in.Bool())
 		default:
 			in.SkipRecursive()
 		}
@@ -518,47 +2924,57 @@ func easyjsonF642ad3eDecodeKudagoInternalGatewayEvent3(in *jlexer.Lexer, out *In
 		in.Consumed()
 	}
 }
-func easyjsonF642ad3eEncodeKudagoInternalGatewayEvent3(out *jwriter.Writer, in InviteNotificationRequest) {
+func easyjsonF642ad3eEncodeKudagoInternalGatewayEvent24(out *jwriter.Writer, in EventImageResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"user_id\":"
+		const prefix string = ",\"id\":"
 		out.RawString(prefix[1:])
-		out.Int(int(in.UserID))
+		out.Int(int(in.ID))
 	}
 	{
-		const prefix string = ",\"event_id\":"
+		const prefix string = ",\"url\":"
 		out.RawString(prefix)
-		out.Int(int(in.EventID))
+		out.String(string(in.URL))
+	}
+	{
+		const prefix string = ",\"thumb\":"
+		out.RawString(prefix)
+		out.String(string(in.Thumb))
+	}
+	{
+		const prefix string = ",\"is_cover\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsCover))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v InviteNotificationRequest) MarshalJSON() ([]byte, error) {
+func (v EventImageResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonF642ad3eEncodeKudagoInternalGatewayEvent3(&w, v)
+	easyjsonF642ad3eEncodeKudagoInternalGatewayEvent24(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v InviteNotificationRequest) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonF642ad3eEncodeKudagoInternalGatewayEvent3(w, v)
+func (v EventImageResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonF642ad3eEncodeKudagoInternalGatewayEvent24(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *InviteNotificationRequest) UnmarshalJSON(data []byte) error {
+func (v *EventImageResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonF642ad3eDecodeKudagoInternalGatewayEvent3(&r, v)
+	easyjsonF642ad3eDecodeKudagoInternalGatewayEvent24(&r, v)
 	return r.Error()
 }
 
-// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *InviteNotificationRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonF642ad3eDecodeKudagoInternalGatewayEvent3(l, v)
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *EventImageResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonF642ad3eDecodeKudagoInternalGatewayEvent24(l, v)
 }
-func easyjsonF642ad3eDecodeKudagoInternalGatewayEvent4(in *jlexer.Lexer, out *GetNotificationsResponse) {
+func easyjsonF642ad3eDecodeKudagoInternalGatewayEvent25(in *jlexer.Lexer, out *CancelEventRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -577,29 +2993,8 @@ func easyjsonF642ad3eDecodeKudagoInternalGatewayEvent4(in *jlexer.Lexer, out *Ge
 			continue
 		}
 		switch key {
-		case "notifications":
-			if in.IsNull() {
-				in.Skip()
-				out.Notifications = nil
-			} else {
-				in.Delim('[')
-				if out.Notifications == nil {
-					if !in.IsDelim(']') {
-						out.Notifications = make([]NotificationWithEvent, 0, 0)
-					} else {
-						out.Notifications = []NotificationWithEvent{}
-					}
-				} else {
-					out.Notifications = (out.Notifications)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v7 NotificationWithEvent
-					(v7).UnmarshalEasyJSON(in)
-					out.Notifications = append(out.Notifications, v7)
-					in.WantComma()
-				}
-				in.Delim(']')
-			}
+		case "reason":
+			out.Reason = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -610,53 +3005,42 @@ func easyjsonF642ad3eDecodeKudagoInternalGatewayEvent4(in *jlexer.Lexer, out *Ge
 		in.Consumed()
 	}
 }
-func easyjsonF642ad3eEncodeKudagoInternalGatewayEvent4(out *jwriter.Writer, in GetNotificationsResponse) {
+func easyjsonF642ad3eEncodeKudagoInternalGatewayEvent25(out *jwriter.Writer, in CancelEventRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"notifications\":"
+		const prefix string = ",\"reason\":"
 		out.RawString(prefix[1:])
-		if in.Notifications == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v8, v9 := range in.Notifications {
-				if v8 > 0 {
-					out.RawByte(',')
-				}
-				(v9).MarshalEasyJSON(out)
-			}
-			out.RawByte(']')
-		}
+		out.String(string(in.Reason))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v GetNotificationsResponse) MarshalJSON() ([]byte, error) {
+func (v CancelEventRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonF642ad3eEncodeKudagoInternalGatewayEvent4(&w, v)
+	easyjsonF642ad3eEncodeKudagoInternalGatewayEvent25(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v GetNotificationsResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonF642ad3eEncodeKudagoInternalGatewayEvent4(w, v)
+func (v CancelEventRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonF642ad3eEncodeKudagoInternalGatewayEvent25(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *GetNotificationsResponse) UnmarshalJSON(data []byte) error {
+func (v *CancelEventRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonF642ad3eDecodeKudagoInternalGatewayEvent4(&r, v)
+	easyjsonF642ad3eDecodeKudagoInternalGatewayEvent25(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *GetNotificationsResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonF642ad3eDecodeKudagoInternalGatewayEvent4(l, v)
+func (v *CancelEventRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonF642ad3eDecodeKudagoInternalGatewayEvent25(l, v)
 }
-func easyjsonF642ad3eDecodeKudagoInternalGatewayEvent5(in *jlexer.Lexer, out *GetEventsResponse) {
+func easyjsonF642ad3eDecodeKudagoInternalGatewayEvent26(in *jlexer.Lexer, out *CalendarTokenResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -675,29 +3059,10 @@ func easyjsonF642ad3eDecodeKudagoInternalGatewayEvent5(in *jlexer.Lexer, out *Ge
 			continue
 		}
 		switch key {
-		case "events":
-			if in.IsNull() {
-				in.Skip()
-				out.Events = nil
-			} else {
-				in.Delim('[')
-				if out.Events == nil {
-					if !in.IsDelim(']') {
-						out.Events = make([]EventResponse, 0, 0)
-					} else {
-						out.Events = []EventResponse{}
-					}
-				} else {
-					out.Events = (out.Events)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v10 EventResponse
-					(v10).UnmarshalEasyJSON(in)
-					out.Events = append(out.Events, v10)
-					in.WantComma()
-				}
-				in.Delim(']')
-			}
+		case "token":
+			out.Token = string(in.String())
+		case "url":
+			out.URL = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -708,53 +3073,47 @@ func easyjsonF642ad3eDecodeKudagoInternalGatewayEvent5(in *jlexer.Lexer, out *Ge
 		in.Consumed()
 	}
 }
-func easyjsonF642ad3eEncodeKudagoInternalGatewayEvent5(out *jwriter.Writer, in GetEventsResponse) {
+func easyjsonF642ad3eEncodeKudagoInternalGatewayEvent26(out *jwriter.Writer, in CalendarTokenResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"events\":"
+		const prefix string = ",\"token\":"
 		out.RawString(prefix[1:])
-		if in.Events == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v11, v12 := range in.Events {
-				if v11 > 0 {
-					out.RawByte(',')
-				}
-				(v12).MarshalEasyJSON(out)
-			}
-			out.RawByte(']')
-		}
+		out.String(string(in.Token))
+	}
+	{
+		const prefix string = ",\"url\":"
+		out.RawString(prefix)
+		out.String(string(in.URL))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v GetEventsResponse) MarshalJSON() ([]byte, error) {
+func (v CalendarTokenResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonF642ad3eEncodeKudagoInternalGatewayEvent5(&w, v)
+	easyjsonF642ad3eEncodeKudagoInternalGatewayEvent26(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v GetEventsResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonF642ad3eEncodeKudagoInternalGatewayEvent5(w, v)
+func (v CalendarTokenResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonF642ad3eEncodeKudagoInternalGatewayEvent26(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *GetEventsResponse) UnmarshalJSON(data []byte) error {
+func (v *CalendarTokenResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonF642ad3eDecodeKudagoInternalGatewayEvent5(&r, v)
+	easyjsonF642ad3eDecodeKudagoInternalGatewayEvent26(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *GetEventsResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonF642ad3eDecodeKudagoInternalGatewayEvent5(l, v)
+func (v *CalendarTokenResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonF642ad3eDecodeKudagoInternalGatewayEvent26(l, v)
 }
-func easyjsonF642ad3eDecodeKudagoInternalGatewayEvent6(in *jlexer.Lexer, out *GetCategoriesResponse) {
+func easyjsonF642ad3eDecodeKudagoInternalGatewayEvent27(in *jlexer.Lexer, out *AttendeesResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -773,25 +3132,25 @@ func easyjsonF642ad3eDecodeKudagoInternalGatewayEvent6(in *jlexer.Lexer, out *Ge
 			continue
 		}
 		switch key {
-		case "categories":
+		case "attendees":
 			if in.IsNull() {
 				in.Skip()
-				out.Categories = nil
+				out.Attendees = nil
 			} else {
 				in.Delim('[')
-				if out.Categories == nil {
+				if out.Attendees == nil {
 					if !in.IsDelim(']') {
-						out.Categories = make([]models.Category, 0, 2)
+						out.Attendees = make([]AttendeeResponse, 0, 0)
 					} else {
-						out.Categories = []models.Category{}
+						out.Attendees = []AttendeeResponse{}
 					}
 				} else {
-					out.Categories = (out.Categories)[:0]
+					out.Attendees = (out.Attendees)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v13 models.Category
-					easyjsonF642ad3eDecodeKudagoInternalModels1(in, &v13)
-					out.Categories = append(out.Categories, v13)
+					var v58 AttendeeResponse
+					(v58).UnmarshalEasyJSON(in)
+					out.Attendees = append(out.Attendees, v58)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -806,22 +3165,22 @@ func easyjsonF642ad3eDecodeKudagoInternalGatewayEvent6(in *jlexer.Lexer, out *Ge
 		in.Consumed()
 	}
 }
-func easyjsonF642ad3eEncodeKudagoInternalGatewayEvent6(out *jwriter.Writer, in GetCategoriesResponse) {
+func easyjsonF642ad3eEncodeKudagoInternalGatewayEvent27(out *jwriter.Writer, in AttendeesResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"categories\":"
+		const prefix string = ",\"attendees\":"
 		out.RawString(prefix[1:])
-		if in.Categories == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		if in.Attendees == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v14, v15 := range in.Categories {
-				if v14 > 0 {
+			for v59, v60 := range in.Attendees {
+				if v59 > 0 {
 					out.RawByte(',')
 				}
-				easyjsonF642ad3eEncodeKudagoInternalModels1(out, v15)
+				(v60).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
@@ -830,29 +3189,29 @@ func easyjsonF642ad3eEncodeKudagoInternalGatewayEvent6(out *jwriter.Writer, in G
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v GetCategoriesResponse) MarshalJSON() ([]byte, error) {
+func (v AttendeesResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonF642ad3eEncodeKudagoInternalGatewayEvent6(&w, v)
+	easyjsonF642ad3eEncodeKudagoInternalGatewayEvent27(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v GetCategoriesResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonF642ad3eEncodeKudagoInternalGatewayEvent6(w, v)
+func (v AttendeesResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonF642ad3eEncodeKudagoInternalGatewayEvent27(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *GetCategoriesResponse) UnmarshalJSON(data []byte) error {
+func (v *AttendeesResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonF642ad3eDecodeKudagoInternalGatewayEvent6(&r, v)
+	easyjsonF642ad3eDecodeKudagoInternalGatewayEvent27(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *GetCategoriesResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonF642ad3eDecodeKudagoInternalGatewayEvent6(l, v)
+func (v *AttendeesResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonF642ad3eDecodeKudagoInternalGatewayEvent27(l, v)
 }
-func easyjsonF642ad3eDecodeKudagoInternalModels1(in *jlexer.Lexer, out *models.Category) {
+func easyjsonF642ad3eDecodeKudagoInternalGatewayEvent28(in *jlexer.Lexer, out *AttendeeResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -871,10 +3230,16 @@ func easyjsonF642ad3eDecodeKudagoInternalModels1(in *jlexer.Lexer, out *models.C
 			continue
 		}
 		switch key {
-		case "id":
-			out.ID = int(in.Int())
-		case "name":
-			out.Name = string(in.String())
+		case "user_id":
+			out.UserID = int(in.Int())
+		case "username":
+			out.Username = string(in.String())
+		case "image":
+			out.ImageURL = string(in.String())
+		case "rsvp_at":
+			out.RSVPAt = string(in.String())
+		case "checked_in_at":
+			out.CheckedInAt = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -885,23 +3250,62 @@ func easyjsonF642ad3eDecodeKudagoInternalModels1(in *jlexer.Lexer, out *models.C
 		in.Consumed()
 	}
 }
-func easyjsonF642ad3eEncodeKudagoInternalModels1(out *jwriter.Writer, in models.Category) {
+func easyjsonF642ad3eEncodeKudagoInternalGatewayEvent28(out *jwriter.Writer, in AttendeeResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"id\":"
+		const prefix string = ",\"user_id\":"
 		out.RawString(prefix[1:])
-		out.Int(int(in.ID))
+		out.Int(int(in.UserID))
 	}
 	{
-		const prefix string = ",\"name\":"
+		const prefix string = ",\"username\":"
 		out.RawString(prefix)
-		out.String(string(in.Name))
+		out.String(string(in.Username))
+	}
+	{
+		const prefix string = ",\"image\":"
+		out.RawString(prefix)
+		out.String(string(in.ImageURL))
+	}
+	{
+		const prefix string = ",\"rsvp_at\":"
+		out.RawString(prefix)
+		out.String(string(in.RSVPAt))
+	}
+	if in.CheckedInAt != "" {
+		const prefix string = ",\"checked_in_at\":"
+		out.RawString(prefix)
+		out.String(string(in.CheckedInAt))
 	}
 	out.RawByte('}')
 }
-func easyjsonF642ad3eDecodeKudagoInternalGatewayEvent7(in *jlexer.Lexer, out *EventResponse) {
+
+// MarshalJSON supports json.Marshaler interface
+func (v AttendeeResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonF642ad3eEncodeKudagoInternalGatewayEvent28(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v AttendeeResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonF642ad3eEncodeKudagoInternalGatewayEvent28(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *AttendeeResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonF642ad3eDecodeKudagoInternalGatewayEvent28(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *AttendeeResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonF642ad3eDecodeKudagoInternalGatewayEvent28(l, v)
+}
+func easyjsonF642ad3eDecodeKudagoInternalGatewayEvent29(in *jlexer.Lexer, out *AttendanceResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -920,53 +3324,10 @@ func easyjsonF642ad3eDecodeKudagoInternalGatewayEvent7(in *jlexer.Lexer, out *Ev
 			continue
 		}
 		switch key {
-		case "id":
-			out.ID = int(in.Int())
-		case "title":
-			out.Title = string(in.String())
-		case "description":
-			out.Description = string(in.String())
-		case "location":
-			out.Location = string(in.String())
-		case "category_id":
-			out.Category = int(in.Int())
-		case "capacity":
-			out.Capacity = int(in.Int())
-		case "tag":
-			if in.IsNull() {
-				in.Skip()
-				out.Tag = nil
-			} else {
-				in.Delim('[')
-				if out.Tag == nil {
-					if !in.IsDelim(']') {
-						out.Tag = make([]string, 0, 4)
-					} else {
-						out.Tag = []string{}
-					}
-				} else {
-					out.Tag = (out.Tag)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v16 string
-					v16 = string(in.String())
-					out.Tag = append(out.Tag, v16)
-					in.WantComma()
-				}
-				in.Delim(']')
-			}
-		case "author":
-			out.AuthorID = int(in.Int())
-		case "event_start":
-			out.EventStart = string(in.String())
-		case "event_end":
-			out.EventEnd = string(in.String())
-		case "image":
-			out.ImageURL = string(in.String())
-		case "Latitude":
-			out.Latitude = float64(in.Float64())
-		case "Longitude":
-			out.Longitude = float64(in.Float64())
+		case "status":
+			out.Status = string(in.String())
+		case "position":
+			out.Position = int(in.Int())
 		default:
 			in.SkipRecursive()
 		}
@@ -977,109 +3338,43 @@ func easyjsonF642ad3eDecodeKudagoInternalGatewayEvent7(in *jlexer.Lexer, out *Ev
 		in.Consumed()
 	}
 }
-func easyjsonF642ad3eEncodeKudagoInternalGatewayEvent7(out *jwriter.Writer, in EventResponse) {
+func easyjsonF642ad3eEncodeKudagoInternalGatewayEvent29(out *jwriter.Writer, in AttendanceResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"id\":"
+		const prefix string = ",\"status\":"
 		out.RawString(prefix[1:])
-		out.Int(int(in.ID))
-	}
-	{
-		const prefix string = ",\"title\":"
-		out.RawString(prefix)
-		out.String(string(in.Title))
-	}
-	{
-		const prefix string = ",\"description\":"
-		out.RawString(prefix)
-		out.String(string(in.Description))
-	}
-	{
-		const prefix string = ",\"location\":"
-		out.RawString(prefix)
-		out.String(string(in.Location))
-	}
-	{
-		const prefix string = ",\"category_id\":"
-		out.RawString(prefix)
-		out.Int(int(in.Category))
-	}
-	{
-		const prefix string = ",\"capacity\":"
-		out.RawString(prefix)
-		out.Int(int(in.Capacity))
-	}
-	{
-		const prefix string = ",\"tag\":"
-		out.RawString(prefix)
-		if in.Tag == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v17, v18 := range in.Tag {
-				if v17 > 0 {
-					out.RawByte(',')
-				}
-				out.String(string(v18))
-			}
-			out.RawByte(']')
-		}
+		out.String(string(in.Status))
 	}
-	{
-		const prefix string = ",\"author\":"
-		out.RawString(prefix)
-		out.Int(int(in.AuthorID))
-	}
-	{
-		const prefix string = ",\"event_start\":"
-		out.RawString(prefix)
-		out.String(string(in.EventStart))
-	}
-	{
-		const prefix string = ",\"event_end\":"
-		out.RawString(prefix)
-		out.String(string(in.EventEnd))
-	}
-	{
-		const prefix string = ",\"image\":"
-		out.RawString(prefix)
-		out.String(string(in.ImageURL))
-	}
-	{
-		const prefix string = ",\"Latitude\":"
-		out.RawString(prefix)
-		out.Float64(float64(in.Latitude))
-	}
-	{
-		const prefix string = ",\"Longitude\":"
+	if in.Position != 0 {
+		const prefix string = ",\"position\":"
 		out.RawString(prefix)
-		out.Float64(float64(in.Longitude))
+		out.Int(int(in.Position))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v EventResponse) MarshalJSON() ([]byte, error) {
+func (v AttendanceResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonF642ad3eEncodeKudagoInternalGatewayEvent7(&w, v)
+	easyjsonF642ad3eEncodeKudagoInternalGatewayEvent29(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v EventResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonF642ad3eEncodeKudagoInternalGatewayEvent7(w, v)
+func (v AttendanceResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonF642ad3eEncodeKudagoInternalGatewayEvent29(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *EventResponse) UnmarshalJSON(data []byte) error {
+func (v *AttendanceResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonF642ad3eDecodeKudagoInternalGatewayEvent7(&r, v)
+	easyjsonF642ad3eDecodeKudagoInternalGatewayEvent29(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *EventResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonF642ad3eDecodeKudagoInternalGatewayEvent7(l, v)
+func (v *AttendanceResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonF642ad3eDecodeKudagoInternalGatewayEvent29(l, v)
 }
@@ -0,0 +1,226 @@
+package events
+
+import (
+	"net/http"
+	"strconv"
+
+	pbEvent "kudago/internal/event/api"
+	httpErrors "kudago/internal/gateway/errors"
+	"kudago/internal/gateway/utils"
+	"kudago/internal/models"
+
+	"github.com/gorilla/mux"
+	easyjson "github.com/mailru/easyjson"
+	grpcCodes "google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+// @Summary Добавление изображения события
+// @Description Загружает изображение в конец галереи события. Первое изображение становится обложкой.
+// @Tags events
+// @Accept  multipart/form-data
+// @Produce  json
+// @Param id path int true "Идентификатор события"
+// @Param image formData file true "Изображение"
+// @Success 201 {object} EventImageResponse
+// @Failure 400 {object} httpErrors.HttpError "Неверное изображение"
+// @Failure 401 {object} httpErrors.HttpError "Неавторизован"
+// @Failure 403 {object} httpErrors.HttpError "Доступ запрещен"
+// @Failure 404 {object} httpErrors.HttpError "Событие не найдено"
+// @Failure 409 {object} httpErrors.HttpError "Галерея заполнена"
+// @Failure 413 {object} httpErrors.HttpError "Изображение больше 10Мб"
+// @Failure 500 {object} httpErrors.HttpError "Внутренняя ошибка сервера"
+// @Router /events/{id}/images [post]
+func (h EventHandler) AddEventImage(w http.ResponseWriter, r *http.Request) {
+	session, ok := utils.GetSessionFromContext(r.Context())
+	if !ok {
+		utils.WriteResponse(w, http.StatusForbidden, httpErrors.ErrUnauthorized)
+		return
+	}
+
+	vars := mux.Vars(r)
+	id, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		utils.WriteResponse(w, http.StatusBadRequest, httpErrors.ErrInvalidID)
+		return
+	}
+
+	_, media, err := utils.ReadImageForm(w, r)
+	if err != nil || media == nil {
+		utils.WriteResponse(w, http.StatusBadRequest, httpErrors.ErrInvalidImage)
+		return
+	}
+
+	url, err := h.uploadImage(r.Context(), media, w)
+	if err != nil {
+		return
+	}
+
+	image, err := h.EventService.AddEventImage(r.Context(), &pbEvent.AddEventImageRequest{
+		EventID:  int32(id),
+		AuthorID: int32(session.UserID),
+		Url:      url,
+	})
+	if err != nil {
+		h.deleteImage(r.Context(), url)
+		st, ok := grpcStatus.FromError(err)
+		if ok {
+			switch st.Code() {
+			case grpcCodes.NotFound:
+				utils.WriteResponse(w, http.StatusNotFound, httpErrors.ErrEventNotFound)
+				return
+			case grpcCodes.PermissionDenied:
+				utils.WriteResponse(w, http.StatusForbidden, httpErrors.ErrAccessDenied)
+				return
+			case grpcCodes.ResourceExhausted:
+				utils.WriteResponse(w, http.StatusConflict, httpErrors.ErrTooManyImages)
+				return
+			}
+		}
+
+		h.logger.Error(r.Context(), "add event image", err)
+		utils.WriteResponse(w, http.StatusInternalServerError, httpErrors.ErrInternal)
+		return
+	}
+
+	utils.WriteResponse(w, http.StatusCreated, eventImageToResponse(image))
+}
+
+// @Summary Порядок и обложка галереи
+// @Description Меняет порядок изображений события и выбирает обложку. Порядок должен перечислять все изображения события; пустой порядок или cover_id не меняют соответствующую часть.
+// @Tags events
+// @Accept  json
+// @Produce  json
+// @Param id path int true "Идентификатор события"
+// @Param json body UpdateEventImagesRequest true "Порядок изображений и обложка"
+// @Success 200 {object} EventImagesResponse
+// @Failure 400 {object} httpErrors.HttpError "Неверный порядок"
+// @Failure 401 {object} httpErrors.HttpError "Неавторизован"
+// @Failure 403 {object} httpErrors.HttpError "Доступ запрещен"
+// @Failure 404 {object} httpErrors.HttpError "Изображение не найдено"
+// @Failure 500 {object} httpErrors.HttpError "Внутренняя ошибка сервера"
+// @Router /events/{id}/images [put]
+func (h EventHandler) UpdateEventImages(w http.ResponseWriter, r *http.Request) {
+	session, ok := utils.GetSessionFromContext(r.Context())
+	if !ok {
+		utils.WriteResponse(w, http.StatusForbidden, httpErrors.ErrUnauthorized)
+		return
+	}
+
+	vars := mux.Vars(r)
+	id, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		utils.WriteResponse(w, http.StatusBadRequest, httpErrors.ErrInvalidID)
+		return
+	}
+
+	var req UpdateEventImagesRequest
+	err = easyjson.UnmarshalFromReader(r.Body, &req)
+	if err != nil {
+		utils.WriteResponse(w, http.StatusBadRequest, httpErrors.ErrInvalidData)
+		return
+	}
+
+	order := make([]int32, 0, len(req.Order))
+	for _, imageID := range req.Order {
+		order = append(order, int32(imageID))
+	}
+
+	images, err := h.EventService.UpdateEventImages(r.Context(), &pbEvent.UpdateEventImagesRequest{
+		EventID:  int32(id),
+		AuthorID: int32(session.UserID),
+		Order:    order,
+		CoverId:  int32(req.CoverID),
+	})
+	if err != nil {
+		h.writeEventImageError(w, r, "update event images", err)
+		return
+	}
+
+	utils.WriteResponse(w, http.StatusOK, EventImagesResponse{Images: eventImagesToResponse(images.Images)})
+}
+
+// @Summary Удаление изображения события
+// @Description Удаляет изображение из галереи события вместе с файлом. Если удалена обложка, ею становится первое оставшееся изображение.
+// @Tags events
+// @Param id path int true "Идентификатор события"
+// @Param imageID path int true "Идентификатор изображения"
+// @Success 204
+// @Failure 401 {object} httpErrors.HttpError "Неавторизован"
+// @Failure 403 {object} httpErrors.HttpError "Доступ запрещен"
+// @Failure 404 {object} httpErrors.HttpError "Изображение не найдено"
+// @Failure 500 {object} httpErrors.HttpError "Внутренняя ошибка сервера"
+// @Router /events/{id}/images/{imageID} [delete]
+func (h EventHandler) DeleteEventImage(w http.ResponseWriter, r *http.Request) {
+	session, ok := utils.GetSessionFromContext(r.Context())
+	if !ok {
+		utils.WriteResponse(w, http.StatusForbidden, httpErrors.ErrUnauthorized)
+		return
+	}
+
+	vars := mux.Vars(r)
+	id, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		utils.WriteResponse(w, http.StatusBadRequest, httpErrors.ErrInvalidID)
+		return
+	}
+	imageID, err := strconv.Atoi(vars["imageID"])
+	if err != nil {
+		utils.WriteResponse(w, http.StatusBadRequest, httpErrors.ErrInvalidID)
+		return
+	}
+
+	image, err := h.EventService.DeleteEventImage(r.Context(), &pbEvent.DeleteEventImageRequest{
+		EventID:  int32(id),
+		AuthorID: int32(session.UserID),
+		ImageID:  int32(imageID),
+	})
+	if err != nil {
+		h.writeEventImageError(w, r, "delete event image", err)
+		return
+	}
+
+	h.deleteImage(r.Context(), image.Url)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h EventHandler) writeEventImageError(w http.ResponseWriter, r *http.Request, method string, err error) {
+	st, ok := grpcStatus.FromError(err)
+	if ok {
+		switch st.Code() {
+		case grpcCodes.NotFound:
+			utils.WriteResponse(w, http.StatusNotFound, httpErrors.ErrImageNotFound)
+			return
+		case grpcCodes.PermissionDenied:
+			utils.WriteResponse(w, http.StatusForbidden, httpErrors.ErrAccessDenied)
+			return
+		case grpcCodes.InvalidArgument:
+			utils.WriteResponse(w, http.StatusBadRequest, httpErrors.ErrInvalidImageOrder)
+			return
+		}
+	}
+
+	h.logger.Error(r.Context(), method, err)
+	utils.WriteResponse(w, http.StatusInternalServerError, httpErrors.ErrInternal)
+}
+
+func eventImageToResponse(image *pbEvent.EventImage) EventImageResponse {
+	return EventImageResponse{
+		ID:      int(image.ID),
+		URL:     models.ImageVariantURL(image.Url, models.ImageFull, models.ImageFormatJPEG),
+		Thumb:   models.ImageVariantURL(image.Url, models.ImageThumb, models.ImageFormatJPEG),
+		IsCover: image.IsCover,
+	}
+}
+
+func eventImagesToResponse(images []*pbEvent.EventImage) []EventImageResponse {
+	if len(images) == 0 {
+		return nil
+	}
+
+	resp := make([]EventImageResponse, 0, len(images))
+	for _, image := range images {
+		resp = append(resp, eventImageToResponse(image))
+	}
+	return resp
+}
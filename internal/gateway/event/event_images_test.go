@@ -0,0 +1,301 @@
+package events
+
+import (
+	"bytes"
+	"encoding/json"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	pb "kudago/internal/event/api"
+	"kudago/internal/event/grpc"
+	"kudago/internal/gateway/event/mocks"
+	"kudago/internal/gateway/utils"
+	pbImage "kudago/internal/image/api"
+	"kudago/internal/logger"
+	"kudago/internal/models"
+
+	"github.com/golang/mock/gomock"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	grpcLib "google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeUploadStream plays the image service side of a streamed upload: it
+// expects the file name in the first chunk and the whole test file.
+type fakeUploadStream struct {
+	grpcLib.ClientStream
+	filename string
+	data     bytes.Buffer
+	resp     *pbImage.UploadResponse
+	err      error
+}
+
+func (s *fakeUploadStream) Send(chunk *pbImage.UploadChunk) error {
+	if s.data.Len() == 0 {
+		s.filename = chunk.Filename
+	}
+	s.data.Write(chunk.Data)
+	return nil
+}
+
+func (s *fakeUploadStream) CloseAndRecv() (*pbImage.UploadResponse, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	if !strings.HasSuffix(s.filename, ".jpg") || s.data.String() != "image data" {
+		return nil, status.Error(codes.Internal, "unexpected upload")
+	}
+	return s.resp, nil
+}
+
+func newImageUploadRequest(t *testing.T) *http.Request {
+	t.Helper()
+
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	part, err := writer.CreateFormFile("image", "photo.jpg")
+	require.NoError(t, err)
+	_, err = part.Write([]byte("image data"))
+	require.NoError(t, err)
+	require.NoError(t, writer.Close())
+
+	req := httptest.NewRequest(http.MethodPost, "/events/1/images", body)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	req = mux.SetURLVars(req, map[string]string{"id": "1"})
+	return req.WithContext(utils.SetSessionInContext(req.Context(), models.Session{UserID: 2, Token: "valid_token"}))
+}
+
+func TestEventHandler_AddEventImage(t *testing.T) {
+	t.Parallel()
+
+	logger, _ := logger.NewLogger()
+
+	tests := []struct {
+		name      string
+		setupFunc func(serviceMock *mocks.MockEventServiceClient, imageMock *mocks.MockImageServiceClient)
+		wantCode  int
+		wantBody  *EventImageResponse
+	}{
+		{
+			name: "Изображение добавлено в галерею",
+			setupFunc: func(serviceMock *mocks.MockEventServiceClient, imageMock *mocks.MockImageServiceClient) {
+				imageMock.EXPECT().UploadImageStream(gomock.Any()).
+					Return(&fakeUploadStream{resp: &pbImage.UploadResponse{FileUrl: "/images/a_full.jpg"}}, nil)
+				serviceMock.EXPECT().AddEventImage(gomock.Any(), &pb.AddEventImageRequest{EventID: 1, AuthorID: 2, Url: "/images/a_full.jpg"}).
+					Return(&pb.EventImage{ID: 5, Url: "/images/a_full.jpg", Position: 1}, nil)
+			},
+			wantCode: http.StatusCreated,
+			wantBody: &EventImageResponse{ID: 5, URL: "/images/a_full.jpg", Thumb: "/images/a_thumb.jpg"},
+		},
+		{
+			name: "Галерея заполнена, файл удаляется",
+			setupFunc: func(serviceMock *mocks.MockEventServiceClient, imageMock *mocks.MockImageServiceClient) {
+				imageMock.EXPECT().UploadImageStream(gomock.Any()).
+					Return(&fakeUploadStream{resp: &pbImage.UploadResponse{FileUrl: "/images/a.jpg"}}, nil)
+				serviceMock.EXPECT().AddEventImage(gomock.Any(), gomock.Any()).
+					Return(nil, status.Error(codes.ResourceExhausted, grpc.ErrTooManyImages))
+				imageMock.EXPECT().DeleteImage(gomock.Any(), &pbImage.DeleteRequest{FileUrl: "/images/a.jpg"}).
+					Return(&pbImage.Empty{}, nil)
+			},
+			wantCode: http.StatusConflict,
+		},
+		{
+			name: "Чужое событие",
+			setupFunc: func(serviceMock *mocks.MockEventServiceClient, imageMock *mocks.MockImageServiceClient) {
+				imageMock.EXPECT().UploadImageStream(gomock.Any()).
+					Return(&fakeUploadStream{resp: &pbImage.UploadResponse{FileUrl: "/images/a.jpg"}}, nil)
+				serviceMock.EXPECT().AddEventImage(gomock.Any(), gomock.Any()).
+					Return(nil, status.Error(codes.PermissionDenied, grpc.ErrPermissionDenied))
+				imageMock.EXPECT().DeleteImage(gomock.Any(), &pbImage.DeleteRequest{FileUrl: "/images/a.jpg"}).
+					Return(&pbImage.Empty{}, nil)
+			},
+			wantCode: http.StatusForbidden,
+		},
+		{
+			name: "Файл не является изображением",
+			setupFunc: func(serviceMock *mocks.MockEventServiceClient, imageMock *mocks.MockImageServiceClient) {
+				imageMock.EXPECT().UploadImageStream(gomock.Any()).
+					Return(&fakeUploadStream{err: status.Error(codes.InvalidArgument, "invalid image")}, nil)
+			},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name: "Файл слишком большой",
+			setupFunc: func(serviceMock *mocks.MockEventServiceClient, imageMock *mocks.MockImageServiceClient) {
+				imageMock.EXPECT().UploadImageStream(gomock.Any()).
+					Return(&fakeUploadStream{err: status.Error(codes.ResourceExhausted, "image is too large")}, nil)
+			},
+			wantCode: http.StatusRequestEntityTooLarge,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			serviceMock := mocks.NewMockEventServiceClient(ctrl)
+			imageMock := mocks.NewMockImageServiceClient(ctrl)
+			tt.setupFunc(serviceMock, imageMock)
+			handler := &EventHandler{EventService: serviceMock, ImageService: imageMock, logger: logger}
+
+			recorder := httptest.NewRecorder()
+			handler.AddEventImage(recorder, newImageUploadRequest(t))
+
+			assert.Equal(t, tt.wantCode, recorder.Code)
+			if tt.wantBody != nil {
+				var resp EventImageResponse
+				assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp))
+				assert.Equal(t, *tt.wantBody, resp)
+			}
+		})
+	}
+}
+
+func TestEventHandler_AddEventImage_NoFile(t *testing.T) {
+	t.Parallel()
+
+	logger, _ := logger.NewLogger()
+	handler := &EventHandler{logger: logger}
+
+	req := httptest.NewRequest(http.MethodPost, "/events/1/images", nil)
+	req = mux.SetURLVars(req, map[string]string{"id": "1"})
+	req = req.WithContext(utils.SetSessionInContext(req.Context(), models.Session{UserID: 2, Token: "valid_token"}))
+	recorder := httptest.NewRecorder()
+	handler.AddEventImage(recorder, req)
+
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+}
+
+func TestEventHandler_UpdateEventImages(t *testing.T) {
+	t.Parallel()
+
+	logger, _ := logger.NewLogger()
+	updateReq := &pb.UpdateEventImagesRequest{EventID: 1, AuthorID: 2, Order: []int32{6, 5}, CoverId: 6}
+
+	tests := []struct {
+		name      string
+		setupFunc func(serviceMock *mocks.MockEventServiceClient)
+		wantCode  int
+		wantBody  *EventImagesResponse
+	}{
+		{
+			name: "Новый порядок и обложка",
+			setupFunc: func(serviceMock *mocks.MockEventServiceClient) {
+				serviceMock.EXPECT().UpdateEventImages(gomock.Any(), updateReq).
+					Return(&pb.EventImages{Images: []*pb.EventImage{
+						{ID: 6, Url: "/images/b.jpg", Position: 0, IsCover: true},
+						{ID: 5, Url: "/images/a.jpg", Position: 1},
+					}}, nil)
+			},
+			wantCode: http.StatusOK,
+			wantBody: &EventImagesResponse{Images: []EventImageResponse{
+				{ID: 6, URL: "/images/b.jpg", Thumb: "/images/b.jpg", IsCover: true},
+				{ID: 5, URL: "/images/a.jpg", Thumb: "/images/a.jpg"},
+			}},
+		},
+		{
+			name: "Порядок перечисляет не все изображения",
+			setupFunc: func(serviceMock *mocks.MockEventServiceClient) {
+				serviceMock.EXPECT().UpdateEventImages(gomock.Any(), updateReq).
+					Return(nil, status.Error(codes.InvalidArgument, grpc.ErrBadData))
+			},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name: "Обложка не из галереи",
+			setupFunc: func(serviceMock *mocks.MockEventServiceClient) {
+				serviceMock.EXPECT().UpdateEventImages(gomock.Any(), updateReq).
+					Return(nil, status.Error(codes.NotFound, grpc.ErrImageNotFound))
+			},
+			wantCode: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			serviceMock := mocks.NewMockEventServiceClient(ctrl)
+			tt.setupFunc(serviceMock)
+			handler := &EventHandler{EventService: serviceMock, logger: logger}
+
+			req := httptest.NewRequest(http.MethodPut, "/events/1/images", strings.NewReader(`{"order": [6, 5], "cover_id": 6}`))
+			req = mux.SetURLVars(req, map[string]string{"id": "1"})
+			req = req.WithContext(utils.SetSessionInContext(req.Context(), models.Session{UserID: 2, Token: "valid_token"}))
+			recorder := httptest.NewRecorder()
+			handler.UpdateEventImages(recorder, req)
+
+			assert.Equal(t, tt.wantCode, recorder.Code)
+			if tt.wantBody != nil {
+				var resp EventImagesResponse
+				assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp))
+				assert.Equal(t, *tt.wantBody, resp)
+			}
+		})
+	}
+}
+
+func TestEventHandler_DeleteEventImage(t *testing.T) {
+	t.Parallel()
+
+	logger, _ := logger.NewLogger()
+
+	tests := []struct {
+		name      string
+		setupFunc func(serviceMock *mocks.MockEventServiceClient, imageMock *mocks.MockImageServiceClient)
+		wantCode  int
+	}{
+		{
+			name: "Изображение и файл удалены",
+			setupFunc: func(serviceMock *mocks.MockEventServiceClient, imageMock *mocks.MockImageServiceClient) {
+				serviceMock.EXPECT().DeleteEventImage(gomock.Any(), &pb.DeleteEventImageRequest{EventID: 1, AuthorID: 2, ImageID: 5}).
+					Return(&pb.EventImage{ID: 5, Url: "/images/a.jpg"}, nil)
+				imageMock.EXPECT().DeleteImage(gomock.Any(), &pbImage.DeleteRequest{FileUrl: "/images/a.jpg"}).
+					Return(&pbImage.Empty{}, nil)
+			},
+			wantCode: http.StatusNoContent,
+		},
+		{
+			name: "Изображение не найдено",
+			setupFunc: func(serviceMock *mocks.MockEventServiceClient, _ *mocks.MockImageServiceClient) {
+				serviceMock.EXPECT().DeleteEventImage(gomock.Any(), gomock.Any()).
+					Return(nil, status.Error(codes.NotFound, grpc.ErrImageNotFound))
+			},
+			wantCode: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			serviceMock := mocks.NewMockEventServiceClient(ctrl)
+			imageMock := mocks.NewMockImageServiceClient(ctrl)
+			tt.setupFunc(serviceMock, imageMock)
+			handler := &EventHandler{EventService: serviceMock, ImageService: imageMock, logger: logger}
+
+			req := httptest.NewRequest(http.MethodDelete, "/events/1/images/5", nil)
+			req = mux.SetURLVars(req, map[string]string{"id": "1", "imageID": "5"})
+			req = req.WithContext(utils.SetSessionInContext(req.Context(), models.Session{UserID: 2, Token: "valid_token"}))
+			recorder := httptest.NewRecorder()
+			handler.DeleteEventImage(recorder, req)
+
+			assert.Equal(t, tt.wantCode, recorder.Code)
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package events
+
+import (
+	"net/http"
+	"strconv"
+
+	pb "kudago/internal/event/api"
+	httpErrors "kudago/internal/gateway/errors"
+	"kudago/internal/gateway/utils"
+
+	"github.com/gorilla/mux"
+)
+
+// @Summary Получение участников события
+// @Description Возвращает пользователей, которые записались на событие
+// @Tags attendance
+// @Produce  json
+// @Param id path int true "ID события"
+// @Param page query int false "Номер страницы (по умолчанию 1)"
+// @Param limit query int false "Количество участников на странице (по умолчанию 30)"
+// @Success 200 {object} AttendeesResponse
+// @Failure 500 {object} httpErrors.HttpError "Internal Server Error"
+// @Router /events/{id}/attendees [get]
+func (h EventHandler) GetAttendees(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		utils.WriteResponse(w, http.StatusBadRequest, httpErrors.ErrInvalidID)
+		return
+	}
+
+	paginationParams := GetPaginationParams(r)
+
+	attendees, err := h.EventService.GetAttendees(r.Context(), &pb.GetAttendeesRequest{
+		EventID: int32(id),
+		Params:  paginationParams,
+	})
+	if err != nil {
+		h.logger.Error(r.Context(), "get attendees", err)
+		utils.WriteResponse(w, http.StatusInternalServerError, httpErrors.ErrInternal)
+		return
+	}
+
+	resp := AttendeesResponse{
+		Attendees: make([]AttendeeResponse, 0, len(attendees.Attendees)),
+	}
+	for _, attendee := range attendees.Attendees {
+		resp.Attendees = append(resp.Attendees, AttendeeResponse{
+			UserID:      int(attendee.UserID),
+			Username:    attendee.Username,
+			ImageURL:    attendee.AvatarUrl,
+			RSVPAt:      attendee.RsvpAt,
+			CheckedInAt: attendee.CheckedInAt,
+		})
+	}
+
+	utils.WriteResponse(w, http.StatusOK, resp)
+}
@@ -4,7 +4,6 @@ import (
 	"net/http"
 	"strconv"
 
-	pb "kudago/internal/event/api"
 	httpErrors "kudago/internal/gateway/errors"
 
 	"kudago/internal/gateway/utils"
@@ -30,7 +29,7 @@ func (h EventHandler) GetEventByID(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	event, err := h.EventService.GetEventByID(r.Context(), &pb.GetEventByIDRequest{ID: int32(id)})
+	event, err := h.EventService.GetEventByID(r.Context(), eventByIDRequest(r, id))
 	if err != nil {
 		st, ok := grpcStatus.FromError(err)
 		if ok {
@@ -9,7 +9,9 @@ import (
 	pb "kudago/internal/event/api"
 	"kudago/internal/event/grpc"
 	"kudago/internal/gateway/event/mocks"
+	"kudago/internal/gateway/utils"
 	"kudago/internal/logger"
+	"kudago/internal/models"
 
 	"github.com/golang/mock/gomock"
 	"github.com/gorilla/mux"
@@ -101,6 +103,66 @@ func TestEventHandler_GetEventsByID(t *testing.T) {
 			wantCode: http.StatusInternalServerError,
 			wantBody: &EventResponse{},
 		},
+		{
+			name: "Черновик скрыт от других пользователей",
+			req: func() *http.Request {
+				req := httptest.NewRequest(http.MethodGet, "/events/1", nil)
+				req = mux.SetURLVars(req, map[string]string{"id": "1"})
+				return req
+			}(),
+			setupFunc: func(ctrl *gomock.Controller) *EventHandler {
+				serviceMock := mocks.NewMockEventServiceClient(ctrl)
+				serviceMock.EXPECT().GetEventByID(gomock.Any(), getEventByIDRequest).
+					Return(nil, status.Error(codes.NotFound, grpc.ErrEventNotFound))
+
+				return &EventHandler{
+					EventService: serviceMock,
+					logger:       logger,
+				}
+			},
+			wantCode: http.StatusNotFound,
+			wantBody: &EventResponse{},
+		},
+		{
+			name: "Черновик виден автору",
+			req: func() *http.Request {
+				req := httptest.NewRequest(http.MethodGet, "/events/1", nil)
+				req = mux.SetURLVars(req, map[string]string{"id": "1"})
+				return req.WithContext(utils.SetSessionInContext(req.Context(), models.Session{UserID: 2, Token: "valid_token"}))
+			}(),
+			setupFunc: func(ctrl *gomock.Controller) *EventHandler {
+				serviceMock := mocks.NewMockEventServiceClient(ctrl)
+				serviceMock.EXPECT().GetEventByID(gomock.Any(), &pb.GetEventByIDRequest{ID: 1, UserID: 2}).
+					Return(&pb.Event{ID: 1, AuthorID: 2, Status: models.EventStatusDraft, PublishAt: "2030-01-01T10:00:00Z"}, nil)
+
+				return &EventHandler{
+					EventService: serviceMock,
+					logger:       logger,
+				}
+			},
+			wantCode: http.StatusOK,
+			wantBody: &EventResponse{ID: 1, AuthorID: 2, Status: models.EventStatusDraft, PublishAt: "2030-01-01T10:00:00Z"},
+		},
+		{
+			name: "Изображение полного размера",
+			req: func() *http.Request {
+				req := httptest.NewRequest(http.MethodGet, "/events/1", nil)
+				req = mux.SetURLVars(req, map[string]string{"id": "1"})
+				return req
+			}(),
+			setupFunc: func(ctrl *gomock.Controller) *EventHandler {
+				serviceMock := mocks.NewMockEventServiceClient(ctrl)
+				serviceMock.EXPECT().GetEventByID(gomock.Any(), getEventByIDRequest).
+					Return(&pb.Event{ID: 1, Image: "static/images/a_full.jpg"}, nil)
+
+				return &EventHandler{
+					EventService: serviceMock,
+					logger:       logger,
+				}
+			},
+			wantCode: http.StatusOK,
+			wantBody: &EventResponse{ID: 1, ImageURL: "static/images/a_full.jpg", ImageWebP: "static/images/a_full.webp"},
+		},
 	}
 
 	for _, tt := range tests {
@@ -17,7 +17,11 @@ import (
 // @Description Возвращает события по ID категории
 // @Tags events
 // @Produce  json
+// @Param page query int false "Номер страницы (по умолчанию 1)"
+// @Param limit query int false "Количество событий на странице (по умолчанию 30)"
+// @Param cursor query string false "Курсор следующей страницы из next_cursor; если передан, параметр page не учитывается"
 // @Success 200 {object} GetEventsResponse
+// @Failure 400 {object} httpErrors.HttpError "Invalid Cursor"
 // @Failure 500 {object} httpErrors.HttpError "Internal Server Error"
 // @Router /events/categories/{category} [get]
 func (h EventHandler) GetEventsByCategory(w http.ResponseWriter, r *http.Request) {
@@ -43,7 +47,7 @@ func (h EventHandler) GetEventsByCategory(w http.ResponseWriter, r *http.Request
 			if ok {
 				switch st.Code() {
 				case grpcCodes.InvalidArgument:
-					utils.WriteResponse(w, http.StatusBadRequest, httpErrors.ErrInvalidCategory)
+					utils.WriteResponse(w, http.StatusBadRequest, httpErrors.ErrInvalidCursor)
 					return
 				}
 			}
@@ -54,7 +58,7 @@ func (h EventHandler) GetEventsByCategory(w http.ResponseWriter, r *http.Request
 		}
 	}
 
-	resp := writeEventsResponse(events.Events, int(paginationParams.Limit))
+	resp := writeCursorEventsResponse(events, int(paginationParams.Limit))
 
 	utils.WriteResponse(w, http.StatusOK, resp)
 }
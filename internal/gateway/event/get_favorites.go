@@ -7,6 +7,9 @@ import (
 	httpErrors "kudago/internal/gateway/errors"
 
 	"kudago/internal/gateway/utils"
+
+	grpcCodes "google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
 )
 
 // @Summary Получение избранных событий
@@ -15,7 +18,9 @@ import (
 // @Produce  json
 // @Param page query int false "Номер страницы (по умолчанию 1)"
 // @Param limit query int false "Количество событий на странице (по умолчанию 30)"
+// @Param cursor query string false "Курсор следующей страницы из next_cursor; если передан, параметр page не учитывается"
 // @Success 200 {object} GetEventsResponse
+// @Failure 400 {object} httpErrors.HttpError "Invalid Cursor"
 // @Failure 401 {object} httpErrors.HttpError "Unauthorized"
 // @Failure 500 {object} httpErrors.HttpError "Internal Server Error"
 // @Router /events/favorites [get]
@@ -35,12 +40,18 @@ func (h EventHandler) GetFavorites(w http.ResponseWriter, r *http.Request) {
 
 	events, err := h.EventService.GetFavorites(r.Context(), req)
 	if err != nil {
+		st, ok := grpcStatus.FromError(err)
+		if ok && st.Code() == grpcCodes.InvalidArgument {
+			utils.WriteResponse(w, http.StatusBadRequest, httpErrors.ErrInvalidCursor)
+			return
+		}
+
 		h.logger.Error(r.Context(), "getFavorites", err)
 		utils.WriteResponse(w, http.StatusInternalServerError, httpErrors.ErrInternal)
 		return
 	}
 
-	resp := writeEventsResponse(events.Events, int(paginationParams.Limit))
+	resp := writeCursorEventsResponse(events, int(paginationParams.Limit))
 
 	utils.WriteResponse(w, http.StatusOK, resp)
 }
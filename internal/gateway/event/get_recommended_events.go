@@ -0,0 +1,43 @@
+package events
+
+import (
+	"net/http"
+
+	pb "kudago/internal/event/api"
+	httpErrors "kudago/internal/gateway/errors"
+	"kudago/internal/gateway/utils"
+)
+
+// @Summary Рекомендованные события
+// @Description Грядущие события, подобранные по избранному и подпискам пользователя. Новым и неавторизованным пользователям возвращаются популярные события.
+// @Tags events
+// @Produce  json
+// @Param page query int false "Номер страницы (по умолчанию 1)"
+// @Param limit query int false "Количество событий на странице (по умолчанию 30)"
+// @Success 200 {object} GetEventsResponse
+// @Failure 500 {object} httpErrors.HttpError "Internal Server Error"
+// @Router /events/recommended [get]
+func (h EventHandler) GetRecommendedEvents(w http.ResponseWriter, r *http.Request) {
+	paginationParams := GetPaginationParams(r)
+
+	var userID int32
+	if session, ok := utils.GetSessionFromContext(r.Context()); ok {
+		userID = int32(session.UserID)
+	}
+
+	req := &pb.GetRecommendedEventsRequest{
+		UserID: userID,
+		Params: paginationParams,
+	}
+
+	events, err := h.EventService.GetRecommendedEvents(r.Context(), req)
+	if err != nil {
+		h.logger.Error(r.Context(), "get recommended events", err)
+		utils.WriteResponse(w, http.StatusInternalServerError, httpErrors.ErrInternal)
+		return
+	}
+
+	resp := writeEventsResponse(events.Events, int(paginationParams.Limit))
+
+	utils.WriteResponse(w, http.StatusOK, resp)
+}
@@ -0,0 +1,92 @@
+package events
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	pb "kudago/internal/event/api"
+	"kudago/internal/event/grpc"
+	"kudago/internal/gateway/event/mocks"
+	"kudago/internal/gateway/utils"
+	"kudago/internal/logger"
+	"kudago/internal/models"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestEventHandler_GetRecommendedEvents(t *testing.T) {
+	t.Parallel()
+
+	params := &pb.PaginationParams{Limit: 30, Offset: 0}
+	events := &pb.Events{Events: []*pb.Event{{ID: 1, Title: "Концерт"}}}
+
+	logger, _ := logger.NewLogger()
+
+	tests := []struct {
+		name      string
+		req       *http.Request
+		setupFunc func(serviceMock *mocks.MockEventServiceClient)
+		wantCode  int
+		wantBody  *GetEventsResponse
+	}{
+		{
+			name: "Рекомендации для пользователя",
+			req: func() *http.Request {
+				req := httptest.NewRequest(http.MethodGet, "/events/recommended", nil)
+				session := models.Session{UserID: 5, Token: "valid_token"}
+				return req.WithContext(utils.SetSessionInContext(req.Context(), session))
+			}(),
+			setupFunc: func(serviceMock *mocks.MockEventServiceClient) {
+				serviceMock.EXPECT().GetRecommendedEvents(gomock.Any(), &pb.GetRecommendedEventsRequest{UserID: 5, Params: params}).Return(events, nil)
+			},
+			wantCode: http.StatusOK,
+			wantBody: &GetEventsResponse{Events: []EventResponse{{ID: 1, Title: "Концерт"}}},
+		},
+		{
+			name: "Без сессии",
+			req:  httptest.NewRequest(http.MethodGet, "/events/recommended", nil),
+			setupFunc: func(serviceMock *mocks.MockEventServiceClient) {
+				serviceMock.EXPECT().GetRecommendedEvents(gomock.Any(), &pb.GetRecommendedEventsRequest{Params: params}).Return(events, nil)
+			},
+			wantCode: http.StatusOK,
+			wantBody: &GetEventsResponse{Events: []EventResponse{{ID: 1, Title: "Концерт"}}},
+		},
+		{
+			name: "Внутренняя ошибка",
+			req:  httptest.NewRequest(http.MethodGet, "/events/recommended", nil),
+			setupFunc: func(serviceMock *mocks.MockEventServiceClient) {
+				serviceMock.EXPECT().GetRecommendedEvents(gomock.Any(), gomock.Any()).Return(nil, status.Error(codes.Internal, grpc.ErrInternal))
+			},
+			wantCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			serviceMock := mocks.NewMockEventServiceClient(ctrl)
+			tt.setupFunc(serviceMock)
+			handler := &EventHandler{EventService: serviceMock, logger: logger}
+
+			recorder := httptest.NewRecorder()
+			handler.GetRecommendedEvents(recorder, tt.req)
+
+			assert.Equal(t, tt.wantCode, recorder.Code)
+			if tt.wantBody != nil {
+				var resp GetEventsResponse
+				err := json.Unmarshal(recorder.Body.Bytes(), &resp)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.wantBody, &resp)
+			}
+		})
+	}
+}
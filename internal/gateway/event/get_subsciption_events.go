@@ -7,13 +7,20 @@ import (
 	httpErrors "kudago/internal/gateway/errors"
 
 	"kudago/internal/gateway/utils"
+
+	grpcCodes "google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
 )
 
 // @Summary Получение событий по подпискам пользователя
 // @Description Возвращает события пользователя
 // @Tags events
 // @Produce  json
+// @Param page query int false "Номер страницы (по умолчанию 1)"
+// @Param limit query int false "Количество событий на странице (по умолчанию 30)"
+// @Param cursor query string false "Курсор следующей страницы из next_cursor; если передан, параметр page не учитывается"
 // @Success 200 {object} GetEventsResponse
+// @Failure 400 {object} httpErrors.HttpError "Invalid Cursor"
 // @Failure 403 {object} httpErrors.HttpError "Status forbidden"
 // @Failure 500 {object} httpErrors.HttpError "Internal Server Error"
 // @Router /events/subscription [get]
@@ -33,11 +40,17 @@ func (h *EventHandler) GetSubscriptionEvents(w http.ResponseWriter, r *http.Requ
 
 	events, err := h.EventService.GetSubscriptionsEvents(r.Context(), req)
 	if err != nil {
+		st, ok := grpcStatus.FromError(err)
+		if ok && st.Code() == grpcCodes.InvalidArgument {
+			utils.WriteResponse(w, http.StatusBadRequest, httpErrors.ErrInvalidCursor)
+			return
+		}
+
 		utils.WriteResponse(w, http.StatusInternalServerError, httpErrors.ErrInternal)
 		return
 	}
 
-	resp := writeEventsResponse(events.Events, int(paginationParams.Limit))
+	resp := writeCursorEventsResponse(events, int(paginationParams.Limit))
 
 	utils.WriteResponse(w, http.StatusOK, resp)
 }
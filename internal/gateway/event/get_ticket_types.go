@@ -0,0 +1,58 @@
+package events
+
+import (
+	"net/http"
+	"strconv"
+
+	pb "kudago/internal/event/api"
+	httpErrors "kudago/internal/gateway/errors"
+	"kudago/internal/gateway/utils"
+
+	"github.com/gorilla/mux"
+	grpcCodes "google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+// @Summary Получение типов билетов
+// @Description Возвращает типы билетов события и количество оставшихся мест (null, если вместимость не ограничена)
+// @Tags tickets
+// @Produce  json
+// @Param id path int true "ID события"
+// @Success 200 {object} TicketTypesResponse
+// @Failure 404 {object} httpErrors.HttpError "Event Not Found"
+// @Failure 500 {object} httpErrors.HttpError "Internal Server Error"
+// @Router /events/{id}/tickets [get]
+func (h EventHandler) GetTicketTypes(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		utils.WriteResponse(w, http.StatusBadRequest, httpErrors.ErrInvalidID)
+		return
+	}
+
+	ticketTypes, err := h.EventService.GetTicketTypes(r.Context(), &pb.GetTicketTypesRequest{EventID: int32(id)})
+	if err != nil {
+		st, ok := grpcStatus.FromError(err)
+		if ok && st.Code() == grpcCodes.NotFound {
+			utils.WriteResponse(w, http.StatusNotFound, httpErrors.ErrEventNotFound)
+			return
+		}
+
+		h.logger.Error(r.Context(), "get ticket types", err)
+		utils.WriteResponse(w, http.StatusInternalServerError, httpErrors.ErrInternal)
+		return
+	}
+
+	resp := TicketTypesResponse{
+		Types: make([]TicketTypeResponse, 0, len(ticketTypes.Types)),
+	}
+	for _, ticketType := range ticketTypes.Types {
+		resp.Types = append(resp.Types, ticketTypeToTicketTypeResponse(ticketType))
+	}
+	if ticketTypes.Limited {
+		remaining := int(ticketTypes.Remaining)
+		resp.Remaining = &remaining
+	}
+
+	utils.WriteResponse(w, http.StatusOK, resp)
+}
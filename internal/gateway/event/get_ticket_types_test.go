@@ -0,0 +1,109 @@
+package events
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	pb "kudago/internal/event/api"
+	"kudago/internal/event/grpc"
+	"kudago/internal/gateway/event/mocks"
+	"kudago/internal/logger"
+
+	"github.com/golang/mock/gomock"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestEventHandler_GetTicketTypes(t *testing.T) {
+	t.Parallel()
+
+	logger, _ := logger.NewLogger()
+
+	newRequest := func() *http.Request {
+		req := httptest.NewRequest(http.MethodGet, "/events/1/tickets", nil)
+		return mux.SetURLVars(req, map[string]string{"id": "1"})
+	}
+
+	tests := []struct {
+		name      string
+		req       *http.Request
+		setupFunc func(ctrl *gomock.Controller) *EventHandler
+		wantCode  int
+		wantBody  string
+	}{
+		{
+			name: "Ограниченная вместимость",
+			req:  newRequest(),
+			setupFunc: func(ctrl *gomock.Controller) *EventHandler {
+				serviceMock := mocks.NewMockEventServiceClient(ctrl)
+
+				serviceMock.EXPECT().GetTicketTypes(gomock.Any(), &pb.GetTicketTypesRequest{EventID: 1}).
+					Return(&pb.TicketTypes{
+						Types:     []*pb.TicketType{{ID: 1, EventID: 1, Name: "VIP", Price: 1500}},
+						Limited:   true,
+						Remaining: 4,
+					}, nil)
+
+				return &EventHandler{
+					EventService: serviceMock,
+					logger:       logger,
+				}
+			},
+			wantCode: http.StatusOK,
+			wantBody: `{"types":[{"id":1,"event_id":1,"name":"VIP","price":1500}],"remaining":4}`,
+		},
+		{
+			name: "Без ограничения вместимости",
+			req:  newRequest(),
+			setupFunc: func(ctrl *gomock.Controller) *EventHandler {
+				serviceMock := mocks.NewMockEventServiceClient(ctrl)
+
+				serviceMock.EXPECT().GetTicketTypes(gomock.Any(), &pb.GetTicketTypesRequest{EventID: 1}).
+					Return(&pb.TicketTypes{}, nil)
+
+				return &EventHandler{
+					EventService: serviceMock,
+					logger:       logger,
+				}
+			},
+			wantCode: http.StatusOK,
+			wantBody: `{"types":[],"remaining":null}`,
+		},
+		{
+			name: "Событие не найдено",
+			req:  newRequest(),
+			setupFunc: func(ctrl *gomock.Controller) *EventHandler {
+				serviceMock := mocks.NewMockEventServiceClient(ctrl)
+
+				serviceMock.EXPECT().GetTicketTypes(gomock.Any(), &pb.GetTicketTypesRequest{EventID: 1}).
+					Return(nil, status.Error(codes.NotFound, grpc.ErrEventNotFound))
+
+				return &EventHandler{
+					EventService: serviceMock,
+					logger:       logger,
+				}
+			},
+			wantCode: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			recorder := httptest.NewRecorder()
+			tt.setupFunc(ctrl).GetTicketTypes(recorder, tt.req)
+
+			assert.Equal(t, tt.wantCode, recorder.Code)
+			if tt.wantBody != "" {
+				assert.JSONEq(t, tt.wantBody, recorder.Body.String())
+			}
+		})
+	}
+}
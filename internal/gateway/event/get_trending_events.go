@@ -0,0 +1,51 @@
+package events
+
+import (
+	"net/http"
+	"strconv"
+
+	pb "kudago/internal/event/api"
+	httpErrors "kudago/internal/gateway/errors"
+	"kudago/internal/gateway/utils"
+)
+
+// @Summary Популярные события
+// @Description Грядущие события, упорядоченные по популярности: недавние добавления в избранное весят больше старых
+// @Tags events
+// @Produce  json
+// @Param category_id query int false "ID категории"
+// @Param page query int false "Номер страницы (по умолчанию 1)"
+// @Param limit query int false "Количество событий на странице (по умолчанию 30)"
+// @Success 200 {object} GetEventsResponse
+// @Failure 400 {object} httpErrors.HttpError "Invalid Category"
+// @Failure 500 {object} httpErrors.HttpError "Internal Server Error"
+// @Router /events/trending [get]
+func (h EventHandler) GetTrendingEvents(w http.ResponseWriter, r *http.Request) {
+	paginationParams := GetPaginationParams(r)
+
+	var categoryID int
+	if value := r.URL.Query().Get("category_id"); value != "" {
+		var err error
+		categoryID, err = strconv.Atoi(value)
+		if err != nil || categoryID <= 0 {
+			utils.WriteResponse(w, http.StatusBadRequest, httpErrors.ErrInvalidCategory)
+			return
+		}
+	}
+
+	req := &pb.GetTrendingEventsRequest{
+		CategoryID: int32(categoryID),
+		Params:     paginationParams,
+	}
+
+	events, err := h.EventService.GetTrendingEvents(r.Context(), req)
+	if err != nil {
+		h.logger.Error(r.Context(), "get trending events", err)
+		utils.WriteResponse(w, http.StatusInternalServerError, httpErrors.ErrInternal)
+		return
+	}
+
+	resp := writeEventsResponse(events.Events, int(paginationParams.Limit))
+
+	utils.WriteResponse(w, http.StatusOK, resp)
+}
@@ -0,0 +1,102 @@
+package events
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	pb "kudago/internal/event/api"
+	"kudago/internal/event/grpc"
+	"kudago/internal/gateway/event/mocks"
+	"kudago/internal/logger"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestEventHandler_GetTrendingEvents(t *testing.T) {
+	t.Parallel()
+
+	params := &pb.PaginationParams{Limit: 30, Offset: 0}
+	events := &pb.Events{Events: []*pb.Event{{ID: 1, Title: "Концерт", FavoritesCount: 7}}}
+
+	logger, _ := logger.NewLogger()
+
+	tests := []struct {
+		name      string
+		url       string
+		setupFunc func(serviceMock *mocks.MockEventServiceClient)
+		wantCode  int
+		wantBody  *GetEventsResponse
+	}{
+		{
+			name: "Популярные события",
+			url:  "/events/trending",
+			setupFunc: func(serviceMock *mocks.MockEventServiceClient) {
+				serviceMock.EXPECT().GetTrendingEvents(gomock.Any(), &pb.GetTrendingEventsRequest{Params: params}).Return(events, nil)
+			},
+			wantCode: http.StatusOK,
+			wantBody: &GetEventsResponse{Events: []EventResponse{{ID: 1, Title: "Концерт", FavoritesCount: 7}}},
+		},
+		{
+			name: "Фильтр по категории",
+			url:  "/events/trending?category_id=3",
+			setupFunc: func(serviceMock *mocks.MockEventServiceClient) {
+				serviceMock.EXPECT().GetTrendingEvents(gomock.Any(), &pb.GetTrendingEventsRequest{CategoryID: 3, Params: params}).Return(events, nil)
+			},
+			wantCode: http.StatusOK,
+			wantBody: &GetEventsResponse{Events: []EventResponse{{ID: 1, Title: "Концерт", FavoritesCount: 7}}},
+		},
+		{
+			name: "Изображение карточки",
+			url:  "/events/trending",
+			setupFunc: func(serviceMock *mocks.MockEventServiceClient) {
+				serviceMock.EXPECT().GetTrendingEvents(gomock.Any(), &pb.GetTrendingEventsRequest{Params: params}).
+					Return(&pb.Events{Events: []*pb.Event{{ID: 1, Image: "static/images/a_full.jpg"}}}, nil)
+			},
+			wantCode: http.StatusOK,
+			wantBody: &GetEventsResponse{Events: []EventResponse{{ID: 1, ImageURL: "static/images/a_card.jpg", ImageWebP: "static/images/a_card.webp"}}},
+		},
+		{
+			name:      "Некорректная категория",
+			url:       "/events/trending?category_id=abc",
+			setupFunc: func(serviceMock *mocks.MockEventServiceClient) {},
+			wantCode:  http.StatusBadRequest,
+		},
+		{
+			name: "Внутренняя ошибка",
+			url:  "/events/trending",
+			setupFunc: func(serviceMock *mocks.MockEventServiceClient) {
+				serviceMock.EXPECT().GetTrendingEvents(gomock.Any(), gomock.Any()).Return(nil, status.Error(codes.Internal, grpc.ErrInternal))
+			},
+			wantCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			serviceMock := mocks.NewMockEventServiceClient(ctrl)
+			tt.setupFunc(serviceMock)
+			handler := &EventHandler{EventService: serviceMock, logger: logger}
+
+			recorder := httptest.NewRecorder()
+			handler.GetTrendingEvents(recorder, httptest.NewRequest(http.MethodGet, tt.url, nil))
+
+			assert.Equal(t, tt.wantCode, recorder.Code)
+			if tt.wantBody != nil {
+				var resp GetEventsResponse
+				err := json.Unmarshal(recorder.Body.Bytes(), &resp)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.wantBody, &resp)
+			}
+		})
+	}
+}
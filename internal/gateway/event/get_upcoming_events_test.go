@@ -72,6 +72,44 @@ func TestEventHandler_GetUpcomingEvents(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Следующая страница по курсору",
+			req:  httptest.NewRequest(http.MethodGet, "/events?cursor=abc&limit=1", nil),
+			setupFunc: func(ctrl *gomock.Controller) *EventHandler {
+				serviceMock := mocks.NewMockEventServiceClient(ctrl)
+				events := &pb.Events{
+					Events:     []*pb.Event{{ID: 2, Title: "next"}},
+					NextCursor: "def",
+				}
+
+				serviceMock.EXPECT().GetUpcomingEvents(gomock.Any(), &pb.PaginationParams{Limit: 1, Cursor: "abc"}).Return(events, nil)
+
+				return &EventHandler{
+					EventService: serviceMock,
+					logger:       logger,
+				}
+			},
+			wantCode: http.StatusOK,
+			wantBody: &GetEventsResponse{
+				Events:     []EventResponse{{ID: 2, Title: "next"}},
+				NextCursor: "def",
+			},
+		},
+		{
+			name: "Некорректный курсор",
+			req:  httptest.NewRequest(http.MethodGet, "/events?cursor=bad", nil),
+			setupFunc: func(ctrl *gomock.Controller) *EventHandler {
+				serviceMock := mocks.NewMockEventServiceClient(ctrl)
+				serviceMock.EXPECT().GetUpcomingEvents(gomock.Any(), &pb.PaginationParams{Limit: 30, Cursor: "bad"}).
+					Return(nil, status.Error(codes.InvalidArgument, grpc.ErrInvalidCursor))
+
+				return &EventHandler{
+					EventService: serviceMock,
+					logger:       logger,
+				}
+			},
+			wantCode: http.StatusBadRequest,
+		},
 		{
 			name: "Internal error",
 			req: func() *http.Request {
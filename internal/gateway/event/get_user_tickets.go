@@ -0,0 +1,48 @@
+package events
+
+import (
+	"net/http"
+
+	pb "kudago/internal/event/api"
+	httpErrors "kudago/internal/gateway/errors"
+	"kudago/internal/gateway/utils"
+)
+
+// @Summary Получение билетов пользователя
+// @Description Возвращает купленные билеты и действующие брони текущего пользователя
+// @Tags tickets
+// @Produce  json
+// @Param page query int false "Номер страницы (по умолчанию 1)"
+// @Param limit query int false "Количество билетов на странице (по умолчанию 30)"
+// @Success 200 {object} TicketsResponse
+// @Failure 401 {object} httpErrors.HttpError "Unauthorized"
+// @Failure 500 {object} httpErrors.HttpError "Internal Server Error"
+// @Router /profile/tickets [get]
+func (h EventHandler) GetUserTickets(w http.ResponseWriter, r *http.Request) {
+	paginationParams := GetPaginationParams(r)
+
+	session, ok := utils.GetSessionFromContext(r.Context())
+	if !ok {
+		utils.WriteResponse(w, http.StatusForbidden, httpErrors.ErrUnauthorized)
+		return
+	}
+
+	tickets, err := h.EventService.GetUserTickets(r.Context(), &pb.GetUserTicketsRequest{
+		UserID: int32(session.UserID),
+		Params: paginationParams,
+	})
+	if err != nil {
+		h.logger.Error(r.Context(), "get user tickets", err)
+		utils.WriteResponse(w, http.StatusInternalServerError, httpErrors.ErrInternal)
+		return
+	}
+
+	resp := TicketsResponse{
+		Tickets: make([]TicketResponse, 0, len(tickets.Tickets)),
+	}
+	for _, ticket := range tickets.Tickets {
+		resp.Tickets = append(resp.Tickets, ticketToTicketResponse(ticket))
+	}
+
+	utils.WriteResponse(w, http.StatusOK, resp)
+}
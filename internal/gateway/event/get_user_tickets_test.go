@@ -0,0 +1,132 @@
+package events
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	pb "kudago/internal/event/api"
+	"kudago/internal/event/grpc"
+	"kudago/internal/gateway/event/mocks"
+	"kudago/internal/gateway/utils"
+	"kudago/internal/logger"
+	"kudago/internal/models"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestEventHandler_GetUserTickets(t *testing.T) {
+	t.Parallel()
+
+	logger, _ := logger.NewLogger()
+
+	newRequest := func(withSession bool) *http.Request {
+		req := httptest.NewRequest(http.MethodGet, "/profile/tickets?page=1&limit=10", nil)
+		if !withSession {
+			return req
+		}
+		session := models.Session{UserID: 3, Token: "valid_token"}
+		return req.WithContext(utils.SetSessionInContext(req.Context(), session))
+	}
+
+	ticketsRequest := &pb.GetUserTicketsRequest{
+		UserID: 3,
+		Params: &pb.PaginationParams{Offset: 10, Limit: 10},
+	}
+
+	tests := []struct {
+		name      string
+		req       *http.Request
+		setupFunc func(ctrl *gomock.Controller) *EventHandler
+		wantCode  int
+		wantBody  string
+	}{
+		{
+			name: "Билеты и брони пользователя",
+			req:  newRequest(true),
+			setupFunc: func(ctrl *gomock.Controller) *EventHandler {
+				serviceMock := mocks.NewMockEventServiceClient(ctrl)
+
+				serviceMock.EXPECT().GetUserTickets(gomock.Any(), ticketsRequest).
+					Return(&pb.Tickets{Tickets: []*pb.Ticket{
+						{ID: 7, EventID: 1, TicketTypeID: 2, Type: "VIP", Price: 1500, Quantity: 2, Status: models.TicketStatusPaid, BoughtAt: "2030-01-01T12:00:00Z"},
+						{ID: 8, EventID: 4, TicketTypeID: 5, Type: "Обычный", Price: 500, Quantity: 1, Status: models.TicketStatusReserved, ReservedUntil: "2030-01-01T12:15:00Z"},
+					}}, nil)
+
+				return &EventHandler{
+					EventService: serviceMock,
+					logger:       logger,
+				}
+			},
+			wantCode: http.StatusOK,
+			wantBody: `{"tickets":[
+				{"id":7,"event_id":1,"type_id":2,"type":"VIP","price":1500,"quantity":2,"status":"paid","bought_at":"2030-01-01T12:00:00Z"},
+				{"id":8,"event_id":4,"type_id":5,"type":"Обычный","price":500,"quantity":1,"status":"reserved","reserved_until":"2030-01-01T12:15:00Z"}
+			]}`,
+		},
+		{
+			name: "Нет билетов",
+			req:  newRequest(true),
+			setupFunc: func(ctrl *gomock.Controller) *EventHandler {
+				serviceMock := mocks.NewMockEventServiceClient(ctrl)
+
+				serviceMock.EXPECT().GetUserTickets(gomock.Any(), ticketsRequest).
+					Return(&pb.Tickets{}, nil)
+
+				return &EventHandler{
+					EventService: serviceMock,
+					logger:       logger,
+				}
+			},
+			wantCode: http.StatusOK,
+			wantBody: `{"tickets":[]}`,
+		},
+		{
+			name: "Нет сессии",
+			req:  newRequest(false),
+			setupFunc: func(ctrl *gomock.Controller) *EventHandler {
+				return &EventHandler{
+					EventService: mocks.NewMockEventServiceClient(ctrl),
+					logger:       logger,
+				}
+			},
+			wantCode: http.StatusForbidden,
+		},
+		{
+			name: "Внутренняя ошибка",
+			req:  newRequest(true),
+			setupFunc: func(ctrl *gomock.Controller) *EventHandler {
+				serviceMock := mocks.NewMockEventServiceClient(ctrl)
+
+				serviceMock.EXPECT().GetUserTickets(gomock.Any(), ticketsRequest).
+					Return(nil, status.Error(codes.Internal, grpc.ErrInternal))
+
+				return &EventHandler{
+					EventService: serviceMock,
+					logger:       logger,
+				}
+			},
+			wantCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			recorder := httptest.NewRecorder()
+			tt.setupFunc(ctrl).GetUserTickets(recorder, tt.req)
+
+			assert.Equal(t, tt.wantCode, recorder.Code)
+			if tt.wantBody != "" {
+				assert.JSONEq(t, tt.wantBody, recorder.Body.String())
+			}
+		})
+	}
+}
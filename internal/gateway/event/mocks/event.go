@@ -56,6 +56,26 @@ func (mr *MockEventServiceClientMockRecorder) AddEvent(ctx, in interface{}, opts
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddEvent", reflect.TypeOf((*MockEventServiceClient)(nil).AddEvent), varargs...)
 }
 
+// AddEventImage mocks base method.
+func (m *MockEventServiceClient) AddEventImage(ctx context.Context, in *event.AddEventImageRequest, opts ...grpc.CallOption) (*event.EventImage, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddEventImage", varargs...)
+	ret0, _ := ret[0].(*event.EventImage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddEventImage indicates an expected call of AddEventImage.
+func (mr *MockEventServiceClientMockRecorder) AddEventImage(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddEventImage", reflect.TypeOf((*MockEventServiceClient)(nil).AddEventImage), varargs...)
+}
+
 // AddEventToFavorites mocks base method.
 func (m *MockEventServiceClient) AddEventToFavorites(ctx context.Context, in *event.FavoriteEvent, opts ...grpc.CallOption) (*event.Empty, error) {
 	m.ctrl.T.Helper()
@@ -76,15 +96,155 @@ func (mr *MockEventServiceClientMockRecorder) AddEventToFavorites(ctx, in interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddEventToFavorites", reflect.TypeOf((*MockEventServiceClient)(nil).AddEventToFavorites), varargs...)
 }
 
+// AddTicketType mocks base method.
+func (m *MockEventServiceClient) AddTicketType(ctx context.Context, in *event.AddTicketTypeRequest, opts ...grpc.CallOption) (*event.TicketType, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddTicketType", varargs...)
+	ret0, _ := ret[0].(*event.TicketType)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddTicketType indicates an expected call of AddTicketType.
+func (mr *MockEventServiceClientMockRecorder) AddTicketType(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTicketType", reflect.TypeOf((*MockEventServiceClient)(nil).AddTicketType), varargs...)
+}
+
+// AttendEvent mocks base method.
+func (m *MockEventServiceClient) AttendEvent(ctx context.Context, in *event.AttendanceRequest, opts ...grpc.CallOption) (*event.AttendanceStatus, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AttendEvent", varargs...)
+	ret0, _ := ret[0].(*event.AttendanceStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AttendEvent indicates an expected call of AttendEvent.
+func (mr *MockEventServiceClientMockRecorder) AttendEvent(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AttendEvent", reflect.TypeOf((*MockEventServiceClient)(nil).AttendEvent), varargs...)
+}
+
+// BuyTicket mocks base method.
+func (m *MockEventServiceClient) BuyTicket(ctx context.Context, in *event.BuyTicketRequest, opts ...grpc.CallOption) (*event.Ticket, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "BuyTicket", varargs...)
+	ret0, _ := ret[0].(*event.Ticket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BuyTicket indicates an expected call of BuyTicket.
+func (mr *MockEventServiceClientMockRecorder) BuyTicket(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuyTicket", reflect.TypeOf((*MockEventServiceClient)(nil).BuyTicket), varargs...)
+}
+
+// CancelAttendance mocks base method.
+func (m *MockEventServiceClient) CancelAttendance(ctx context.Context, in *event.AttendanceRequest, opts ...grpc.CallOption) (*event.WaitlistPromotions, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CancelAttendance", varargs...)
+	ret0, _ := ret[0].(*event.WaitlistPromotions)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelAttendance indicates an expected call of CancelAttendance.
+func (mr *MockEventServiceClientMockRecorder) CancelAttendance(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelAttendance", reflect.TypeOf((*MockEventServiceClient)(nil).CancelAttendance), varargs...)
+}
+
+// CancelEvent mocks base method.
+func (m *MockEventServiceClient) CancelEvent(ctx context.Context, in *event.CancelEventRequest, opts ...grpc.CallOption) (*event.Event, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CancelEvent", varargs...)
+	ret0, _ := ret[0].(*event.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelEvent indicates an expected call of CancelEvent.
+func (mr *MockEventServiceClientMockRecorder) CancelEvent(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelEvent", reflect.TypeOf((*MockEventServiceClient)(nil).CancelEvent), varargs...)
+}
+
+// CheckInAttendee mocks base method.
+func (m *MockEventServiceClient) CheckInAttendee(ctx context.Context, in *event.CheckInAttendeeRequest, opts ...grpc.CallOption) (*event.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CheckInAttendee", varargs...)
+	ret0, _ := ret[0].(*event.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckInAttendee indicates an expected call of CheckInAttendee.
+func (mr *MockEventServiceClientMockRecorder) CheckInAttendee(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckInAttendee", reflect.TypeOf((*MockEventServiceClient)(nil).CheckInAttendee), varargs...)
+}
+
+// CreateCalendarToken mocks base method.
+func (m *MockEventServiceClient) CreateCalendarToken(ctx context.Context, in *event.CreateCalendarTokenRequest, opts ...grpc.CallOption) (*event.CalendarToken, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateCalendarToken", varargs...)
+	ret0, _ := ret[0].(*event.CalendarToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCalendarToken indicates an expected call of CreateCalendarToken.
+func (mr *MockEventServiceClientMockRecorder) CreateCalendarToken(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCalendarToken", reflect.TypeOf((*MockEventServiceClient)(nil).CreateCalendarToken), varargs...)
+}
+
 // DeleteEvent mocks base method.
-func (m *MockEventServiceClient) DeleteEvent(ctx context.Context, in *event.DeleteEventRequest, opts ...grpc.CallOption) (*event.Empty, error) {
+func (m *MockEventServiceClient) DeleteEvent(ctx context.Context, in *event.DeleteEventRequest, opts ...grpc.CallOption) (*event.DeleteEventResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
 	ret := m.ctrl.Call(m, "DeleteEvent", varargs...)
-	ret0, _ := ret[0].(*event.Empty)
+	ret0, _ := ret[0].(*event.DeleteEventResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -116,6 +276,46 @@ func (mr *MockEventServiceClientMockRecorder) DeleteEventFromFavorites(ctx, in i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEventFromFavorites", reflect.TypeOf((*MockEventServiceClient)(nil).DeleteEventFromFavorites), varargs...)
 }
 
+// DeleteEventImage mocks base method.
+func (m *MockEventServiceClient) DeleteEventImage(ctx context.Context, in *event.DeleteEventImageRequest, opts ...grpc.CallOption) (*event.EventImage, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteEventImage", varargs...)
+	ret0, _ := ret[0].(*event.EventImage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteEventImage indicates an expected call of DeleteEventImage.
+func (mr *MockEventServiceClientMockRecorder) DeleteEventImage(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEventImage", reflect.TypeOf((*MockEventServiceClient)(nil).DeleteEventImage), varargs...)
+}
+
+// GetAttendees mocks base method.
+func (m *MockEventServiceClient) GetAttendees(ctx context.Context, in *event.GetAttendeesRequest, opts ...grpc.CallOption) (*event.Attendees, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetAttendees", varargs...)
+	ret0, _ := ret[0].(*event.Attendees)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAttendees indicates an expected call of GetAttendees.
+func (mr *MockEventServiceClientMockRecorder) GetAttendees(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttendees", reflect.TypeOf((*MockEventServiceClient)(nil).GetAttendees), varargs...)
+}
+
 // GetCategories mocks base method.
 func (m *MockEventServiceClient) GetCategories(ctx context.Context, in *event.Empty, opts ...grpc.CallOption) (*event.GetCategoriesResponse, error) {
 	m.ctrl.T.Helper()
@@ -136,6 +336,26 @@ func (mr *MockEventServiceClientMockRecorder) GetCategories(ctx, in interface{},
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategories", reflect.TypeOf((*MockEventServiceClient)(nil).GetCategories), varargs...)
 }
 
+// GetDrafts mocks base method.
+func (m *MockEventServiceClient) GetDrafts(ctx context.Context, in *event.GetEventsByUserRequest, opts ...grpc.CallOption) (*event.Events, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetDrafts", varargs...)
+	ret0, _ := ret[0].(*event.Events)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDrafts indicates an expected call of GetDrafts.
+func (mr *MockEventServiceClientMockRecorder) GetDrafts(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDrafts", reflect.TypeOf((*MockEventServiceClient)(nil).GetDrafts), varargs...)
+}
+
 // GetEventByID mocks base method.
 func (m *MockEventServiceClient) GetEventByID(ctx context.Context, in *event.GetEventByIDRequest, opts ...grpc.CallOption) (*event.Event, error) {
 	m.ctrl.T.Helper()
@@ -256,6 +476,26 @@ func (mr *MockEventServiceClientMockRecorder) GetPastEvents(ctx, in interface{},
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPastEvents", reflect.TypeOf((*MockEventServiceClient)(nil).GetPastEvents), varargs...)
 }
 
+// GetRecommendedEvents mocks base method.
+func (m *MockEventServiceClient) GetRecommendedEvents(ctx context.Context, in *event.GetRecommendedEventsRequest, opts ...grpc.CallOption) (*event.Events, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetRecommendedEvents", varargs...)
+	ret0, _ := ret[0].(*event.Events)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRecommendedEvents indicates an expected call of GetRecommendedEvents.
+func (mr *MockEventServiceClientMockRecorder) GetRecommendedEvents(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecommendedEvents", reflect.TypeOf((*MockEventServiceClient)(nil).GetRecommendedEvents), varargs...)
+}
+
 // GetSubscribersIDs mocks base method.
 func (m *MockEventServiceClient) GetSubscribersIDs(ctx context.Context, in *event.GetSubscribersIDsRequest, opts ...grpc.CallOption) (*event.GetUserIDsResponse, error) {
 	m.ctrl.T.Helper()
@@ -296,6 +536,46 @@ func (mr *MockEventServiceClientMockRecorder) GetSubscriptionsEvents(ctx, in int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubscriptionsEvents", reflect.TypeOf((*MockEventServiceClient)(nil).GetSubscriptionsEvents), varargs...)
 }
 
+// GetTicketTypes mocks base method.
+func (m *MockEventServiceClient) GetTicketTypes(ctx context.Context, in *event.GetTicketTypesRequest, opts ...grpc.CallOption) (*event.TicketTypes, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetTicketTypes", varargs...)
+	ret0, _ := ret[0].(*event.TicketTypes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTicketTypes indicates an expected call of GetTicketTypes.
+func (mr *MockEventServiceClientMockRecorder) GetTicketTypes(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketTypes", reflect.TypeOf((*MockEventServiceClient)(nil).GetTicketTypes), varargs...)
+}
+
+// GetTrendingEvents mocks base method.
+func (m *MockEventServiceClient) GetTrendingEvents(ctx context.Context, in *event.GetTrendingEventsRequest, opts ...grpc.CallOption) (*event.Events, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetTrendingEvents", varargs...)
+	ret0, _ := ret[0].(*event.Events)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTrendingEvents indicates an expected call of GetTrendingEvents.
+func (mr *MockEventServiceClientMockRecorder) GetTrendingEvents(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrendingEvents", reflect.TypeOf((*MockEventServiceClient)(nil).GetTrendingEvents), varargs...)
+}
+
 // GetUpcomingEvents mocks base method.
 func (m *MockEventServiceClient) GetUpcomingEvents(ctx context.Context, in *event.PaginationParams, opts ...grpc.CallOption) (*event.Events, error) {
 	m.ctrl.T.Helper()
@@ -316,6 +596,26 @@ func (mr *MockEventServiceClientMockRecorder) GetUpcomingEvents(ctx, in interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUpcomingEvents", reflect.TypeOf((*MockEventServiceClient)(nil).GetUpcomingEvents), varargs...)
 }
 
+// GetUserIDByCalendarToken mocks base method.
+func (m *MockEventServiceClient) GetUserIDByCalendarToken(ctx context.Context, in *event.CalendarToken, opts ...grpc.CallOption) (*event.CalendarTokenOwner, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetUserIDByCalendarToken", varargs...)
+	ret0, _ := ret[0].(*event.CalendarTokenOwner)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserIDByCalendarToken indicates an expected call of GetUserIDByCalendarToken.
+func (mr *MockEventServiceClientMockRecorder) GetUserIDByCalendarToken(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserIDByCalendarToken", reflect.TypeOf((*MockEventServiceClient)(nil).GetUserIDByCalendarToken), varargs...)
+}
+
 // GetUserIDsByFavoriteEvent mocks base method.
 func (m *MockEventServiceClient) GetUserIDsByFavoriteEvent(ctx context.Context, in *event.GetUserIDsByFavoriteEventRequest, opts ...grpc.CallOption) (*event.GetUserIDsResponse, error) {
 	m.ctrl.T.Helper()
@@ -336,6 +636,86 @@ func (mr *MockEventServiceClientMockRecorder) GetUserIDsByFavoriteEvent(ctx, in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserIDsByFavoriteEvent", reflect.TypeOf((*MockEventServiceClient)(nil).GetUserIDsByFavoriteEvent), varargs...)
 }
 
+// GetUserTickets mocks base method.
+func (m *MockEventServiceClient) GetUserTickets(ctx context.Context, in *event.GetUserTicketsRequest, opts ...grpc.CallOption) (*event.Tickets, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetUserTickets", varargs...)
+	ret0, _ := ret[0].(*event.Tickets)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserTickets indicates an expected call of GetUserTickets.
+func (mr *MockEventServiceClientMockRecorder) GetUserTickets(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserTickets", reflect.TypeOf((*MockEventServiceClient)(nil).GetUserTickets), varargs...)
+}
+
+// PublishEvent mocks base method.
+func (m *MockEventServiceClient) PublishEvent(ctx context.Context, in *event.PublishEventRequest, opts ...grpc.CallOption) (*event.Event, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PublishEvent", varargs...)
+	ret0, _ := ret[0].(*event.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PublishEvent indicates an expected call of PublishEvent.
+func (mr *MockEventServiceClientMockRecorder) PublishEvent(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishEvent", reflect.TypeOf((*MockEventServiceClient)(nil).PublishEvent), varargs...)
+}
+
+// RescheduleEvent mocks base method.
+func (m *MockEventServiceClient) RescheduleEvent(ctx context.Context, in *event.RescheduleEventRequest, opts ...grpc.CallOption) (*event.Event, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RescheduleEvent", varargs...)
+	ret0, _ := ret[0].(*event.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RescheduleEvent indicates an expected call of RescheduleEvent.
+func (mr *MockEventServiceClientMockRecorder) RescheduleEvent(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RescheduleEvent", reflect.TypeOf((*MockEventServiceClient)(nil).RescheduleEvent), varargs...)
+}
+
+// ReserveTickets mocks base method.
+func (m *MockEventServiceClient) ReserveTickets(ctx context.Context, in *event.ReserveTicketsRequest, opts ...grpc.CallOption) (*event.Ticket, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ReserveTickets", varargs...)
+	ret0, _ := ret[0].(*event.Ticket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReserveTickets indicates an expected call of ReserveTickets.
+func (mr *MockEventServiceClientMockRecorder) ReserveTickets(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReserveTickets", reflect.TypeOf((*MockEventServiceClient)(nil).ReserveTickets), varargs...)
+}
+
 // SearchEvents mocks base method.
 func (m *MockEventServiceClient) SearchEvents(ctx context.Context, in *event.SearchParams, opts ...grpc.CallOption) (*event.Events, error) {
 	m.ctrl.T.Helper()
@@ -356,6 +736,26 @@ func (mr *MockEventServiceClientMockRecorder) SearchEvents(ctx, in interface{},
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchEvents", reflect.TypeOf((*MockEventServiceClient)(nil).SearchEvents), varargs...)
 }
 
+// SuggestEvents mocks base method.
+func (m *MockEventServiceClient) SuggestEvents(ctx context.Context, in *event.SuggestRequest, opts ...grpc.CallOption) (*event.Suggestions, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SuggestEvents", varargs...)
+	ret0, _ := ret[0].(*event.Suggestions)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SuggestEvents indicates an expected call of SuggestEvents.
+func (mr *MockEventServiceClientMockRecorder) SuggestEvents(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SuggestEvents", reflect.TypeOf((*MockEventServiceClient)(nil).SuggestEvents), varargs...)
+}
+
 // UpdateEvent mocks base method.
 func (m *MockEventServiceClient) UpdateEvent(ctx context.Context, in *event.Event, opts ...grpc.CallOption) (*event.Event, error) {
 	m.ctrl.T.Helper()
@@ -376,6 +776,26 @@ func (mr *MockEventServiceClientMockRecorder) UpdateEvent(ctx, in interface{}, o
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEvent", reflect.TypeOf((*MockEventServiceClient)(nil).UpdateEvent), varargs...)
 }
 
+// UpdateEventImages mocks base method.
+func (m *MockEventServiceClient) UpdateEventImages(ctx context.Context, in *event.UpdateEventImagesRequest, opts ...grpc.CallOption) (*event.EventImages, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateEventImages", varargs...)
+	ret0, _ := ret[0].(*event.EventImages)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateEventImages indicates an expected call of UpdateEventImages.
+func (mr *MockEventServiceClientMockRecorder) UpdateEventImages(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEventImages", reflect.TypeOf((*MockEventServiceClient)(nil).UpdateEventImages), varargs...)
+}
+
 // MockEventServiceServer is a mock of EventServiceServer interface.
 type MockEventServiceServer struct {
 	ctrl     *gomock.Controller
@@ -414,6 +834,21 @@ func (mr *MockEventServiceServerMockRecorder) AddEvent(arg0, arg1 interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddEvent", reflect.TypeOf((*MockEventServiceServer)(nil).AddEvent), arg0, arg1)
 }
 
+// AddEventImage mocks base method.
+func (m *MockEventServiceServer) AddEventImage(arg0 context.Context, arg1 *event.AddEventImageRequest) (*event.EventImage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddEventImage", arg0, arg1)
+	ret0, _ := ret[0].(*event.EventImage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddEventImage indicates an expected call of AddEventImage.
+func (mr *MockEventServiceServerMockRecorder) AddEventImage(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddEventImage", reflect.TypeOf((*MockEventServiceServer)(nil).AddEventImage), arg0, arg1)
+}
+
 // AddEventToFavorites mocks base method.
 func (m *MockEventServiceServer) AddEventToFavorites(arg0 context.Context, arg1 *event.FavoriteEvent) (*event.Empty, error) {
 	m.ctrl.T.Helper()
@@ -429,11 +864,116 @@ func (mr *MockEventServiceServerMockRecorder) AddEventToFavorites(arg0, arg1 int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddEventToFavorites", reflect.TypeOf((*MockEventServiceServer)(nil).AddEventToFavorites), arg0, arg1)
 }
 
+// AddTicketType mocks base method.
+func (m *MockEventServiceServer) AddTicketType(arg0 context.Context, arg1 *event.AddTicketTypeRequest) (*event.TicketType, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddTicketType", arg0, arg1)
+	ret0, _ := ret[0].(*event.TicketType)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddTicketType indicates an expected call of AddTicketType.
+func (mr *MockEventServiceServerMockRecorder) AddTicketType(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTicketType", reflect.TypeOf((*MockEventServiceServer)(nil).AddTicketType), arg0, arg1)
+}
+
+// AttendEvent mocks base method.
+func (m *MockEventServiceServer) AttendEvent(arg0 context.Context, arg1 *event.AttendanceRequest) (*event.AttendanceStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AttendEvent", arg0, arg1)
+	ret0, _ := ret[0].(*event.AttendanceStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AttendEvent indicates an expected call of AttendEvent.
+func (mr *MockEventServiceServerMockRecorder) AttendEvent(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AttendEvent", reflect.TypeOf((*MockEventServiceServer)(nil).AttendEvent), arg0, arg1)
+}
+
+// BuyTicket mocks base method.
+func (m *MockEventServiceServer) BuyTicket(arg0 context.Context, arg1 *event.BuyTicketRequest) (*event.Ticket, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BuyTicket", arg0, arg1)
+	ret0, _ := ret[0].(*event.Ticket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BuyTicket indicates an expected call of BuyTicket.
+func (mr *MockEventServiceServerMockRecorder) BuyTicket(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuyTicket", reflect.TypeOf((*MockEventServiceServer)(nil).BuyTicket), arg0, arg1)
+}
+
+// CancelAttendance mocks base method.
+func (m *MockEventServiceServer) CancelAttendance(arg0 context.Context, arg1 *event.AttendanceRequest) (*event.WaitlistPromotions, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelAttendance", arg0, arg1)
+	ret0, _ := ret[0].(*event.WaitlistPromotions)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelAttendance indicates an expected call of CancelAttendance.
+func (mr *MockEventServiceServerMockRecorder) CancelAttendance(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelAttendance", reflect.TypeOf((*MockEventServiceServer)(nil).CancelAttendance), arg0, arg1)
+}
+
+// CancelEvent mocks base method.
+func (m *MockEventServiceServer) CancelEvent(arg0 context.Context, arg1 *event.CancelEventRequest) (*event.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelEvent", arg0, arg1)
+	ret0, _ := ret[0].(*event.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelEvent indicates an expected call of CancelEvent.
+func (mr *MockEventServiceServerMockRecorder) CancelEvent(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelEvent", reflect.TypeOf((*MockEventServiceServer)(nil).CancelEvent), arg0, arg1)
+}
+
+// CheckInAttendee mocks base method.
+func (m *MockEventServiceServer) CheckInAttendee(arg0 context.Context, arg1 *event.CheckInAttendeeRequest) (*event.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckInAttendee", arg0, arg1)
+	ret0, _ := ret[0].(*event.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckInAttendee indicates an expected call of CheckInAttendee.
+func (mr *MockEventServiceServerMockRecorder) CheckInAttendee(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckInAttendee", reflect.TypeOf((*MockEventServiceServer)(nil).CheckInAttendee), arg0, arg1)
+}
+
+// CreateCalendarToken mocks base method.
+func (m *MockEventServiceServer) CreateCalendarToken(arg0 context.Context, arg1 *event.CreateCalendarTokenRequest) (*event.CalendarToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCalendarToken", arg0, arg1)
+	ret0, _ := ret[0].(*event.CalendarToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCalendarToken indicates an expected call of CreateCalendarToken.
+func (mr *MockEventServiceServerMockRecorder) CreateCalendarToken(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCalendarToken", reflect.TypeOf((*MockEventServiceServer)(nil).CreateCalendarToken), arg0, arg1)
+}
+
 // DeleteEvent mocks base method.
-func (m *MockEventServiceServer) DeleteEvent(arg0 context.Context, arg1 *event.DeleteEventRequest) (*event.Empty, error) {
+func (m *MockEventServiceServer) DeleteEvent(arg0 context.Context, arg1 *event.DeleteEventRequest) (*event.DeleteEventResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "DeleteEvent", arg0, arg1)
-	ret0, _ := ret[0].(*event.Empty)
+	ret0, _ := ret[0].(*event.DeleteEventResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -459,6 +999,36 @@ func (mr *MockEventServiceServerMockRecorder) DeleteEventFromFavorites(arg0, arg
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEventFromFavorites", reflect.TypeOf((*MockEventServiceServer)(nil).DeleteEventFromFavorites), arg0, arg1)
 }
 
+// DeleteEventImage mocks base method.
+func (m *MockEventServiceServer) DeleteEventImage(arg0 context.Context, arg1 *event.DeleteEventImageRequest) (*event.EventImage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEventImage", arg0, arg1)
+	ret0, _ := ret[0].(*event.EventImage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteEventImage indicates an expected call of DeleteEventImage.
+func (mr *MockEventServiceServerMockRecorder) DeleteEventImage(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEventImage", reflect.TypeOf((*MockEventServiceServer)(nil).DeleteEventImage), arg0, arg1)
+}
+
+// GetAttendees mocks base method.
+func (m *MockEventServiceServer) GetAttendees(arg0 context.Context, arg1 *event.GetAttendeesRequest) (*event.Attendees, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAttendees", arg0, arg1)
+	ret0, _ := ret[0].(*event.Attendees)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAttendees indicates an expected call of GetAttendees.
+func (mr *MockEventServiceServerMockRecorder) GetAttendees(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttendees", reflect.TypeOf((*MockEventServiceServer)(nil).GetAttendees), arg0, arg1)
+}
+
 // GetCategories mocks base method.
 func (m *MockEventServiceServer) GetCategories(arg0 context.Context, arg1 *event.Empty) (*event.GetCategoriesResponse, error) {
 	m.ctrl.T.Helper()
@@ -474,6 +1044,21 @@ func (mr *MockEventServiceServerMockRecorder) GetCategories(arg0, arg1 interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategories", reflect.TypeOf((*MockEventServiceServer)(nil).GetCategories), arg0, arg1)
 }
 
+// GetDrafts mocks base method.
+func (m *MockEventServiceServer) GetDrafts(arg0 context.Context, arg1 *event.GetEventsByUserRequest) (*event.Events, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDrafts", arg0, arg1)
+	ret0, _ := ret[0].(*event.Events)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDrafts indicates an expected call of GetDrafts.
+func (mr *MockEventServiceServerMockRecorder) GetDrafts(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDrafts", reflect.TypeOf((*MockEventServiceServer)(nil).GetDrafts), arg0, arg1)
+}
+
 // GetEventByID mocks base method.
 func (m *MockEventServiceServer) GetEventByID(arg0 context.Context, arg1 *event.GetEventByIDRequest) (*event.Event, error) {
 	m.ctrl.T.Helper()
@@ -564,6 +1149,21 @@ func (mr *MockEventServiceServerMockRecorder) GetPastEvents(arg0, arg1 interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPastEvents", reflect.TypeOf((*MockEventServiceServer)(nil).GetPastEvents), arg0, arg1)
 }
 
+// GetRecommendedEvents mocks base method.
+func (m *MockEventServiceServer) GetRecommendedEvents(arg0 context.Context, arg1 *event.GetRecommendedEventsRequest) (*event.Events, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRecommendedEvents", arg0, arg1)
+	ret0, _ := ret[0].(*event.Events)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRecommendedEvents indicates an expected call of GetRecommendedEvents.
+func (mr *MockEventServiceServerMockRecorder) GetRecommendedEvents(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecommendedEvents", reflect.TypeOf((*MockEventServiceServer)(nil).GetRecommendedEvents), arg0, arg1)
+}
+
 // GetSubscribersIDs mocks base method.
 func (m *MockEventServiceServer) GetSubscribersIDs(arg0 context.Context, arg1 *event.GetSubscribersIDsRequest) (*event.GetUserIDsResponse, error) {
 	m.ctrl.T.Helper()
@@ -594,6 +1194,36 @@ func (mr *MockEventServiceServerMockRecorder) GetSubscriptionsEvents(arg0, arg1
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubscriptionsEvents", reflect.TypeOf((*MockEventServiceServer)(nil).GetSubscriptionsEvents), arg0, arg1)
 }
 
+// GetTicketTypes mocks base method.
+func (m *MockEventServiceServer) GetTicketTypes(arg0 context.Context, arg1 *event.GetTicketTypesRequest) (*event.TicketTypes, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTicketTypes", arg0, arg1)
+	ret0, _ := ret[0].(*event.TicketTypes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTicketTypes indicates an expected call of GetTicketTypes.
+func (mr *MockEventServiceServerMockRecorder) GetTicketTypes(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketTypes", reflect.TypeOf((*MockEventServiceServer)(nil).GetTicketTypes), arg0, arg1)
+}
+
+// GetTrendingEvents mocks base method.
+func (m *MockEventServiceServer) GetTrendingEvents(arg0 context.Context, arg1 *event.GetTrendingEventsRequest) (*event.Events, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTrendingEvents", arg0, arg1)
+	ret0, _ := ret[0].(*event.Events)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTrendingEvents indicates an expected call of GetTrendingEvents.
+func (mr *MockEventServiceServerMockRecorder) GetTrendingEvents(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrendingEvents", reflect.TypeOf((*MockEventServiceServer)(nil).GetTrendingEvents), arg0, arg1)
+}
+
 // GetUpcomingEvents mocks base method.
 func (m *MockEventServiceServer) GetUpcomingEvents(arg0 context.Context, arg1 *event.PaginationParams) (*event.Events, error) {
 	m.ctrl.T.Helper()
@@ -609,6 +1239,21 @@ func (mr *MockEventServiceServerMockRecorder) GetUpcomingEvents(arg0, arg1 inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUpcomingEvents", reflect.TypeOf((*MockEventServiceServer)(nil).GetUpcomingEvents), arg0, arg1)
 }
 
+// GetUserIDByCalendarToken mocks base method.
+func (m *MockEventServiceServer) GetUserIDByCalendarToken(arg0 context.Context, arg1 *event.CalendarToken) (*event.CalendarTokenOwner, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserIDByCalendarToken", arg0, arg1)
+	ret0, _ := ret[0].(*event.CalendarTokenOwner)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserIDByCalendarToken indicates an expected call of GetUserIDByCalendarToken.
+func (mr *MockEventServiceServerMockRecorder) GetUserIDByCalendarToken(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserIDByCalendarToken", reflect.TypeOf((*MockEventServiceServer)(nil).GetUserIDByCalendarToken), arg0, arg1)
+}
+
 // GetUserIDsByFavoriteEvent mocks base method.
 func (m *MockEventServiceServer) GetUserIDsByFavoriteEvent(arg0 context.Context, arg1 *event.GetUserIDsByFavoriteEventRequest) (*event.GetUserIDsResponse, error) {
 	m.ctrl.T.Helper()
@@ -624,6 +1269,66 @@ func (mr *MockEventServiceServerMockRecorder) GetUserIDsByFavoriteEvent(arg0, ar
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserIDsByFavoriteEvent", reflect.TypeOf((*MockEventServiceServer)(nil).GetUserIDsByFavoriteEvent), arg0, arg1)
 }
 
+// GetUserTickets mocks base method.
+func (m *MockEventServiceServer) GetUserTickets(arg0 context.Context, arg1 *event.GetUserTicketsRequest) (*event.Tickets, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserTickets", arg0, arg1)
+	ret0, _ := ret[0].(*event.Tickets)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserTickets indicates an expected call of GetUserTickets.
+func (mr *MockEventServiceServerMockRecorder) GetUserTickets(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserTickets", reflect.TypeOf((*MockEventServiceServer)(nil).GetUserTickets), arg0, arg1)
+}
+
+// PublishEvent mocks base method.
+func (m *MockEventServiceServer) PublishEvent(arg0 context.Context, arg1 *event.PublishEventRequest) (*event.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishEvent", arg0, arg1)
+	ret0, _ := ret[0].(*event.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PublishEvent indicates an expected call of PublishEvent.
+func (mr *MockEventServiceServerMockRecorder) PublishEvent(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishEvent", reflect.TypeOf((*MockEventServiceServer)(nil).PublishEvent), arg0, arg1)
+}
+
+// RescheduleEvent mocks base method.
+func (m *MockEventServiceServer) RescheduleEvent(arg0 context.Context, arg1 *event.RescheduleEventRequest) (*event.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RescheduleEvent", arg0, arg1)
+	ret0, _ := ret[0].(*event.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RescheduleEvent indicates an expected call of RescheduleEvent.
+func (mr *MockEventServiceServerMockRecorder) RescheduleEvent(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RescheduleEvent", reflect.TypeOf((*MockEventServiceServer)(nil).RescheduleEvent), arg0, arg1)
+}
+
+// ReserveTickets mocks base method.
+func (m *MockEventServiceServer) ReserveTickets(arg0 context.Context, arg1 *event.ReserveTicketsRequest) (*event.Ticket, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReserveTickets", arg0, arg1)
+	ret0, _ := ret[0].(*event.Ticket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReserveTickets indicates an expected call of ReserveTickets.
+func (mr *MockEventServiceServerMockRecorder) ReserveTickets(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReserveTickets", reflect.TypeOf((*MockEventServiceServer)(nil).ReserveTickets), arg0, arg1)
+}
+
 // SearchEvents mocks base method.
 func (m *MockEventServiceServer) SearchEvents(arg0 context.Context, arg1 *event.SearchParams) (*event.Events, error) {
 	m.ctrl.T.Helper()
@@ -639,6 +1344,21 @@ func (mr *MockEventServiceServerMockRecorder) SearchEvents(arg0, arg1 interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchEvents", reflect.TypeOf((*MockEventServiceServer)(nil).SearchEvents), arg0, arg1)
 }
 
+// SuggestEvents mocks base method.
+func (m *MockEventServiceServer) SuggestEvents(arg0 context.Context, arg1 *event.SuggestRequest) (*event.Suggestions, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SuggestEvents", arg0, arg1)
+	ret0, _ := ret[0].(*event.Suggestions)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SuggestEvents indicates an expected call of SuggestEvents.
+func (mr *MockEventServiceServerMockRecorder) SuggestEvents(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SuggestEvents", reflect.TypeOf((*MockEventServiceServer)(nil).SuggestEvents), arg0, arg1)
+}
+
 // UpdateEvent mocks base method.
 func (m *MockEventServiceServer) UpdateEvent(arg0 context.Context, arg1 *event.Event) (*event.Event, error) {
 	m.ctrl.T.Helper()
@@ -654,6 +1374,21 @@ func (mr *MockEventServiceServerMockRecorder) UpdateEvent(arg0, arg1 interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEvent", reflect.TypeOf((*MockEventServiceServer)(nil).UpdateEvent), arg0, arg1)
 }
 
+// UpdateEventImages mocks base method.
+func (m *MockEventServiceServer) UpdateEventImages(arg0 context.Context, arg1 *event.UpdateEventImagesRequest) (*event.EventImages, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateEventImages", arg0, arg1)
+	ret0, _ := ret[0].(*event.EventImages)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateEventImages indicates an expected call of UpdateEventImages.
+func (mr *MockEventServiceServerMockRecorder) UpdateEventImages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEventImages", reflect.TypeOf((*MockEventServiceServer)(nil).UpdateEventImages), arg0, arg1)
+}
+
 // mustEmbedUnimplementedEventServiceServer mocks base method.
 func (m *MockEventServiceServer) mustEmbedUnimplementedEventServiceServer() {
 	m.ctrl.T.Helper()
@@ -0,0 +1,77 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ../utils/image.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	image "kudago/internal/image/api"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	grpc "google.golang.org/grpc"
+)
+
+// MockImageServiceClient is a mock of ImageServiceClient interface.
+type MockImageServiceClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockImageServiceClientMockRecorder
+}
+
+// MockImageServiceClientMockRecorder is the mock recorder for MockImageServiceClient.
+type MockImageServiceClientMockRecorder struct {
+	mock *MockImageServiceClient
+}
+
+// NewMockImageServiceClient creates a new mock instance.
+func NewMockImageServiceClient(ctrl *gomock.Controller) *MockImageServiceClient {
+	mock := &MockImageServiceClient{ctrl: ctrl}
+	mock.recorder = &MockImageServiceClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockImageServiceClient) EXPECT() *MockImageServiceClientMockRecorder {
+	return m.recorder
+}
+
+// DeleteImage mocks base method.
+func (m *MockImageServiceClient) DeleteImage(ctx context.Context, in *image.DeleteRequest, opts ...grpc.CallOption) (*image.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteImage", varargs...)
+	ret0, _ := ret[0].(*image.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteImage indicates an expected call of DeleteImage.
+func (mr *MockImageServiceClientMockRecorder) DeleteImage(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteImage", reflect.TypeOf((*MockImageServiceClient)(nil).DeleteImage), varargs...)
+}
+
+// UploadImageStream mocks base method.
+func (m *MockImageServiceClient) UploadImageStream(ctx context.Context, opts ...grpc.CallOption) (image.ImageService_UploadImageStreamClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UploadImageStream", varargs...)
+	ret0, _ := ret[0].(image.ImageService_UploadImageStreamClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UploadImageStream indicates an expected call of UploadImageStream.
+func (mr *MockImageServiceClientMockRecorder) UploadImageStream(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadImageStream", reflect.TypeOf((*MockImageServiceClient)(nil).UploadImageStream), varargs...)
+}
@@ -0,0 +1,107 @@
+package events
+
+import (
+	"net/http"
+	"strconv"
+
+	pb "kudago/internal/event/api"
+	httpErrors "kudago/internal/gateway/errors"
+	"kudago/internal/gateway/utils"
+	"kudago/internal/models"
+
+	"github.com/asaskevich/govalidator"
+	"github.com/gorilla/mux"
+	easyjson "github.com/mailru/easyjson"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	grpcCodes "google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+// @Summary Бронирование билетов
+// @Description Бронирует билеты выбранного типа. Бронь действует ограниченное время, затем её нужно оплатить
+// @Tags tickets
+// @Accept  json
+// @Produce  json
+// @Param id path int true "ID события"
+// @Param reservation body ReserveTicketsRequest true "Тип и количество билетов"
+// @Success 201 {object} TicketResponse
+// @Failure 400 {object} httpErrors.HttpError "Bad Request"
+// @Failure 401 {object} httpErrors.HttpError "Unauthorized"
+// @Failure 404 {object} httpErrors.HttpError "Event Or Ticket Type Not Found"
+// @Failure 409 {object} httpErrors.HttpError "Not Enough Tickets Left Or Event Cancelled"
+// @Failure 500 {object} httpErrors.HttpError "Internal Server Error"
+// @Router /events/{id}/tickets [post]
+func (h EventHandler) ReserveTickets(w http.ResponseWriter, r *http.Request) {
+	session, ok := utils.GetSessionFromContext(r.Context())
+	if !ok {
+		utils.WriteResponse(w, http.StatusForbidden, httpErrors.ErrUnauthorized)
+		return
+	}
+
+	vars := mux.Vars(r)
+	id, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		utils.WriteResponse(w, http.StatusBadRequest, httpErrors.ErrInvalidID)
+		return
+	}
+
+	var req ReserveTicketsRequest
+	err = easyjson.UnmarshalFromReader(r.Body, &req)
+	if err != nil {
+		utils.WriteResponse(w, http.StatusBadRequest, httpErrors.ErrInvalidData)
+		return
+	}
+
+	_, err = govalidator.ValidateStruct(&req)
+	if err != nil {
+		utils.ProcessValidationErrors(w, err)
+		return
+	}
+
+	ticket, err := h.EventService.ReserveTickets(r.Context(), &pb.ReserveTicketsRequest{
+		UserID:       int32(session.UserID),
+		EventID:      int32(id),
+		TicketTypeID: int32(req.TicketTypeID),
+		Quantity:     int32(req.Quantity),
+	})
+	if err != nil {
+		st, ok := grpcStatus.FromError(err)
+		if ok {
+			switch st.Code() {
+			case grpcCodes.NotFound:
+				if ticketTypeNotFound(st) {
+					utils.WriteResponse(w, http.StatusNotFound, httpErrors.ErrTicketTypeNotFound)
+					return
+				}
+				utils.WriteResponse(w, http.StatusNotFound, httpErrors.ErrEventNotFound)
+				return
+			case grpcCodes.ResourceExhausted:
+				utils.WriteResponse(w, http.StatusConflict, httpErrors.ErrNoTicketsLeft)
+				return
+			case grpcCodes.FailedPrecondition:
+				utils.WriteResponse(w, http.StatusConflict, httpErrors.ErrEventClosed)
+				return
+			case grpcCodes.InvalidArgument:
+				utils.WriteResponse(w, http.StatusBadRequest, httpErrors.ErrInvalidData)
+				return
+			}
+		}
+
+		h.logger.Error(r.Context(), "reserve tickets", err)
+		utils.WriteResponse(w, http.StatusInternalServerError, httpErrors.ErrInternal)
+		return
+	}
+
+	utils.WriteResponse(w, http.StatusCreated, ticketToTicketResponse(ticket))
+}
+
+// ticketTypeNotFound reports whether the event service pointed at the ticket
+// type rather than the event as the missing resource.
+func ticketTypeNotFound(st *grpcStatus.Status) bool {
+	for _, detail := range st.Details() {
+		if info, ok := detail.(*errdetails.ResourceInfo); ok {
+			return info.ResourceType == models.TicketTypeResource
+		}
+	}
+	return false
+}
@@ -0,0 +1,179 @@
+package events
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	pb "kudago/internal/event/api"
+	"kudago/internal/event/grpc"
+	"kudago/internal/gateway/event/mocks"
+	"kudago/internal/gateway/utils"
+	"kudago/internal/logger"
+	"kudago/internal/models"
+
+	"github.com/golang/mock/gomock"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestEventHandler_ReserveTickets(t *testing.T) {
+	t.Parallel()
+
+	logger, _ := logger.NewLogger()
+
+	newRequest := func(body string, withSession bool) *http.Request {
+		req := httptest.NewRequest(http.MethodPost, "/events/1/tickets", bytes.NewBufferString(body))
+		req = mux.SetURLVars(req, map[string]string{"id": "1"})
+		if !withSession {
+			return req
+		}
+		session := models.Session{UserID: 3, Token: "valid_token"}
+		return req.WithContext(utils.SetSessionInContext(req.Context(), session))
+	}
+
+	reserveRequest := &pb.ReserveTicketsRequest{UserID: 3, EventID: 1, TicketTypeID: 2, Quantity: 2}
+	validBody := `{"type_id": 2, "quantity": 2}`
+
+	tests := []struct {
+		name      string
+		req       *http.Request
+		setupFunc func(ctrl *gomock.Controller) *EventHandler
+		wantCode  int
+		wantBody  string
+	}{
+		{
+			name: "Успешное бронирование",
+			req:  newRequest(validBody, true),
+			setupFunc: func(ctrl *gomock.Controller) *EventHandler {
+				serviceMock := mocks.NewMockEventServiceClient(ctrl)
+
+				serviceMock.EXPECT().ReserveTickets(gomock.Any(), reserveRequest).
+					Return(&pb.Ticket{ID: 7, EventID: 1, TicketTypeID: 2, UserID: 3, Quantity: 2, Status: models.TicketStatusReserved}, nil)
+
+				return &EventHandler{
+					EventService: serviceMock,
+					logger:       logger,
+				}
+			},
+			wantCode: http.StatusCreated,
+		},
+		{
+			name: "Нет сессии",
+			req:  newRequest(validBody, false),
+			setupFunc: func(ctrl *gomock.Controller) *EventHandler {
+				return &EventHandler{
+					EventService: mocks.NewMockEventServiceClient(ctrl),
+					logger:       logger,
+				}
+			},
+			wantCode: http.StatusForbidden,
+		},
+		{
+			name: "Слишком много билетов",
+			req:  newRequest(`{"type_id": 2, "quantity": 100}`, true),
+			setupFunc: func(ctrl *gomock.Controller) *EventHandler {
+				return &EventHandler{
+					EventService: mocks.NewMockEventServiceClient(ctrl),
+					logger:       logger,
+				}
+			},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name: "Билеты закончились",
+			req:  newRequest(validBody, true),
+			setupFunc: func(ctrl *gomock.Controller) *EventHandler {
+				serviceMock := mocks.NewMockEventServiceClient(ctrl)
+
+				serviceMock.EXPECT().ReserveTickets(gomock.Any(), reserveRequest).
+					Return(nil, status.Error(codes.ResourceExhausted, grpc.ErrNoTicketsLeft))
+
+				return &EventHandler{
+					EventService: serviceMock,
+					logger:       logger,
+				}
+			},
+			wantCode: http.StatusConflict,
+		},
+		{
+			name: "Тип билета не найден",
+			req:  newRequest(validBody, true),
+			setupFunc: func(ctrl *gomock.Controller) *EventHandler {
+				serviceMock := mocks.NewMockEventServiceClient(ctrl)
+
+				serviceMock.EXPECT().ReserveTickets(gomock.Any(), reserveRequest).
+					Return(nil, ticketTypeNotFoundErr())
+
+				return &EventHandler{
+					EventService: serviceMock,
+					logger:       logger,
+				}
+			},
+			wantCode: http.StatusNotFound,
+			wantBody: "Ticket type not found",
+		},
+		{
+			name: "Событие не найдено",
+			req:  newRequest(validBody, true),
+			setupFunc: func(ctrl *gomock.Controller) *EventHandler {
+				serviceMock := mocks.NewMockEventServiceClient(ctrl)
+
+				serviceMock.EXPECT().ReserveTickets(gomock.Any(), reserveRequest).
+					Return(nil, status.Error(codes.NotFound, grpc.ErrEventNotFound))
+
+				return &EventHandler{
+					EventService: serviceMock,
+					logger:       logger,
+				}
+			},
+			wantCode: http.StatusNotFound,
+			wantBody: "Event not found",
+		},
+		{
+			name: "Внутренняя ошибка",
+			req:  newRequest(validBody, true),
+			setupFunc: func(ctrl *gomock.Controller) *EventHandler {
+				serviceMock := mocks.NewMockEventServiceClient(ctrl)
+
+				serviceMock.EXPECT().ReserveTickets(gomock.Any(), reserveRequest).
+					Return(nil, status.Error(codes.Internal, grpc.ErrInternal))
+
+				return &EventHandler{
+					EventService: serviceMock,
+					logger:       logger,
+				}
+			},
+			wantCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			recorder := httptest.NewRecorder()
+			tt.setupFunc(ctrl).ReserveTickets(recorder, tt.req)
+
+			assert.Equal(t, tt.wantCode, recorder.Code)
+			if tt.wantBody != "" {
+				assert.Contains(t, recorder.Body.String(), tt.wantBody)
+			}
+		})
+	}
+}
+
+func ticketTypeNotFoundErr() error {
+	st, _ := status.New(codes.NotFound, grpc.ErrTicketTypeNotFound).WithDetails(&errdetails.ResourceInfo{
+		ResourceType: models.TicketTypeResource,
+		ResourceName: "2",
+	})
+	return st.Err()
+}
@@ -1,6 +1,7 @@
 package events
 
 import (
+	"errors"
 	"net/http"
 	"strconv"
 
@@ -21,6 +22,11 @@ import (
 // @Param event_end query string false "Дата окончания события в формате YYYY-MM-DD"
 // @Param tags query []string false "Список тегов"
 // @Param category_id query int false "ID категории"
+// @Param lat query number false "Широта точки для поиска по радиусу"
+// @Param lon query number false "Долгота точки для поиска по радиусу"
+// @Param radius query number false "Радиус поиска в метрах (не больше 100 км)"
+// @Param sort query string false "Сортировка: distance — по расстоянию от точки"
+// @Param facets query bool false "Вернуть количество совпадений по категориям, тегам и датам"
 // @Success 200 {object} GetEventsResponse "Список событий"
 // @Failure 400 {object} httpErrors.HttpError "Invalid Data"
 // @Failure 500 {object} httpErrors.HttpError "Internal Server Error"
@@ -39,6 +45,12 @@ func (h EventHandler) SearchEvents(w http.ResponseWriter, r *http.Request) {
 		categoryID = 0
 	}
 
+	lat, lon, radius, err := getRadiusParams(r)
+	if err != nil {
+		utils.WriteResponse(w, http.StatusBadRequest, httpErrors.ErrInvalidLocation)
+		return
+	}
+
 	params := &pb.SearchParams{
 		Query:      query,
 		EventStart: eventStart,
@@ -46,6 +58,12 @@ func (h EventHandler) SearchEvents(w http.ResponseWriter, r *http.Request) {
 		Tag:        tags,
 		CategoryID: int32(categoryID),
 		Params:     paginationParams,
+
+		Latitude:       lat,
+		Longitude:      lon,
+		Radius:         radius,
+		SortByDistance: r.URL.Query().Get("sort") == sortByDistance && radius > 0,
+		WithFacets:     r.URL.Query().Get("facets") == "true",
 	}
 
 	events, err := h.EventService.SearchEvents(r.Context(), params)
@@ -56,5 +74,70 @@ func (h EventHandler) SearchEvents(w http.ResponseWriter, r *http.Request) {
 	}
 
 	resp := writeEventsResponse(events.Events, int(paginationParams.Limit))
+	if events.Facets != nil {
+		resp.Facets = searchFacetsToResponse(events.Facets)
+	}
 	utils.WriteResponse(w, http.StatusOK, resp)
 }
+
+func searchFacetsToResponse(facets *pb.SearchFacets) *SearchFacetsResponse {
+	resp := &SearchFacetsResponse{
+		Categories: make([]CategoryFacetResponse, 0, len(facets.Categories)),
+		Tags:       make([]TagFacetResponse, 0, len(facets.Tags)),
+		Dates: DateFacetsResponse{
+			Today:       int(facets.Dates.GetToday()),
+			ThisWeekend: int(facets.Dates.GetThisWeekend()),
+			NextWeek:    int(facets.Dates.GetNextWeek()),
+		},
+	}
+
+	for _, category := range facets.Categories {
+		resp.Categories = append(resp.Categories, CategoryFacetResponse{
+			CategoryID: int(category.CategoryID),
+			Count:      int(category.Count),
+		})
+	}
+
+	for _, tag := range facets.Tags {
+		resp.Tags = append(resp.Tags, TagFacetResponse{
+			Tag:   tag.Tag,
+			Count: int(tag.Count),
+		})
+	}
+
+	return resp
+}
+
+const (
+	sortByDistance = "distance"
+	maxRadius      = 100_000
+)
+
+var errInvalidLocation = errors.New("invalid location")
+
+// getRadiusParams reads the "near me" point and radius, which are either all
+// present or all absent.
+func getRadiusParams(r *http.Request) (lat, lon, radius float64, err error) {
+	query := r.URL.Query()
+	latStr, lonStr, radiusStr := query.Get("lat"), query.Get("lon"), query.Get("radius")
+	if latStr == "" && lonStr == "" && radiusStr == "" {
+		return 0, 0, 0, nil
+	}
+
+	lat, err = strconv.ParseFloat(latStr, 64)
+	if err != nil || lat < -90 || lat > 90 {
+		return 0, 0, 0, errInvalidLocation
+	}
+
+	lon, err = strconv.ParseFloat(lonStr, 64)
+	if err != nil || lon < -180 || lon > 180 {
+		return 0, 0, 0, errInvalidLocation
+	}
+
+	radius, err = strconv.ParseFloat(radiusStr, 64)
+	if err != nil || radius <= 0 || radius > maxRadius {
+		return 0, 0, 0, errInvalidLocation
+	}
+
+	return lat, lon, radius, nil
+}
@@ -74,6 +74,80 @@ func TestEventHandler_Search(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Поиск по радиусу с сортировкой по расстоянию",
+			req:  httptest.NewRequest(http.MethodGet, "/events/search?lat=55.75&lon=37.62&radius=5000&sort=distance", nil),
+			setupFunc: func(ctrl *gomock.Controller) *EventHandler {
+				serviceMock := mocks.NewMockEventServiceClient(ctrl)
+				distance := 1200.5
+				events := &pb.Events{
+					Events: []*pb.Event{{ID: 1, Title: "near", Distance: &distance}},
+				}
+
+				serviceMock.EXPECT().SearchEvents(gomock.Any(), &pb.SearchParams{
+					Params:         searchEvents.Params,
+					Latitude:       55.75,
+					Longitude:      37.62,
+					Radius:         5000,
+					SortByDistance: true,
+				}).Return(events, nil)
+
+				return &EventHandler{
+					EventService: serviceMock,
+					logger:       logger,
+				}
+			},
+			wantCode: http.StatusOK,
+			wantBody: &GetEventsResponse{
+				Events: []EventResponse{{ID: 1, Title: "near", Distance: func() *float64 { d := 1200.5; return &d }()}},
+			},
+		},
+		{
+			name: "Поиск с фасетами",
+			req:  httptest.NewRequest(http.MethodGet, "/events/search?query=jazz&facets=true", nil),
+			setupFunc: func(ctrl *gomock.Controller) *EventHandler {
+				serviceMock := mocks.NewMockEventServiceClient(ctrl)
+				events := &pb.Events{
+					Events: []*pb.Event{{ID: 1, Title: "jazz"}},
+					Facets: &pb.SearchFacets{
+						Categories: []*pb.CategoryFacet{{CategoryID: 2, Count: 1}},
+						Tags:       []*pb.TagFacet{{Tag: "jazz", Count: 1}},
+						Dates:      &pb.DateFacets{ThisWeekend: 1},
+					},
+				}
+
+				serviceMock.EXPECT().SearchEvents(gomock.Any(), &pb.SearchParams{
+					Query:      "jazz",
+					Params:     searchEvents.Params,
+					WithFacets: true,
+				}).Return(events, nil)
+
+				return &EventHandler{
+					EventService: serviceMock,
+					logger:       logger,
+				}
+			},
+			wantCode: http.StatusOK,
+			wantBody: &GetEventsResponse{
+				Events: []EventResponse{{ID: 1, Title: "jazz"}},
+				Facets: &SearchFacetsResponse{
+					Categories: []CategoryFacetResponse{{CategoryID: 2, Count: 1}},
+					Tags:       []TagFacetResponse{{Tag: "jazz", Count: 1}},
+					Dates:      DateFacetsResponse{ThisWeekend: 1},
+				},
+			},
+		},
+		{
+			name: "Радиус без точки",
+			req:  httptest.NewRequest(http.MethodGet, "/events/search?radius=5000", nil),
+			setupFunc: func(ctrl *gomock.Controller) *EventHandler {
+				return &EventHandler{
+					EventService: mocks.NewMockEventServiceClient(ctrl),
+					logger:       logger,
+				}
+			},
+			wantCode: http.StatusBadRequest,
+		},
 		{
 			name: "Internal error",
 			req: func() *http.Request {
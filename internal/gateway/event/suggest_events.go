@@ -0,0 +1,70 @@
+package events
+
+import (
+	"net/http"
+
+	pb "kudago/internal/event/api"
+	httpErrors "kudago/internal/gateway/errors"
+	"kudago/internal/gateway/utils"
+
+	grpcCodes "google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+const defaultSuggestLimit = 5
+
+// @Summary Подсказки для поиска
+// @Description Возвращает события и теги, названия которых начинаются с введенного текста или похожи на него. Популярные (по числу добавлений в избранное) идут первыми
+// @Tags events
+// @Produce  json
+// @Param q query string true "Введенный текст (от 2 символов)"
+// @Param limit query int false "Количество подсказок каждого вида (по умолчанию 5, не больше 10)"
+// @Success 200 {object} SuggestionsResponse
+// @Failure 500 {object} httpErrors.HttpError "Internal Server Error"
+// @Failure 504 {object} httpErrors.HttpError "Gateway Timeout"
+// @Router /events/suggest [get]
+func (h EventHandler) SuggestEvents(w http.ResponseWriter, r *http.Request) {
+	req := &pb.SuggestRequest{
+		Query: r.URL.Query().Get("q"),
+		Limit: int32(GetQueryParamInt(r, "limit", defaultSuggestLimit)),
+	}
+
+	suggestions, err := h.EventService.SuggestEvents(r.Context(), req)
+	if err != nil {
+		st, ok := grpcStatus.FromError(err)
+		if ok && st.Code() == grpcCodes.DeadlineExceeded {
+			utils.WriteResponse(w, http.StatusGatewayTimeout, httpErrors.ErrSuggestTimeout)
+			return
+		}
+
+		h.logger.Error(r.Context(), "suggest events", err)
+		utils.WriteResponse(w, http.StatusInternalServerError, httpErrors.ErrInternal)
+		return
+	}
+
+	utils.WriteResponse(w, http.StatusOK, suggestionsToResponse(suggestions))
+}
+
+func suggestionsToResponse(suggestions *pb.Suggestions) SuggestionsResponse {
+	resp := SuggestionsResponse{
+		Events: make([]EventSuggestionResponse, 0, len(suggestions.Events)),
+		Tags:   make([]TagSuggestionResponse, 0, len(suggestions.Tags)),
+	}
+
+	for _, event := range suggestions.Events {
+		resp.Events = append(resp.Events, EventSuggestionResponse{
+			ID:             int(event.ID),
+			Title:          event.Title,
+			FavoritesCount: int(event.FavoritesCount),
+		})
+	}
+
+	for _, tag := range suggestions.Tags {
+		resp.Tags = append(resp.Tags, TagSuggestionResponse{
+			Name:           tag.Name,
+			FavoritesCount: int(tag.FavoritesCount),
+		})
+	}
+
+	return resp
+}
@@ -0,0 +1,105 @@
+package events
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	pb "kudago/internal/event/api"
+	"kudago/internal/event/grpc"
+	"kudago/internal/gateway/event/mocks"
+	"kudago/internal/logger"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestEventHandler_SuggestEvents(t *testing.T) {
+	t.Parallel()
+
+	logger, _ := logger.NewLogger()
+
+	tests := []struct {
+		name      string
+		req       *http.Request
+		setupFunc func(ctrl *gomock.Controller) *EventHandler
+		wantCode  int
+		wantBody  *SuggestionsResponse
+	}{
+		{
+			name: "Успешное получение подсказок",
+			req:  httptest.NewRequest(http.MethodGet, "/events/suggest?q=jaz", nil),
+			setupFunc: func(ctrl *gomock.Controller) *EventHandler {
+				serviceMock := mocks.NewMockEventServiceClient(ctrl)
+				serviceMock.EXPECT().SuggestEvents(gomock.Any(), &pb.SuggestRequest{Query: "jaz", Limit: defaultSuggestLimit}).
+					Return(&pb.Suggestions{
+						Events: []*pb.EventSuggestion{{ID: 1, Title: "Jazz night", FavoritesCount: 7}},
+					}, nil)
+
+				return &EventHandler{
+					EventService: serviceMock,
+					logger:       logger,
+				}
+			},
+			wantCode: http.StatusOK,
+			wantBody: &SuggestionsResponse{
+				Events: []EventSuggestionResponse{{ID: 1, Title: "Jazz night", FavoritesCount: 7}},
+				Tags:   []TagSuggestionResponse{},
+			},
+		},
+		{
+			name: "Превышено время ожидания",
+			req:  httptest.NewRequest(http.MethodGet, "/events/suggest?q=jaz&limit=3", nil),
+			setupFunc: func(ctrl *gomock.Controller) *EventHandler {
+				serviceMock := mocks.NewMockEventServiceClient(ctrl)
+				serviceMock.EXPECT().SuggestEvents(gomock.Any(), &pb.SuggestRequest{Query: "jaz", Limit: 3}).
+					Return(nil, status.Error(codes.DeadlineExceeded, grpc.ErrSuggestTimeout))
+
+				return &EventHandler{
+					EventService: serviceMock,
+					logger:       logger,
+				}
+			},
+			wantCode: http.StatusGatewayTimeout,
+		},
+		{
+			name: "Внутренняя ошибка",
+			req:  httptest.NewRequest(http.MethodGet, "/events/suggest?q=jaz", nil),
+			setupFunc: func(ctrl *gomock.Controller) *EventHandler {
+				serviceMock := mocks.NewMockEventServiceClient(ctrl)
+				serviceMock.EXPECT().SuggestEvents(gomock.Any(), gomock.Any()).
+					Return(nil, status.Error(codes.Internal, grpc.ErrInternal))
+
+				return &EventHandler{
+					EventService: serviceMock,
+					logger:       logger,
+				}
+			},
+			wantCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			recorder := httptest.NewRecorder()
+			tt.setupFunc(ctrl).SuggestEvents(recorder, tt.req)
+
+			assert.Equal(t, tt.wantCode, recorder.Code)
+
+			if tt.wantBody != nil {
+				var resp SuggestionsResponse
+				err := json.Unmarshal(recorder.Body.Bytes(), &resp)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.wantBody, &resp)
+			}
+		})
+	}
+}
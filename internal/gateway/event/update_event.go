@@ -25,7 +25,7 @@ import (
 // @Produce  json
 // @Param id path int true "Идентификатор события"
 // @Param json body NewEventRequest true "Данные для обновления события"
-// @Param image formData file false "Изображение события"
+// @Param image formData file false "Изображение события, передаётся после части json"
 // @Success 200 {object} NewEventResponse "Успешное обновление события"
 // @Failure 400 {object} httpErrors.HttpError "Неверные данные"
 // @Failure 401 {object} httpErrors.HttpError "Неавторизован"
@@ -40,7 +40,7 @@ func (h EventHandler) UpdateEvent(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	req, media, reqErr := parseEventData(r)
+	req, media, reqErr := parseEventData(w, r)
 	if reqErr != nil {
 		utils.WriteResponse(w, http.StatusBadRequest, reqErr)
 		return
@@ -97,7 +97,9 @@ func (h EventHandler) UpdateEvent(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err = h.sendUpdateNotifications(r.Context(), int(event.ID))
+	h.deleteImage(r.Context(), event.ReplacedImage)
+
+	err = h.sendFavoritesNotifications(r.Context(), int(event.ID), UpdatedEventMsg)
 	if err != nil {
 		h.logger.Error(r.Context(), "send update notifications", err)
 	}
@@ -109,16 +111,23 @@ func (h EventHandler) UpdateEvent(w http.ResponseWriter, r *http.Request) {
 	utils.WriteResponse(w, http.StatusOK, resp)
 }
 
-func (h EventHandler) sendUpdateNotifications(ctx context.Context, eventID int) error {
+// sendFavoritesNotifications notifies everyone who saved the event about a
+// change to it. Changes apply to a series as a whole, so users who saved only
+// some of its occurrences are notified as well.
+func (h EventHandler) sendFavoritesNotifications(ctx context.Context, eventID int, message string) error {
 	idsResp, err := h.EventService.GetUserIDsByFavoriteEvent(ctx, &pbEvent.GetUserIDsByFavoriteEventRequest{ID: int32(eventID)})
 	if err != nil {
 		return err
 	}
 
+	if len(idsResp.IDs) == 0 {
+		return nil
+	}
+
 	req := &pbNtf.CreateNotificationsRequest{
-		UserIDs: make([]int32, len(idsResp.IDs)),
+		UserIDs: make([]int32, 0, len(idsResp.IDs)),
 		Notification: &pbNtf.Notification{
-			Message:  UpdatedEventMsg,
+			Message:  message,
 			NotifyAt: time.Now().String(),
 			EventID:  int32(eventID),
 		},
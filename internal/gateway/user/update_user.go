@@ -4,6 +4,7 @@ import (
 	"context"
 	"net/http"
 
+	pbAuth "kudago/internal/auth/api"
 	httpErrors "kudago/internal/gateway/errors"
 	"kudago/internal/gateway/utils"
 	pbImage "kudago/internal/image/api"
@@ -23,7 +24,7 @@ func (h *UserHandlers) UpdateUser(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	req, media, reqErr := parseUpdateData(r)
+	req, media, reqErr := parseUpdateData(w, r)
 	if reqErr != nil {
 		utils.WriteResponse(w, http.StatusBadRequest, reqErr)
 		return
@@ -40,9 +41,26 @@ func (h *UserHandlers) UpdateUser(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if media != nil {
+		err = media.CheckEnd()
+		if err != nil {
+			h.deleteImage(r.Context(), url)
+			utils.WriteResponse(w, http.StatusBadRequest, httpErrors.ErrInvalidData)
+			return
+		}
+	}
+
 	req.AvatarUrl = url
 	req.ID = int32(session.UserID)
 
+	emailChanged, err := h.emailChanged(r.Context(), req)
+	if err != nil {
+		h.deleteImage(r.Context(), url)
+		h.logger.Error(r.Context(), "update user", err)
+		utils.WriteResponse(w, http.StatusInternalServerError, httpErrors.ErrInternal)
+		return
+	}
+
 	user, err := h.UserService.UpdateUser(r.Context(), req)
 	if err != nil {
 		h.deleteImage(r.Context(), url)
@@ -59,26 +77,47 @@ func (h *UserHandlers) UpdateUser(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
+	// The new address is unconfirmed, so the sessions must stop passing
+	// as verified and the user needs a fresh link
+	if emailChanged {
+		_, err = h.AuthService.ResetEmailVerification(r.Context(), &pbAuth.ResetEmailVerificationRequest{UserID: req.ID})
+		if err != nil {
+			h.logger.Error(r.Context(), "reset email verification", err)
+			utils.WriteResponse(w, http.StatusInternalServerError, httpErrors.ErrInternal)
+			return
+		}
+	}
+
 	resp := userToUserResponse(user)
 
 	utils.WriteResponse(w, http.StatusOK, resp)
 	return
 }
 
-func parseUpdateData(r *http.Request) (*pb.User, *pbImage.UploadRequest, *httpErrors.HttpError) {
-	var req models.User
-	jsonData := r.FormValue("json")
+func (h *UserHandlers) emailChanged(ctx context.Context, req *pb.User) (bool, error) {
+	if req.Email == "" {
+		return false, nil
+	}
 
-	err := req.UnmarshalJSON([]byte(jsonData))
+	current, err := h.UserService.GetUserByID(ctx, &pb.GetUserByIDRequest{ID: req.ID})
 	if err != nil {
-		return nil, nil, httpErrors.ErrInvalidData
+		return false, err
 	}
+	return current.Email != req.Email, nil
+}
 
-	media, err := utils.HandleImageUpload(r)
+func parseUpdateData(w http.ResponseWriter, r *http.Request) (*pb.User, *utils.ImageUpload, *httpErrors.HttpError) {
+	var req models.User
+	jsonData, media, err := utils.ReadImageForm(w, r)
 	if err != nil {
 		return nil, nil, httpErrors.ErrInvalidImage
 	}
 
+	err = req.UnmarshalJSON(jsonData)
+	if err != nil {
+		return nil, nil, httpErrors.ErrInvalidData
+	}
+
 	user := &pb.User{
 		ID:        int32(req.ID),
 		Username:  req.Username,
@@ -88,14 +127,16 @@ func parseUpdateData(r *http.Request) (*pb.User, *pbImage.UploadRequest, *httpEr
 	return user, media, nil
 }
 
-func (h *UserHandlers) uploadImage(ctx context.Context, media *pbImage.UploadRequest, w http.ResponseWriter) (string, error) {
+func (h *UserHandlers) uploadImage(ctx context.Context, media *utils.ImageUpload, w http.ResponseWriter) (string, error) {
 	if media != nil {
-		url, err := h.ImageService.UploadImage(ctx, media)
+		url, err := utils.UploadImage(ctx, h.ImageService, media)
 		if err != nil {
-			switch err {
-			case models.ErrInvalidImage:
+			switch {
+			case grpcStatus.Code(err) == grpcCodes.InvalidArgument, err == models.ErrInvalidImage:
 				utils.WriteResponse(w, http.StatusBadRequest, httpErrors.ErrInvalidImage)
-			case models.ErrInvalidImageFormat:
+			case grpcStatus.Code(err) == grpcCodes.ResourceExhausted, err == models.ErrImageTooLarge:
+				utils.WriteResponse(w, http.StatusRequestEntityTooLarge, httpErrors.ErrImageTooLarge)
+			case err == models.ErrInvalidImageFormat:
 				utils.WriteResponse(w, http.StatusBadRequest, httpErrors.ErrInvalidImageFormat)
 			default:
 				h.logger.Error(ctx, "upload image", err)
@@ -1,19 +1,62 @@
 package handlers
 
 import (
+	"bytes"
+	"errors"
+	"mime/multipart"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 
+	pbAuth "kudago/internal/auth/api"
 	"kudago/internal/gateway/user/mocks"
 	"kudago/internal/gateway/utils"
+	pbImage "kudago/internal/image/api"
 	"kudago/internal/logger"
 	"kudago/internal/models"
+	pb "kudago/internal/user/api"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
 )
 
+// fakeUploadStream accepts any upload and answers with resp.
+type fakeUploadStream struct {
+	grpc.ClientStream
+	resp *pbImage.UploadResponse
+}
+
+func (s *fakeUploadStream) Send(chunk *pbImage.UploadChunk) error {
+	return nil
+}
+
+func (s *fakeUploadStream) CloseAndRecv() (*pbImage.UploadResponse, error) {
+	return s.resp, nil
+}
+
+// newProfileFormRequest builds a profile update form of the given parts,
+// written in order; a part named "image" is sent as a file.
+func newProfileFormRequest(parts ...[3]string) *http.Request {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	for _, part := range parts {
+		name, filename, content := part[0], part[1], part[2]
+		if name == "image" {
+			file, _ := writer.CreateFormFile(name, filename)
+			file.Write([]byte(content))
+			continue
+		}
+		writer.WriteField(name, content)
+	}
+	writer.Close()
+
+	req := httptest.NewRequest(http.MethodPut, "/profile", body)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	session := models.Session{UserID: 1, Token: "valid_token"}
+	return req.WithContext(utils.SetSessionInContext(req.Context(), session))
+}
+
 func TestUserHandler_UpdateUser(t *testing.T) {
 	t.Parallel()
 
@@ -59,6 +102,133 @@ func TestUserHandler_UpdateUser(t *testing.T) {
 			},
 			wantCode: http.StatusBadRequest,
 		},
+		{
+			name: "Смена имени без почты",
+			req:  newProfileFormRequest([3]string{"json", "", `{"username":"newname"}`}),
+			setupFunc: func(ctrl *gomock.Controller) *UserHandlers {
+				serviceMock := mocks.NewMockUserServiceClient(ctrl)
+				authMock := mocks.NewMockAuthServiceClient(ctrl)
+
+				serviceMock.EXPECT().UpdateUser(gomock.Any(), &pb.User{ID: 1, Username: "newname"}).
+					Return(&pb.User{ID: 1, Username: "newname", Email: "old@mail.ru"}, nil)
+
+				return &UserHandlers{
+					UserService: serviceMock,
+					AuthService: authMock,
+					logger:      logger,
+				}
+			},
+			wantCode: http.StatusOK,
+		},
+		{
+			name: "Почта не изменилась",
+			req:  newProfileFormRequest([3]string{"json", "", `{"username":"newname","email":"old@mail.ru"}`}),
+			setupFunc: func(ctrl *gomock.Controller) *UserHandlers {
+				serviceMock := mocks.NewMockUserServiceClient(ctrl)
+				authMock := mocks.NewMockAuthServiceClient(ctrl)
+
+				serviceMock.EXPECT().GetUserByID(gomock.Any(), &pb.GetUserByIDRequest{ID: 1}).
+					Return(&pb.User{ID: 1, Username: "oldname", Email: "old@mail.ru"}, nil)
+				serviceMock.EXPECT().UpdateUser(gomock.Any(), &pb.User{ID: 1, Username: "newname", Email: "old@mail.ru"}).
+					Return(&pb.User{ID: 1, Username: "newname", Email: "old@mail.ru"}, nil)
+
+				return &UserHandlers{
+					UserService: serviceMock,
+					AuthService: authMock,
+					logger:      logger,
+				}
+			},
+			wantCode: http.StatusOK,
+		},
+		{
+			name: "Смена почты сбрасывает подтверждение",
+			req:  newProfileFormRequest([3]string{"json", "", `{"email":"new@mail.ru"}`}),
+			setupFunc: func(ctrl *gomock.Controller) *UserHandlers {
+				serviceMock := mocks.NewMockUserServiceClient(ctrl)
+				authMock := mocks.NewMockAuthServiceClient(ctrl)
+
+				serviceMock.EXPECT().GetUserByID(gomock.Any(), &pb.GetUserByIDRequest{ID: 1}).
+					Return(&pb.User{ID: 1, Username: "name", Email: "old@mail.ru"}, nil)
+				serviceMock.EXPECT().UpdateUser(gomock.Any(), &pb.User{ID: 1, Email: "new@mail.ru"}).
+					Return(&pb.User{ID: 1, Username: "name", Email: "new@mail.ru"}, nil)
+				authMock.EXPECT().ResetEmailVerification(gomock.Any(), &pbAuth.ResetEmailVerificationRequest{UserID: 1}).
+					Return(&pbAuth.Empty{}, nil)
+
+				return &UserHandlers{
+					UserService: serviceMock,
+					AuthService: authMock,
+					logger:      logger,
+				}
+			},
+			wantCode: http.StatusOK,
+		},
+		{
+			name: "Ошибка сброса подтверждения",
+			req:  newProfileFormRequest([3]string{"json", "", `{"email":"new@mail.ru"}`}),
+			setupFunc: func(ctrl *gomock.Controller) *UserHandlers {
+				serviceMock := mocks.NewMockUserServiceClient(ctrl)
+				authMock := mocks.NewMockAuthServiceClient(ctrl)
+
+				serviceMock.EXPECT().GetUserByID(gomock.Any(), &pb.GetUserByIDRequest{ID: 1}).
+					Return(&pb.User{ID: 1, Username: "name", Email: "old@mail.ru"}, nil)
+				serviceMock.EXPECT().UpdateUser(gomock.Any(), &pb.User{ID: 1, Email: "new@mail.ru"}).
+					Return(&pb.User{ID: 1, Username: "name", Email: "new@mail.ru"}, nil)
+				authMock.EXPECT().ResetEmailVerification(gomock.Any(), &pbAuth.ResetEmailVerificationRequest{UserID: 1}).
+					Return(nil, errors.New("auth error"))
+
+				return &UserHandlers{
+					UserService: serviceMock,
+					AuthService: authMock,
+					logger:      logger,
+				}
+			},
+			wantCode: http.StatusInternalServerError,
+		},
+		{
+			name: "Пустой файл изображения не считается изображением",
+			req: newProfileFormRequest(
+				[3]string{"json", "", `{"username":"newname"}`},
+				[3]string{"image", "", ""},
+			),
+			setupFunc: func(ctrl *gomock.Controller) *UserHandlers {
+				serviceMock := mocks.NewMockUserServiceClient(ctrl)
+				imageMock := mocks.NewMockImageServiceClient(ctrl)
+
+				serviceMock.EXPECT().UpdateUser(gomock.Any(), &pb.User{ID: 1, Username: "newname"}).
+					Return(&pb.User{ID: 1, Username: "newname", Email: "old@mail.ru"}, nil)
+
+				return &UserHandlers{
+					UserService:  serviceMock,
+					ImageService: imageMock,
+					logger:       logger,
+				}
+			},
+			wantCode: http.StatusOK,
+		},
+		{
+			name: "Часть json после изображения",
+			req: newProfileFormRequest(
+				[3]string{"json", "", `{"username":"newname"}`},
+				[3]string{"image", "avatar.jpg", "image data"},
+				[3]string{"json", "", `{"email":"new@mail.ru"}`},
+			),
+			setupFunc: func(ctrl *gomock.Controller) *UserHandlers {
+				serviceMock := mocks.NewMockUserServiceClient(ctrl)
+				imageMock := mocks.NewMockImageServiceClient(ctrl)
+
+				imageMock.EXPECT().UploadImageStream(gomock.Any()).
+					Return(&fakeUploadStream{resp: &pbImage.UploadResponse{FileUrl: "/images/a.jpg"}}, nil)
+				imageMock.EXPECT().DeleteImage(gomock.Any(), &pbImage.DeleteRequest{FileUrl: "/images/a.jpg"}).
+					Return(&pbImage.Empty{}, nil)
+
+				return &UserHandlers{
+					UserService:  serviceMock,
+					ImageService: imageMock,
+					logger:       logger,
+				}
+			},
+			wantCode: http.StatusBadRequest,
+		},
 	}
 
 	for _, tt := range tests {
@@ -1,5 +1,6 @@
 //go:generate mockgen -source=../../user/api/user_grpc.pb.go -destination=mocks/user.go -package=mocks
-//go:generate mockgen -source=../../image/api/image_grpc.pb.go -destination=mocks/image.go -package=mocks
+//go:generate mockgen -source=../utils/image.go -destination=mocks/image.go -package=mocks
+//go:generate mockgen -source=../../auth/api/auth_grpc.pb.go -destination=mocks/auth.go -package=mocks
 
 //go:generate easyjson user.go
 package handlers
@@ -7,7 +8,8 @@ package handlers
 import (
 	"regexp"
 
-	pbImage "kudago/internal/image/api"
+	pbAuth "kudago/internal/auth/api"
+	"kudago/internal/gateway/utils"
 	"kudago/internal/logger"
 	pb "kudago/internal/user/api"
 	user "kudago/internal/user/api"
@@ -27,18 +29,25 @@ func init() {
 
 type UserHandlers struct {
 	UserService  pb.UserServiceClient
-	ImageService pbImage.ImageServiceClient
+	AuthService  pbAuth.AuthServiceClient
+	ImageService utils.ImageServiceClient
 	logger       *logger.Logger
 }
 
-func NewHandlers(userServiceAddr string, logger *logger.Logger) (*UserHandlers, error) {
-	authConn, err := grpc.NewClient(userServiceAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+func NewHandlers(userServiceAddr string, authServiceAddr string, logger *logger.Logger) (*UserHandlers, error) {
+	userConn, err := grpc.NewClient(userServiceAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, err
+	}
+
+	authConn, err := grpc.NewClient(authServiceAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
 	if err != nil {
 		return nil, err
 	}
 
 	return &UserHandlers{
-		UserService: user.NewUserServiceClient(authConn),
+		UserService: user.NewUserServiceClient(userConn),
+		AuthService: pbAuth.NewAuthServiceClient(authConn),
 		logger:      logger,
 	}, nil
 }
@@ -0,0 +1,66 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+
+	pbImage "kudago/internal/image/api"
+	"kudago/internal/models"
+
+	"google.golang.org/grpc"
+)
+
+// imageChunkSize is the size of the chunks images are streamed in.
+const imageChunkSize = 64 * 1024
+
+// ImageServiceClient is the part of the image service client the gateway
+// uses. The stream is named by its alias: mockgen can't parse the generic
+// type the generated client spells out.
+type ImageServiceClient interface {
+	UploadImageStream(ctx context.Context, opts ...grpc.CallOption) (pbImage.ImageService_UploadImageStreamClient, error)
+	DeleteImage(ctx context.Context, in *pbImage.DeleteRequest, opts ...grpc.CallOption) (*pbImage.Empty, error)
+}
+
+// UploadImage streams an image of a multipart form to the image service, so
+// the gateway never holds the whole file in memory.
+func UploadImage(ctx context.Context, client ImageServiceClient, image *ImageUpload) (*pbImage.UploadResponse, error) {
+	stream, err := client.UploadImageStream(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	buf := make([]byte, imageChunkSize)
+	chunk := &pbImage.UploadChunk{Filename: image.Filename}
+	for {
+		// The image service checks the content type on the first chunk, so
+		// chunks are filled completely rather than sent as they are read.
+		n, readErr := io.ReadFull(image.File, buf)
+		if n > 0 {
+			chunk.Data = buf[:n]
+			if err := stream.Send(chunk); err != nil {
+				// The service closed the stream, its error comes from
+				// CloseAndRecv.
+				if errors.Is(err, io.EOF) {
+					break
+				}
+				return nil, err
+			}
+			chunk = &pbImage.UploadChunk{}
+		}
+
+		if readErr == io.EOF || readErr == io.ErrUnexpectedEOF {
+			break
+		}
+		if readErr != nil {
+			var tooLarge *http.MaxBytesError
+			if errors.As(readErr, &tooLarge) {
+				return nil, models.ErrImageTooLarge
+			}
+			return nil, readErr
+		}
+	}
+
+	return stream.CloseAndRecv()
+}
@@ -10,13 +10,13 @@ import (
 	"fmt"
 	"io"
 	"mime/multipart"
+	"net"
 	"net/http"
 	"path/filepath"
 	"strconv"
 	"strings"
 	"time"
 
-	pbImage "kudago/internal/image/api"
 	"kudago/internal/models"
 
 	"github.com/asaskevich/govalidator"
@@ -25,9 +25,10 @@ import (
 )
 
 const (
-	defaultPage   = 0
-	defaultLimit  = 30
-	maxUploadSize = 10 * 1024 * 1024 // 10Mb
+	defaultPage  = 0
+	defaultLimit = 30
+	// maxFormSize bounds a multipart form: an image and the JSON sent with it.
+	maxFormSize = models.MaxImageSize + 1024*1024 // 11Mb
 )
 
 func WriteResponse(w http.ResponseWriter, status int, body easyjson.Marshaler) {
@@ -35,57 +36,95 @@ func WriteResponse(w http.ResponseWriter, status int, body easyjson.Marshaler) {
 	_, _ = easyjson.MarshalToWriter(body, w)
 }
 
-func HandleImageUpload(r *http.Request) (*pbImage.UploadRequest, error) {
-	err := r.ParseMultipartForm(maxUploadSize)
-	if err != nil {
-		return nil, models.ErrInvalidImage
-	}
+// ErrPartAfterImage is returned by CheckEnd for a form that goes on after
+// its image.
+var ErrPartAfterImage = errors.New("form part after image")
 
-	file, header, err := r.FormFile("image")
-	if err != nil {
-		if err != http.ErrMissingFile {
-			return nil, models.ErrInvalidImage
-		}
-		return nil, nil
-	}
-	defer file.Close()
+// ImageUpload is the image part of a multipart form. File is read straight
+// from the request body, by UploadImage only.
+type ImageUpload struct {
+	Filename string
+	File     io.Reader
+
+	form *multipart.Reader
+}
 
-	err = GenerateFilename(header)
+// CheckEnd reports parts sent after the image, which would otherwise be
+// dropped unread. It is called once the image has been uploaded.
+func (u *ImageUpload) CheckEnd() error {
+	_, err := u.form.NextPart()
+	if errors.Is(err, io.EOF) {
+		return nil
+	}
 	if err != nil {
-		return nil, models.ErrInvalidImage
+		return models.ErrInvalidImage
 	}
+	return ErrPartAfterImage
+}
 
-	fileData, err := io.ReadAll(file)
+// ReadImageForm reads a multipart form of a "json" part followed by an
+// "image" part, both optional; an image part without a file name, as
+// browsers send for an empty file input, counts as no image. The image is
+// returned unread, renamed for storage, so that it is streamed to the image
+// service as it arrives and is never buffered by the gateway; parts sent
+// after it are left to ImageUpload.CheckEnd.
+func ReadImageForm(w http.ResponseWriter, r *http.Request) ([]byte, *ImageUpload, error) {
+	r.Body = http.MaxBytesReader(w, r.Body, maxFormSize)
+	reader, err := r.MultipartReader()
 	if err != nil {
-		return nil, models.ErrInvalidImage
+		return nil, nil, models.ErrInvalidImage
 	}
 
-	return &pbImage.UploadRequest{
-		Filename: header.Filename,
-		File:     fileData,
-	}, nil
+	var jsonData []byte
+	for {
+		part, err := reader.NextPart()
+		if errors.Is(err, io.EOF) {
+			return jsonData, nil, nil
+		}
+		if err != nil {
+			return nil, nil, models.ErrInvalidImage
+		}
+
+		switch part.FormName() {
+		case "json":
+			jsonData, err = io.ReadAll(part)
+			if err != nil {
+				return nil, nil, models.ErrInvalidImage
+			}
+		case "image":
+			if part.FileName() == "" {
+				continue
+			}
+			filename, err := GenerateFilename(part.FileName())
+			if err != nil {
+				return nil, nil, models.ErrInvalidImage
+			}
+			return jsonData, &ImageUpload{Filename: filename, File: part, form: reader}, nil
+		}
+	}
 }
 
-func GenerateFilename(header *multipart.FileHeader) error {
+// GenerateFilename returns a random name for storing an upload, keeping the
+// extension of its original name.
+func GenerateFilename(name string) (string, error) {
 	bytes := make([]byte, 12)
 	_, err := rand.Read(bytes)
 	if err != nil {
-		return models.ErrInvalidImage
+		return "", models.ErrInvalidImage
 	}
 
 	token := base64.URLEncoding.EncodeToString(bytes)
 	timestamp := time.Now().UnixNano() / int64(time.Millisecond)
 
 	filename := fmt.Sprintf("%s_%d", token, timestamp)
-	extension := getFileExtension(header.Filename)
+	extension := getFileExtension(name)
 
 	switch extension {
 	case "jpeg", "jpg", "gif", "png":
 	default:
-		return models.ErrInvalidImageFormat
+		return "", models.ErrInvalidImageFormat
 	}
-	header.Filename = fmt.Sprintf("%s.%s", filename, extension)
-	return nil
+	return fmt.Sprintf("%s.%s", filename, extension), nil
 }
 
 func getFileExtension(fileName string) string {
@@ -104,6 +143,10 @@ var sessionKey sessionKeyType
 
 type requestIDKeyType struct{}
 
+type clientIPKeyType struct{}
+
+var clientIPKey clientIPKeyType
+
 var requestIDKey requestIDKeyType
 
 func GetSessionFromContext(ctx context.Context) (models.Session, bool) {
@@ -118,6 +161,15 @@ func SetSessionInContext(ctx context.Context, session models.Session) context.Co
 	return context.WithValue(ctx, sessionKey, session)
 }
 
+func SetSessionCookie(w http.ResponseWriter, token string, expires time.Time) {
+	http.SetCookie(w, &http.Cookie{
+		Name:     models.SessionToken,
+		Value:    token,
+		Expires:  expires,
+		HttpOnly: true,
+	})
+}
+
 func ProcessValidationErrors(w http.ResponseWriter, err error) {
 	resp := ValidationErrResponse{}
 	validationErrors := err.(govalidator.Errors)
@@ -136,6 +188,28 @@ func ProcessValidationErrors(w http.ResponseWriter, err error) {
 	WriteResponse(w, http.StatusBadRequest, resp)
 }
 
+// GetClientIP returns the client address resolved by ClientIPMiddleware, or
+// the remote address of the connection when the middleware hasn't run.
+func GetClientIP(r *http.Request) string {
+	if ip, ok := r.Context().Value(clientIPKey).(string); ok {
+		return ip
+	}
+	return RemoteIP(r)
+}
+
+// RemoteIP returns the address of the peer of the connection.
+func RemoteIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+func SetClientIPInContext(ctx context.Context, ip string) context.Context {
+	return context.WithValue(ctx, clientIPKey, ip)
+}
+
 func GetPaginationParams(r *http.Request) models.PaginationParams {
 	page := GetQueryParamInt(r, "page", defaultPage)
 	limit := GetQueryParamInt(r, "limit", defaultLimit)
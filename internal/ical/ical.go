@@ -0,0 +1,261 @@
+// Package ical renders events as an RFC 5545 iCalendar feed that calendar
+// apps can import or subscribe to.
+package ical
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	_ "time/tzdata" // calendar zones must resolve even without system tzdata
+	"unicode/utf8"
+
+	"kudago/internal/models"
+)
+
+const (
+	prodID = "-//vyhodnoy.online//Events//RU"
+	// uidDomain makes UIDs globally unique. UIDs depend on the event ID
+	// only, so re-imported or re-fetched events replace the existing entries.
+	uidDomain = "vyhodnoy.online"
+
+	localLayout = "20060102T150405"
+	utcLayout   = "20060102T150405Z"
+
+	maxLineOctets = 75
+	// seriesSpan is how far VTIMEZONE covers recurring events past their
+	// first occurrence.
+	seriesSpan = 2 * 365 * 24 * time.Hour
+)
+
+type Calendar struct {
+	// Name is shown by calendar apps for subscribed feeds.
+	Name string
+	// Location is the zone event times are written in.
+	Location *time.Location
+	Events   []models.Event
+	// Stamp is written as DTSTAMP of every event.
+	Stamp time.Time
+}
+
+type vevent struct {
+	event models.Event
+	start time.Time
+	end   time.Time
+}
+
+func UID(eventID int) string {
+	return "event-" + strconv.Itoa(eventID) + "@" + uidDomain
+}
+
+// Encode renders the calendar. Events with malformed times are rejected
+// rather than silently dropped from a feed.
+func (c Calendar) Encode() ([]byte, error) {
+	loc := c.Location
+	if loc == nil {
+		loc = time.UTC
+	}
+
+	events := make([]vevent, 0, len(c.Events))
+	var from, to time.Time
+	for _, event := range c.Events {
+		start, err := time.Parse(time.RFC3339, event.EventStart)
+		if err != nil {
+			return nil, fmt.Errorf("event %d start: %w", event.ID, err)
+		}
+		end, err := time.Parse(time.RFC3339, event.EventEnd)
+		if err != nil {
+			return nil, fmt.Errorf("event %d end: %w", event.ID, err)
+		}
+		// A series listed by a later occurrence is written from its first
+		// one, or COUNT and the saved occurrences would not line up.
+		if event.RecurrenceRule != "" && event.SeriesStart != "" && !event.SavedOccurrence {
+			seriesStart, err := time.Parse(time.RFC3339, event.SeriesStart)
+			if err != nil {
+				return nil, fmt.Errorf("event %d series start: %w", event.ID, err)
+			}
+			end = seriesStart.Add(end.Sub(start))
+			start = seriesStart
+		}
+
+		last := end
+		if event.RecurrenceRule != "" && !event.SavedOccurrence {
+			last = end.Add(seriesSpan)
+		}
+		if from.IsZero() || start.Before(from) {
+			from = start
+		}
+		if last.After(to) {
+			to = last
+		}
+
+		events = append(events, vevent{event: event, start: start, end: end})
+	}
+
+	var w writer
+	w.line("BEGIN:VCALENDAR")
+	w.line("VERSION:2.0")
+	w.line("PRODID:" + prodID)
+	w.line("CALSCALE:GREGORIAN")
+	w.line("METHOD:PUBLISH")
+	if c.Name != "" {
+		w.line("X-WR-CALNAME:" + escapeText(c.Name))
+	}
+	if loc != time.UTC {
+		w.line("X-WR-TIMEZONE:" + loc.String())
+		if len(events) > 0 {
+			writeTimezone(&w, loc, from, to)
+		}
+	}
+
+	stamp := c.Stamp.UTC().Format(utcLayout)
+	for _, e := range events {
+		writeEvent(&w, e, loc, stamp)
+	}
+
+	w.line("END:VCALENDAR")
+	return w.buf.Bytes(), nil
+}
+
+func writeEvent(w *writer, e vevent, loc *time.Location, stamp string) {
+	w.line("BEGIN:VEVENT")
+	w.line("UID:" + UID(e.event.ID))
+	w.line("DTSTAMP:" + stamp)
+	w.line("DTSTART" + dateTime(e.start, loc))
+	w.line("DTEND" + dateTime(e.end, loc))
+	w.line("SUMMARY:" + escapeText(e.event.Title))
+	// Subscribed calendars mark the entry as cancelled instead of keeping
+	// a stale event or silently dropping it.
+	if e.event.Status == models.EventStatusCancelled {
+		w.line("STATUS:CANCELLED")
+	}
+	if e.event.Description != "" {
+		w.line("DESCRIPTION:" + escapeText(e.event.Description))
+	}
+	if e.event.Location != "" {
+		w.line("LOCATION:" + escapeText(e.event.Location))
+	}
+	if e.event.Latitude != 0 || e.event.Longitude != 0 {
+		w.line(fmt.Sprintf("GEO:%s;%s", formatCoordinate(e.event.Latitude), formatCoordinate(e.event.Longitude)))
+	}
+	if len(e.event.Tag) > 0 {
+		categories := make([]string, 0, len(e.event.Tag))
+		for _, tag := range e.event.Tag {
+			categories = append(categories, escapeText(tag))
+		}
+		w.line("CATEGORIES:" + strings.Join(categories, ","))
+	}
+	switch {
+	case e.event.RecurrenceRule != "" && e.event.SavedOccurrence:
+		// One occurrence of a series shares the series UID and is told
+		// apart by its start.
+		w.line("RECURRENCE-ID" + dateTime(e.start, loc))
+	case e.event.RecurrenceRule != "":
+		w.line("RRULE:" + strings.TrimPrefix(e.event.RecurrenceRule, "RRULE:"))
+		for _, exception := range e.event.RecurrenceExceptions {
+			exdate, err := time.Parse(time.RFC3339, exception)
+			if err != nil {
+				continue
+			}
+			w.line("EXDATE" + dateTime(exdate, loc))
+		}
+	}
+	w.line("END:VEVENT")
+}
+
+// dateTime formats a DTSTART-like value including its parameters and colon.
+func dateTime(t time.Time, loc *time.Location) string {
+	if loc == time.UTC {
+		return ":" + t.UTC().Format(utcLayout)
+	}
+	return ";TZID=" + loc.String() + ":" + t.In(loc).Format(localLayout)
+}
+
+func formatCoordinate(value float64) string {
+	return strconv.FormatFloat(value, 'f', 6, 64)
+}
+
+// writeTimezone describes loc over [from, to] with one observance per offset
+// period, which is enough for clients to place every event correctly.
+func writeTimezone(w *writer, loc *time.Location, from, to time.Time) {
+	w.line("BEGIN:VTIMEZONE")
+	w.line("TZID:" + loc.String())
+
+	t := from.In(loc)
+	for {
+		periodStart, periodEnd := t.ZoneBounds()
+		name, offset := t.Zone()
+
+		offsetFrom := offset
+		dtstart := time.Date(1970, time.January, 1, 0, 0, 0, 0, time.UTC).Format(localLayout)
+		if !periodStart.IsZero() {
+			_, offsetFrom = periodStart.Add(-time.Second).Zone()
+			dtstart = periodStart.In(time.FixedZone("", offsetFrom)).Format(localLayout)
+		}
+
+		component := "STANDARD"
+		if t.IsDST() {
+			component = "DAYLIGHT"
+		}
+
+		w.line("BEGIN:" + component)
+		w.line("DTSTART:" + dtstart)
+		w.line("TZOFFSETFROM:" + formatOffset(offsetFrom))
+		w.line("TZOFFSETTO:" + formatOffset(offset))
+		if name != "" && !strings.ContainsAny(name, "+-") {
+			w.line("TZNAME:" + name)
+		}
+		w.line("END:" + component)
+
+		if periodEnd.IsZero() || periodEnd.After(to) {
+			break
+		}
+		t = periodEnd
+	}
+
+	w.line("END:VTIMEZONE")
+}
+
+func formatOffset(offset int) string {
+	sign := '+'
+	if offset < 0 {
+		sign = '-'
+		offset = -offset
+	}
+	return fmt.Sprintf("%c%02d%02d", sign, offset/3600, offset%3600/60)
+}
+
+func escapeText(value string) string {
+	return strings.NewReplacer(
+		`\`, `\\`,
+		";", `\;`,
+		",", `\,`,
+		"\r\n", `\n`,
+		"\n", `\n`,
+		"\r", "",
+	).Replace(value)
+}
+
+type writer struct {
+	buf bytes.Buffer
+}
+
+// line writes a content line folded to 75 octets without splitting UTF-8
+// sequences, terminated by CRLF.
+func (w *writer) line(value string) {
+	limit := maxLineOctets
+	for len(value) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(value[cut]) {
+			cut--
+		}
+		w.buf.WriteString(value[:cut])
+		w.buf.WriteString("\r\n ")
+		value = value[cut:]
+		// Continuation lines start with a space that counts to the limit.
+		limit = maxLineOctets - 1
+	}
+	w.buf.WriteString(value)
+	w.buf.WriteString("\r\n")
+}
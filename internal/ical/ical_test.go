@@ -0,0 +1,147 @@
+package ical
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"kudago/internal/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCalendar_Encode(t *testing.T) {
+	t.Parallel()
+
+	moscow, err := time.LoadLocation("Europe/Moscow")
+	require.NoError(t, err)
+
+	cal := Calendar{
+		Name:     "Избранное",
+		Location: moscow,
+		Stamp:    time.Date(2025, time.January, 1, 12, 0, 0, 0, time.UTC),
+		Events: []models.Event{
+			{
+				ID:                   42,
+				Title:                "Йога; в парке, утром",
+				Description:          "Первая строка\nвторая",
+				Location:             "Парк Горького",
+				EventStart:           "2025-01-06T16:00:00Z",
+				EventEnd:             "2025-01-06T17:30:00Z",
+				Latitude:             55.7298,
+				Longitude:            37.6011,
+				Tag:                  []string{"спорт", "утро"},
+				RecurrenceRule:       "FREQ=WEEKLY;BYDAY=MO",
+				RecurrenceExceptions: []string{"2025-01-13T16:00:00Z"},
+			},
+			{
+				ID:         43,
+				Title:      "Концерт",
+				EventStart: "2025-01-10T16:00:00Z",
+				EventEnd:   "2025-01-10T18:00:00Z",
+				Status:     models.EventStatusCancelled,
+			},
+		},
+	}
+
+	data, err := cal.Encode()
+	require.NoError(t, err)
+	ics := string(data)
+
+	assert.True(t, strings.HasPrefix(ics, "BEGIN:VCALENDAR\r\n"))
+	assert.True(t, strings.HasSuffix(ics, "END:VCALENDAR\r\n"))
+	for _, line := range []string{
+		"X-WR-CALNAME:Избранное",
+		"BEGIN:VTIMEZONE\r\nTZID:Europe/Moscow",
+		"TZOFFSETTO:+0300",
+		"UID:event-42@vyhodnoy.online",
+		"DTSTAMP:20250101T120000Z",
+		"DTSTART;TZID=Europe/Moscow:20250106T190000",
+		"DTEND;TZID=Europe/Moscow:20250106T203000",
+		`SUMMARY:Йога\; в парке\, утром`,
+		`DESCRIPTION:Первая строка\nвторая`,
+		"GEO:55.729800;37.601100",
+		"CATEGORIES:спорт,утро",
+		"RRULE:FREQ=WEEKLY;BYDAY=MO",
+		"EXDATE;TZID=Europe/Moscow:20250113T190000",
+		"SUMMARY:Концерт\r\nSTATUS:CANCELLED",
+	} {
+		assert.Contains(t, ics, line+"\r\n")
+	}
+
+	_, err = Calendar{Events: []models.Event{{ID: 1, EventStart: "tomorrow"}}}.Encode()
+	assert.Error(t, err)
+}
+
+func TestCalendar_EncodeSeriesOccurrences(t *testing.T) {
+	t.Parallel()
+
+	cal := Calendar{
+		Stamp: time.Date(2025, time.January, 1, 12, 0, 0, 0, time.UTC),
+		Events: []models.Event{
+			{
+				ID:             42,
+				Title:          "Йога",
+				EventStart:     "2025-01-20T16:00:00Z",
+				EventEnd:       "2025-01-20T17:30:00Z",
+				SeriesStart:    "2025-01-06T16:00:00Z",
+				RecurrenceRule: "FREQ=WEEKLY;COUNT=5",
+			},
+			{
+				ID:              42,
+				Title:           "Йога",
+				EventStart:      "2025-01-27T16:00:00Z",
+				EventEnd:        "2025-01-27T17:30:00Z",
+				SeriesStart:     "2025-01-06T16:00:00Z",
+				RecurrenceRule:  "FREQ=WEEKLY;COUNT=5",
+				SavedOccurrence: true,
+			},
+		},
+	}
+
+	data, err := cal.Encode()
+	require.NoError(t, err)
+	ics := string(data)
+
+	// The series is written from its first occurrence, the saved one as an
+	// instance of it.
+	assert.Contains(t, ics, "DTSTART:20250106T160000Z\r\nDTEND:20250106T173000Z\r\n")
+	assert.Contains(t, ics, "RRULE:FREQ=WEEKLY;COUNT=5\r\n")
+	assert.Contains(t, ics, "DTSTART:20250127T160000Z\r\n")
+	assert.Contains(t, ics, "RECURRENCE-ID:20250127T160000Z\r\n")
+	assert.Equal(t, 1, strings.Count(ics, "RRULE:"))
+	assert.Equal(t, 2, strings.Count(ics, "UID:event-42@vyhodnoy.online"))
+}
+
+func TestWriteTimezone_Transitions(t *testing.T) {
+	t.Parallel()
+
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	require.NoError(t, err)
+
+	var w writer
+	writeTimezone(&w, berlin,
+		time.Date(2025, time.January, 10, 0, 0, 0, 0, time.UTC),
+		time.Date(2025, time.December, 10, 0, 0, 0, 0, time.UTC))
+	vtimezone := w.buf.String()
+
+	// Winter time, the switch to summer time in March and back in October.
+	assert.Equal(t, 2, strings.Count(vtimezone, "BEGIN:STANDARD"))
+	assert.Equal(t, 1, strings.Count(vtimezone, "BEGIN:DAYLIGHT"))
+	assert.Contains(t, vtimezone, "BEGIN:DAYLIGHT\r\nDTSTART:20250330T020000\r\nTZOFFSETFROM:+0100\r\nTZOFFSETTO:+0200\r\nTZNAME:CEST\r\n")
+	assert.Contains(t, vtimezone, "DTSTART:20251026T030000\r\nTZOFFSETFROM:+0200\r\nTZOFFSETTO:+0100\r\n")
+}
+
+func TestWriter_Folding(t *testing.T) {
+	t.Parallel()
+
+	var w writer
+	w.line("DESCRIPTION:" + strings.Repeat("ж", 100))
+
+	for _, line := range strings.Split(strings.TrimSuffix(w.buf.String(), "\r\n"), "\r\n") {
+		assert.LessOrEqual(t, len(line), maxLineOctets)
+		assert.True(t, strings.ToValidUTF8(line, "") == line)
+	}
+	assert.Equal(t, "DESCRIPTION:"+strings.Repeat("ж", 100), strings.ReplaceAll(strings.TrimSuffix(w.buf.String(), "\r\n"), "\r\n ", ""))
+}
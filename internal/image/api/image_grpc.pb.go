@@ -19,8 +19,9 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ImageService_UploadImage_FullMethodName = "/image.ImageService/UploadImage"
-	ImageService_DeleteImage_FullMethodName = "/image.ImageService/DeleteImage"
+	ImageService_UploadImage_FullMethodName       = "/image.ImageService/UploadImage"
+	ImageService_UploadImageStream_FullMethodName = "/image.ImageService/UploadImageStream"
+	ImageService_DeleteImage_FullMethodName       = "/image.ImageService/DeleteImage"
 )
 
 // ImageServiceClient is the client API for ImageService service.
@@ -28,6 +29,7 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type ImageServiceClient interface {
 	UploadImage(ctx context.Context, in *UploadRequest, opts ...grpc.CallOption) (*UploadResponse, error)
+	UploadImageStream(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadChunk, UploadResponse], error)
 	DeleteImage(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*Empty, error)
 }
 
@@ -49,6 +51,19 @@ func (c *imageServiceClient) UploadImage(ctx context.Context, in *UploadRequest,
 	return out, nil
 }
 
+func (c *imageServiceClient) UploadImageStream(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadChunk, UploadResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ImageService_ServiceDesc.Streams[0], ImageService_UploadImageStream_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[UploadChunk, UploadResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ImageService_UploadImageStreamClient = grpc.ClientStreamingClient[UploadChunk, UploadResponse]
+
 func (c *imageServiceClient) DeleteImage(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(Empty)
@@ -64,6 +79,7 @@ func (c *imageServiceClient) DeleteImage(ctx context.Context, in *DeleteRequest,
 // for forward compatibility.
 type ImageServiceServer interface {
 	UploadImage(context.Context, *UploadRequest) (*UploadResponse, error)
+	UploadImageStream(grpc.ClientStreamingServer[UploadChunk, UploadResponse]) error
 	DeleteImage(context.Context, *DeleteRequest) (*Empty, error)
 	mustEmbedUnimplementedImageServiceServer()
 }
@@ -78,6 +94,9 @@ type UnimplementedImageServiceServer struct{}
 func (UnimplementedImageServiceServer) UploadImage(context.Context, *UploadRequest) (*UploadResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UploadImage not implemented")
 }
+func (UnimplementedImageServiceServer) UploadImageStream(grpc.ClientStreamingServer[UploadChunk, UploadResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method UploadImageStream not implemented")
+}
 func (UnimplementedImageServiceServer) DeleteImage(context.Context, *DeleteRequest) (*Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteImage not implemented")
 }
@@ -120,6 +139,13 @@ func _ImageService_UploadImage_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ImageService_UploadImageStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ImageServiceServer).UploadImageStream(&grpc.GenericServerStream[UploadChunk, UploadResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ImageService_UploadImageStreamServer = grpc.ClientStreamingServer[UploadChunk, UploadResponse]
+
 func _ImageService_DeleteImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteRequest)
 	if err := dec(in); err != nil {
@@ -154,6 +180,12 @@ var ImageService_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _ImageService_DeleteImage_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "UploadImageStream",
+			Handler:       _ImageService_UploadImageStream_Handler,
+			ClientStreams: true,
+		},
+	},
 	Metadata: "image.proto",
 }
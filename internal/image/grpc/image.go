@@ -11,7 +11,9 @@ import (
 )
 
 const (
-	ErrInternal = "internal error"
+	ErrInternal      = "internal error"
+	ErrInvalidImage  = "invalid image"
+	ErrImageTooLarge = "image is too large"
 )
 
 type ServerAPI struct {
@@ -21,7 +23,7 @@ type ServerAPI struct {
 }
 
 type ImageService interface {
-	UploadImage(ctx context.Context, media models.MediaFile) (string, error)
+	UploadImage(ctx context.Context, media models.MediaFile) (models.UploadedImage, error)
 	DeleteImage(ctx context.Context, imagePath string) error
 }
 
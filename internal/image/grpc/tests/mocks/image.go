@@ -50,10 +50,10 @@ func (mr *MockImageServiceMockRecorder) DeleteImage(ctx, imagePath interface{})
 }
 
 // UploadImage mocks base method.
-func (m *MockImageService) UploadImage(ctx context.Context, media models.MediaFile) (string, error) {
+func (m *MockImageService) UploadImage(ctx context.Context, media models.MediaFile) (models.UploadedImage, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "UploadImage", ctx, media)
-	ret0, _ := ret[0].(string)
+	ret0, _ := ret[0].(models.UploadedImage)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -0,0 +1,118 @@
+package grpc
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"testing"
+
+	pb "kudago/internal/image/api"
+	image "kudago/internal/image/grpc"
+	"kudago/internal/image/grpc/tests/mocks"
+	"kudago/internal/logger"
+	"kudago/internal/models"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type fakeUploadStream struct {
+	grpc.ServerStream
+	chunks []*pb.UploadChunk
+	resp   *pb.UploadResponse
+}
+
+func (s *fakeUploadStream) Context() context.Context {
+	return context.Background()
+}
+
+func (s *fakeUploadStream) Recv() (*pb.UploadChunk, error) {
+	if len(s.chunks) == 0 {
+		return nil, io.EOF
+	}
+	chunk := s.chunks[0]
+	s.chunks = s.chunks[1:]
+	return chunk, nil
+}
+
+func (s *fakeUploadStream) SendAndClose(resp *pb.UploadResponse) error {
+	s.resp = resp
+	return nil
+}
+
+func TestImageGRPC_UploadImageStream(t *testing.T) {
+	t.Parallel()
+
+	pngHeader := []byte("\x89PNG\r\n\x1a\n")
+
+	tests := []struct {
+		name        string
+		chunks      []*pb.UploadChunk
+		setupMocks  func(mockService *mocks.MockImageService)
+		expectedRes *pb.UploadResponse
+		expectedErr error
+	}{
+		{
+			name: "successful upload",
+			chunks: []*pb.UploadChunk{
+				{Filename: "test.png", Data: pngHeader},
+				{Data: []byte("rest")},
+			},
+			setupMocks: func(mockService *mocks.MockImageService) {
+				mockService.EXPECT().UploadImage(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, media models.MediaFile) (models.UploadedImage, error) {
+						data, _ := io.ReadAll(media.File)
+						assert.Equal(t, "test.png", media.Filename)
+						assert.Equal(t, append(append([]byte{}, pngHeader...), "rest"...), data)
+						return models.UploadedImage{URL: "/test_full.jpg"}, nil
+					})
+			},
+			expectedRes: &pb.UploadResponse{FileUrl: "/test_full.jpg", Variants: []*pb.ImageVariant{}},
+		},
+		{
+			name: "not an image",
+			chunks: []*pb.UploadChunk{
+				{Filename: "test.png", Data: []byte("plain text")},
+			},
+			setupMocks:  func(mockService *mocks.MockImageService) {},
+			expectedErr: status.Error(codes.InvalidArgument, image.ErrInvalidImage),
+		},
+		{
+			name:        "empty stream",
+			setupMocks:  func(mockService *mocks.MockImageService) {},
+			expectedErr: status.Error(codes.InvalidArgument, image.ErrInvalidImage),
+		},
+		{
+			name: "too large",
+			chunks: []*pb.UploadChunk{
+				{Filename: "test.png", Data: pngHeader},
+				{Data: bytes.Repeat([]byte{0}, models.MaxImageSize)},
+			},
+			setupMocks:  func(mockService *mocks.MockImageService) {},
+			expectedErr: status.Error(codes.ResourceExhausted, image.ErrImageTooLarge),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockService := mocks.NewMockImageService(ctrl)
+			tt.setupMocks(mockService)
+			logger, _ := logger.NewLogger()
+			server := image.NewServerAPI(mockService, logger)
+
+			stream := &fakeUploadStream{chunks: tt.chunks}
+			err := server.UploadImageStream(stream)
+
+			assert.Equal(t, tt.expectedErr, err)
+			assert.Equal(t, tt.expectedRes, stream.resp)
+		})
+	}
+}
@@ -41,15 +41,44 @@ func TestImageGRPC_UploadImage(t *testing.T) {
 
 				mockService.EXPECT().
 					UploadImage(gomock.Any(), gomock.Any()).
-					Return("/test.png", nil)
+					Return(models.UploadedImage{
+						URL: "/test_full.jpg",
+						Variants: []models.ImageVariant{
+							{Size: models.ImageThumb, Format: models.ImageFormatWebP, URL: "/test_thumb.webp"},
+							{Size: models.ImageFull, Format: models.ImageFormatJPEG, URL: "/test_full.jpg"},
+						},
+					}, nil)
 
 				return image.NewServerAPI(mockService, logger)
 			},
 			expectedRes: &pb.UploadResponse{
-				FileUrl: "/test.png",
+				FileUrl: "/test_full.jpg",
+				Variants: []*pb.ImageVariant{
+					{Size: models.ImageThumb, Format: models.ImageFormatWebP, Url: "/test_thumb.webp"},
+					{Size: models.ImageFull, Format: models.ImageFormatJPEG, Url: "/test_full.jpg"},
+				},
 			},
 			expectedErr: nil,
 		},
+		{
+			name: "not an image",
+			req: &pb.UploadRequest{
+				Filename: "test.png",
+				File:     []byte("image-data"),
+			},
+			setupFunc: func(ctrl *gomock.Controller) *image.ServerAPI {
+				mockService := mocks.NewMockImageService(ctrl)
+				logger, _ := logger.NewLogger()
+
+				mockService.EXPECT().
+					UploadImage(gomock.Any(), gomock.Any()).
+					Return(models.UploadedImage{}, models.ErrUnsupportedFile)
+
+				return image.NewServerAPI(mockService, logger)
+			},
+			expectedRes: nil,
+			expectedErr: status.Error(codes.InvalidArgument, image.ErrInvalidImage),
+		},
 		{
 			name: "internal error",
 			req: &pb.UploadRequest{
@@ -62,7 +91,7 @@ func TestImageGRPC_UploadImage(t *testing.T) {
 
 				mockService.EXPECT().
 					UploadImage(gomock.Any(), gomock.Any()).
-					Return("", models.ErrInternal)
+					Return(models.UploadedImage{}, models.ErrInternal)
 
 				return image.NewServerAPI(mockService, logger)
 			},
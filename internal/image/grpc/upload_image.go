@@ -3,6 +3,7 @@ package grpc
 import (
 	"bytes"
 	"context"
+	"errors"
 
 	pb "kudago/internal/image/api"
 	"kudago/internal/models"
@@ -19,14 +20,27 @@ func (s *ServerAPI) UploadImage(ctx context.Context, req *pb.UploadRequest) (*pb
 		File:     &readSeekCloser{Reader: file},
 	}
 
-	url, err := s.service.UploadImage(ctx, mediaFile)
+	uploaded, err := s.service.UploadImage(ctx, mediaFile)
 	if err != nil {
-		s.logger.Error(ctx, "upload image", err)
-		return nil, status.Error(codes.Internal, ErrInternal)
+		switch {
+		case errors.Is(err, models.ErrUnsupportedFile), errors.Is(err, models.ErrInvalidImage):
+			return nil, status.Error(codes.InvalidArgument, ErrInvalidImage)
+		default:
+			s.logger.Error(ctx, "upload image", err)
+			return nil, status.Error(codes.Internal, ErrInternal)
+		}
 	}
 
 	resp := &pb.UploadResponse{
-		FileUrl: url,
+		FileUrl:  uploaded.URL,
+		Variants: make([]*pb.ImageVariant, 0, len(uploaded.Variants)),
+	}
+	for _, variant := range uploaded.Variants {
+		resp.Variants = append(resp.Variants, &pb.ImageVariant{
+			Size:   variant.Size,
+			Format: variant.Format,
+			Url:    variant.URL,
+		})
 	}
 	return resp, nil
 }
@@ -0,0 +1,64 @@
+package grpc
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net/http"
+
+	pb "kudago/internal/image/api"
+	"kudago/internal/models"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// UploadImageStream receives an image in chunks. The content type is checked
+// on the first chunk, so a client sending something else is stopped before
+// it uploads the rest, and the upload is cut off once it exceeds the limit.
+func (s *ServerAPI) UploadImageStream(stream grpc.ClientStreamingServer[pb.UploadChunk, pb.UploadResponse]) error {
+	ctx := stream.Context()
+
+	first, err := stream.Recv()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return status.Error(codes.InvalidArgument, ErrInvalidImage)
+		}
+		return err
+	}
+
+	if !models.IsSupportedImageType(http.DetectContentType(first.Data)) {
+		return status.Error(codes.InvalidArgument, ErrInvalidImage)
+	}
+
+	if len(first.Data) > models.MaxImageSize {
+		return status.Error(codes.ResourceExhausted, ErrImageTooLarge)
+	}
+
+	var file bytes.Buffer
+	file.Write(first.Data)
+	for {
+		chunk, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		if file.Len()+len(chunk.Data) > models.MaxImageSize {
+			return status.Error(codes.ResourceExhausted, ErrImageTooLarge)
+		}
+		file.Write(chunk.Data)
+	}
+
+	resp, err := s.UploadImage(ctx, &pb.UploadRequest{
+		Filename: first.Filename,
+		File:     file.Bytes(),
+	})
+	if err != nil {
+		return err
+	}
+	return stream.SendAndClose(resp)
+}
@@ -3,80 +3,33 @@ package images
 import (
 	"context"
 	"fmt"
-	"io"
-	"net/http"
-	"os"
-	"path/filepath"
-
-	"kudago/internal/models"
 )
 
-type ImageDB struct {
-	UploadPath string
-}
+const (
+	StorageLocal = "local"
+	StorageS3    = "s3"
+)
 
 type ImageConfig struct {
-	Path string
-}
-
-func NewDB(config ImageConfig) *ImageDB {
-	return &ImageDB{UploadPath: config.Path}
-}
-
-func (r *ImageDB) UploadImage(ctx context.Context, media models.MediaFile) (string, error) {
-	defer media.File.Close()
-
-	buffer := make([]byte, 512)
-	if _, err := media.File.Read(buffer); err != nil {
-		return "", fmt.Errorf("%s: %w", models.LevelDB, err)
-	}
-
-	fileType := http.DetectContentType(buffer)
-	if !isSupportedImageType(fileType) {
-		return "", fmt.Errorf("%s: %w", models.LevelDB, models.ErrUnsupportedFile)
-	}
-
-	if _, err := media.File.Seek(0, io.SeekStart); err != nil {
-		return "", fmt.Errorf("%s: %w", models.LevelDB, err)
-	}
-
-	newPath := filepath.Join(r.UploadPath, media.Filename)
-	if err := os.MkdirAll(r.UploadPath, os.ModePerm); err != nil {
-		return "", fmt.Errorf("%s: %w", models.LevelDB, models.ErrUnsupportedFile)
-	}
-
-	newFile, err := os.Create(newPath)
-	if err != nil {
-		return "", fmt.Errorf("%s: %w", models.LevelDB, models.ErrUnsupportedFile)
-	}
-	defer newFile.Close()
-
-	_, err = io.Copy(newFile, media.File)
-	if err != nil {
-		return "", fmt.Errorf("%s: %w", models.LevelDB, models.ErrUnsupportedFile)
-	}
-	return newPath, nil
+	// Storage selects where images are kept, StorageLocal or StorageS3.
+	Storage string
+	Path    string
+	S3      S3Config
 }
 
-func (r *ImageDB) DeleteImage(ctx context.Context, imagePath string) error {
-	if _, err := os.Stat(imagePath); os.IsNotExist(err) {
-		return fmt.Errorf("%s: %w", models.LevelDB, models.ErrNotFound)
-	}
-
-	if err := os.Remove(imagePath); err != nil {
-		return fmt.Errorf("%s: %w", models.LevelDB, models.ErrUnsupportedFile)
-	}
-
-	return nil
+type Storage interface {
+	SaveImage(ctx context.Context, name string, data []byte) (string, error)
+	DeleteImage(ctx context.Context, imageURL string) error
 }
 
-func isSupportedImageType(fileType string) bool {
-	supportedTypes := map[string]bool{
-		"image/jpeg": true,
-		"image/jpg":  true,
-		"image/png":  true,
-		"image/gif":  true,
+// NewStorage returns the storage selected by the config.
+func NewStorage(config ImageConfig) (Storage, error) {
+	switch config.Storage {
+	case StorageLocal, "":
+		return NewLocalStorage(config.Path), nil
+	case StorageS3:
+		return NewS3Storage(config.S3)
+	default:
+		return nil, fmt.Errorf("unknown image storage %q", config.Storage)
 	}
-
-	return supportedTypes[fileType]
 }
@@ -0,0 +1,46 @@
+package images
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"kudago/internal/models"
+)
+
+// LocalStorage keeps images on the local disk. The server serves them itself,
+// so it only suits a single image service replica.
+type LocalStorage struct {
+	UploadPath string
+}
+
+func NewLocalStorage(path string) *LocalStorage {
+	return &LocalStorage{UploadPath: path}
+}
+
+// SaveImage writes an encoded image under the upload path and returns the
+// path it is served from.
+func (r *LocalStorage) SaveImage(ctx context.Context, name string, data []byte) (string, error) {
+	if err := os.MkdirAll(r.UploadPath, os.ModePerm); err != nil {
+		return "", fmt.Errorf("%s: %w", models.LevelDB, err)
+	}
+
+	newPath := filepath.Join(r.UploadPath, filepath.Base(name))
+	if err := os.WriteFile(newPath, data, 0o644); err != nil {
+		return "", fmt.Errorf("%s: %w", models.LevelDB, err)
+	}
+	return newPath, nil
+}
+
+func (r *LocalStorage) DeleteImage(ctx context.Context, imagePath string) error {
+	if _, err := os.Stat(imagePath); os.IsNotExist(err) {
+		return fmt.Errorf("%s: %w", models.LevelDB, models.ErrNotFound)
+	}
+
+	if err := os.Remove(imagePath); err != nil {
+		return fmt.Errorf("%s: %w", models.LevelDB, models.ErrUnsupportedFile)
+	}
+
+	return nil
+}
@@ -0,0 +1,55 @@
+package images
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"kudago/internal/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalStorage(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	dir := filepath.Join(t.TempDir(), "images")
+	storage := NewLocalStorage(dir)
+
+	t.Run("сохранение и удаление изображения", func(t *testing.T) {
+		url, err := storage.SaveImage(ctx, "a_full.jpg", []byte("jpeg"))
+		require.NoError(t, err)
+		assert.Equal(t, filepath.Join(dir, "a_full.jpg"), url)
+
+		data, err := os.ReadFile(url)
+		require.NoError(t, err)
+		assert.Equal(t, []byte("jpeg"), data)
+
+		require.NoError(t, storage.DeleteImage(ctx, url))
+		assert.ErrorIs(t, storage.DeleteImage(ctx, url), models.ErrNotFound)
+	})
+
+	t.Run("имя файла не выходит за каталог", func(t *testing.T) {
+		url, err := storage.SaveImage(ctx, "../../b_full.jpg", []byte("jpeg"))
+		require.NoError(t, err)
+		assert.Equal(t, filepath.Join(dir, "b_full.jpg"), url)
+	})
+}
+
+func TestNewStorage(t *testing.T) {
+	t.Parallel()
+
+	storage, err := NewStorage(ImageConfig{Path: t.TempDir()})
+	require.NoError(t, err)
+	assert.IsType(t, &LocalStorage{}, storage)
+
+	storage, err = NewStorage(ImageConfig{Storage: StorageS3, S3: S3Config{Endpoint: "localhost:9000", Bucket: "images"}})
+	require.NoError(t, err)
+	assert.IsType(t, &S3Storage{}, storage)
+
+	_, err = NewStorage(ImageConfig{Storage: "ftp"})
+	assert.Error(t, err)
+}
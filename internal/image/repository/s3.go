@@ -0,0 +1,85 @@
+package images
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"mime"
+	"path"
+	"strings"
+
+	"kudago/internal/models"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+// Image names are unique, so an object never changes once it is written.
+const imageCacheControl = "public, max-age=31536000, immutable"
+
+type S3Config struct {
+	Endpoint  string
+	Region    string
+	Bucket    string
+	AccessKey string
+	SecretKey string
+	UseSSL    bool
+	// PublicURL is where the bucket is served from: a CDN, the bucket website
+	// endpoint or a proxy in front of it.
+	PublicURL string
+}
+
+// S3Storage keeps images in an S3 compatible bucket and returns their public
+// URLs. Presigned URLs are not used: they expire, while the URLs are stored
+// with events and users for good.
+type S3Storage struct {
+	client    *minio.Client
+	bucket    string
+	publicURL string
+}
+
+func NewS3Storage(config S3Config) (*S3Storage, error) {
+	client, err := minio.New(config.Endpoint, &minio.Options{
+		Creds:  credentials.NewStaticV4(config.AccessKey, config.SecretKey, ""),
+		Secure: config.UseSSL,
+		Region: config.Region,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", models.LevelDB, err)
+	}
+
+	return &S3Storage{
+		client:    client,
+		bucket:    config.Bucket,
+		publicURL: strings.TrimSuffix(config.PublicURL, "/"),
+	}, nil
+}
+
+func (s *S3Storage) SaveImage(ctx context.Context, name string, data []byte) (string, error) {
+	key := path.Base(name)
+	_, err := s.client.PutObject(ctx, s.bucket, key, bytes.NewReader(data), int64(len(data)), minio.PutObjectOptions{
+		ContentType:  mime.TypeByExtension(path.Ext(key)),
+		CacheControl: imageCacheControl,
+	})
+	if err != nil {
+		return "", fmt.Errorf("%s: %w", models.LevelDB, err)
+	}
+
+	return s.publicURL + "/" + key, nil
+}
+
+// DeleteImage removes the object behind a URL returned by SaveImage. URLs
+// from elsewhere, e.g. images stored locally before the switch to S3, are
+// reported as not found.
+func (s *S3Storage) DeleteImage(ctx context.Context, imageURL string) error {
+	key, ok := strings.CutPrefix(imageURL, s.publicURL+"/")
+	if !ok || key == "" {
+		return fmt.Errorf("%s: %w", models.LevelDB, models.ErrNotFound)
+	}
+
+	err := s.client.RemoveObject(ctx, s.bucket, key, minio.RemoveObjectOptions{})
+	if err != nil {
+		return fmt.Errorf("%s: %w", models.LevelDB, err)
+	}
+	return nil
+}
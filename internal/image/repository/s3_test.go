@@ -0,0 +1,159 @@
+package images
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"kudago/internal/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type storedObject struct {
+	data         []byte
+	contentType  string
+	cacheControl string
+}
+
+// fakeS3 is an in-memory stand-in for an S3 compatible server. It handles
+// only the requests S3Storage makes.
+type fakeS3 struct {
+	mu      sync.Mutex
+	objects map[string]storedObject
+}
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	key := strings.TrimPrefix(r.URL.Path, "/")
+	switch r.Method {
+	case http.MethodPut:
+		data, err := io.ReadAll(r.Body)
+		if err == nil && strings.HasPrefix(r.Header.Get("X-Amz-Content-Sha256"), "STREAMING-") {
+			data, err = decodeAWSChunked(data)
+		}
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		f.objects[key] = storedObject{
+			data:         data,
+			contentType:  r.Header.Get("Content-Type"),
+			cacheControl: r.Header.Get("Cache-Control"),
+		}
+		w.Header().Set("ETag", `"etag"`)
+		w.WriteHeader(http.StatusOK)
+	case http.MethodDelete:
+		delete(f.objects, key)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+// decodeAWSChunked strips the chunk signatures of a streaming upload: clients
+// sign every chunk when they talk to S3 over plain HTTP.
+func decodeAWSChunked(body []byte) ([]byte, error) {
+	var data []byte
+	for {
+		header, rest, ok := bytes.Cut(body, []byte("\r\n"))
+		if !ok {
+			return nil, errors.New("malformed chunk")
+		}
+		sizeHex, _, _ := bytes.Cut(header, []byte(";"))
+		size, err := strconv.ParseInt(string(sizeHex), 16, 64)
+		if err != nil || int64(len(rest)) < size {
+			return nil, errors.New("malformed chunk")
+		}
+		if size == 0 {
+			return data, nil
+		}
+		data = append(data, rest[:size]...)
+		body = bytes.TrimPrefix(rest[size:], []byte("\r\n"))
+	}
+}
+
+func newTestS3Storage(t *testing.T) (*S3Storage, *fakeS3) {
+	fake := &fakeS3{objects: make(map[string]storedObject)}
+	server := httptest.NewServer(fake)
+	t.Cleanup(server.Close)
+
+	storage, err := NewS3Storage(S3Config{
+		Endpoint:  strings.TrimPrefix(server.URL, "http://"),
+		Region:    "us-east-1",
+		Bucket:    "images",
+		AccessKey: "access",
+		SecretKey: "secret",
+		PublicURL: "https://cdn.example.com/images/",
+	})
+	require.NoError(t, err)
+
+	return storage, fake
+}
+
+func TestS3Storage_SaveImage(t *testing.T) {
+	t.Parallel()
+
+	storage, fake := newTestS3Storage(t)
+
+	url, err := storage.SaveImage(context.Background(), "a_card.webp", []byte("webp"))
+	require.NoError(t, err)
+	assert.Equal(t, "https://cdn.example.com/images/a_card.webp", url)
+
+	object, ok := fake.objects["images/a_card.webp"]
+	require.True(t, ok)
+	assert.Equal(t, []byte("webp"), object.data)
+	assert.Equal(t, "image/webp", object.contentType)
+	assert.Equal(t, imageCacheControl, object.cacheControl)
+}
+
+func TestS3Storage_DeleteImage(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		url     string
+		wantErr error
+		deleted bool
+	}{
+		{
+			name:    "удаление изображения",
+			url:     "https://cdn.example.com/images/a_full.jpg",
+			deleted: true,
+		},
+		{
+			name:    "изображение из локального хранилища",
+			url:     "static/images/a_full.jpg",
+			wantErr: models.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			storage, fake := newTestS3Storage(t)
+			fake.objects["images/a_full.jpg"] = storedObject{data: []byte("jpeg")}
+
+			err := storage.DeleteImage(context.Background(), tt.url)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			_, exists := fake.objects["images/a_full.jpg"]
+			assert.Equal(t, tt.deleted, !exists)
+		})
+	}
+}
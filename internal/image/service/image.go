@@ -0,0 +1,181 @@
+package service
+
+//go:generate mockgen -source ./image.go -destination=./mocks/image.go -package=mocks
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+
+	"kudago/internal/models"
+
+	"github.com/chai2010/webp"
+	"github.com/disintegration/imaging"
+)
+
+const (
+	jpegQuality = 85
+	webpQuality = 80
+
+	// maxPixels keeps a small file that decodes into a huge bitmap from
+	// exhausting the memory of the service.
+	maxPixels = 40_000_000
+)
+
+// imageSize describes a variant. Cropped variants fill the box exactly, the
+// rest are scaled down to fit into it and are never enlarged.
+type imageSize struct {
+	name   string
+	width  int
+	height int
+	crop   bool
+}
+
+var imageSizes = []imageSize{
+	{name: models.ImageThumb, width: 320, height: 320, crop: true},
+	{name: models.ImageCard, width: 800, height: 450, crop: true},
+	{name: models.ImageFull, width: 1920, height: 1920},
+}
+
+type ImageService struct {
+	Storage ImageStorage
+}
+
+// ImageStorage keeps encoded images and returns the URLs they are served
+// from.
+type ImageStorage interface {
+	SaveImage(ctx context.Context, name string, data []byte) (string, error)
+	DeleteImage(ctx context.Context, imageURL string) error
+}
+
+func NewService(storage ImageStorage) ImageService {
+	return ImageService{Storage: storage}
+}
+
+// UploadImage renders the upload into every size in JPEG and WebP. Decoding
+// applies the EXIF orientation and re-encoding drops the metadata, so
+// location and camera details of the original are never stored.
+func (s *ImageService) UploadImage(ctx context.Context, media models.MediaFile) (models.UploadedImage, error) {
+	defer media.File.Close()
+
+	data, err := io.ReadAll(media.File)
+	if err != nil {
+		return models.UploadedImage{}, fmt.Errorf("%s: %w", models.LevelService, err)
+	}
+
+	img, err := decodeImage(data)
+	if err != nil {
+		return models.UploadedImage{}, err
+	}
+
+	base := strings.TrimSuffix(filepath.Base(media.Filename), filepath.Ext(media.Filename))
+	var uploaded models.UploadedImage
+	for _, size := range imageSizes {
+		resized := size.resize(img)
+		for _, format := range []string{models.ImageFormatJPEG, models.ImageFormatWebP} {
+			encoded, err := encodeImage(resized, format)
+			if err != nil {
+				s.deleteVariants(ctx, uploaded.Variants)
+				return models.UploadedImage{}, fmt.Errorf("%s: %w", models.LevelService, err)
+			}
+
+			url, err := s.Storage.SaveImage(ctx, models.ImageVariantName(base, size.name, format), encoded)
+			if err != nil {
+				s.deleteVariants(ctx, uploaded.Variants)
+				return models.UploadedImage{}, err
+			}
+
+			uploaded.Variants = append(uploaded.Variants, models.ImageVariant{Size: size.name, Format: format, URL: url})
+			if size.name == models.ImageFull && format == models.ImageFormatJPEG {
+				uploaded.URL = url
+			}
+		}
+	}
+
+	return uploaded, nil
+}
+
+// DeleteImage removes every variant of the image. Images uploaded before
+// variants existed are a single file.
+func (s *ImageService) DeleteImage(ctx context.Context, imageURL string) error {
+	if models.ImageVariantURL(imageURL, models.ImageFull, models.ImageFormatWebP) == "" {
+		return s.Storage.DeleteImage(ctx, imageURL)
+	}
+
+	for _, size := range imageSizes {
+		for _, format := range []string{models.ImageFormatJPEG, models.ImageFormatWebP} {
+			err := s.Storage.DeleteImage(ctx, models.ImageVariantURL(imageURL, size.name, format))
+			if err != nil && !errors.Is(err, models.ErrNotFound) {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (s *ImageService) deleteVariants(ctx context.Context, variants []models.ImageVariant) {
+	for _, variant := range variants {
+		s.Storage.DeleteImage(ctx, variant.URL)
+	}
+}
+
+func decodeImage(data []byte) (image.Image, error) {
+	if !models.IsSupportedImageType(http.DetectContentType(data)) {
+		return nil, fmt.Errorf("%s: %w", models.LevelService, models.ErrUnsupportedFile)
+	}
+
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", models.LevelService, models.ErrInvalidImage)
+	}
+	if config.Width*config.Height > maxPixels {
+		return nil, fmt.Errorf("%s: %w", models.LevelService, models.ErrInvalidImage)
+	}
+
+	img, err := imaging.Decode(bytes.NewReader(data), imaging.AutoOrientation(true))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", models.LevelService, models.ErrInvalidImage)
+	}
+	return img, nil
+}
+
+func (size imageSize) resize(img image.Image) image.Image {
+	if size.crop {
+		return imaging.Fill(img, size.width, size.height, imaging.Center, imaging.Lanczos)
+	}
+	return imaging.Fit(img, size.width, size.height, imaging.Lanczos)
+}
+
+func encodeImage(img image.Image, format string) ([]byte, error) {
+	var buf bytes.Buffer
+	var err error
+	switch format {
+	case models.ImageFormatWebP:
+		err = webp.Encode(&buf, img, &webp.Options{Quality: webpQuality})
+	default:
+		err = imaging.Encode(&buf, flatten(img), imaging.JPEG, imaging.JPEGQuality(jpegQuality))
+	}
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// flatten puts a transparent image on a white background, otherwise the
+// transparent parts of a PNG turn black in JPEG.
+func flatten(img image.Image) image.Image {
+	if opaque, ok := img.(interface{ Opaque() bool }); ok && opaque.Opaque() {
+		return img
+	}
+
+	bounds := img.Bounds()
+	background := imaging.New(bounds.Dx(), bounds.Dy(), color.White)
+	return imaging.Overlay(background, img, image.Pt(0, 0), 1)
+}
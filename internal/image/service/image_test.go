@@ -0,0 +1,204 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"testing"
+
+	"kudago/internal/image/service/mocks"
+	"kudago/internal/models"
+
+	_ "github.com/chai2010/webp"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type readSeekCloser struct {
+	*bytes.Reader
+}
+
+func (r *readSeekCloser) Close() error {
+	return nil
+}
+
+func mediaFile(name string, data []byte) models.MediaFile {
+	return models.MediaFile{Filename: name, File: &readSeekCloser{Reader: bytes.NewReader(data)}}
+}
+
+// rotatedJPEG returns a landscape JPEG whose EXIF says it has to be turned
+// 90 degrees clockwise to be displayed.
+func rotatedJPEG(t *testing.T, width, height int) []byte {
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for x := 0; x < width; x++ {
+		for y := 0; y < height; y++ {
+			img.Set(x, y, color.RGBA{R: 200, G: 100, B: 50, A: 255})
+		}
+	}
+	var buf bytes.Buffer
+	require.NoError(t, jpeg.Encode(&buf, img, nil))
+
+	exif := []byte("Exif\x00\x00" +
+		"MM\x00\x2a\x00\x00\x00\x08" +
+		"\x00\x01" +
+		"\x01\x12\x00\x03\x00\x00\x00\x01\x00\x06\x00\x00" +
+		"\x00\x00\x00\x00")
+	segment := append([]byte{0xff, 0xe1, byte((len(exif) + 2) >> 8), byte(len(exif) + 2)}, exif...)
+
+	encoded := buf.Bytes()
+	return append(append(append([]byte{}, encoded[:2]...), segment...), encoded[2:]...)
+}
+
+func TestImageService_UploadImage(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	mockStorage := mocks.NewMockImageStorage(ctrl)
+	service := NewService(mockStorage)
+
+	saved := make(map[string][]byte)
+	mockStorage.EXPECT().SaveImage(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, name string, data []byte) (string, error) {
+			saved[name] = data
+			return "static/images/" + name, nil
+		}).Times(6)
+
+	uploaded, err := service.UploadImage(context.Background(), mediaFile("photo.jpeg", rotatedJPEG(t, 40, 20)))
+	require.NoError(t, err)
+
+	assert.Equal(t, "static/images/photo_full.jpg", uploaded.URL)
+	assert.Len(t, uploaded.Variants, 6)
+
+	expectedSizes := map[string]image.Point{
+		"photo_thumb.jpg":  {X: 320, Y: 320},
+		"photo_thumb.webp": {X: 320, Y: 320},
+		"photo_card.jpg":   {X: 800, Y: 450},
+		"photo_card.webp":  {X: 800, Y: 450},
+		"photo_full.jpg":   {X: 20, Y: 40},
+		"photo_full.webp":  {X: 20, Y: 40},
+	}
+	for name, size := range expectedSizes {
+		data, ok := saved[name]
+		require.True(t, ok, name)
+		assert.False(t, bytes.Contains(data, []byte("Exif")), name)
+
+		config, _, err := image.DecodeConfig(bytes.NewReader(data))
+		require.NoError(t, err, name)
+		assert.Equal(t, size, image.Pt(config.Width, config.Height), name)
+	}
+}
+
+func TestImageService_UploadImage_Errors(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name       string
+		data       func(t *testing.T) []byte
+		setupMocks func(mockStorage *mocks.MockImageStorage)
+		wantErr    error
+	}{
+		{
+			name: "not an image",
+			data: func(t *testing.T) []byte {
+				return []byte("just some text")
+			},
+			setupMocks: func(mockStorage *mocks.MockImageStorage) {},
+			wantErr:    models.ErrUnsupportedFile,
+		},
+		{
+			name: "broken image",
+			data: func(t *testing.T) []byte {
+				return rotatedJPEG(t, 40, 20)[:200]
+			},
+			setupMocks: func(mockStorage *mocks.MockImageStorage) {},
+			wantErr:    models.ErrInvalidImage,
+		},
+		{
+			name: "saved variants removed on failure",
+			data: func(t *testing.T) []byte {
+				return rotatedJPEG(t, 40, 20)
+			},
+			setupMocks: func(mockStorage *mocks.MockImageStorage) {
+				gomock.InOrder(
+					mockStorage.EXPECT().SaveImage(gomock.Any(), "photo_thumb.jpg", gomock.Any()).Return("static/images/photo_thumb.jpg", nil),
+					mockStorage.EXPECT().SaveImage(gomock.Any(), "photo_thumb.webp", gomock.Any()).Return("", models.ErrInternal),
+					mockStorage.EXPECT().DeleteImage(gomock.Any(), "static/images/photo_thumb.jpg").Return(nil),
+				)
+			},
+			wantErr: models.ErrInternal,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			mockStorage := mocks.NewMockImageStorage(ctrl)
+			tc.setupMocks(mockStorage)
+			service := NewService(mockStorage)
+
+			_, err := service.UploadImage(context.Background(), mediaFile("photo.jpeg", tc.data(t)))
+			assert.ErrorIs(t, err, tc.wantErr)
+		})
+	}
+}
+
+func TestImageService_DeleteImage(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name       string
+		url        string
+		setupMocks func(mockStorage *mocks.MockImageStorage)
+		wantErr    error
+	}{
+		{
+			name: "all variants",
+			url:  "static/images/photo_full.jpg",
+			setupMocks: func(mockStorage *mocks.MockImageStorage) {
+				for _, name := range []string{"thumb.jpg", "thumb.webp", "card.jpg", "card.webp", "full.jpg"} {
+					mockStorage.EXPECT().DeleteImage(gomock.Any(), "static/images/photo_"+name).Return(nil)
+				}
+				mockStorage.EXPECT().DeleteImage(gomock.Any(), "static/images/photo_full.webp").Return(models.ErrNotFound)
+			},
+		},
+		{
+			name: "image without variants",
+			url:  "static/images/photo.png",
+			setupMocks: func(mockStorage *mocks.MockImageStorage) {
+				mockStorage.EXPECT().DeleteImage(gomock.Any(), "static/images/photo.png").Return(nil)
+			},
+		},
+		{
+			name: "storage error",
+			url:  "static/images/photo_full.jpg",
+			setupMocks: func(mockStorage *mocks.MockImageStorage) {
+				mockStorage.EXPECT().DeleteImage(gomock.Any(), "static/images/photo_thumb.jpg").Return(models.ErrInternal)
+			},
+			wantErr: models.ErrInternal,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			mockStorage := mocks.NewMockImageStorage(ctrl)
+			tc.setupMocks(mockStorage)
+			service := NewService(mockStorage)
+
+			err := service.DeleteImage(context.Background(), tc.url)
+			if tc.wantErr != nil {
+				assert.True(t, errors.Is(err, tc.wantErr))
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
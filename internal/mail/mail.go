@@ -0,0 +1,74 @@
+package mail
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+const defaultDir = "mail"
+
+type Message struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+type Config struct {
+	Dir string
+}
+
+func GetConfig() Config {
+	dir := os.Getenv("MAIL_DIR")
+	if dir == "" {
+		dir = defaultDir
+	}
+	return Config{Dir: dir}
+}
+
+// FileSender is a local replacement for a real mail provider: every
+// message is stored as an .eml file in Dir, one file per message.
+type FileSender struct {
+	Dir string
+}
+
+func NewFileSender(config Config) *FileSender {
+	return &FileSender{
+		Dir: config.Dir,
+	}
+}
+
+func (s *FileSender) Send(ctx context.Context, msg Message) error {
+	err := os.MkdirAll(s.Dir, 0o755)
+	if err != nil {
+		return fmt.Errorf("create mail dir: %w", err)
+	}
+
+	now := time.Now()
+	name := fmt.Sprintf("%d_%s.eml", now.UnixNano(), sanitize(msg.To))
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "To: %s\r\n", msg.To)
+	fmt.Fprintf(&b, "Subject: %s\r\n", msg.Subject)
+	fmt.Fprintf(&b, "Date: %s\r\n", now.Format(time.RFC1123Z))
+	b.WriteString("Content-Type: text/plain; charset=utf-8\r\n\r\n")
+	b.WriteString(msg.Body)
+
+	err = os.WriteFile(filepath.Join(s.Dir, name), []byte(b.String()), 0o644)
+	if err != nil {
+		return fmt.Errorf("write mail: %w", err)
+	}
+	return nil
+}
+
+func sanitize(address string) string {
+	return strings.Map(func(r rune) rune {
+		if r == '/' || r == '\\' || r == os.PathSeparator {
+			return '_'
+		}
+		return r
+	}, address)
+}
@@ -0,0 +1,36 @@
+package mail
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileSender_Send(t *testing.T) {
+	t.Parallel()
+
+	dir := filepath.Join(t.TempDir(), "mail")
+	sender := NewFileSender(Config{Dir: dir})
+
+	err := sender.Send(context.Background(), Message{
+		To:      "user1@mail.ru",
+		Subject: "Восстановление пароля",
+		Body:    "https://example.com/reset?token=abc",
+	})
+	require.NoError(t, err)
+
+	files, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+	assert.Contains(t, files[0].Name(), "user1@mail.ru")
+
+	data, err := os.ReadFile(filepath.Join(dir, files[0].Name()))
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "To: user1@mail.ru\r\n")
+	assert.Contains(t, string(data), "Subject: Восстановление пароля\r\n")
+	assert.Contains(t, string(data), "https://example.com/reset?token=abc")
+}
@@ -26,11 +26,15 @@ var whitelist = []string{
 	"/static",
 	"/session",
 	"/logout",
+	"/password/reset",
+	"/verify-email",
 	"/docs",
 	"/categories",
 	"/swagger",
 	"/profile",
 	"/metrics",
+	// The calendar feed is fetched by calendar apps, which have no session.
+	"/calendar",
 }
 
 type sessionChecker interface {
@@ -48,6 +52,9 @@ func AuthMiddleware(sessionChecker sessionChecker, next http.Handler) http.Handl
 			sessionPB, err := sessionChecker.CheckSession(r.Context(), req)
 			if err == nil {
 				session := sessionPBToSession(sessionPB)
+				if sessionPB.Renewed {
+					utils.SetSessionCookie(w, session.Token, session.Expires)
+				}
 				ctx := utils.SetSessionInContext(r.Context(), session)
 				r = r.WithContext(ctx)
 				next.ServeHTTP(w, r)
@@ -70,8 +77,10 @@ func AuthMiddleware(sessionChecker sessionChecker, next http.Handler) http.Handl
 func sessionPBToSession(sessionPB *pb.Session) models.Session {
 	expires, _ := time.Parse(time.RFC3339, sessionPB.Expires)
 	return models.Session{
-		UserID:  int(sessionPB.UserID),
-		Token:   sessionPB.Token,
-		Expires: expires,
+		UserID:   int(sessionPB.UserID),
+		Token:    sessionPB.Token,
+		Expires:  expires,
+		Renewed:  sessionPB.Renewed,
+		Verified: sessionPB.Verified,
 	}
 }
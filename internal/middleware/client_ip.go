@@ -0,0 +1,59 @@
+package middleware
+
+import (
+	"net"
+	"net/http"
+	"strings"
+
+	"kudago/internal/gateway/utils"
+)
+
+// ClientIPMiddleware resolves the address of the client. The forwarding
+// headers are only believed when the request comes from one of the trusted
+// proxies, anyone else could set them to pose as another address.
+func ClientIPMiddleware(next http.Handler, trustedProxies []*net.IPNet) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ip := utils.RemoteIP(r)
+		if isTrusted(ip, trustedProxies) {
+			ip = forwardedIP(r, ip, trustedProxies)
+		}
+
+		r = r.WithContext(utils.SetClientIPInContext(r.Context(), ip))
+		next.ServeHTTP(w, r)
+	})
+}
+
+// forwardedIP returns the address set by the proxy. In X-Forwarded-For each
+// proxy appends the address it got the request from, so it is the last one
+// that isn't a trusted proxy: earlier ones come from the client.
+func forwardedIP(r *http.Request, remoteIP string, trustedProxies []*net.IPNet) string {
+	if ip := strings.TrimSpace(r.Header.Get("X-Real-IP")); net.ParseIP(ip) != nil {
+		return ip
+	}
+
+	forwarded := strings.Split(r.Header.Get("X-Forwarded-For"), ",")
+	for i := len(forwarded) - 1; i >= 0; i-- {
+		ip := strings.TrimSpace(forwarded[i])
+		if net.ParseIP(ip) == nil {
+			break
+		}
+		if !isTrusted(ip, trustedProxies) {
+			return ip
+		}
+	}
+	return remoteIP
+}
+
+func isTrusted(ip string, trustedProxies []*net.IPNet) bool {
+	parsed := net.ParseIP(ip)
+	if parsed == nil {
+		return false
+	}
+
+	for _, network := range trustedProxies {
+		if network.Contains(parsed) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,70 @@
+package middleware
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"kudago/internal/gateway/utils"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClientIPMiddleware(t *testing.T) {
+	t.Parallel()
+
+	_, proxies, _ := net.ParseCIDR("172.18.0.0/16")
+	trusted := []*net.IPNet{proxies}
+
+	tests := []struct {
+		name       string
+		remoteAddr string
+		headers    map[string]string
+		expected   string
+	}{
+		{
+			name:       "заголовки от клиента не учитываются",
+			remoteAddr: "203.0.113.7:51000",
+			headers:    map[string]string{"X-Real-IP": "10.0.0.1", "X-Forwarded-For": "10.0.0.1"},
+			expected:   "203.0.113.7",
+		},
+		{
+			name:       "адрес от доверенного прокси",
+			remoteAddr: "172.18.0.2:51000",
+			headers:    map[string]string{"X-Real-IP": "203.0.113.7"},
+			expected:   "203.0.113.7",
+		},
+		{
+			name:       "подставленный клиентом X-Forwarded-For пропускается",
+			remoteAddr: "172.18.0.2:51000",
+			headers:    map[string]string{"X-Forwarded-For": "10.0.0.1, 203.0.113.7, 172.18.0.3"},
+			expected:   "203.0.113.7",
+		},
+		{
+			name:       "доверенный прокси без заголовков",
+			remoteAddr: "172.18.0.2:51000",
+			expected:   "172.18.0.2",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var got string
+			handler := ClientIPMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				got = utils.GetClientIP(r)
+			}), trusted)
+
+			req := httptest.NewRequest(http.MethodPost, "/login", nil)
+			req.RemoteAddr = tt.remoteAddr
+			for key, value := range tt.headers {
+				req.Header.Set(key, value)
+			}
+			handler.ServeHTTP(httptest.NewRecorder(), req)
+
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
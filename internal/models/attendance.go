@@ -0,0 +1,30 @@
+package models
+
+import "time"
+
+const (
+	AttendanceStatusAttending  = "attending"
+	AttendanceStatusWaitlisted = "waitlisted"
+)
+
+type Attendee struct {
+	UserID      int
+	Username    string
+	ImageURL    string
+	RSVPAt      time.Time
+	CheckedInAt time.Time
+}
+
+// WaitlistEntry is a user promoted from the waitlist who holds a freed spot
+// until ClaimUntil.
+type WaitlistEntry struct {
+	EventID    int
+	UserID     int
+	ClaimUntil time.Time
+}
+
+type AttendanceResult struct {
+	Status   string
+	Position int
+	Promoted []WaitlistEntry
+}
@@ -1,6 +1,7 @@
 package models
 
 type Credentials struct {
-	Username string
+	Login    string
 	Password string
+	IP       string
 }
@@ -0,0 +1,32 @@
+package models
+
+const (
+	MinAnswer = 1
+	MaxAnswer = 10
+)
+
+type Test struct {
+	ID        int
+	Title     string
+	Questions []Question
+}
+
+type Question struct {
+	ID   int
+	Text string
+}
+
+type Answer struct {
+	QuestionID int
+	Value      int
+}
+
+// QuestionStatistics aggregates the answers to a question. Distribution[i]
+// holds the number of answers equal to i+MinAnswer.
+type QuestionStatistics struct {
+	QuestionID   int
+	Question     string
+	Average      float64
+	Total        int
+	Distribution []int
+}
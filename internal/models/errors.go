@@ -1,6 +1,9 @@
 package models
 
-import "errors"
+import (
+	"errors"
+	"time"
+)
 
 var (
 	ErrEventNotFound       = errors.New("event not found")
@@ -11,9 +14,28 @@ var (
 	ErrInvalidImageFormat  = errors.New("invalid image format")
 	ErrInvalidImage        = errors.New("invalid image")
 	ErrUnsupportedFile     = errors.New("unsupported file type")
+	ErrImageTooLarge       = errors.New("image is too large")
 	ErrForeignKeyViolation = errors.New("violates foreign key constraint")
 	ErrNotFound            = errors.New("not found")
 	ErrNothingToInsert     = errors.New("nothing to insert")
+	ErrWrongPassword       = errors.New("wrong password")
+	ErrInvalidToken        = errors.New("invalid or expired token")
+	ErrTicketTypeNotFound  = errors.New("ticket type not found")
+	ErrTicketNotFound      = errors.New("ticket not found")
+	ErrNoTicketsLeft       = errors.New("no tickets left")
+	ErrEventNotStarted     = errors.New("event has not started yet")
+	ErrTestNotFound        = errors.New("test not found")
+	ErrQuestionNotFound    = errors.New("question not found")
+	ErrAlreadyAnswered     = errors.New("user already answered the question")
+	ErrInvalidAnswers      = errors.New("invalid answers")
+	ErrInvalidCursor       = errors.New("invalid cursor")
+	ErrInvalidRecurrence   = errors.New("invalid recurrence rule or exceptions")
+	ErrInvalidStatus       = errors.New("invalid event status")
+	ErrNotDraft            = errors.New("event is not a draft")
+	ErrNotPublished        = errors.New("event is not published")
+	ErrImageNotFound       = errors.New("image not found")
+	ErrTooManyImages       = errors.New("too many images")
+	ErrInvalidImageOrder   = errors.New("image order must list every image of the event once")
 )
 
 const (
@@ -42,3 +64,11 @@ var (
 func (e AuthError) Error() string {
 	return e.Message
 }
+
+type LockoutError struct {
+	RetryAfter time.Duration
+}
+
+func (e LockoutError) Error() string {
+	return "too many login attempts"
+}
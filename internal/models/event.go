@@ -2,24 +2,82 @@ package models
 
 import "time"
 
+const (
+	// EventStatusDraft events are visible to their author only.
+	EventStatusDraft     = "draft"
+	EventStatusPublished = "published"
+	EventStatusCancelled = "cancelled"
+	// EventStatusArchived events are kept for history but left out of listings.
+	EventStatusArchived = "archived"
+)
+
 type Event struct {
-	ID          int       `json:"id"`
-	Title       string    `json:"title"`
-	Description string    `json:"description"`
-	EventStart  string    `json:"event_start"`
-	EventEnd    string    `json:"event_finish"`
-	Location    string    `json:"location"`
-	Capacity    int       `json:"capacity"`
-	CreatedAt   time.Time `json:"created_at"`
-	CategoryID  int       `json:"category_id"`
-	AuthorID    int       `json:"author"`
-	Latitude    float64   `json:"Latitude"`
-	Longitude   float64   `json:"Longitude"`
-	Tag         []string  `json:"tag"`
-	ImageURL    string    `json:"image"`
+	ID             int       `json:"id"`
+	Title          string    `json:"title"`
+	Description    string    `json:"description"`
+	EventStart     string    `json:"event_start"`
+	EventEnd       string    `json:"event_finish"`
+	Location       string    `json:"location"`
+	Capacity       int       `json:"capacity"`
+	CreatedAt      time.Time `json:"created_at"`
+	CategoryID     int       `json:"category_id"`
+	AuthorID       int       `json:"author"`
+	Latitude       float64   `json:"Latitude"`
+	Longitude      float64   `json:"Longitude"`
+	Tag            []string  `json:"tag"`
+	ImageURL       string    `json:"image"`
+	Attendees      int       `json:"attendees"`
+	FavoritesCount int       `json:"favorites_count"`
+	Status         string    `json:"status"`
+	// PublishAt is the RFC 3339 time a draft gets published automatically.
+	PublishAt string `json:"publish_at,omitempty"`
+	// CancelReason is the author's explanation shown on a cancelled event.
+	CancelReason string `json:"cancel_reason,omitempty"`
+	// Images is the gallery in display order, loaded for a single event only.
+	Images []EventImage `json:"images,omitempty"`
+	// ReplacedImageURL is the cover replaced by an update, set only by
+	// UpdateEvent so that the caller can delete its files.
+	ReplacedImageURL string `json:"-"`
+
+	// Search snippets with matches wrapped in <mark>, set only by SearchEvents.
+	TitleHighlight       string `json:"title_highlight,omitempty"`
+	DescriptionHighlight string `json:"description_highlight,omitempty"`
+	// Distance in meters from the search point, nil when it wasn't given.
+	Distance *float64 `json:"distance,omitempty"`
+
+	// RecurrenceRule is an RFC 5545 RRULE; EventStart and EventEnd then hold
+	// the first occurrence of an event loaded by ID, and in listings the
+	// occurrence the series is listed by.
+	RecurrenceRule string `json:"recurrence_rule,omitempty"`
+	// RecurrenceExceptions are RFC 3339 starts of skipped occurrences.
+	RecurrenceExceptions []string `json:"recurrence_exceptions,omitempty"`
+	// SeriesStart is the first occurrence of the series an occurrence is
+	// expanded from.
+	SeriesStart string `json:"series_start,omitempty"`
+	// SavedOccurrence marks a favorite saved for this occurrence of the
+	// series only.
+	SavedOccurrence bool `json:"saved_occurrence,omitempty"`
+	// Start is EventStart at full precision. EventStart is formatted to
+	// whole seconds, so listing cursors are built from Start instead.
+	Start time.Time `json:"-"`
+}
+
+// Cursor points the next page of a listing right after the event.
+func (e Event) Cursor() Cursor {
+	return Cursor{EventStart: e.Start, ID: e.ID}
+}
+
+type EventImage struct {
+	ID       int    `json:"id"`
+	URL      string `json:"url"`
+	Position int    `json:"position"`
+	IsCover  bool   `json:"is_cover"`
 }
 
 type FavoriteEvent struct {
 	EventID int `json:"event_id"`
 	UserID  int `json:"user_id"`
+	// OccurrenceStart narrows the favorite to one occurrence of a series;
+	// empty means the whole event.
+	OccurrenceStart string `json:"occurrence_start,omitempty"`
 }
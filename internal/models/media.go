@@ -1,8 +1,72 @@
 package models
 
-import "io"
+import (
+	"io"
+	"strings"
+)
 
 type MediaFile struct {
 	Filename string
 	File     io.ReadSeekCloser
 }
+
+// MaxImageSize is the largest upload the image service accepts.
+const MaxImageSize = 10 * 1024 * 1024 // 10Mb
+
+// IsSupportedImageType reports whether uploads of the detected content type
+// are accepted.
+func IsSupportedImageType(contentType string) bool {
+	supportedTypes := map[string]bool{
+		"image/jpeg": true,
+		"image/jpg":  true,
+		"image/png":  true,
+		"image/gif":  true,
+	}
+
+	return supportedTypes[contentType]
+}
+
+// Sizes and formats the image service renders every upload into.
+const (
+	ImageThumb = "thumb"
+	ImageCard  = "card"
+	ImageFull  = "full"
+
+	ImageFormatJPEG = "jpg"
+	ImageFormatWebP = "webp"
+)
+
+// ImageVariant is one stored rendition of an uploaded image.
+type ImageVariant struct {
+	Size   string
+	Format string
+	URL    string
+}
+
+// UploadedImage is the result of an upload. URL points to the full size JPEG
+// and is the one stored with events and users: the other variants are derived
+// from it with ImageVariantURL.
+type UploadedImage struct {
+	URL      string
+	Variants []ImageVariant
+}
+
+// ImageVariantName names a variant of the image with the given base name.
+func ImageVariantName(base, size, format string) string {
+	return base + "_" + size + "." + format
+}
+
+// ImageVariantURL returns the URL of a variant of the image stored at url.
+// Images uploaded before variants existed only have the original, which is
+// returned for JPEG; there is no WebP for them.
+func ImageVariantURL(url, size, format string) string {
+	base, ok := strings.CutSuffix(url, "_"+ImageFull+"."+ImageFormatJPEG)
+	if !ok {
+		if format == ImageFormatJPEG {
+			return url
+		}
+		return ""
+	}
+
+	return ImageVariantName(base, size, format)
+}
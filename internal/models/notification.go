@@ -3,6 +3,16 @@ package models
 
 import "time"
 
+// CreatedEventMessage is sent to subscribers of the author of a newly
+// published event.
+const CreatedEventMessage = "У вас новое мероприятие в подписках! . Посмотреть тут:"
+
+// WaitlistPromotedMessage is sent to a user promoted from the waitlist, it is
+// formatted with the end of the claim window in ClaimDeadlineLayout.
+const WaitlistPromotedMessage = "Освободилось место на мероприятии! Подтвердите участие до %s. Посмотреть тут:"
+
+const ClaimDeadlineLayout = "02.01.2006 15:04 MST"
+
 //easyjson:json
 type Notification struct {
 	ID       int       `json:"id"`
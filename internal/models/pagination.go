@@ -1,6 +1,51 @@
 package models
 
+import (
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
 type PaginationParams struct {
 	Offset int
 	Limit  int
+	Cursor *Cursor
+}
+
+// Cursor points at the last event of the previous page; the next page starts
+// strictly after (EventStart, ID) in the listing order.
+type Cursor struct {
+	EventStart time.Time
+	ID         int
+}
+
+func (c Cursor) Encode() string {
+	raw := c.EventStart.UTC().Format(time.RFC3339Nano) + "|" + strconv.Itoa(c.ID)
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func DecodeCursor(token string) (Cursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return Cursor{}, fmt.Errorf("%w: %w", ErrInvalidCursor, err)
+	}
+
+	start, id, ok := strings.Cut(string(raw), "|")
+	if !ok {
+		return Cursor{}, ErrInvalidCursor
+	}
+
+	eventStart, err := time.Parse(time.RFC3339Nano, start)
+	if err != nil {
+		return Cursor{}, fmt.Errorf("%w: %w", ErrInvalidCursor, err)
+	}
+
+	ID, err := strconv.Atoi(id)
+	if err != nil || ID <= 0 {
+		return Cursor{}, ErrInvalidCursor
+	}
+
+	return Cursor{EventStart: eventStart, ID: ID}, nil
 }
@@ -10,4 +10,34 @@ type SearchParams struct {
 	LatitudeMax  float64
 	LongitudeMin float64
 	LongitudeMax float64
+
+	// Radius search around the point, the radius is in meters.
+	Latitude       float64
+	Longitude      float64
+	Radius         float64
+	SortByDistance bool
+}
+
+type SearchFacets struct {
+	Categories []CategoryFacet
+	Tags       []TagFacet
+	Dates      DateFacets
+}
+
+type CategoryFacet struct {
+	CategoryID int
+	Count      int
+}
+
+type TagFacet struct {
+	Tag   string
+	Count int
+}
+
+// DateFacets counts matches overlapping each calendar window: the rest of
+// today, the coming (or current) weekend and the next Monday-to-Sunday week.
+type DateFacets struct {
+	Today       int
+	ThisWeekend int
+	NextWeek    int
 }
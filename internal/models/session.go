@@ -7,7 +7,18 @@ const (
 )
 
 type Session struct {
-	UserID  int
-	Token   string
-	Expires time.Time
+	UserID   int
+	Token    string
+	Expires  time.Time
+	Renewed  bool
+	Verified bool
+}
+
+type SessionInfo struct {
+	ID         string
+	UserAgent  string
+	IP         string
+	CreatedAt  time.Time
+	LastUsedAt time.Time
+	Current    bool
 }
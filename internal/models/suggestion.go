@@ -0,0 +1,17 @@
+package models
+
+type Suggestions struct {
+	Events []EventSuggestion
+	Tags   []TagSuggestion
+}
+
+type EventSuggestion struct {
+	ID             int
+	Title          string
+	FavoritesCount int
+}
+
+type TagSuggestion struct {
+	Name           string
+	FavoritesCount int
+}
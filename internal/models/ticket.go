@@ -0,0 +1,39 @@
+package models
+
+import "time"
+
+const (
+	TicketStatusReserved = "reserved"
+	TicketStatusPaid     = "paid"
+)
+
+// TicketTypeResource names the ticket type in not found error details so
+// that clients can tell it apart from a missing event.
+const TicketTypeResource = "ticket_type"
+
+type TicketType struct {
+	ID      int
+	EventID int
+	Name    string
+	Price   float64
+}
+
+// TicketTypes lists the ticket types of an event together with the number of
+// seats still available. Remaining is nil when the event has no capacity limit.
+type TicketTypes struct {
+	Types     []TicketType
+	Remaining *int
+}
+
+type Ticket struct {
+	ID            int
+	EventID       int
+	TicketTypeID  int
+	UserID        int
+	Type          string
+	Price         float64
+	Quantity      int
+	Status        string
+	ReservedUntil time.Time
+	BoughtAt      time.Time
+}
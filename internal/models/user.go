@@ -8,6 +8,7 @@ type User struct {
 	Email    string `json:"email"`
 	Password string `json:"password"`
 	ImageURL string `json:"image"`
+	Verified bool   `json:"verified"`
 }
 
 type NewUserData struct {
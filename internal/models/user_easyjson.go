@@ -46,6 +46,8 @@ func easyjson9e1087fdDecodeKudagoInternalModels(in *jlexer.Lexer, out *User) {
 			out.Password = string(in.String())
 		case "image":
 			out.ImageURL = string(in.String())
+		case "verified":
+			out.Verified = bool(in.Bool())
 		default:
 			in.SkipRecursive()
 		}
@@ -85,6 +87,11 @@ func easyjson9e1087fdEncodeKudagoInternalModels(out *jwriter.Writer, in User) {
 		out.RawString(prefix)
 		out.String(string(in.ImageURL))
 	}
+	{
+		const prefix string = ",\"verified\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Verified))
+	}
 	out.RawByte('}')
 }
 
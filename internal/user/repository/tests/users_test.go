@@ -91,7 +91,7 @@ func TestUserRepository_UpdateUser(t *testing.T) {
 				ImageURL: "http://example.com/avatar.jpg",
 			},
 			mockSetup: func(m pgxmock.PgxConnIface) {
-				m.ExpectQuery(`UPDATE "USER" SET username = COALESCE\(\$2, username\), email = COALESCE\(\$3, email\), URL_to_avatar = COALESCE\(\$4, URL_to_avatar\), modified_at = NOW\(\) WHERE id = \$1 RETURNING id, username, email, URL_to_avatar`).
+				m.ExpectQuery(`UPDATE "USER" SET username = COALESCE\(\$2, username\), email = COALESCE\(\$3, email\), URL_to_avatar = COALESCE\(\$4, URL_to_avatar\), modified_at = NOW\(\), verified_at = CASE WHEN COALESCE\(\$3, email\) = email THEN verified_at END WHERE id = \$1 RETURNING id, username, email, URL_to_avatar`).
 					WithArgs(3, "invalidUser", "invalid@example.com", "http://example.com/avatar.jpg").
 					WillReturnError(fmt.Errorf("database error"))
 			},
@@ -13,7 +13,8 @@ const updateUserQuery = `
 		username = COALESCE($2, username), 
 		email = COALESCE($3, email), 
 		URL_to_avatar = COALESCE($4, URL_to_avatar), 
-		modified_at = NOW()
+		modified_at = NOW(),
+		verified_at = CASE WHEN COALESCE($3, email) = email THEN verified_at END
 	WHERE id = $1 
 	RETURNING id, username, email, URL_to_avatar
 `